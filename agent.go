@@ -13,14 +13,16 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
-	"net"
 	"os"
 	"os/exec"
 	"os/signal"
 	"path/filepath"
+	"regexp"
 	"runtime"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -95,6 +97,58 @@ type process struct {
 	exitCodeCh  chan int
 	sync.Once
 	stdinClosed bool
+
+	// Structured termination reason, filled once the process has been
+	// waited for, under the process lock.
+	exitReasonSet bool
+	exitReason    pb.WaitProcessResponse_Reason
+	exitSignal    int32
+
+	// PID of a process re-adopted after an agent restart, libcontainer
+	// having no handle on such a process.
+	restoredPid int
+
+	// Copies of the output read by the runtime.
+	stdoutLog *rotatingFile
+	stderrLog *rotatingFile
+
+	// Buffering of the output read by the runtime, the output read but
+	// not delivered yet being kept in the buffers.
+	outputBuffering pb.ExecProcessRequest_OutputBuffering
+	flushInterval   time.Duration
+	stdoutBuffer    *outputBuffer
+	stderrBuffer    *outputBuffer
+
+	// Timestamping of the output lines read by the runtime, and copied
+	// to the output log.
+	stdoutTimestamper *outputTimestamper
+	stderrTimestamper *outputTimestamper
+
+	// File mode creation mask of the process, the agent one being
+	// inherited by exec processes when nil.
+	umask *uint32
+
+	// Nice value of the process, overriding the container one when set.
+	nice *int
+
+	// Variables set to the descriptors passed to the libcontainer init
+	// process, listed in its extra files.
+	initFds []string
+
+	// Socket the umask is passed to the libcontainer init process on, and
+	// its end passed to the process.
+	umaskSock     *os.File
+	umaskSockPeer *os.File
+
+	// Socket the notification descriptor of the seccomp filter of the
+	// process is received on, and its end passed to the process.
+	seccompSock     *os.File
+	seccompSockPeer *os.File
+
+	// Outcome of the supervision of the seccomp notifications, and the
+	// notification descriptor when supervised by the agent.
+	seccompErrCh    chan error
+	seccompListener *os.File
 }
 
 type container struct {
@@ -107,7 +161,71 @@ type container struct {
 	processes       map[string]*process
 	mounts          []string
 	useSandboxPidNs bool
+	useCgroupNs     bool
 	ctx             context.Context
+
+	// Set when an OOM kill has been notified for the container cgroups.
+	oomKilled uint32
+
+	// Project quota limiting the container rootfs usage, if any.
+	quotaDevice    string
+	quotaProjectID uint32
+
+	// Execution domain of the container processes, the agent one being
+	// inherited when nil.
+	personality *uint32
+
+	// File mode creation mask of the container process, 0022 being set
+	// by libcontainer when nil.
+	umask *uint32
+
+	// Signal sent by stop requests, SIGTERM when zero.
+	stopSignal syscall.Signal
+
+	// Processes signaled by stop requests, stopPolicyInit when empty.
+	stopPolicy string
+
+	// CPU time, in microseconds, the cgroup of the container can
+	// accumulate beyond its quota.
+	cpuBurst uint64
+
+	// The OOM killer is disabled for the container, or its usage
+	// throttled below the memory limit with cgroup v2.
+	oomKillDisable bool
+
+	// Set while the agent intervenes on an OOM of the container, its
+	// OOM killer being disabled.
+	oomIntervening uint32
+
+	// inotify instance watching the memory events of the container
+	// cgroup v2, when its usage is throttled.
+	memoryHighWatch *os.File
+
+	// Nice value of the container processes, the agent one being
+	// inherited when nil.
+	nice *int
+
+	// Closed once the start of the container completes, when it timed
+	// out.
+	startDone chan struct{}
+
+	// Offsets of the clocks of the time namespaces of the container
+	// processes, the agent one being shared when nil.
+	timeOffsets *timeOffsets
+
+	// Syscalls of the container processes supervised from user space,
+	// if any.
+	seccompNotify *seccompNotify
+
+	// Recent samples of the container working set, if sampled.
+	workingSet *workingSetSampler
+
+	// Mount points of the storages waited for before starting the
+	// container.
+	storageDeps []string
+
+	// Mount point of the rootfs exposed to the host, if any.
+	exposedRootfs string
 }
 
 type sandboxStorage struct {
@@ -121,7 +239,7 @@ type sandbox struct {
 	id                string
 	hostname          string
 	containers        map[string]*container
-	channel           channel
+	channels          []channel
 	network           network
 	wg                sync.WaitGroup
 	sharedPidNs       namespace
@@ -135,11 +253,38 @@ type sandbox struct {
 	guestHooks        *specs.Hooks
 	guestHooksPresent bool
 	running           bool
+	created           bool
 	noPivotRoot       bool
 	enableGrpcTrace   bool
 	sandboxPidNs      bool
 	storages          map[string]*sandboxStorage
 	stopServer        chan struct{}
+	shmMounted        bool
+	shmSize           uint64
+	quotaProjectID    uint32
+
+	// Serializes the reservations of the exec processes when their
+	// number is limited.
+	execLock sync.Mutex
+
+	// Number of exec processes being spawned, by container ID, counted
+	// against the maximum numbers of exec processes.
+	pendingExecs map[string]int
+
+	// IDs of the containers being created, counted against the maximum
+	// number of containers.
+	pendingContainers map[string]bool
+
+	// Serializes the CreateSandbox requests, a replayed request waiting
+	// for the original one to complete.
+	createLock sync.Mutex
+
+	// Layers being transferred, by path.
+	layersLock sync.Mutex
+	layers     map[string]*layerTransfer
+
+	// Timings of the agent initialization phases.
+	initTimings *initTimings
 }
 
 var agentFields = logrus.Fields{
@@ -171,12 +316,85 @@ var debugConsole = false
 // Specify a vsock port where logs are written.
 var logsVSockPort = uint32(0)
 
+// Specify a syslog endpoint, e.g. vsock://2:514, where logs are forwarded
+// in addition to the console.
+var syslogDestination = ""
+
 // Specify a vsock port where debug console is attached.
 var debugConsoleVSockPort = uint32(0)
 
 // Timeout waiting for a device to be hotplugged
 var hotplugTimeout = 3 * time.Second
 
+// Interval between the sysfs checks of a hotplugged PCI device, for guests
+// where uevents cannot be relied on. Uevents are used when 0.
+var pciPollInterval time.Duration
+
+// Log an audit entry for each container process spawned.
+var auditLog = false
+
+// Raise fs.nr_open when a container requests a higher RLIMIT_NOFILE.
+var raiseNrOpen = false
+
+// Allow exposing the container rootfs read-only to the host for inspection.
+var rootfsInspect = false
+
+// Allow the host to request profiles of the agent.
+var profiling = false
+
+// Apply the AppArmor profiles of the container processes, which are
+// ignored otherwise.
+var apparmorProfiles = false
+
+// Accept a CreateSandbox request replayed for the sandbox already created,
+// as over a flaky channel, without setting it up again.
+var sandboxReplay = true
+
+// Default nice value of the container processes, unless overridden per
+// container. The agent one is inherited when nil.
+var defaultNice *int
+
+// Interval between the samples of the container working sets, sampling
+// being disabled when 0.
+var workingSetInterval = 10 * time.Second
+
+// Policy followed when the guest memory is resized by the balloon, and
+// interval between the checks of its size, checking being disabled when 0.
+var balloonPolicy = balloonPolicyNone
+var balloonInterval = 5 * time.Second
+
+// Policy followed when the name requested for an interface is used by
+// another interface.
+var ifaceNameCollisionPolicy = ifaceNameCollisionError
+
+// Timeout waiting for each unmount attempt before escalating to a lazy,
+// then forced unmount.
+var unmountTimeout = 10 * time.Second
+
+// Timeout waiting for the storages of a container to be mounted before
+// starting it, waiting being disabled when 0.
+var storageReadyTimeout = 30 * time.Second
+
+// Number of attempts to spawn a process when clone/fork fails transiently,
+// and delay before the first retry, doubled after each attempt.
+var spawnAttempts = 3
+var spawnRetryDelay = 100 * time.Millisecond
+
+// Maximum number of containers a sandbox can hold, 0 meaning unlimited.
+var maxContainers = 0
+
+// Maximum number of exec processes, in the sandbox and per container, 0
+// meaning unlimited.
+var maxExecs = 0
+var maxContainerExecs = 0
+
+// Regular expression matching the names of the environment variables
+// stripped from container processes.
+var envDenylist *regexp.Regexp
+
+// if true, denylisted environment variables are masked instead of removed.
+var envMask = false
+
 // commType is used to denote the communication channel type used.
 type commType int
 
@@ -193,6 +411,15 @@ const (
 
 var commCh = unknownCh
 
+// Listeners enabled in addition to, or instead of, the channel detected
+// per commCh. The gRPC server listens on all of them at once.
+var vsockListener = false
+var serialListener = false
+
+// Loopback address of the TCP listener, bound in its own network namespace,
+// disabled when empty.
+var tcpListener = ""
+
 // This is the list of file descriptors we can properly close after the process
 // has been started. When the new process is exec(), those file descriptors are
 // duplicated and it is our responsibility to close them since we have opened
@@ -217,6 +444,37 @@ func (p *process) closePostStartFDs() {
 	if p.consoleSock != nil {
 		p.consoleSock.Close()
 	}
+
+	for _, f := range p.process.ExtraFiles {
+		f.Close()
+	}
+}
+
+// closeStdin closes the stdin pipe of the process, for it to read EOF. This
+// is a no-op for processes using a terminal, which have no stdin pipe, and
+// when stdin is already closed.
+func (p *process) closeStdin() error {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.stdin == nil || p.stdinClosed {
+		return nil
+	}
+
+	if err := p.stdin.Close(); err != nil {
+		return err
+	}
+
+	p.stdinClosed = true
+
+	return nil
+}
+
+func (p *process) isStdinClosed() bool {
+	p.RLock()
+	defer p.RUnlock()
+
+	return p.stdinClosed
 }
 
 // This is the list of file descriptors we can properly close after the process
@@ -244,6 +502,102 @@ func (p *process) closePostExitFDs() {
 	}
 }
 
+// pid returns the PID of the process, be it started by this agent or
+// re-adopted after a restart.
+func (p *process) pid() (int, error) {
+	if p.restoredPid > 0 {
+		return p.restoredPid, nil
+	}
+
+	return p.process.Pid()
+}
+
+// signal sends the signal to the process.
+func (p *process) signal(sig os.Signal) error {
+	if p.restoredPid > 0 {
+		osProcess, err := os.FindProcess(p.restoredPid)
+		if err != nil {
+			return err
+		}
+		return osProcess.Signal(sig)
+	}
+
+	return p.process.Signal(sig)
+}
+
+// getExitReason returns the structured termination reason of the process,
+// derived from the wait status recorded by the subreaper the first time.
+// The OOM kill notified for the container is attributed to the first
+// process found killed by SIGKILL, for the later ones not to be reported
+// as OOM killed as well.
+func (p *process) getExitReason(r reaper, c *container) (pb.WaitProcessResponse_Reason, int32) {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.exitReasonSet {
+		return p.exitReason, p.exitSignal
+	}
+	p.exitReasonSet = true
+
+	pid, err := p.pid()
+	if err != nil {
+		return p.exitReason, p.exitSignal
+	}
+
+	status, err := r.getExitStatus(pid)
+	if err != nil {
+		return p.exitReason, p.exitSignal
+	}
+
+	oomKilled := status.Signaled() && status.Signal() == unix.SIGKILL &&
+		atomic.CompareAndSwapUint32(&c.oomKilled, 1, 0)
+	p.exitReason, p.exitSignal = exitReason(status, oomKilled)
+
+	return p.exitReason, p.exitSignal
+}
+
+// deleteExitStatuses drops the wait statuses recorded by the subreaper for
+// the container processes never waited for.
+func (s *sandbox) deleteExitStatuses(c *container) {
+	c.RLock()
+	defer c.RUnlock()
+
+	for _, proc := range c.processes {
+		if pid, err := proc.pid(); err == nil {
+			s.subreaper.deleteExitStatus(pid)
+		}
+	}
+}
+
+// watchOOM records the OOM kills happening in the container cgroups, so
+// that they can be reported as the exit reason of the killed processes.
+func (c *container) watchOOM() {
+	oomCh, err := c.container.NotifyOOM()
+	if err != nil {
+		agentLog.WithError(err).WithField("container", c.id).Warn("Could not watch OOM events")
+		return
+	}
+
+	if oomCh == nil {
+		return
+	}
+
+	go func() {
+		for range oomCh {
+			// Nothing is killed with the OOM killer disabled, the
+			// agent intervening instead.
+			if c.oomKillDisable && !isCgroupV2() {
+				c.oomLog().Warn("OOM notified with the OOM killer disabled")
+				go c.interveneOOM()
+				continue
+			}
+
+			agentLog.WithField("container", c.id).Info("OOM kill notified")
+			atomic.StoreUint32(&c.oomKilled, 1)
+		}
+	}()
+}
+
 func (c *container) trace(name string) (*agentSpan, context.Context) {
 	if c.ctx == nil {
 		agentLog.WithField("type", "bug").Error("trace called before context set")
@@ -259,6 +613,22 @@ func (c *container) setProcess(process *process) {
 	c.Unlock()
 }
 
+// execCount returns the number of exec processes of the container, which
+// are tracked until waited for.
+func (c *container) execCount() int {
+	c.RLock()
+	defer c.RUnlock()
+
+	count := len(c.processes)
+	if c.initProcess != nil {
+		if _, ok := c.processes[c.initProcess.id]; ok {
+			count--
+		}
+	}
+
+	return count
+}
+
 func (c *container) deleteProcess(execID string) {
 	span, _ := c.trace("deleteProcess")
 	span.setTag("exec-id", execID)
@@ -278,6 +648,26 @@ func (c *container) removeContainer() error {
 		return err
 	}
 
+	// The container mounts must still be removed.
+	if err := removeContainerDNS(c.id); err != nil {
+		agentLog.WithError(err).WithField("container", c.id).Warn("Could not remove container DNS")
+	}
+
+	if err := c.removeContainerQuota(); err != nil {
+		return err
+	}
+
+	if c.initProcess != nil {
+		c.initProcess.closeOutputLog()
+	}
+
+	c.stopWorkingSetSampling()
+	c.stopMemoryHighWatch()
+
+	if err := c.unexposeRootfs(); err != nil {
+		agentLog.WithError(err).WithField("container", c.id).Warn("Could not remove exposed rootfs")
+	}
+
 	return removeMounts(c.mounts)
 }
 
@@ -450,6 +840,50 @@ func (s *sandbox) getContainer(id string) (*container, error) {
 	return ctr, nil
 }
 
+// reserveContainer reserves the ID of a container being created, until
+// released once the container is added to the sandbox or its creation
+// failed. Concurrent creations cannot exceed the maximum number of
+// containers, nor use the same ID.
+func (s *sandbox) reserveContainer(id string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if _, exist := s.containers[id]; exist || s.pendingContainers[id] {
+		return grpcStatus.Errorf(codes.AlreadyExists, "Container %s already exists, impossible to create", id)
+	}
+
+	if maxContainers > 0 && len(s.containers)+len(s.pendingContainers) >= maxContainers {
+		return grpcStatus.Errorf(codes.ResourceExhausted, "Sandbox already holds the maximum of %d containers, impossible to create container %s", maxContainers, id)
+	}
+
+	if s.pendingContainers == nil {
+		s.pendingContainers = make(map[string]bool)
+	}
+	s.pendingContainers[id] = true
+
+	return nil
+}
+
+func (s *sandbox) releaseContainer(id string) {
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.pendingContainers, id)
+}
+
+// execCount returns the number of exec processes of the sandbox.
+func (s *sandbox) execCount() int {
+	s.RLock()
+	defer s.RUnlock()
+
+	count := 0
+	for _, ctr := range s.containers {
+		count += ctr.execCount()
+	}
+
+	return count
+}
+
 func (s *sandbox) setContainer(ctx context.Context, id string, ctr *container) {
 	// Update the context. This is required since the function is called
 	// from by gRPC functions meaning we must use the latest context
@@ -523,6 +957,23 @@ func (s *sandbox) readStdio(cid, execID string, length int, stdout bool) ([]byte
 		return nil, err
 	}
 
+	timestamper := proc.stderrTimestamper
+	if stdout {
+		timestamper = proc.stdoutTimestamper
+	}
+
+	// The timestamper is not locked while reading, for the process state
+	// to be saved meanwhile.
+	if timestamper != nil {
+		timestamper.Lock()
+		data := timestamper.next(length)
+		timestamper.Unlock()
+
+		if data != nil {
+			return data, nil
+		}
+	}
+
 	var file *os.File
 	if proc.termMaster != nil {
 		// The process's epoller's run() will return a file descriptor of the process's
@@ -544,14 +995,41 @@ func (s *sandbox) readStdio(cid, execID string, length int, stdout bool) ([]byte
 		}
 	}
 
-	buf := make([]byte, length)
+	var data []byte
+	if proc.outputBuffering != pb.ExecProcessRequest_NONE {
+		buffer := proc.stderrBuffer
+		if stdout {
+			buffer = proc.stdoutBuffer
+		}
 
-	bytesRead, err := file.Read(buf)
-	if err != nil {
-		return nil, err
+		data, err = proc.readBuffered(file, buffer, length)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		buf := make([]byte, length)
+
+		bytesRead, err := file.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		data = buf[:bytesRead]
+	}
+
+	if timestamper == nil {
+		proc.teeOutput(data, stdout)
+		return data, nil
 	}
 
-	return buf[:bytesRead], nil
+	timestamper.Lock()
+	defer timestamper.Unlock()
+
+	// The output log gets the stamped output as a whole, the runtime
+	// reading it up to the requested length.
+	data = timestamper.stamp(data, time.Now())
+	proc.teeOutput(data, stdout)
+
+	return timestamper.deliver(data, length), nil
 }
 
 func (s *sandbox) setupSharedNamespaces(ctx context.Context) error {
@@ -984,6 +1462,15 @@ func (s *sandbox) initLogger(ctx context.Context) error {
 
 	agentLog.Logger.SetLevel(config.logLevel)
 
+	if syslogDestination != "" {
+		hook, err := newSyslogHook(syslogDestination)
+		if err != nil {
+			agentLog.WithError(err).Warn("Failed to setup syslog forwarding")
+		} else {
+			agentLog.Logger.AddHook(hook)
+		}
+	}
+
 	agentLog = agentLog.WithField("debug_console", debugConsole)
 
 	if logsVSockPort != 0 {
@@ -1011,16 +1498,16 @@ func (s *sandbox) initLogger(ctx context.Context) error {
 	return announce()
 }
 
-func (s *sandbox) initChannel() error {
-	span, ctx := s.trace("initChannel")
+func (s *sandbox) initChannels() error {
+	span, ctx := s.trace("initChannels")
 	defer span.finish()
 
-	c, err := newChannel(ctx)
+	channels, err := newChannels(ctx)
 	if err != nil {
 		return err
 	}
 
-	s.channel = c
+	s.channels = channels
 
 	return nil
 }
@@ -1115,7 +1602,7 @@ func (s *sandbox) startGRPC() {
 		// associated with runtime-initiated traces.
 		tracer := span.tracer()
 
-		serverOpts = append(serverOpts, grpc.UnaryInterceptor(otgrpc.OpenTracingServerInterceptor(tracer.tracer)))
+		serverOpts = append(serverOpts, grpc.UnaryInterceptor(makeMethodCheckInterceptor(otgrpc.OpenTracingServerInterceptor(tracer.tracer))))
 	} else {
 		// Enable interceptor whether tracing is enabled or not. This
 		// is necessary to support StartTracing() and StopTracing()
@@ -1125,7 +1612,7 @@ func (s *sandbox) startGRPC() {
 		// When tracing is enabled, the interceptor handles "isolated"
 		// tracing (agent traces are not associated with runtime-initiated
 		// traces).
-		serverOpts = append(serverOpts, grpc.UnaryInterceptor(makeUnaryInterceptor()))
+		serverOpts = append(serverOpts, grpc.UnaryInterceptor(makeMethodCheckInterceptor(makeUnaryInterceptor())))
 	}
 
 	grpcServer = grpc.NewServer(serverOpts...)
@@ -1134,57 +1621,63 @@ func (s *sandbox) startGRPC() {
 	pb.RegisterHealthServer(grpcServer, grpcImpl)
 	s.server = grpcServer
 
-	s.wg.Add(1)
-	go func() {
-		defer s.wg.Done()
+	// All the listeners are closed when the server is stopped.
+	for _, c := range s.channels {
+		s.wg.Add(1)
+		go func(c channel) {
+			defer s.wg.Done()
+			serveGRPC(grpcServer, c)
+		}(c)
+	}
+}
 
-		var err error
-		var servErr error
-		for {
-			agentLog.Info("agent grpc server starts")
+// serveGRPC serves the gRPC server on the channel until it is stopped,
+// listening again whenever the channel is closed.
+func serveGRPC(grpcServer *grpc.Server, c channel) {
+	fieldLogger := agentLog.WithField("channel", fmt.Sprintf("%T", c))
 
-			err = s.channel.setup()
-			if err != nil {
-				agentLog.WithError(err).Warn("Failed to setup agent grpc channel")
-				return
-			}
+	for {
+		fieldLogger.Info("agent grpc server starts")
 
-			err = s.channel.wait()
-			if err != nil {
-				agentLog.WithError(err).Warn("Failed to wait agent grpc channel ready")
-				return
-			}
+		if err := c.setup(); err != nil {
+			fieldLogger.WithError(err).Warn("Failed to setup agent grpc channel")
+			return
+		}
 
-			var l net.Listener
-			l, err = s.channel.listen()
-			if err != nil {
-				agentLog.WithError(err).Warn("Failed to create agent grpc listener")
-				return
-			}
+		if err := c.wait(); err != nil {
+			fieldLogger.WithError(err).Warn("Failed to wait agent grpc channel ready")
+			return
+		}
 
-			// l is closed when Serve() returns
-			servErr = grpcServer.Serve(l)
-			if servErr != nil {
-				agentLog.WithError(servErr).Warn("agent grpc server quits")
-			}
+		l, err := c.listen()
+		if err != nil {
+			fieldLogger.WithError(err).Warn("Failed to create agent grpc listener")
+			return
+		}
 
-			err = s.channel.teardown()
-			if err != nil {
-				agentLog.WithError(err).Warn("agent grpc channel teardown failed")
-			}
+		// l is closed when Serve() returns
+		servErr := grpcServer.Serve(l)
+		if servErr != nil {
+			fieldLogger.WithError(servErr).Warn("agent grpc server quits")
+		}
 
-			// Based on the definition of grpc.Serve(), the function
-			// returns nil in case of a proper stop triggered by either
-			// grpc.GracefulStop() or grpc.Stop(). Those calls can only
-			// be issued by the chain of events coming from DestroySandbox
-			// and explicitly means the server should not try to listen
-			// again, as the sandbox is being completely removed.
-			if servErr == nil {
-				agentLog.Info("agent grpc server has been explicitly stopped")
-				return
-			}
+		if err := c.teardown(); err != nil {
+			fieldLogger.WithError(err).Warn("agent grpc channel teardown failed")
 		}
-	}()
+
+		// Based on the definition of grpc.Serve(), the function
+		// returns nil in case of a proper stop triggered by either
+		// grpc.GracefulStop() or grpc.Stop(). Those calls can only
+		// be issued by the chain of events coming from DestroySandbox
+		// and explicitly means the server should not try to listen
+		// again, as the sandbox is being completely removed. A channel
+		// only ready once the server has been stopped gets
+		// ErrServerStopped.
+		if servErr == nil || servErr == grpc.ErrServerStopped {
+			fieldLogger.Info("agent grpc server has been explicitly stopped")
+			return
+		}
+	}
 }
 
 func getGRPCContext() context.Context {
@@ -1435,10 +1928,46 @@ func initAgentAsInit() error {
 	return announce()
 }
 
+// initExtraFds returns the descriptors inherited by the libcontainer init
+// process besides its standard ones, some of which are closed as the agent
+// hooks consume them.
+func initExtraFds() ([]int, error) {
+	entries, err := ioutil.ReadDir("/proc/self/fd")
+	if err != nil {
+		return nil, err
+	}
+
+	var fds []int
+	for _, entry := range entries {
+		fd, err := strconv.Atoi(entry.Name())
+		if err == nil && fd >= landlockRulesetFd {
+			fds = append(fds, fd)
+		}
+	}
+
+	return fds, nil
+}
+
 func init() {
 	if len(os.Args) > 1 && os.Args[1] == "init" {
 		runtime.GOMAXPROCS(1)
 		runtime.LockOSThread()
+		if err := setupTimeNamespaceInit(); err != nil {
+			agentLog.WithError(err).Error("time namespace setup failed")
+			os.Exit(1)
+		}
+		if err := setupUmaskInit(); err != nil {
+			agentLog.WithError(err).Error("umask setup failed")
+			os.Exit(1)
+		}
+		if err := setupSeccompNotifyInit(); err != nil {
+			agentLog.WithError(err).Error("seccomp notification setup failed")
+			os.Exit(1)
+		}
+		if err := restrictLandlockInit(); err != nil {
+			agentLog.WithError(err).Error("landlock restriction failed")
+			os.Exit(1)
+		}
 		factory, _ := libcontainer.New("")
 		if err := factory.StartInitialization(); err != nil {
 			agentLog.WithError(err).Error("init failed")
@@ -1460,8 +1989,13 @@ func realMain() error {
 		return nil
 	}
 
+	// An agent restarted by its predecessor restores its state, the
+	// guest having already been setup.
+	statePath := os.Getenv(agentStateEnv)
+	os.Unsetenv(agentStateEnv)
+
 	// Check if this agent has been run as the init process.
-	if os.Getpid() == 1 {
+	if os.Getpid() == 1 && statePath == "" {
 		if err = initAgentAsInit(); err != nil {
 			panic(fmt.Sprintf("failed to setup agent as init: %v", err))
 		}
@@ -1487,12 +2021,15 @@ func realMain() error {
 		deviceWatchers: make(map[string](chan string)),
 		storages:       make(map[string]*sandboxStorage),
 		stopServer:     make(chan struct{}),
+		initTimings:    newInitTimings(agentStartTime),
 	}
+	s.initTimings.startupPhaseDone(initPhaseInit)
 
 	rootSpan, rootContext, err = setupTracing(agentName)
 	if err != nil {
 		return fmt.Errorf("failed to setup tracing: %v", err)
 	}
+	s.initTimings.startupPhaseDone(initPhaseTracing)
 
 	if err = s.initLogger(rootContext); err != nil {
 		return fmt.Errorf("failed to setup logger: %v", err)
@@ -1505,28 +2042,48 @@ func realMain() error {
 	// Set the sandbox context now that the context contains the tracing
 	// information.
 	s.ctx = rootContext
+	s.initTimings.startupPhaseDone(initPhaseLogger)
+
+	if statePath != "" {
+		if err = s.restoreStateFile(statePath); err != nil {
+			agentLog.WithError(err).Error("failed to restore agent state")
+		}
+		s.initTimings.startupPhaseDone(initPhaseRestore)
+	}
 
 	if err = s.setupSignalHandler(); err != nil {
 		return fmt.Errorf("failed to setup signal handler: %v", err)
 	}
 
+	if statePath != "" {
+		// Reap the processes which terminated during the restart.
+		if err = s.subreaper.reap(); err != nil {
+			agentLog.WithError(err).Error("failed to reap processes")
+		}
+	}
+
 	if err = s.handleLocalhost(); err != nil {
 		return fmt.Errorf("failed to handle localhost: %v", err)
 	}
+	s.initTimings.startupPhaseDone(initPhaseSetup)
 
-	// Check for vsock vs serial. This will fill the sandbox structure with
-	// information about the channel.
-	if err = s.initChannel(); err != nil {
+	// Check for vsock vs serial, unless the listeners are configured. This
+	// will fill the sandbox structure with information about the channels.
+	if err = s.initChannels(); err != nil {
 		return fmt.Errorf("failed to setup channels: %v", err)
 	}
+	s.initTimings.startupPhaseDone(initPhaseChannels)
 
 	// Start gRPC server.
 	s.startGRPC()
+	s.initTimings.startupPhaseDone(initPhaseGRPC)
 
 	go s.waitForStopServer()
 
 	go s.listenToUdevEvents()
 
+	s.startBalloonMonitor()
+
 	s.wg.Wait()
 
 	if !tracing {
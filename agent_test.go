@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"path"
 	"path/filepath"
@@ -17,13 +18,16 @@ import (
 	"strings"
 	"syscall"
 	"testing"
+	"time"
 
+	pb "github.com/kata-containers/agent/protocols/grpc"
 	"github.com/opencontainers/runc/libcontainer"
 	"github.com/opencontainers/runc/libcontainer/configs"
 	"github.com/opencontainers/runc/libcontainer/specconv"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -475,7 +479,7 @@ func TestStartStopGRPCServer(t *testing.T) {
 
 	s := &sandbox{
 		containers: make(map[string]*container),
-		channel:    &serialChannel{serialConn: out},
+		channels:   []channel{&serialChannel{serialConn: out}},
 	}
 
 	s.startGRPC()
@@ -491,7 +495,7 @@ func TestSettingGrpcTracer(t *testing.T) {
 
 	s := &sandbox{
 		containers:      make(map[string]*container),
-		channel:         &serialChannel{serialConn: out},
+		channels:        []channel{&serialChannel{serialConn: out}},
 		enableGrpcTrace: true,
 	}
 
@@ -502,6 +506,79 @@ func TestSettingGrpcTracer(t *testing.T) {
 	assert.Nil(t, s.server, "failed stopping grpc server")
 }
 
+func TestStartStopGRPCServerListeners(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedPersistentNsDir := persistentNsDir
+	defer func() {
+		persistentNsDir = savedPersistentNsDir
+	}()
+	persistentNsDir = dir
+
+	// Each listener has its own network namespace
+	const addr = "127.0.0.1:1024"
+	nsNames := []string{"agent-net-0", "agent-net-1"}
+
+	s := &sandbox{
+		containers: make(map[string]*container),
+		channels: []channel{
+			&tcpChannel{addr: addr, nsName: nsNames[0]},
+			&tcpChannel{addr: addr, nsName: nsNames[1]},
+		},
+	}
+
+	s.startGRPC()
+	assert.NotNil(s.server, "failed starting grpc server")
+
+	for _, name := range nsNames {
+		ns := &namespace{path: filepath.Join(dir, name)}
+		dialer := func(addr string, timeout time.Duration) (net.Conn, error) {
+			return dialInNs(ns, addr)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		conn, err := grpc.DialContext(ctx, addr, grpc.WithInsecure(), grpc.WithBlock(), grpc.WithDialer(dialer))
+		if !assert.NoError(err, "namespace %s", name) {
+			continue
+		}
+		defer conn.Close()
+
+		resp, err := pb.NewHealthClient(conn).Check(ctx, &pb.CheckRequest{})
+		assert.NoError(err, "namespace %s", name)
+		assert.Equal(pb.HealthCheckResponse_SERVING, resp.GetStatus(), "namespace %s", name)
+	}
+
+	s.stopGRPC()
+	assert.Nil(s.server, "failed stopping grpc server")
+
+	// All the listeners are closed
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		assert.Fail("grpc server still listening")
+	}
+
+	// Along with their namespaces
+	for _, name := range nsNames {
+		_, err := os.Stat(filepath.Join(dir, name))
+		assert.True(os.IsNotExist(err), "namespace %s", name)
+	}
+}
+
 func TestMountToRootfs(t *testing.T) {
 	if os.Geteuid() != 0 {
 		t.Skip("mount need cap_sys_admin")
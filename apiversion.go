@@ -0,0 +1,116 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const agentServiceName = "grpc.AgentService"
+
+var (
+	// Comma separated list of the AgentService methods disabled in this
+	// build. Set by the build.
+	disabledMethods string
+
+	// AgentService methods due to be removed, with the reason logged
+	// when they are called.
+	deprecatedMethods = map[string]string{}
+)
+
+// splitMethodName returns the service and method names of a gRPC full
+// method name, such as /grpc.AgentService/CreateContainer.
+func splitMethodName(fullMethod string) (string, string) {
+	fields := strings.SplitN(strings.TrimPrefix(fullMethod, "/"), "/", 2)
+	if len(fields) != 2 {
+		return "", fullMethod
+	}
+
+	return fields[0], fields[1]
+}
+
+// getDisabledMethods returns the sorted list of methods disabled in this
+// build. GetAPIVersion cannot be disabled.
+func getDisabledMethods() []string {
+	var methods []string
+
+	for _, m := range strings.Split(disabledMethods, ",") {
+		m = strings.TrimSpace(m)
+		if m != "" && m != "GetAPIVersion" {
+			methods = append(methods, m)
+		}
+	}
+
+	sort.Strings(methods)
+
+	return methods
+}
+
+func isMethodDisabled(method string) bool {
+	for _, m := range getDisabledMethods() {
+		if m == method {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkMethod rejects the calls to the methods disabled in this build and
+// warns about the deprecated ones.
+func checkMethod(fullMethod string) error {
+	service, method := splitMethodName(fullMethod)
+	if service != agentServiceName {
+		return nil
+	}
+
+	if isMethodDisabled(method) {
+		return grpcStatus.Errorf(codes.Unimplemented, "%s is disabled in this build of the agent (version %s)", method, version)
+	}
+
+	if reason, ok := deprecatedMethods[method]; ok {
+		agentLog.WithFields(logrus.Fields{
+			"method": method,
+			"reason": reason,
+		}).Warn("Deprecated method called")
+	}
+
+	return nil
+}
+
+// makeMethodCheckInterceptor wraps interceptor so that it is only called
+// for the methods available in this build.
+func makeMethodCheckInterceptor(interceptor grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkMethod(info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return interceptor(ctx, req, info, handler)
+	}
+}
+
+// getAgentMethods returns the sorted list of AgentService methods
+// registered on the server, disabled ones included.
+func getAgentMethods(server *grpc.Server) []string {
+	var methods []string
+
+	for _, m := range server.GetServiceInfo()[agentServiceName].Methods {
+		methods = append(methods, m.Name)
+	}
+
+	sort.Strings(methods)
+
+	return methods
+}
@@ -0,0 +1,113 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestGetAPIVersion(t *testing.T) {
+	assert := assert.New(t)
+
+	savedDisabledMethods := disabledMethods
+	savedDeprecatedMethods := deprecatedMethods
+	defer func() {
+		disabledMethods = savedDisabledMethods
+		deprecatedMethods = savedDeprecatedMethods
+	}()
+	disabledMethods = ""
+	deprecatedMethods = map[string]string{}
+
+	a := &agentGRPC{
+		sandbox: &sandbox{},
+	}
+
+	_, err := a.GetAPIVersion(context.Background(), &pb.GetAPIVersionRequest{})
+	assert.Error(err)
+
+	a.sandbox.server = grpc.NewServer()
+	pb.RegisterAgentServiceServer(a.sandbox.server, a)
+	pb.RegisterHealthServer(a.sandbox.server, a)
+
+	serverType := reflect.TypeOf((*pb.AgentServiceServer)(nil)).Elem()
+	var expected []string
+	for i := 0; i < serverType.NumMethod(); i++ {
+		expected = append(expected, serverType.Method(i).Name)
+	}
+
+	resp, err := a.GetAPIVersion(context.Background(), &pb.GetAPIVersionRequest{})
+	assert.NoError(err)
+	assert.Equal(pb.APIVersion, resp.Version)
+	assert.Equal(expected, resp.Methods)
+	assert.Empty(resp.DeprecatedMethods)
+	assert.Empty(resp.DisabledMethods)
+
+	disabledMethods = "RestartAgent, CopyFile,GetAPIVersion"
+	deprecatedMethods = map[string]string{"OnlineCPUMem": "test"}
+
+	resp, err = a.GetAPIVersion(context.Background(), &pb.GetAPIVersionRequest{})
+	assert.NoError(err)
+	assert.Len(resp.Methods, len(expected)-2)
+	assert.NotContains(resp.Methods, "CopyFile")
+	assert.NotContains(resp.Methods, "RestartAgent")
+	assert.Contains(resp.Methods, "GetAPIVersion")
+	assert.Equal([]string{"OnlineCPUMem"}, resp.DeprecatedMethods)
+	assert.Equal([]string{"CopyFile", "RestartAgent"}, resp.DisabledMethods)
+}
+
+func TestMethodCheckInterceptor(t *testing.T) {
+	assert := assert.New(t)
+
+	savedDisabledMethods := disabledMethods
+	defer func() {
+		disabledMethods = savedDisabledMethods
+	}()
+	disabledMethods = "CopyFile,Check"
+
+	type testData struct {
+		fullMethod     string
+		expectedCalled bool
+	}
+
+	data := []testData{
+		{"/grpc.AgentService/CreateContainer", true},
+		{"/grpc.AgentService/CopyFile", false},
+		{"/grpc.AgentService/GetAPIVersion", true},
+		{"/grpc.Health/Check", true},
+		{"CopyFile", true},
+	}
+
+	for i, d := range data {
+		called := false
+		interceptor := makeMethodCheckInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			called = true
+			return handler(ctx, req)
+		})
+
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return emptyResp, nil
+		}
+
+		resp, err := interceptor(context.Background(), &pb.CopyFileRequest{}, &grpc.UnaryServerInfo{FullMethod: d.fullMethod}, handler)
+		assert.Equal(d.expectedCalled, called, "test %d (%+v)", i, d)
+		if d.expectedCalled {
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.Equal(emptyResp, resp, "test %d (%+v)", i, d)
+		} else {
+			assert.Equal(codes.Unimplemented, grpcStatus.Code(err), "test %d (%+v)", i, d)
+			assert.Nil(resp, "test %d (%+v)", i, d)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bufio"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/opencontainers/runc/libcontainer/apparmor"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Profile name leaving the processes unconfined, never listed.
+const apparmorUnconfined = "unconfined"
+
+var (
+	apparmorParserPath   = "/sbin/apparmor_parser"
+	apparmorProfilesPath = "/sys/kernel/security/apparmor/profiles"
+)
+
+// set function in variable to overwrite for testing.
+// Profiles can only be applied when the agent is built with the apparmor
+// build tag.
+var isApparmorEnabled = apparmor.IsEnabled
+
+// useApparmor returns whether the AppArmor profiles are applied, which
+// requires both the agent.apparmor option and the guest support.
+func useApparmor() bool {
+	return apparmorProfiles && isApparmorEnabled()
+}
+
+// apparmorProfileName returns the profile the processes are confined with,
+// none when the profiles are not applied.
+func apparmorProfileName(name string) string {
+	if !useApparmor() {
+		return ""
+	}
+
+	return name
+}
+
+// loadApparmorProfile loads the profile into the kernel, replacing any
+// profile of the same name.
+func loadApparmorProfile(profile string) error {
+	cmd := exec.Command(apparmorParserPath, "--replace", "--quiet")
+	cmd.Stdin = strings.NewReader(profile)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Could not load AppArmor profile: %v: %s",
+			err, strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// isApparmorProfileLoaded returns whether the profile is loaded, the lines
+// of the profiles file being made of "<name> (<mode>)".
+func isApparmorProfileLoaded(name string) (bool, error) {
+	f, err := os.Open(apparmorProfilesPath)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.LastIndex(line, " ("); i >= 0 && line[:i] == name {
+			return true, nil
+		}
+	}
+
+	return false, scanner.Err()
+}
+
+// setupApparmorProfile loads the profile content, if any, and checks the
+// named profile can be applied to the container processes, failing early
+// instead of when starting them. Profiles are ignored unless applied.
+func setupApparmorProfile(profile, name string) error {
+	if profile == "" && (name == "" || name == apparmorUnconfined) {
+		return nil
+	}
+
+	if !useApparmor() {
+		if apparmorProfiles {
+			agentLog.WithField("apparmor-profile", name).Warn("AppArmor not supported in the guest, ignoring profile")
+		}
+		return nil
+	}
+
+	if profile != "" {
+		if err := loadApparmorProfile(profile); err != nil {
+			return err
+		}
+
+		agentLog.WithField("apparmor-profile", name).Info("AppArmor profile loaded")
+	}
+
+	if name == "" || name == apparmorUnconfined {
+		return nil
+	}
+
+	loaded, err := isApparmorProfileLoaded(name)
+	if err != nil {
+		agentLog.WithError(err).WithField("apparmor-profile", name).Warn("Could not list AppArmor profiles")
+		return nil
+	}
+
+	if !loaded {
+		return grpcStatus.Errorf(codes.FailedPrecondition, "AppArmor profile %q not loaded", name)
+	}
+
+	return nil
+}
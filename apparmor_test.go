@@ -0,0 +1,194 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestLoadApparmorProfileParser(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedApparmorParserPath := apparmorParserPath
+	defer func() {
+		apparmorParserPath = savedApparmorParserPath
+	}()
+
+	loaded := filepath.Join(dir, "loaded")
+	apparmorParserPath = filepath.Join(dir, "apparmor_parser")
+	script := fmt.Sprintf("#!/bin/sh\necho \"$@\" > %s.args\ncat > %s\n", loaded, loaded)
+	assert.NoError(ioutil.WriteFile(apparmorParserPath, []byte(script), 0700))
+
+	profile := "profile foo { file, }\n"
+	assert.NoError(loadApparmorProfile(profile))
+
+	content, err := ioutil.ReadFile(loaded)
+	assert.NoError(err)
+	assert.Equal(profile, string(content))
+
+	args, err := ioutil.ReadFile(loaded + ".args")
+	assert.NoError(err)
+	assert.Equal("--replace --quiet\n", string(args))
+
+	script = "#!/bin/sh\necho 'AppArmor parser error at line 1: syntax error' >&2\nexit 1\n"
+	assert.NoError(ioutil.WriteFile(apparmorParserPath, []byte(script), 0700))
+
+	err = loadApparmorProfile(profile)
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+	assert.True(strings.Contains(err.Error(), "syntax error"), err.Error())
+}
+
+func TestSetupApparmorProfile(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedApparmorProfiles := apparmorProfiles
+	savedIsApparmorEnabled := isApparmorEnabled
+	savedApparmorParserPath := apparmorParserPath
+	savedApparmorProfilesPath := apparmorProfilesPath
+	defer func() {
+		apparmorProfiles = savedApparmorProfiles
+		isApparmorEnabled = savedIsApparmorEnabled
+		apparmorParserPath = savedApparmorParserPath
+		apparmorProfilesPath = savedApparmorProfilesPath
+	}()
+
+	apparmorParserPath = "/bin/true"
+	apparmorProfilesPath = filepath.Join(dir, "profiles")
+	err = ioutil.WriteFile(apparmorProfilesPath, []byte("foo (enforce)\nbar baz (complain)\n"), testFileMode)
+	assert.NoError(err)
+
+	type testData struct {
+		applied      bool
+		enabled      bool
+		profile      string
+		name         string
+		expectedCode codes.Code
+	}
+
+	data := []testData{
+		{true, false, "", "", codes.OK},
+		{true, false, "", "unconfined", codes.OK},
+		// Ignored without AppArmor support
+		{true, false, "", "foo", codes.OK},
+		{true, false, "profile foo {}", "", codes.OK},
+		// Ignored unless applied
+		{false, true, "", "bar", codes.OK},
+		{false, true, "profile qux {}", "qux", codes.OK},
+		{true, true, "", "foo", codes.OK},
+		{true, true, "", "bar baz", codes.OK},
+		{true, true, "", "bar", codes.FailedPrecondition},
+		{true, true, "", "fo", codes.FailedPrecondition},
+		{true, true, "profile foo {}", "foo", codes.OK},
+		{true, true, "profile qux {}", "qux", codes.FailedPrecondition},
+	}
+
+	for i, d := range data {
+		apparmorProfiles = d.applied
+		enabled := d.enabled
+		isApparmorEnabled = func() bool {
+			return enabled
+		}
+
+		err := setupApparmorProfile(d.profile, d.name)
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+	}
+
+	// Profiles which cannot be listed are not checked
+	apparmorProfiles = true
+	isApparmorEnabled = func() bool {
+		return true
+	}
+	apparmorProfilesPath = filepath.Join(dir, "missing")
+	assert.NoError(setupApparmorProfile("", "qux"))
+}
+
+func TestApparmorProfile(t *testing.T) {
+	skipUnlessRoot(t)
+
+	if _, err := os.Stat(apparmorProfilesPath); err != nil {
+		t.Skip("AppArmor support needed")
+	}
+	if _, err := exec.LookPath(apparmorParserPath); err != nil {
+		t.Skip("apparmor_parser needed")
+	}
+
+	assert := assert.New(t)
+
+	savedApparmorProfiles := apparmorProfiles
+	savedIsApparmorEnabled := isApparmorEnabled
+	defer func() {
+		apparmorProfiles = savedApparmorProfiles
+		isApparmorEnabled = savedIsApparmorEnabled
+	}()
+
+	apparmorProfiles = true
+	isApparmorEnabled = func() bool {
+		return true
+	}
+
+	name := "kata-agent-test"
+	profile := fmt.Sprintf("profile %s flags=(attach_disconnected) {\n  file,\n}\n", name)
+	if !assert.NoError(setupApparmorProfile(profile, name)) {
+		return
+	}
+	defer func() {
+		cmd := exec.Command(apparmorParserPath, "--remove", "--quiet")
+		cmd.Stdin = strings.NewReader(profile)
+		cmd.Run()
+	}()
+
+	type result struct {
+		err     error
+		current string
+	}
+
+	resultCh := make(chan result, 1)
+
+	// The profile is applied on exec to the processes spawned from a
+	// thread only used for the test, which terminates with the goroutine.
+	go func() {
+		runtime.LockOSThread()
+
+		var r result
+		defer func() {
+			resultCh <- r
+		}()
+
+		attr := fmt.Sprintf("/proc/self/task/%d/attr/exec", unix.Gettid())
+		if r.err = ioutil.WriteFile(attr, []byte("exec "+name), 0); r.err != nil {
+			return
+		}
+
+		var output []byte
+		output, r.err = exec.Command("cat", "/proc/self/attr/current").Output()
+		r.current = strings.TrimSpace(string(output))
+	}()
+
+	r := <-resultCh
+	assert.NoError(r.err)
+	assert.Equal(name+" (enforce)", r.current)
+}
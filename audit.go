@@ -0,0 +1,107 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Seccomp modes, as reported by /proc/<pid>/status.
+var seccompModes = map[string]string{
+	"0": "disabled",
+	"1": "strict",
+	"2": "filter",
+}
+
+// set function in variable to overwrite for testing.
+var procStatusPath = func(pid int) string {
+	return fmt.Sprintf("/proc/%d/status", pid)
+}
+
+// getProcessStatusFields returns the values of the requested fields of the
+// process status, see proc(5).
+func getProcessStatusFields(pid int, names ...string) (map[string]string, error) {
+	f, err := os.Open(procStatusPath(pid))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fields := make(map[string]string)
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		kv := strings.SplitN(scanner.Text(), ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+
+		for _, name := range names {
+			if kv[0] == name {
+				fields[name] = strings.TrimSpace(kv[1])
+			}
+		}
+	}
+
+	return fields, scanner.Err()
+}
+
+// auditProcessSpawn logs the user, capabilities and seccomp status of a
+// process just spawned in the container. Both the configured effective
+// capabilities and the ones of the running process are recorded, the
+// latter being unknown if the process already exited.
+func auditProcessSpawn(ctr *container, proc *process, pid int) {
+	if !auditLog {
+		return
+	}
+
+	caps := proc.process.Capabilities
+	if caps == nil {
+		caps = ctr.config.Capabilities
+	}
+
+	var effective []string
+	if caps != nil {
+		effective = caps.Effective
+	}
+
+	command := ""
+	if len(proc.process.Args) > 0 {
+		command = proc.process.Args[0]
+	}
+
+	fields := logrus.Fields{
+		"subsystem":         "audit",
+		"container":         ctr.id,
+		"exec-id":           proc.id,
+		"pid":               pid,
+		"init":              proc.process.Init,
+		"command":           command,
+		"user":              proc.process.User,
+		"additional-groups": strings.Join(proc.process.AdditionalGroups, ","),
+		"capabilities":      strings.Join(effective, ","),
+		"no-new-privileges": ctr.config.NoNewPrivileges,
+		"seccomp-profile":   ctr.config.Seccomp != nil,
+	}
+
+	status, err := getProcessStatusFields(pid, "CapEff", "Seccomp")
+	if err != nil {
+		agentLog.WithError(err).WithField("pid", pid).Debug("Could not read the process status for audit")
+	} else {
+		fields["cap-eff"] = status["CapEff"]
+		if mode, ok := seccompModes[status["Seccomp"]]; ok {
+			fields["seccomp"] = mode
+		}
+	}
+
+	agentLog.WithFields(fields).Info("Container process spawned")
+}
@@ -0,0 +1,143 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetProcessStatusFields(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedProcStatusPath := procStatusPath
+	defer func() {
+		procStatusPath = savedProcStatusPath
+	}()
+
+	file := filepath.Join(dir, "status")
+	procStatusPath = func(pid int) string {
+		return file
+	}
+
+	_, err = getProcessStatusFields(1, "CapEff")
+	assert.Error(err)
+
+	status := "Name:\tsleep\nUid:\t0\t0\t0\t0\nfoo\nCapEff:\t00000000a80425fb\nSeccomp:\t2\n"
+	assert.NoError(ioutil.WriteFile(file, []byte(status), testFileMode))
+
+	fields, err := getProcessStatusFields(1, "CapEff", "Seccomp", "Bar")
+	assert.NoError(err)
+	assert.Equal(map[string]string{"CapEff": "00000000a80425fb", "Seccomp": "2"}, fields)
+}
+
+func TestAuditProcessSpawn(t *testing.T) {
+	assert := assert.New(t)
+
+	savedLog := agentLog
+	savedAuditLog := auditLog
+	savedSpawnProcess := spawnProcess
+	defer func() {
+		agentLog = savedLog
+		auditLog = savedAuditLog
+		spawnProcess = savedSpawnProcess
+	}()
+
+	buf := &bytes.Buffer{}
+	logger := logrus.New()
+	logger.Out = buf
+	logger.Formatter = &logrus.JSONFormatter{}
+	agentLog = logrus.NewEntry(logger)
+
+	cmd := exec.Command("sleep", "10")
+	assert.NoError(cmd.Start())
+	defer func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	}()
+
+	spawnProcess = func(c libcontainer.Container, p *libcontainer.Process, createContainer bool) (int, error) {
+		return cmd.Process.Pid, nil
+	}
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			subreaper: &mockreaper{},
+		},
+	}
+
+	ctr := &container{
+		id:        "foo",
+		container: &mockContainer{},
+		config: configs.Config{
+			Capabilities: &configs.Capabilities{
+				Effective: []string{"CAP_CHOWN", "CAP_KILL"},
+			},
+			NoNewPrivileges: true,
+		},
+	}
+
+	proc := &process{
+		id: "bar",
+		process: libcontainer.Process{
+			Args: []string{"sleep", "10"},
+			User: "1000:1001",
+		},
+	}
+
+	// Disabled
+	auditLog = false
+	assert.NoError(a.execProcess(ctr, proc, false))
+	assert.Empty(buf.String())
+
+	auditLog = true
+	assert.NoError(a.execProcess(ctr, proc, false))
+
+	var entry map[string]interface{}
+	assert.NoError(json.Unmarshal(buf.Bytes(), &entry))
+
+	assert.Equal("audit", entry["subsystem"])
+	assert.Equal("foo", entry["container"])
+	assert.Equal("bar", entry["exec-id"])
+	assert.Equal(float64(cmd.Process.Pid), entry["pid"])
+	assert.Equal("sleep", entry["command"])
+	assert.Equal("1000:1001", entry["user"])
+	assert.Equal("CAP_CHOWN,CAP_KILL", entry["capabilities"])
+	assert.Equal(true, entry["no-new-privileges"])
+	assert.Equal(false, entry["seccomp-profile"])
+
+	// The process inherits the capabilities of the test
+	self, err := getProcessStatusFields(os.Getpid(), "CapEff", "Seccomp")
+	assert.NoError(err)
+	assert.Equal(self["CapEff"], entry["cap-eff"])
+	assert.Equal(seccompModes[self["Seccomp"]], entry["seccomp"])
+
+	// The process capabilities override the container ones
+	buf.Reset()
+	proc.process.Capabilities = &configs.Capabilities{
+		Effective: []string{"CAP_NET_RAW"},
+	}
+	assert.NoError(a.execProcess(ctr, proc, false))
+
+	entry = nil
+	assert.NoError(json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal("CAP_NET_RAW", entry["capabilities"])
+}
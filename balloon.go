@@ -0,0 +1,191 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Policies followed when the guest memory is reclaimed or returned by the
+// host through the virtio-balloon device.
+const (
+	// Nothing is done.
+	balloonPolicyNone = "none"
+	// The guest memory changes are logged.
+	balloonPolicyEvent = "event"
+	// The guest memory changes are logged, and the memory high watermarks
+	// of the containers scaled accordingly.
+	balloonPolicyLimit = "limit"
+)
+
+const (
+	memoryHighFileV2      = "memory.high"
+	memorySoftLimitFileV1 = "memory.soft_limit_in_bytes"
+)
+
+var balloonPolicies = map[string]bool{
+	balloonPolicyNone:  true,
+	balloonPolicyEvent: true,
+	balloonPolicyLimit: true,
+}
+
+// set in variable to overwrite for testing.
+var balloonMeminfo = meminfo
+
+// balloonMonitor follows the size of the guest memory. The pages taken by
+// the balloon are removed from the total memory of the guest, which is
+// compared to its size before any inflation.
+type balloonMonitor struct {
+	sandbox *sandbox
+	policy  string
+
+	// Guest memory size before inflation, and at the last check.
+	baseline uint64
+	total    uint64
+}
+
+func newBalloonMonitor(s *sandbox, policy string) (*balloonMonitor, error) {
+	info, err := parseMeminfo(balloonMeminfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &balloonMonitor{
+		sandbox:  s,
+		policy:   policy,
+		baseline: info["MemTotal"],
+		total:    info["MemTotal"],
+	}, nil
+}
+
+// startBalloonMonitor periodically checks the guest memory size, unless
+// disabled by the policy.
+func (s *sandbox) startBalloonMonitor() {
+	if balloonPolicy == balloonPolicyNone || balloonInterval == 0 {
+		return
+	}
+
+	m, err := newBalloonMonitor(s, balloonPolicy)
+	if err != nil {
+		agentLog.WithError(err).Warn("Could not monitor the guest memory")
+		return
+	}
+
+	go m.run(balloonInterval)
+}
+
+func (m *balloonMonitor) run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := m.check(); err != nil {
+			agentLog.WithError(err).Warn("Could not check the guest memory")
+		}
+	}
+}
+
+// check logs the guest memory size changes since the last check. With the
+// limit policy, the containers are kept scaled while the guest is smaller
+// than its baseline, picking up the containers created or updated since,
+// and restored once it is back to it.
+func (m *balloonMonitor) check() error {
+	info, err := parseMeminfo(balloonMeminfo)
+	if err != nil {
+		return err
+	}
+
+	total := info["MemTotal"]
+	changed := total != m.total
+	m.total = total
+
+	// Memory hotplugged or returned past the initial size.
+	if total > m.baseline {
+		m.baseline = total
+	}
+
+	if changed {
+		log := agentLog.WithFields(logrus.Fields{
+			"subsystem":     "balloon",
+			"policy":        m.policy,
+			"mem-total":     total,
+			"mem-available": info["MemAvailable"],
+			"baseline":      m.baseline,
+		})
+
+		if total < m.baseline {
+			log.Warn("Guest memory reclaimed by the host")
+		} else {
+			log.Info("Guest memory returned by the host")
+		}
+	}
+
+	if m.policy == balloonPolicyLimit && (changed || total < m.baseline) {
+		m.sandbox.scaleMemoryHigh(total, m.baseline)
+	}
+
+	return nil
+}
+
+// scaleMemoryHigh scales the memory high watermarks of the containers to
+// the ratio of the guest memory left by the balloon.
+func (s *sandbox) scaleMemoryHigh(total, baseline uint64) {
+	cgroupV2 := isCgroupV2()
+
+	s.RLock()
+	defer s.RUnlock()
+
+	for _, ctr := range s.containers {
+		if err := ctr.scaleMemoryHigh(cgroupV2, total, baseline); err != nil {
+			agentLog.WithError(err).WithField("container", ctr.id).Warn("Could not scale the container memory high watermark")
+		}
+	}
+}
+
+// scaleMemoryHigh sets the memory high watermark of the container to its
+// limit scaled by the ratio of the guest memory total to its baseline, the
+// container being throttled and reclaimed before its limit is hit in a
+// guest smaller than the one it was sized for. The watermark is memory.high
+// with cgroup v2, and the soft limit with cgroup v1, which is not raised
+// above the reservation of the container. Containers without memory limit
+// are left alone.
+func (c *container) scaleMemoryHigh(cgroupV2 bool, total, baseline uint64) error {
+	config := c.container.Config()
+	if config.Cgroups == nil || config.Cgroups.Resources == nil || config.Cgroups.Resources.Memory <= 0 {
+		return nil
+	}
+	resources := config.Cgroups.Resources
+
+	dir, err := c.getMemoryCgroupPath(cgroupV2)
+	if err != nil {
+		return err
+	}
+
+	var high int64 = -1
+	if total < baseline {
+		high = int64(float64(resources.Memory) * float64(total) / float64(baseline))
+	}
+
+	file, value := memoryHighFileV2, "max"
+	if !cgroupV2 {
+		if resources.MemoryReservation > 0 && (high < 0 || resources.MemoryReservation < high) {
+			high = resources.MemoryReservation
+		}
+		file, value = memorySoftLimitFileV1, "-1"
+	}
+
+	if high >= 0 {
+		value = strconv.FormatInt(high, 10)
+	}
+
+	return ioutil.WriteFile(filepath.Join(dir, file), []byte(value), cgroupFileWriteMode)
+}
@@ -0,0 +1,176 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+const testBalloonLimit = 1024 * 1024 * 1024
+
+func writeTestMeminfo(t *testing.T, path string, totalKB, availableKB uint64) {
+	content := fmt.Sprintf("MemTotal: %d kB\nMemFree: 1024 kB\nMemAvailable: %d kB\n", totalKB, availableKB)
+	assert.NoError(t, ioutil.WriteFile(path, []byte(content), testFileMode))
+}
+
+func TestBalloonMonitorEvent(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedLog := agentLog
+	savedBalloonMeminfo := balloonMeminfo
+	defer func() {
+		agentLog = savedLog
+		balloonMeminfo = savedBalloonMeminfo
+	}()
+
+	buf := &bytes.Buffer{}
+	logger := logrus.New()
+	logger.Out = buf
+	logger.Formatter = &logrus.JSONFormatter{}
+	agentLog = logrus.NewEntry(logger)
+
+	balloonMeminfo = filepath.Join(dir, "meminfo")
+	_, err = newBalloonMonitor(&sandbox{}, balloonPolicyEvent)
+	assert.Error(err)
+
+	writeTestMeminfo(t, balloonMeminfo, 4194304, 3145728)
+	m, err := newBalloonMonitor(&sandbox{}, balloonPolicyEvent)
+	if !assert.NoError(err) {
+		return
+	}
+	assert.Equal(uint64(4294967296), m.baseline)
+
+	// Unchanged size
+	writeTestMeminfo(t, balloonMeminfo, 4194304, 2097152)
+	assert.NoError(m.check())
+	assert.Empty(buf.String())
+
+	// Inflated balloon
+	writeTestMeminfo(t, balloonMeminfo, 3145728, 1048576)
+	assert.NoError(m.check())
+
+	var entry map[string]interface{}
+	assert.NoError(json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal("balloon", entry["subsystem"])
+	assert.Equal("warning", entry["level"])
+	assert.Equal(float64(3221225472), entry["mem-total"])
+	assert.Equal(float64(1073741824), entry["mem-available"])
+	assert.Equal(float64(4294967296), entry["baseline"])
+
+	// Deflated balloon
+	buf.Reset()
+	writeTestMeminfo(t, balloonMeminfo, 4194304, 3145728)
+	assert.NoError(m.check())
+
+	entry = nil
+	assert.NoError(json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal("info", entry["level"])
+	assert.Equal(float64(4294967296), entry["mem-total"])
+}
+
+func TestBalloonMonitorLimit(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedBalloonMeminfo := balloonMeminfo
+	savedCgroupPath := cgroupPath
+	savedCgroupMemoryPath := cgroupMemoryPath
+	savedIsCgroupV2 := isCgroupV2
+	defer func() {
+		balloonMeminfo = savedBalloonMeminfo
+		cgroupPath = savedCgroupPath
+		cgroupMemoryPath = savedCgroupMemoryPath
+		isCgroupV2 = savedIsCgroupV2
+	}()
+
+	balloonMeminfo = filepath.Join(dir, "meminfo")
+	cgroupPath = dir
+	cgroupMemoryPath = filepath.Join(dir, "memory")
+
+	s := &sandbox{
+		containers: map[string]*container{
+			"foo": {
+				id:        "foo",
+				container: &mockContainer{id: "foo", memory: testBalloonLimit},
+			},
+			"bar": {
+				id:        "bar",
+				container: &mockContainer{id: "bar"},
+			},
+		},
+	}
+
+	type testData struct {
+		cgroupV2         bool
+		cgroupDir        string
+		highFile         string
+		expectedScaled   string
+		expectedRestored string
+	}
+
+	data := []testData{
+		{false, cgroupMemoryPath, memorySoftLimitFileV1, "805306368", "-1"},
+		{true, cgroupPath, memoryHighFileV2, "805306368", "max"},
+	}
+
+	for i, d := range data {
+		isCgroupV2 = func() bool {
+			return d.cgroupV2
+		}
+
+		fooHigh := filepath.Join(d.cgroupDir, "cgroup", "foo", d.highFile)
+		barHigh := filepath.Join(d.cgroupDir, "cgroup", "bar", d.highFile)
+		for _, file := range []string{fooHigh, barHigh} {
+			assert.NoError(os.MkdirAll(filepath.Dir(file), testDirMode), "test %d (%+v)", i, d)
+			assert.NoError(ioutil.WriteFile(file, []byte(d.expectedRestored), testFileMode), "test %d (%+v)", i, d)
+		}
+
+		writeTestMeminfo(t, balloonMeminfo, 4194304, 3145728)
+		m, err := newBalloonMonitor(s, balloonPolicyLimit)
+		if !assert.NoError(err, "test %d (%+v)", i, d) {
+			continue
+		}
+
+		// The limited container is scaled to the guest memory left
+		writeTestMeminfo(t, balloonMeminfo, 3145728, 1048576)
+		assert.NoError(m.check(), "test %d (%+v)", i, d)
+
+		content, err := ioutil.ReadFile(fooHigh)
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedScaled, string(content), "test %d (%+v)", i, d)
+
+		content, err = ioutil.ReadFile(barHigh)
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedRestored, string(content), "test %d (%+v)", i, d)
+
+		// And restored once the memory is returned
+		writeTestMeminfo(t, balloonMeminfo, 4194304, 3145728)
+		assert.NoError(m.check(), "test %d (%+v)", i, d)
+
+		content, err = ioutil.ReadFile(fooHigh)
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedRestored, string(content), "test %d (%+v)", i, d)
+
+		assert.NoError(os.RemoveAll(filepath.Join(d.cgroupDir, "cgroup")), "test %d (%+v)", i, d)
+	}
+}
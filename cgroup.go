@@ -7,13 +7,47 @@
 package main
 
 import (
+	"bufio"
 	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/docker/docker/pkg/parsers"
+	pb "github.com/kata-containers/agent/protocols/grpc"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	memoryStatFile      = "memory.stat"
+	memoryPeakFileV1    = "memory.max_usage_in_bytes"
+	memoryPeakFileV2    = "memory.peak"
+	cgroupFileWriteMode = os.FileMode(0200)
+
+	// memory.stat entries of the page cache, the v1 total ones accounting
+	// for the descendant cgroups as well.
+	cacheStatV1     = "total_cache"
+	dirtyStatV1     = "total_dirty"
+	writebackStatV1 = "total_writeback"
+	cacheStatV2     = "file"
+	dirtyStatV2     = "file_dirty"
+	writebackStatV2 = "file_writeback"
 )
 
+// set function in variable to overwrite for testing.
+var isCgroupV2 = func() bool {
+	var st unix.Statfs_t
+	if err := unix.Statfs(cgroupPath, &st); err != nil {
+		return false
+	}
+
+	return st.Type == unix.CGROUP2_SUPER_MAGIC
+}
+
 // set function in variable to overwrite for testing.
 var getCpusetGuest = func() (string, error) {
 	cpusetGuestByte, err := ioutil.ReadFile("/sys/fs/cgroup/cpuset/cpuset.cpus")
@@ -63,3 +97,179 @@ func getAvailableCpusetList(cpusetReq string) (string, error) {
 	}).Debugf("the requested cpuset is valid, using it")
 	return cpusetReq, nil
 }
+
+// getCgroupRelPath returns the cgroup path of the container, relative to
+// the hierarchies.
+func (c *container) getCgroupRelPath() (string, error) {
+	config := c.container.Config()
+	if config.Cgroups == nil || config.Cgroups.Path == "" {
+		return "", grpcStatus.Errorf(codes.FailedPrecondition, "Container %s has no cgroup", c.id)
+	}
+
+	return config.Cgroups.Path, nil
+}
+
+// getCgroupV2Path returns the cgroup directory of the container in the
+// unified hierarchy.
+func (c *container) getCgroupV2Path() (string, error) {
+	path, err := c.getCgroupRelPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(cgroupPath, path), nil
+}
+
+// getMemoryCgroupPath returns the memory cgroup directory of the container,
+// which lives in the unified hierarchy with cgroup v2.
+func (c *container) getMemoryCgroupPath(cgroupV2 bool) (string, error) {
+	if cgroupV2 {
+		return c.getCgroupV2Path()
+	}
+
+	path, err := c.getCgroupRelPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(cgroupMemoryPath, path), nil
+}
+
+// parseMemoryStat parses a memory.stat file, made of "name value" lines.
+func parseMemoryStat(path string) (map[string]uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	stat := make(map[string]uint64)
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			return nil, grpcStatus.Errorf(codes.Internal, "Invalid line %q in %s", scanner.Text(), path)
+		}
+
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, grpcStatus.Errorf(codes.Internal, "Invalid value of %s in %s: %v", fields[0], path, err)
+		}
+
+		stat[fields[0]] = value
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return stat, nil
+}
+
+func memoryPeakFile(cgroupV2 bool) string {
+	if cgroupV2 {
+		return memoryPeakFileV2
+	}
+
+	return memoryPeakFileV1
+}
+
+// getMemoryPeak returns the peak memory usage of the cgroup. memory.peak
+// only exists with recent cgroup v2 kernels.
+func getMemoryPeak(dir string, cgroupV2 bool) (uint64, error) {
+	content, err := ioutil.ReadFile(filepath.Join(dir, memoryPeakFile(cgroupV2)))
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+}
+
+// resetMemoryPeak resets the peak memory usage of the cgroup to its current
+// usage. This is not supported with cgroup v2, the kernel only accounting
+// the reset for the file descriptor it was written to, which the agent
+// does not keep open between requests.
+func resetMemoryPeak(dir string, cgroupV2 bool) error {
+	if cgroupV2 {
+		return grpcStatus.Error(codes.FailedPrecondition, "Resetting the peak memory usage is not supported with cgroup v2")
+	}
+
+	return ioutil.WriteFile(filepath.Join(dir, memoryPeakFile(cgroupV2)), []byte("0"), cgroupFileWriteMode)
+}
+
+// getPageCacheStats returns the page cache statistics of the memory cgroup.
+func getPageCacheStats(dir string, cgroupV2 bool) (*pb.PageCacheStats, error) {
+	stat, err := parseMemoryStat(filepath.Join(dir, memoryStatFile))
+	if err != nil {
+		return nil, err
+	}
+
+	if cgroupV2 {
+		return &pb.PageCacheStats{
+			Cache:     stat[cacheStatV2],
+			Dirty:     stat[dirtyStatV2],
+			Writeback: stat[writebackStatV2],
+		}, nil
+	}
+
+	return &pb.PageCacheStats{
+		Cache:     stat[cacheStatV1],
+		Dirty:     stat[dirtyStatV1],
+		Writeback: stat[writebackStatV1],
+	}, nil
+}
+
+// parseMeminfo parses /proc/meminfo, returning its entries in bytes, see
+// proc(5).
+func parseMeminfo(path string) (map[string]uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	meminfo := make(map[string]uint64)
+
+	// Lines are made of "name: value [kB]".
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || !strings.HasSuffix(fields[0], ":") {
+			return nil, grpcStatus.Errorf(codes.Internal, "Invalid line %q in %s", scanner.Text(), path)
+		}
+
+		name := strings.TrimSuffix(fields[0], ":")
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, grpcStatus.Errorf(codes.Internal, "Invalid value of %s in %s: %v", name, path, err)
+		}
+
+		if len(fields) == 3 && fields[2] == "kB" {
+			value *= 1024
+		}
+
+		meminfo[name] = value
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return meminfo, nil
+}
+
+// getSandboxPageCacheStats returns the page cache statistics of the whole
+// sandbox.
+func getSandboxPageCacheStats() (*pb.PageCacheStats, error) {
+	info, err := parseMeminfo(meminfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PageCacheStats{
+		Cache:     info["Cached"],
+		Dirty:     info["Dirty"],
+		Writeback: info["Writeback"],
+	}, nil
+}
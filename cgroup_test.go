@@ -6,11 +6,25 @@
 package main
 
 import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	pb "github.com/kata-containers/agent/protocols/grpc"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
+const testMemoryStat = `cache 1056768
+rss 16384000
+mapped_file 532480
+swap 0
+total_rss 16384000
+`
+
 func TestGetAvailableCpusetList(t *testing.T) {
 	fakeGuestCpuset := "0-3"
 	getCpusetGuest = func() (string, error) {
@@ -43,3 +57,239 @@ func TestGetAvailableCpusetList(t *testing.T) {
 		assert.Equal(t, out, c.expectedOutput)
 	}
 }
+
+func TestParseMemoryStat(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, memoryStatFile)
+
+	_, err = parseMemoryStat(file)
+	assert.Error(err)
+
+	type testData struct {
+		content      string
+		expectedStat map[string]uint64
+		expectError  bool
+	}
+
+	data := []testData{
+		{"", map[string]uint64{}, false},
+		{"rss", nil, true},
+		{"rss foo", nil, true},
+		{"rss -1", nil, true},
+		{"rss 1 2", nil, true},
+		{testMemoryStat, map[string]uint64{
+			"cache":       1056768,
+			"rss":         16384000,
+			"mapped_file": 532480,
+			"swap":        0,
+			"total_rss":   16384000,
+		}, false},
+	}
+
+	for i, d := range data {
+		err := ioutil.WriteFile(file, []byte(d.content), testFileMode)
+		assert.NoError(err)
+
+		stat, err := parseMemoryStat(file)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.Equal(d.expectedStat, stat, "test %d (%+v)", i, d)
+		}
+	}
+}
+
+func TestMemoryStatContainer(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedCgroupPath := cgroupPath
+	savedCgroupMemoryPath := cgroupMemoryPath
+	savedIsCgroupV2 := isCgroupV2
+	defer func() {
+		cgroupPath = savedCgroupPath
+		cgroupMemoryPath = savedCgroupMemoryPath
+		isCgroupV2 = savedIsCgroupV2
+	}()
+
+	cgroupPath = dir
+	cgroupMemoryPath = filepath.Join(dir, "memory")
+
+	containerID := "foo"
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: map[string]*container{
+				containerID: {
+					id:        containerID,
+					container: &mockContainer{id: containerID},
+				},
+			},
+		},
+	}
+
+	_, err = a.MemoryStatContainer(context.TODO(), &pb.MemoryStatContainerRequest{ContainerId: "bar"})
+	assert.Error(err)
+
+	type testData struct {
+		cgroupV2  bool
+		cgroupDir string
+		peakFile  string
+	}
+
+	data := []testData{
+		{false, filepath.Join(cgroupMemoryPath, "cgroup", containerID), memoryPeakFileV1},
+		{true, filepath.Join(cgroupPath, "cgroup", containerID), memoryPeakFileV2},
+	}
+
+	for i, d := range data {
+		isCgroupV2 = func() bool {
+			return d.cgroupV2
+		}
+
+		req := &pb.MemoryStatContainerRequest{ContainerId: containerID}
+
+		// No cgroup
+		_, err := a.MemoryStatContainer(context.TODO(), req)
+		assert.Error(err, "test %d (%+v)", i, d)
+
+		err = os.MkdirAll(d.cgroupDir, testDirMode)
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		err = ioutil.WriteFile(filepath.Join(d.cgroupDir, memoryStatFile), []byte(testMemoryStat), testFileMode)
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		// The peak memory usage is not supported by all kernels
+		resp, err := a.MemoryStatContainer(context.TODO(), req)
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(uint64(16384000), resp.Stat["rss"], "test %d (%+v)", i, d)
+		assert.Equal(uint64(0), resp.Peak, "test %d (%+v)", i, d)
+		assert.Equal(d.cgroupV2, resp.CgroupV2, "test %d (%+v)", i, d)
+
+		peakFile := filepath.Join(d.cgroupDir, d.peakFile)
+		err = ioutil.WriteFile(peakFile, []byte("20971520\n"), testFileMode)
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		resp, err = a.MemoryStatContainer(context.TODO(), req)
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(uint64(20971520), resp.Peak, "test %d (%+v)", i, d)
+
+		// The peak is reported before being reset, which cgroup v2 does
+		// not support for another file descriptor.
+		req.ResetPeak = true
+		resp, err = a.MemoryStatContainer(context.TODO(), req)
+		content, readErr := ioutil.ReadFile(peakFile)
+		assert.NoError(readErr, "test %d (%+v)", i, d)
+		if d.cgroupV2 {
+			assert.Equal(codes.FailedPrecondition, grpcStatus.Code(err), "test %d (%+v)", i, d)
+			assert.Equal("20971520\n", string(content), "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.Equal(uint64(20971520), resp.Peak, "test %d (%+v)", i, d)
+			assert.Equal("0", string(content), "test %d (%+v)", i, d)
+		}
+
+		assert.NoError(os.RemoveAll(filepath.Join(dir, "memory", "cgroup")))
+		assert.NoError(os.RemoveAll(filepath.Join(dir, "cgroup")))
+	}
+}
+
+func TestPageCacheStats(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedCgroupPath := cgroupPath
+	savedCgroupMemoryPath := cgroupMemoryPath
+	savedIsCgroupV2 := isCgroupV2
+	savedMeminfo := meminfo
+	defer func() {
+		cgroupPath = savedCgroupPath
+		cgroupMemoryPath = savedCgroupMemoryPath
+		isCgroupV2 = savedIsCgroupV2
+		meminfo = savedMeminfo
+	}()
+
+	cgroupPath = dir
+	cgroupMemoryPath = filepath.Join(dir, "memory")
+	meminfo = filepath.Join(dir, "meminfo")
+
+	err = ioutil.WriteFile(meminfo, []byte("MemTotal:        2043656 kB\n"+
+		"Cached:           104856 kB\nDirty:               48 kB\n"+
+		"Writeback:            8 kB\nHugePages_Total:       0\n"), testFileMode)
+	assert.NoError(err)
+
+	containerID := "foo"
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: map[string]*container{
+				containerID: {
+					id: containerID,
+					container: &mockContainer{
+						id: containerID,
+					},
+				},
+			},
+		},
+	}
+
+	type testData struct {
+		cgroupV2 bool
+		stat     string
+		expected pb.PageCacheStats
+	}
+
+	data := []testData{
+		{
+			false,
+			"cache 1056768\ndirty 4096\nwriteback 0\ntotal_cache 2105344\ntotal_dirty 12288\ntotal_writeback 8192\n",
+			pb.PageCacheStats{Cache: 2105344, Dirty: 12288, Writeback: 8192},
+		},
+		{
+			true,
+			"anon 16384000\nfile 1056768\nfile_dirty 4096\nfile_writeback 8192\n",
+			pb.PageCacheStats{Cache: 1056768, Dirty: 4096, Writeback: 8192},
+		},
+	}
+
+	for i, d := range data {
+		cgroupV2 := d.cgroupV2
+		isCgroupV2 = func() bool {
+			return cgroupV2
+		}
+
+		cgroupDir := filepath.Join(cgroupMemoryPath, "cgroup", containerID)
+		if d.cgroupV2 {
+			cgroupDir = filepath.Join(cgroupPath, "cgroup", containerID)
+		}
+		assert.NoError(os.MkdirAll(cgroupDir, testDirMode))
+		assert.NoError(ioutil.WriteFile(filepath.Join(cgroupDir, memoryStatFile), []byte(d.stat), testFileMode))
+
+		resp, err := a.StatsContainer(context.Background(), &pb.StatsContainerRequest{ContainerId: containerID})
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(&d.expected, resp.PageCache, "test %d (%+v)", i, d)
+		assert.Equal(&pb.PageCacheStats{Cache: 104856 * 1024, Dirty: 48 * 1024, Writeback: 8 * 1024},
+			resp.SandboxPageCache, "test %d (%+v)", i, d)
+
+		assert.NoError(os.RemoveAll(filepath.Join(dir, "memory", "cgroup")))
+		assert.NoError(os.RemoveAll(filepath.Join(dir, "cgroup")))
+	}
+
+	// Statistics which cannot be read are left unset
+	assert.NoError(ioutil.WriteFile(meminfo, []byte("Cached: foo kB\n"), testFileMode))
+
+	resp, err := a.StatsContainer(context.Background(), &pb.StatsContainerRequest{ContainerId: containerID})
+	assert.NoError(err)
+	assert.Nil(resp.PageCache)
+	assert.Nil(resp.SandboxPageCache)
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/hashicorp/yamux"
 	"github.com/mdlayher/vsock"
+	"github.com/vishvananda/netlink"
 	"golang.org/x/sys/unix"
 	"google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
@@ -46,15 +47,21 @@ type channel interface {
 // (channelExistMaxTries * channelExistWaitTime) / 1000 = timeout in seconds
 // If there are neither vsocks nor serial ports, an error is returned.
 func newChannel(ctx context.Context) (channel, error) {
-	span, _ := trace(ctx, "channel", "newChannel")
+	span, ctx := trace(ctx, "channel", "newChannel")
 	defer span.finish()
 
+	return findChannel(ctx, commCh)
+}
+
+// findChannel waits for a channel of the given type, either of them being
+// looked for if the type is unknown.
+func findChannel(ctx context.Context, chType commType) (channel, error) {
 	var serialErr error
 	var vsockErr error
 	var ch channel
 
 	for i := 0; i < channelExistMaxTries; i++ {
-		switch commCh {
+		switch chType {
 		case serialCh:
 			if ch, serialErr = checkForSerialChannel(ctx); serialErr == nil && ch.(*serialChannel) != nil {
 				return ch, nil
@@ -89,6 +96,46 @@ func newChannel(ctx context.Context) (channel, error) {
 	return nil, fmt.Errorf("Neither vsocks nor serial ports were found")
 }
 
+// newChannels creates the channels of the listeners enabled on the kernel
+// command line, or the channel found by newChannel if none is.
+func newChannels(ctx context.Context) ([]channel, error) {
+	if !vsockListener && !serialListener && tcpListener == "" {
+		ch, err := newChannel(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return []channel{ch}, nil
+	}
+
+	span, ctx := trace(ctx, "channel", "newChannels")
+	defer span.finish()
+
+	var channels []channel
+
+	if vsockListener {
+		ch, err := findChannel(ctx, vsockCh)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, ch)
+	}
+
+	if serialListener {
+		ch, err := findChannel(ctx, serialCh)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, ch)
+	}
+
+	if tcpListener != "" {
+		channels = append(channels, &tcpChannel{addr: tcpListener, nsName: tcpListenerNsName})
+	}
+
+	return channels, nil
+}
+
 func checkForSerialChannel(ctx context.Context) (*serialChannel, error) {
 	span, _ := trace(ctx, "channel", "checkForSerialChannel")
 	defer span.finish()
@@ -268,6 +315,79 @@ func (c *serialChannel) teardown() error {
 	return c.serialConn.Close()
 }
 
+// Name of the persistent network namespace of the TCP listener.
+const tcpListenerNsName = "agent-net"
+
+// tcpChannel listens on a loopback TCP address, for tooling running in the
+// guest rather than reaching it through a vsock or a serial port. The agent
+// API is not authenticated and the containers share the guest network
+// namespace, loopback included. The listener is thus bound in a network
+// namespace of its own, that the tooling enters through its persistent path,
+// e.g. with nsenter --net=/var/run/sandbox-ns/agent-net.
+type tcpChannel struct {
+	addr   string
+	nsName string
+	ns     *namespace
+}
+
+func (c *tcpChannel) setup() error {
+	if err := validateTCPListener(c.addr); err != nil {
+		return err
+	}
+
+	ns, err := setupNamedPersistentNs(nsTypeNet, c.nsName)
+	if err != nil {
+		return err
+	}
+
+	// The loopback interface of a new network namespace is down.
+	err = ns.run(nsTypeNet, func() error {
+		lo, err := netlink.LinkByName("lo")
+		if err != nil {
+			return err
+		}
+		return netlink.LinkSetUp(lo)
+	})
+	if err != nil {
+		ns.remove()
+		return err
+	}
+
+	c.ns = ns
+
+	return nil
+}
+
+func (c *tcpChannel) wait() error {
+	return nil
+}
+
+func (c *tcpChannel) listen() (net.Listener, error) {
+	var l net.Listener
+	err := c.ns.run(nsTypeNet, func() (err error) {
+		l, err = net.Listen("tcp", c.addr)
+		return err
+	})
+	if err != nil {
+		// The channel is not torn down when it cannot listen.
+		c.teardown()
+		return nil, err
+	}
+
+	return l, nil
+}
+
+func (c *tcpChannel) teardown() error {
+	if c.ns == nil {
+		return nil
+	}
+
+	err := c.ns.remove()
+	c.ns = nil
+
+	return err
+}
+
 // isAFVSockSupported checks if vsock channel is used by the runtime
 // by checking for devices under the vhost-vsock driver path.
 // It returns true if a device is found for the vhost-vsock driver.
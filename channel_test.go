@@ -9,13 +9,18 @@ package main
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"syscall"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
 )
 
 func TestSetupVSockChannel(t *testing.T) {
@@ -91,6 +96,99 @@ func TestTeardownSerialChannelTimeout(t *testing.T) {
 	assert.NotNil(t, err, "channel close should timeout")
 }
 
+// Argument used by the TCP channel tests to re-execute the test binary as a
+// process connecting to the address passed as next argument.
+const tcpDialTestArg = "tcp-dial-test"
+
+func init() {
+	if len(os.Args) < 3 || os.Args[1] != tcpDialTestArg {
+		return
+	}
+
+	conn, err := net.DialTimeout("tcp", os.Args[2], time.Second)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	conn.Close()
+	os.Exit(0)
+}
+
+// dialInNs connects to the address from the persistent network namespace.
+func dialInNs(ns *namespace, addr string) (net.Conn, error) {
+	var conn net.Conn
+	err := ns.run(nsTypeNet, func() (err error) {
+		conn, err = net.DialTimeout("tcp", addr, time.Second)
+		return err
+	})
+
+	return conn, err
+}
+
+func TestListenTCPChannel(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedPersistentNsDir := persistentNsDir
+	defer func() {
+		persistentNsDir = savedPersistentNsDir
+	}()
+	persistentNsDir = dir
+
+	// The agent API is never exposed to the guest network
+	c := &tcpChannel{addr: "0.0.0.0:0", nsName: tcpListenerNsName}
+	assert.Error(c.setup())
+	_, err = os.Stat(filepath.Join(dir, tcpListenerNsName))
+	assert.True(os.IsNotExist(err))
+
+	c = &tcpChannel{addr: "127.0.0.1:0", nsName: tcpListenerNsName}
+	if !assert.NoError(c.setup()) {
+		return
+	}
+	l, err := c.listen()
+	if !assert.NoError(err) {
+		c.teardown()
+		return
+	}
+	defer l.Close()
+
+	addr := l.Addr().String()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	// The tooling entering the listener namespace reaches it
+	conn, err := dialInNs(c.ns, addr)
+	if assert.NoError(err) {
+		conn.Close()
+	}
+
+	// Container processes, sharing the guest network namespace or
+	// running in their own one, do not
+	for _, cloneflags := range []uintptr{0, unix.CLONE_NEWNET} {
+		cmd := exec.Command(os.Args[0], tcpDialTestArg, addr)
+		cmd.SysProcAttr = &syscall.SysProcAttr{Cloneflags: cloneflags}
+		output, err := cmd.CombinedOutput()
+		assert.Error(err, "clone flags %#x: %s", cloneflags, output)
+	}
+
+	nsPath := c.ns.path
+	assert.NoError(c.teardown())
+	_, err = os.Stat(nsPath)
+	assert.True(os.IsNotExist(err))
+}
+
 func TestNewChannel(t *testing.T) {
 	assert := assert.New(t)
 
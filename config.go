@@ -8,6 +8,8 @@ package main
 
 import (
 	"io/ioutil"
+	"net"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -27,6 +29,30 @@ const (
 	debugConsoleFlag      = optionPrefix + "debug_console"
 	debugConsoleVPortFlag = optionPrefix + "debug_console_vport"
 	hotplugTimeoutFlag    = optionPrefix + "hotplug_timeout"
+	envDenylistFlag       = optionPrefix + "env_denylist"
+	envMaskFlag           = optionPrefix + "env_mask"
+	maxContainersFlag     = optionPrefix + "max_containers"
+	maxExecsFlag          = optionPrefix + "max_execs"
+	maxContainerExecsFlag = optionPrefix + "max_container_execs"
+	unmountTimeoutFlag    = optionPrefix + "unmount_timeout"
+	storageTimeoutFlag    = optionPrefix + "storage_ready_timeout"
+	spawnAttemptsFlag     = optionPrefix + "spawn_attempts"
+	syslogFlag            = optionPrefix + "syslog"
+	pciPollIntervalFlag   = optionPrefix + "pci_poll_interval"
+	workingSetFlag        = optionPrefix + "working_set_interval"
+	balloonPolicyFlag     = optionPrefix + "balloon_policy"
+	balloonIntervalFlag   = optionPrefix + "balloon_interval"
+	auditLogFlag          = optionPrefix + "audit_log"
+	vsockListenerFlag     = optionPrefix + "vsock_listener"
+	serialListenerFlag    = optionPrefix + "serial_listener"
+	tcpListenerFlag       = optionPrefix + "tcp_listener"
+	raiseNrOpenFlag       = optionPrefix + "raise_nr_open"
+	rootfsInspectFlag     = optionPrefix + "rootfs_inspect"
+	profilingFlag         = optionPrefix + "profiling"
+	defaultNiceFlag       = optionPrefix + "default_nice"
+	sandboxReplayFlag     = optionPrefix + "sandbox_replay"
+	ifaceCollisionFlag    = optionPrefix + "iface_name_collision"
+	apparmorFlag          = optionPrefix + "apparmor"
 	kernelCmdlineFile     = "/proc/cmdline"
 	traceModeStatic       = "static"
 	traceModeDynamic      = "dynamic"
@@ -116,6 +142,11 @@ func (c *agentConfig) parseCmdlineOption(option string) error {
 			return err
 		}
 		logsVSockPort = uint32(port)
+	case syslogFlag:
+		if _, _, err := parseSyslogDestination(split[valuePosition]); err != nil {
+			return err
+		}
+		syslogDestination = split[valuePosition]
 	case debugConsoleVPortFlag:
 		port, err := strconv.ParseUint(split[valuePosition], 10, 32)
 		if err != nil {
@@ -139,6 +170,165 @@ func (c *agentConfig) parseCmdlineOption(option string) error {
 		case traceTypeCollated:
 			enableTracing(traceModeStatic, traceTypeCollated)
 		}
+	case unmountTimeoutFlag:
+		timeout, err := time.ParseDuration(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		// Only use the provided timeout if a positive value is provided
+		if timeout > 0 {
+			unmountTimeout = timeout
+		}
+	case storageTimeoutFlag:
+		timeout, err := time.ParseDuration(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		if timeout < 0 {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid storage ready timeout %s", timeout)
+		}
+		storageReadyTimeout = timeout
+	case pciPollIntervalFlag:
+		interval, err := time.ParseDuration(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		if interval < 0 {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid PCI poll interval %s", interval)
+		}
+		pciPollInterval = interval
+	case workingSetFlag:
+		interval, err := time.ParseDuration(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		if interval < 0 {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid working set sampling interval %s", interval)
+		}
+		workingSetInterval = interval
+	case balloonPolicyFlag:
+		if !balloonPolicies[split[valuePosition]] {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid balloon policy %q", split[valuePosition])
+		}
+		balloonPolicy = split[valuePosition]
+	case ifaceCollisionFlag:
+		if !ifaceNameCollisionPolicies[split[valuePosition]] {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid interface name collision policy %q", split[valuePosition])
+		}
+		ifaceNameCollisionPolicy = split[valuePosition]
+	case balloonIntervalFlag:
+		interval, err := time.ParseDuration(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		if interval < 0 {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid balloon check interval %s", interval)
+		}
+		balloonInterval = interval
+	case spawnAttemptsFlag:
+		attempts, err := strconv.ParseUint(split[valuePosition], 10, 32)
+		if err != nil {
+			return err
+		}
+		// At least one attempt is always made
+		if attempts > 0 {
+			spawnAttempts = int(attempts)
+		}
+	case maxContainersFlag:
+		max, err := strconv.ParseUint(split[valuePosition], 10, 32)
+		if err != nil {
+			return err
+		}
+		maxContainers = int(max)
+	case maxExecsFlag:
+		max, err := strconv.ParseUint(split[valuePosition], 10, 32)
+		if err != nil {
+			return err
+		}
+		maxExecs = int(max)
+	case maxContainerExecsFlag:
+		max, err := strconv.ParseUint(split[valuePosition], 10, 32)
+		if err != nil {
+			return err
+		}
+		maxContainerExecs = int(max)
+	case envDenylistFlag:
+		// The expression itself may contain the separator
+		expr := strings.SplitN(option, optionSeparator, 2)[valuePosition]
+		denylist, err := regexp.Compile(expr)
+		if err != nil {
+			return err
+		}
+		envDenylist = denylist
+	case envMaskFlag:
+		flag, err := strconv.ParseBool(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		envMask = flag
+	case auditLogFlag:
+		flag, err := strconv.ParseBool(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		auditLog = flag
+	case raiseNrOpenFlag:
+		flag, err := strconv.ParseBool(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		raiseNrOpen = flag
+	case rootfsInspectFlag:
+		flag, err := strconv.ParseBool(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		rootfsInspect = flag
+	case profilingFlag:
+		flag, err := strconv.ParseBool(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		profiling = flag
+	case defaultNiceFlag:
+		nice, err := parseNice(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		defaultNice = &nice
+	case sandboxReplayFlag:
+		flag, err := strconv.ParseBool(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		sandboxReplay = flag
+	case apparmorFlag:
+		flag, err := strconv.ParseBool(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		apparmorProfiles = flag
+	case vsockListenerFlag:
+		flag, err := strconv.ParseBool(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		vsockListener = flag
+	case serialListenerFlag:
+		flag, err := strconv.ParseBool(split[valuePosition])
+		if err != nil {
+			return err
+		}
+		serialListener = flag
+	case tcpListenerFlag:
+		addr := split[valuePosition]
+		// An empty address disables the listener
+		if addr != "" {
+			if err := validateTCPListener(addr); err != nil {
+				return err
+			}
+		}
+		tcpListener = addr
 	case useVsockFlag:
 		flag, err := strconv.ParseBool(split[valuePosition])
 		if err != nil {
@@ -161,6 +351,26 @@ func (c *agentConfig) parseCmdlineOption(option string) error {
 
 }
 
+// validateTCPListener checks the TCP listener address, which may only be a
+// loopback address as the network namespace of the listener has no other
+// interface.
+func validateTCPListener(addr string) error {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Invalid TCP listener port %q", port)
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsLoopback() {
+		return grpcStatus.Errorf(codes.InvalidArgument, "TCP listener address %q is not a loopback address", host)
+	}
+
+	return nil
+}
+
 func enableTracing(traceMode, traceType string) {
 	tracing = true
 
@@ -451,3 +451,795 @@ func TestParseCmdlineOptionHotplugTimeout(t *testing.T) {
 		assert.Equal(d.expectedHotplugTimeout, hotplugTimeout, "test %d (%+v)", i, d)
 	}
 }
+
+func TestParseCmdlineOptionEnvDenylist(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentConfig{}
+
+	savedEnvDenylist := envDenylist
+	savedEnvMask := envMask
+	defer func() {
+		envDenylist = savedEnvDenylist
+		envMask = savedEnvMask
+	}()
+
+	type testData struct {
+		option           string
+		shouldErr        bool
+		expectedDenylist string
+		expectedMask     bool
+	}
+
+	data := []testData{
+		{"", false, "", false},
+		{"env_denylist=^FOO", false, "", false},
+		{"agent.env_denylist=^FOO", false, "^FOO", false},
+		{"agent.env_denylist=^(FOO|BAR=)", false, "^(FOO|BAR=)", false},
+		{"agent.env_denylist=^(FOO", true, "", false},
+		{"agent.env_mask=true", false, "", true},
+		{"agent.env_mask=0", false, "", false},
+		{"agent.env_mask=foo", true, "", false},
+	}
+
+	for i, d := range data {
+		envDenylist = nil
+		envMask = false
+
+		err := a.parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		if d.expectedDenylist == "" {
+			assert.Nil(envDenylist, "test %d (%+v)", i, d)
+		} else {
+			assert.Equal(d.expectedDenylist, envDenylist.String(), "test %d (%+v)", i, d)
+		}
+
+		assert.Equal(d.expectedMask, envMask, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionMaxContainers(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentConfig{}
+
+	savedMaxContainers := maxContainers
+	defer func() {
+		maxContainers = savedMaxContainers
+	}()
+
+	type testData struct {
+		option                string
+		shouldErr             bool
+		expectedMaxContainers int
+	}
+
+	data := []testData{
+		{"", false, 0},
+		{"max_containers=10", false, 0},
+		{"agent.max_containers=10", false, 10},
+		{"agent.max_containers=0", false, 0},
+		{"agent.max_containers=-1", true, 0},
+		{"agent.max_containers=foo", true, 0},
+	}
+
+	for i, d := range data {
+		maxContainers = 0
+
+		err := a.parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		assert.Equal(d.expectedMaxContainers, maxContainers, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionMaxExecs(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentConfig{}
+
+	savedMaxExecs := maxExecs
+	savedMaxContainerExecs := maxContainerExecs
+	defer func() {
+		maxExecs = savedMaxExecs
+		maxContainerExecs = savedMaxContainerExecs
+	}()
+
+	type testData struct {
+		option                    string
+		shouldErr                 bool
+		expectedMaxExecs          int
+		expectedMaxContainerExecs int
+	}
+
+	data := []testData{
+		{"", false, 0, 0},
+		{"max_execs=10", false, 0, 0},
+		{"agent.max_execs=10", false, 10, 0},
+		{"agent.max_execs=0", false, 0, 0},
+		{"agent.max_execs=-1", true, 0, 0},
+		{"agent.max_execs=foo", true, 0, 0},
+		{"agent.max_container_execs=5", false, 0, 5},
+		{"agent.max_container_execs=-1", true, 0, 0},
+		{"agent.max_container_execs=", true, 0, 0},
+	}
+
+	for i, d := range data {
+		maxExecs = 0
+		maxContainerExecs = 0
+
+		err := a.parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		assert.Equal(d.expectedMaxExecs, maxExecs, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedMaxContainerExecs, maxContainerExecs, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionUnmountTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentConfig{}
+
+	savedUnmountTimeout := unmountTimeout
+	defer func() {
+		unmountTimeout = savedUnmountTimeout
+	}()
+
+	type testData struct {
+		option                 string
+		shouldErr              bool
+		expectedUnmountTimeout time.Duration
+	}
+
+	data := []testData{
+		{"", false, 10 * time.Second},
+		{"unmount_timeout=1s", false, 10 * time.Second},
+		{"agent.unmount_timeout=1s", false, 1 * time.Second},
+		{"agent.unmount_timeout=2m", false, 2 * time.Minute},
+		{"agent.unmount_timeout=0", false, 10 * time.Second},
+		{"agent.unmount_timeout=-1s", false, 10 * time.Second},
+		{"agent.unmount_timeout=foo", true, 10 * time.Second},
+	}
+
+	for i, d := range data {
+		unmountTimeout = 10 * time.Second
+
+		err := a.parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		assert.Equal(d.expectedUnmountTimeout, unmountTimeout, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionStorageReadyTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentConfig{}
+
+	savedStorageReadyTimeout := storageReadyTimeout
+	defer func() {
+		storageReadyTimeout = savedStorageReadyTimeout
+	}()
+
+	type testData struct {
+		option                      string
+		shouldErr                   bool
+		expectedStorageReadyTimeout time.Duration
+	}
+
+	data := []testData{
+		{"", false, 30 * time.Second},
+		{"storage_ready_timeout=1s", false, 30 * time.Second},
+		{"agent.storage_ready_timeout=1s", false, time.Second},
+		{"agent.storage_ready_timeout=2m", false, 2 * time.Minute},
+		{"agent.storage_ready_timeout=0", false, 0},
+		{"agent.storage_ready_timeout=-1s", true, 30 * time.Second},
+		{"agent.storage_ready_timeout=foo", true, 30 * time.Second},
+	}
+
+	for i, d := range data {
+		storageReadyTimeout = 30 * time.Second
+
+		err := a.parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		assert.Equal(d.expectedStorageReadyTimeout, storageReadyTimeout, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionSpawnAttempts(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentConfig{}
+
+	savedSpawnAttempts := spawnAttempts
+	defer func() {
+		spawnAttempts = savedSpawnAttempts
+	}()
+
+	type testData struct {
+		option                string
+		shouldErr             bool
+		expectedSpawnAttempts int
+	}
+
+	data := []testData{
+		{"", false, 3},
+		{"spawn_attempts=5", false, 3},
+		{"agent.spawn_attempts=5", false, 5},
+		{"agent.spawn_attempts=1", false, 1},
+		{"agent.spawn_attempts=0", false, 3},
+		{"agent.spawn_attempts=-1", true, 3},
+		{"agent.spawn_attempts=foo", true, 3},
+	}
+
+	for i, d := range data {
+		spawnAttempts = 3
+
+		err := a.parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		assert.Equal(d.expectedSpawnAttempts, spawnAttempts, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionSyslog(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentConfig{}
+
+	savedSyslogDestination := syslogDestination
+	defer func() {
+		syslogDestination = savedSyslogDestination
+	}()
+
+	type testData struct {
+		option                    string
+		shouldErr                 bool
+		expectedSyslogDestination string
+	}
+
+	data := []testData{
+		{"", false, ""},
+		{"syslog=vsock://2:514", false, ""},
+		{"agent.syslog=vsock://2:514", false, "vsock://2:514"},
+		{"agent.syslog=unix:///dev/log", false, "unix:///dev/log"},
+		{"agent.syslog=vsock://2", true, ""},
+		{"agent.syslog=foo", true, ""},
+	}
+
+	for i, d := range data {
+		syslogDestination = ""
+
+		err := a.parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		assert.Equal(d.expectedSyslogDestination, syslogDestination, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionPCIPollInterval(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentConfig{}
+
+	savedPCIPollInterval := pciPollInterval
+	defer func() {
+		pciPollInterval = savedPCIPollInterval
+	}()
+
+	type testData struct {
+		option                  string
+		shouldErr               bool
+		expectedPCIPollInterval time.Duration
+	}
+
+	data := []testData{
+		{"", false, 0},
+		{"pci_poll_interval=10ms", false, 0},
+		{"agent.pci_poll_interval=10ms", false, 10 * time.Millisecond},
+		{"agent.pci_poll_interval=1s", false, time.Second},
+		{"agent.pci_poll_interval=0", false, 0},
+		{"agent.pci_poll_interval=-1s", true, 0},
+		{"agent.pci_poll_interval=foo", true, 0},
+	}
+
+	for i, d := range data {
+		pciPollInterval = 0
+
+		err := a.parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		assert.Equal(d.expectedPCIPollInterval, pciPollInterval, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionWorkingSetInterval(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentConfig{}
+
+	savedWorkingSetInterval := workingSetInterval
+	defer func() {
+		workingSetInterval = savedWorkingSetInterval
+	}()
+
+	type testData struct {
+		option                     string
+		shouldErr                  bool
+		expectedWorkingSetInterval time.Duration
+	}
+
+	data := []testData{
+		{"", false, 0},
+		{"working_set_interval=10ms", false, 0},
+		{"agent.working_set_interval=10ms", false, 10 * time.Millisecond},
+		{"agent.working_set_interval=1s", false, time.Second},
+		{"agent.working_set_interval=0", false, 0},
+		{"agent.working_set_interval=-1s", true, 0},
+		{"agent.working_set_interval=foo", true, 0},
+	}
+
+	for i, d := range data {
+		workingSetInterval = 0
+
+		err := a.parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		assert.Equal(d.expectedWorkingSetInterval, workingSetInterval, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionBalloon(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentConfig{}
+
+	savedBalloonPolicy := balloonPolicy
+	savedBalloonInterval := balloonInterval
+	defer func() {
+		balloonPolicy = savedBalloonPolicy
+		balloonInterval = savedBalloonInterval
+	}()
+
+	type testData struct {
+		option                  string
+		shouldErr               bool
+		expectedBalloonPolicy   string
+		expectedBalloonInterval time.Duration
+	}
+
+	data := []testData{
+		{"", false, balloonPolicyNone, 0},
+		{"balloon_policy=limit", false, balloonPolicyNone, 0},
+		{"agent.balloon_policy=event", false, balloonPolicyEvent, 0},
+		{"agent.balloon_policy=limit", false, balloonPolicyLimit, 0},
+		{"agent.balloon_policy=none", false, balloonPolicyNone, 0},
+		{"agent.balloon_policy=foo", true, balloonPolicyNone, 0},
+		{"agent.balloon_interval=1s", false, balloonPolicyNone, time.Second},
+		{"agent.balloon_interval=0", false, balloonPolicyNone, 0},
+		{"agent.balloon_interval=-1s", true, balloonPolicyNone, 0},
+		{"agent.balloon_interval=foo", true, balloonPolicyNone, 0},
+	}
+
+	for i, d := range data {
+		balloonPolicy = balloonPolicyNone
+		balloonInterval = 0
+
+		err := a.parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		assert.Equal(d.expectedBalloonPolicy, balloonPolicy, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedBalloonInterval, balloonInterval, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionIfaceNameCollision(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentConfig{}
+
+	savedPolicy := ifaceNameCollisionPolicy
+	defer func() {
+		ifaceNameCollisionPolicy = savedPolicy
+	}()
+
+	type testData struct {
+		option         string
+		shouldErr      bool
+		expectedPolicy string
+	}
+
+	data := []testData{
+		{"", false, ifaceNameCollisionError},
+		{"iface_name_collision=rename", false, ifaceNameCollisionError},
+		{"agent.iface_name_collision=rename", false, ifaceNameCollisionRename},
+		{"agent.iface_name_collision=error", false, ifaceNameCollisionError},
+		{"agent.iface_name_collision=foo", true, ifaceNameCollisionError},
+	}
+
+	for i, d := range data {
+		ifaceNameCollisionPolicy = ifaceNameCollisionError
+
+		err := a.parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		assert.Equal(d.expectedPolicy, ifaceNameCollisionPolicy, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionAuditLog(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentConfig{}
+
+	savedAuditLog := auditLog
+	defer func() {
+		auditLog = savedAuditLog
+	}()
+
+	type testData struct {
+		option           string
+		shouldErr        bool
+		expectedAuditLog bool
+	}
+
+	data := []testData{
+		{"", false, false},
+		{"audit_log=true", false, false},
+		{"agent.audit_log=true", false, true},
+		{"agent.audit_log=1", false, true},
+		{"agent.audit_log=false", false, false},
+		{"agent.audit_log=foo", true, false},
+	}
+
+	for i, d := range data {
+		auditLog = false
+
+		err := a.parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		assert.Equal(d.expectedAuditLog, auditLog, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionListeners(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentConfig{}
+
+	savedVsockListener := vsockListener
+	savedSerialListener := serialListener
+	savedTCPListener := tcpListener
+	defer func() {
+		vsockListener = savedVsockListener
+		serialListener = savedSerialListener
+		tcpListener = savedTCPListener
+	}()
+
+	type testData struct {
+		option                 string
+		shouldErr              bool
+		expectedVsockListener  bool
+		expectedSerialListener bool
+		expectedTCPListener    string
+	}
+
+	data := []testData{
+		{"", false, false, false, ""},
+		{"agent.vsock_listener=true", false, true, false, ""},
+		{"agent.vsock_listener=foo", true, false, false, ""},
+		{"agent.serial_listener=1", false, false, true, ""},
+		{"agent.serial_listener=false", false, false, false, ""},
+		{"agent.tcp_listener=127.0.0.1:1024", false, false, false, "127.0.0.1:1024"},
+		{"agent.tcp_listener=[::1]:1024", false, false, false, "[::1]:1024"},
+		{"agent.tcp_listener=0.0.0.0:1024", true, false, false, ""},
+		{"agent.tcp_listener=[::]:1024", true, false, false, ""},
+		{"agent.tcp_listener=:1024", true, false, false, ""},
+		{"agent.tcp_listener=10.0.0.1:1024", true, false, false, ""},
+		{"agent.tcp_listener=localhost:1024", true, false, false, ""},
+		{"agent.tcp_listener=", false, false, false, ""},
+		{"agent.tcp_listener=1024", true, false, false, ""},
+		{"agent.tcp_listener=:foo", true, false, false, ""},
+		{"agent.tcp_listener=:65536", true, false, false, ""},
+	}
+
+	for i, d := range data {
+		vsockListener = false
+		serialListener = false
+		tcpListener = ""
+
+		err := a.parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		assert.Equal(d.expectedVsockListener, vsockListener, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedSerialListener, serialListener, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedTCPListener, tcpListener, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionRaiseNrOpen(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentConfig{}
+
+	savedRaiseNrOpen := raiseNrOpen
+	defer func() {
+		raiseNrOpen = savedRaiseNrOpen
+	}()
+
+	type testData struct {
+		option              string
+		shouldErr           bool
+		expectedRaiseNrOpen bool
+	}
+
+	data := []testData{
+		{"", false, false},
+		{"raise_nr_open=true", false, false},
+		{"agent.raise_nr_open=true", false, true},
+		{"agent.raise_nr_open=false", false, false},
+		{"agent.raise_nr_open=foo", true, false},
+	}
+
+	for i, d := range data {
+		raiseNrOpen = false
+
+		err := a.parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		assert.Equal(d.expectedRaiseNrOpen, raiseNrOpen, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionRootfsInspect(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentConfig{}
+
+	savedRootfsInspect := rootfsInspect
+	defer func() {
+		rootfsInspect = savedRootfsInspect
+	}()
+
+	type testData struct {
+		option                string
+		shouldErr             bool
+		expectedRootfsInspect bool
+	}
+
+	data := []testData{
+		{"", false, false},
+		{"rootfs_inspect=true", false, false},
+		{"agent.rootfs_inspect=true", false, true},
+		{"agent.rootfs_inspect=false", false, false},
+		{"agent.rootfs_inspect=foo", true, false},
+	}
+
+	for i, d := range data {
+		rootfsInspect = false
+
+		err := a.parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		assert.Equal(d.expectedRootfsInspect, rootfsInspect, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionProfiling(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentConfig{}
+
+	savedProfiling := profiling
+	defer func() {
+		profiling = savedProfiling
+	}()
+
+	type testData struct {
+		option            string
+		shouldErr         bool
+		expectedProfiling bool
+	}
+
+	data := []testData{
+		{"", false, false},
+		{"profiling=true", false, false},
+		{"agent.profiling=true", false, true},
+		{"agent.profiling=false", false, false},
+		{"agent.profiling=foo", true, false},
+	}
+
+	for i, d := range data {
+		profiling = false
+
+		err := a.parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		assert.Equal(d.expectedProfiling, profiling, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionSandboxReplay(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentConfig{}
+
+	savedSandboxReplay := sandboxReplay
+	defer func() {
+		sandboxReplay = savedSandboxReplay
+	}()
+
+	type testData struct {
+		option                string
+		shouldErr             bool
+		expectedSandboxReplay bool
+	}
+
+	data := []testData{
+		{"", false, true},
+		{"sandbox_replay=false", false, true},
+		{"agent.sandbox_replay=false", false, false},
+		{"agent.sandbox_replay=true", false, true},
+		{"agent.sandbox_replay=foo", true, true},
+	}
+
+	for i, d := range data {
+		sandboxReplay = true
+
+		err := a.parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		assert.Equal(d.expectedSandboxReplay, sandboxReplay, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionApparmor(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentConfig{}
+
+	savedApparmorProfiles := apparmorProfiles
+	defer func() {
+		apparmorProfiles = savedApparmorProfiles
+	}()
+
+	type testData struct {
+		option                   string
+		shouldErr                bool
+		expectedApparmorProfiles bool
+	}
+
+	data := []testData{
+		{"", false, false},
+		{"apparmor=true", false, false},
+		{"agent.apparmor=true", false, true},
+		{"agent.apparmor=false", false, false},
+		{"agent.apparmor=foo", true, false},
+	}
+
+	for i, d := range data {
+		apparmorProfiles = false
+
+		err := a.parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		assert.Equal(d.expectedApparmorProfiles, apparmorProfiles, "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseCmdlineOptionDefaultNice(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentConfig{}
+
+	savedDefaultNice := defaultNice
+	defer func() {
+		defaultNice = savedDefaultNice
+	}()
+
+	type testData struct {
+		option       string
+		shouldErr    bool
+		expectedNice *int
+	}
+
+	nice := 5
+	negativeNice := -3
+
+	data := []testData{
+		{"", false, nil},
+		{"default_nice=5", false, nil},
+		{"agent.default_nice=5", false, &nice},
+		{"agent.default_nice=-3", false, &negativeNice},
+		{"agent.default_nice=20", true, nil},
+		{"agent.default_nice=foo", true, nil},
+	}
+
+	for i, d := range data {
+		defaultNice = nil
+
+		err := a.parseCmdlineOption(d.option)
+		if d.shouldErr {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		assert.Equal(d.expectedNice, defaultNice, "test %d (%+v)", i, d)
+	}
+}
@@ -0,0 +1,110 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Number of attempts creating the parent directories of a copied file, a
+// directory being created then removed by a concurrent request making an
+// attempt fail.
+var copyFileMkdirAttempts = 3
+
+// mkdirParents creates the missing directories of path below root, with
+// the given mode and ownership. Existing directories, including the ones
+// created concurrently by another request, are left untouched.
+func mkdirParents(root, path string, mode os.FileMode, uid, gid int) (err error) {
+	for attempt := 1; ; attempt++ {
+		err = mkdirAllOwned(root, path, mode, uid, gid)
+		if err == nil || attempt >= copyFileMkdirAttempts || !os.IsNotExist(err) {
+			return err
+		}
+
+		agentLog.WithError(err).WithFields(logrus.Fields{
+			"path":    path,
+			"attempt": attempt,
+		}).Warn("Directory removed while creating it, retrying")
+	}
+}
+
+func mkdirAllOwned(root, path string, mode os.FileMode, uid, gid int) error {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return err
+	}
+
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return grpcStatus.Errorf(codes.InvalidArgument, "%s is not below %s", path, root)
+	}
+
+	dir := root
+	for _, name := range strings.Split(rel, string(filepath.Separator)) {
+		if name == "." {
+			continue
+		}
+		dir = filepath.Join(dir, name)
+
+		if err := os.Mkdir(dir, mode); err != nil {
+			if !os.IsExist(err) {
+				return err
+			}
+
+			// Possibly created by a concurrent request.
+			st, err := os.Stat(dir)
+			if err != nil {
+				return err
+			}
+			if !st.IsDir() {
+				return grpcStatus.Errorf(codes.FailedPrecondition, "%s is not a directory", dir)
+			}
+			continue
+		}
+
+		// The mode passed to mkdir is filtered by the umask.
+		if err := os.Chmod(dir, mode); err != nil {
+			return err
+		}
+
+		if err := os.Chown(dir, uid, gid); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkCopiedFile verifies the mode and ownership of the copied file, once
+// moved to its destination.
+func checkCopiedFile(path string, mode os.FileMode, uid, gid int) error {
+	st, err := os.Lstat(path)
+	if err != nil {
+		return err
+	}
+
+	if !st.Mode().IsRegular() {
+		return grpcStatus.Errorf(codes.Internal, "%s is not a regular file", path)
+	}
+
+	if st.Mode().Perm() != mode.Perm() {
+		return grpcStatus.Errorf(codes.Internal, "Unexpected mode %v of %s, expected %v", st.Mode().Perm(), path, mode.Perm())
+	}
+
+	stat, ok := st.Sys().(*syscall.Stat_t)
+	if !ok || int(stat.Uid) != uid || int(stat.Gid) != gid {
+		return grpcStatus.Errorf(codes.Internal, "Unexpected ownership of %s, expected %d:%d", path, uid, gid)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMkdirParents(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := ioutil.TempDir("", "mkdir")
+	assert.NoError(err)
+	defer os.RemoveAll(root)
+
+	existing := filepath.Join(root, "existing")
+	assert.NoError(os.Mkdir(existing, 0700))
+
+	path := filepath.Join(existing, "a", "b")
+	assert.NoError(mkdirParents(root, path, 0750, os.Getuid(), os.Getgid()))
+
+	// Existing directories are left untouched.
+	st, err := os.Stat(existing)
+	assert.NoError(err)
+	assert.Equal(os.FileMode(0700), st.Mode().Perm())
+
+	for _, dir := range []string{filepath.Join(existing, "a"), path} {
+		st, err := os.Stat(dir)
+		assert.NoError(err)
+		assert.True(st.IsDir())
+		assert.Equal(os.FileMode(0750), st.Mode().Perm(), dir)
+	}
+
+	// Already created
+	assert.NoError(mkdirParents(root, path, 0750, os.Getuid(), os.Getgid()))
+
+	// Not a directory
+	file := filepath.Join(root, "file")
+	assert.NoError(ioutil.WriteFile(file, nil, 0600))
+	assert.Error(mkdirParents(root, filepath.Join(file, "a"), 0750, os.Getuid(), os.Getgid()))
+
+	// Outside of the root
+	assert.Error(mkdirParents(root, filepath.Dir(root), 0750, os.Getuid(), os.Getgid()))
+}
+
+func TestMkdirParentsConcurrent(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := ioutil.TempDir("", "mkdir")
+	assert.NoError(err)
+	defer os.RemoveAll(root)
+
+	path := filepath.Join(root, "a", "b", "c")
+
+	var wg sync.WaitGroup
+	errs := make([]error, 10)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = mkdirParents(root, filepath.Join(path, "d"), 0755, os.Getuid(), os.Getgid())
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		assert.NoError(err)
+	}
+	assert.DirExists(filepath.Join(path, "d"))
+}
+
+func TestCopyFileDeepPath(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := ioutil.TempDir("", "copy")
+	assert.NoError(err)
+	defer os.RemoveAll(root)
+
+	oldContainersRootfsPath := containersRootfsPath
+	containersRootfsPath = root
+	defer func() {
+		containersRootfsPath = oldContainersRootfsPath
+	}()
+
+	// Not the default umask filtered mode.
+	const dirMode = 0710
+	uid := os.Getuid()
+	gid := os.Getgid()
+
+	data := []byte("hello")
+	req := &pb.CopyFileRequest{
+		Path:     filepath.Join(root, "a", "b", "c", "d", "file"),
+		FileSize: int64(len(data)),
+		FileMode: 0640,
+		DirMode:  dirMode,
+		Uid:      int32(uid),
+		Gid:      int32(gid),
+		Data:     data,
+	}
+
+	a := &agentGRPC{}
+	_, err = a.CopyFile(context.Background(), req)
+	assert.NoError(err)
+
+	content, err := ioutil.ReadFile(req.Path)
+	assert.NoError(err)
+	assert.Equal(data, content)
+
+	for dir := filepath.Dir(req.Path); dir != root; dir = filepath.Dir(dir) {
+		st, err := os.Stat(dir)
+		assert.NoError(err)
+		assert.Equal(os.FileMode(dirMode), st.Mode().Perm(), dir)
+
+		stat := st.Sys().(*syscall.Stat_t)
+		assert.Equal(uid, int(stat.Uid), dir)
+		assert.Equal(gid, int(stat.Gid), dir)
+	}
+
+	assert.NoError(checkCopiedFile(req.Path, 0640, uid, gid))
+	assert.Error(checkCopiedFile(req.Path, 0600, uid, gid))
+	assert.Error(checkCopiedFile(req.Path, 0640, uid+1, gid))
+	assert.Error(checkCopiedFile(filepath.Dir(req.Path), 0710, uid, gid))
+}
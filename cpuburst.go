@@ -0,0 +1,98 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Annotation used to request the CPU burst of the container, in
+// microseconds, when not set by the OCI resources.
+const cpuBurstAnnotation = "io.katacontainers.container.cpu_burst"
+
+// CPU time the cgroup can accumulate beyond its quota, only available with
+// cgroup v2.
+const cpuMaxBurstFile = "cpu.max.burst"
+
+// validateCPUBurst checks the burst against the quota it extends, the
+// kernel rejecting a burst larger than the quota.
+func validateCPUBurst(burst uint64, quota int64) error {
+	if burst == 0 {
+		return nil
+	}
+
+	if quota <= 0 {
+		return grpcStatus.Errorf(codes.InvalidArgument, "CPU burst %d requires a CPU quota", burst)
+	}
+
+	if burst > uint64(quota) {
+		return grpcStatus.Errorf(codes.InvalidArgument, "CPU burst %d larger than the CPU quota %d", burst, quota)
+	}
+
+	return nil
+}
+
+// setContainerCPUBurst records the CPU burst requested by the OCI resources
+// or the spec annotations, validating it against the CPU quota.
+func (c *container) setContainerCPUBurst(spec *specs.Spec, linux *pb.Linux) error {
+	var burst uint64
+	var quota int64
+
+	if linux != nil && linux.Resources != nil && linux.Resources.CPU != nil {
+		burst = linux.Resources.CPU.Burst
+		quota = linux.Resources.CPU.Quota
+	}
+
+	if value, ok := spec.Annotations[cpuBurstAnnotation]; ok && burst == 0 {
+		var err error
+		if burst, err = strconv.ParseUint(value, 10, 64); err != nil {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid CPU burst %q", value)
+		}
+	}
+
+	if err := validateCPUBurst(burst, quota); err != nil {
+		return err
+	}
+
+	c.cpuBurst = burst
+
+	return nil
+}
+
+// applyCPUBurst writes the CPU burst of the container to its cgroup. Only
+// cgroup v2 supports it, a burst being ignored otherwise.
+func (c *container) applyCPUBurst(cgroupV2 bool, burst uint64) error {
+	if !cgroupV2 {
+		if burst > 0 {
+			agentLog.WithFields(logrus.Fields{
+				"container": c.id,
+				"burst":     burst,
+			}).Warn("CPU burst requires cgroup v2, ignoring it")
+		}
+		return nil
+	}
+
+	dir, err := c.getCgroupV2Path()
+	if err != nil {
+		return err
+	}
+
+	value := strconv.FormatUint(burst, 10)
+	if err := ioutil.WriteFile(filepath.Join(dir, cpuMaxBurstFile), []byte(value), cgroupFileWriteMode); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not set the CPU burst of container %s: %v", c.id, err)
+	}
+
+	return nil
+}
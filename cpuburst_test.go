@@ -0,0 +1,159 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestSetContainerCPUBurst(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		cpu           *pb.LinuxCPU
+		annotations   map[string]string
+		expectedBurst uint64
+		expectError   bool
+	}
+
+	data := []testData{
+		{nil, nil, 0, false},
+		{&pb.LinuxCPU{Quota: 50000}, nil, 0, false},
+		{&pb.LinuxCPU{Quota: 50000, Burst: 20000}, nil, 20000, false},
+		{&pb.LinuxCPU{Quota: 50000, Burst: 50000}, nil, 50000, false},
+		// The burst is bounded by the quota
+		{&pb.LinuxCPU{Quota: 50000, Burst: 50001}, nil, 0, true},
+		{&pb.LinuxCPU{Quota: -1, Burst: 20000}, nil, 0, true},
+		{&pb.LinuxCPU{Burst: 20000}, nil, 0, true},
+		// The annotation applies without OCI burst
+		{&pb.LinuxCPU{Quota: 50000}, map[string]string{cpuBurstAnnotation: "10000"}, 10000, false},
+		{&pb.LinuxCPU{Quota: 50000, Burst: 20000}, map[string]string{cpuBurstAnnotation: "10000"}, 20000, false},
+		{&pb.LinuxCPU{Quota: 50000}, map[string]string{cpuBurstAnnotation: "60000"}, 0, true},
+		{&pb.LinuxCPU{Quota: 50000}, map[string]string{cpuBurstAnnotation: "foo"}, 0, true},
+		{nil, map[string]string{cpuBurstAnnotation: "10000"}, 0, true},
+	}
+
+	for i, d := range data {
+		linux := &pb.Linux{}
+		if d.cpu != nil {
+			linux.Resources = &pb.LinuxResources{CPU: d.cpu}
+		}
+
+		ctr := &container{}
+		err := ctr.setContainerCPUBurst(&specs.Spec{Annotations: d.annotations}, linux)
+		if d.expectError {
+			assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+		assert.Equal(d.expectedBurst, ctr.cpuBurst, "test %d (%+v)", i, d)
+	}
+}
+
+func TestUpdateContainerCPUBurst(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "cgroup")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedCgroupPath := cgroupPath
+	savedIsCgroupV2 := isCgroupV2
+	defer func() {
+		cgroupPath = savedCgroupPath
+		isCgroupV2 = savedIsCgroupV2
+	}()
+
+	cgroupPath = dir
+
+	burstFile := filepath.Join(dir, "cgroup", "foo", cpuMaxBurstFile)
+	assert.NoError(os.MkdirAll(filepath.Dir(burstFile), testDirMode))
+
+	ctr := &container{
+		id:        "foo",
+		container: &mockContainer{id: "foo"},
+	}
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: map[string]*container{"foo": ctr},
+		},
+	}
+
+	type testData struct {
+		cgroupV2      bool
+		cpu           *pb.LinuxCPU
+		expectedBurst string
+		expectError   bool
+	}
+
+	data := []testData{
+		{true, nil, "", false},
+		{true, &pb.LinuxCPU{Quota: 50000, Period: 100000, Burst: 20000}, "20000", false},
+		{true, &pb.LinuxCPU{Quota: 50000, Period: 100000, Burst: 30000}, "30000", false},
+		// Left untouched when the CPU resources are not updated
+		{true, nil, "30000", false},
+		{true, &pb.LinuxCPU{Quota: 10000, Period: 100000, Burst: 20000}, "30000", true},
+		// Kept when not set, and validated against the new quota
+		{true, &pb.LinuxCPU{Quota: 40000, Period: 100000}, "30000", false},
+		{true, &pb.LinuxCPU{Quota: 20000, Period: 100000}, "30000", true},
+		// Validated against the current quota when not set
+		{true, &pb.LinuxCPU{Shares: 512}, "30000", false},
+		{true, &pb.LinuxCPU{Shares: 512, Burst: 40000}, "40000", false},
+		{true, &pb.LinuxCPU{Shares: 512, Burst: 60000}, "40000", true},
+		// Ignored with cgroup v1
+		{false, &pb.LinuxCPU{Quota: 50000, Period: 100000, Burst: 20000}, "40000", false},
+	}
+
+	for i, d := range data {
+		cgroupV2 := d.cgroupV2
+		isCgroupV2 = func() bool {
+			return cgroupV2
+		}
+
+		req := &pb.UpdateContainerRequest{
+			ContainerId: "foo",
+			Resources: &pb.LinuxResources{
+				Pids: &pb.LinuxPids{Limit: 100},
+				CPU:  d.cpu,
+			},
+		}
+
+		_, err := a.UpdateContainer(context.Background(), req)
+		if d.expectError {
+			assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		content, err := ioutil.ReadFile(burstFile)
+		if d.expectedBurst == "" {
+			assert.True(os.IsNotExist(err), "test %d (%+v)", i, d)
+			continue
+		}
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedBurst, string(content), "test %d (%+v)", i, d)
+	}
+
+	// Create requests apply the recorded burst
+	assert.NoError(os.Remove(burstFile))
+	ctr.cpuBurst = 40000
+	assert.NoError(ctr.applyCPUBurst(true, ctr.cpuBurst))
+	content, err := ioutil.ReadFile(burstFile)
+	assert.NoError(err)
+	assert.Equal("40000", string(content))
+}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"path"
 	"path/filepath"
 	"strconv"
@@ -34,6 +35,7 @@ const (
 	driverNvdimmType    = "nvdimm"
 	driverEphemeralType = "ephemeral"
 	driverLocalType     = "local"
+	driverVerityType    = "verity"
 )
 
 const (
@@ -47,6 +49,7 @@ var (
 	systemDevPath       = "/dev"
 	getSCSIDevPath      = getSCSIDevPathImpl
 	getPCIDeviceName    = getPCIDeviceNameImpl
+	waitPCIDeviceReady  = waitPCIDeviceReadyImpl
 	getDevicePCIAddress = getDevicePCIAddressImpl
 	scanSCSIBus         = scanSCSIBusImpl
 )
@@ -171,21 +174,124 @@ func getDeviceName(s *sandbox, devID string) (string, error) {
 	return filepath.Join(systemDevPath, devName), nil
 }
 
-func getPCIDeviceNameImpl(s *sandbox, pciID string) (string, error) {
-	pciAddr, err := getDevicePCIAddress(pciID)
+// pciDeviceReady returns true once the PCI device is bound to its driver,
+// as well as the virtio device it exposes, if any. The device directory
+// shows up before the drivers are done with the device.
+func pciDeviceReady(devPath string) bool {
+	if _, err := os.Stat(filepath.Join(devPath, "driver")); err != nil {
+		return false
+	}
+
+	virtioDevs, err := filepath.Glob(filepath.Join(devPath, "virtio*"))
 	if err != nil {
+		return false
+	}
+
+	for _, dev := range virtioDevs {
+		if _, err := os.Stat(filepath.Join(dev, "driver")); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// waitPCIDevice polls sysfs until the PCI device is ready and returns its
+// sysfs path. This is used instead of uevents when pciPollInterval is set.
+func waitPCIDevice(pciAddr string) (string, error) {
+	devPath := filepath.Join(sysBusPrefix, path.Base(pciAddr))
+
+	timeout := time.After(hotplugTimeout)
+	for !pciDeviceReady(devPath) {
+		select {
+		case <-timeout:
+			return "", grpcStatus.Errorf(codes.DeadlineExceeded,
+				"Timeout reached after %s waiting for PCI device %s to be bound",
+				hotplugTimeout, pciAddr)
+		case <-time.After(pciPollInterval):
+		}
+	}
+
+	return devPath, nil
+}
+
+// getPCIBlockDeviceName returns the name of the block device exposed by a
+// virtio PCI device, empty for other devices.
+func getPCIBlockDeviceName(devPath string) (string, error) {
+	blockDevs, err := filepath.Glob(filepath.Join(devPath, "virtio*", "block", "*"))
+	if err != nil || len(blockDevs) == 0 {
 		return "", err
 	}
 
-	fieldLogger := agentLog.WithField("pciAddr", pciAddr)
+	return filepath.Base(blockDevs[0]), nil
+}
+
+// rescanPCIDevice returns the PCI address of the device, the PCI bus being
+// rescanned for it to show up.
+func rescanPCIDevice(pciID string) (string, error) {
+	pciAddr, err := getDevicePCIAddress(pciID)
+	if err != nil {
+		return "", err
+	}
 
 	// Rescan pci bus if we need to wait for a new pci device
 	if err = rescanPciBus(); err != nil {
-		fieldLogger.WithError(err).Error("Failed to scan pci bus")
+		agentLog.WithError(err).WithField("pciAddr", pciAddr).Error("Failed to scan pci bus")
+		return "", err
+	}
+
+	return pciAddr, nil
+}
+
+func getPCIDeviceNameImpl(s *sandbox, pciID string) (string, error) {
+	pciAddr, err := rescanPCIDevice(pciID)
+	if err != nil {
 		return "", err
 	}
 
-	return getDeviceName(s, pciAddr)
+	if pciPollInterval == 0 {
+		return getDeviceName(s, pciAddr)
+	}
+
+	devPath, err := waitPCIDevice(pciAddr)
+	if err != nil {
+		return "", err
+	}
+
+	devName, err := getPCIBlockDeviceName(devPath)
+	if err != nil {
+		return "", err
+	}
+
+	if devName == "" {
+		return "", grpcStatus.Errorf(codes.NotFound, "No block device exposed by PCI device %s", pciAddr)
+	}
+
+	agentLog.WithFields(logrus.Fields{
+		"pciAddr": pciAddr,
+		"devName": devName,
+	}).Info("PCI device bound")
+
+	return filepath.Join(systemDevPath, devName), nil
+}
+
+// waitPCIDeviceReadyImpl waits for the PCI device to be ready, for the
+// devices not exposing a device node such as network devices. Without
+// polling, the device is waited for as the other devices.
+func waitPCIDeviceReadyImpl(s *sandbox, pciID string) error {
+	if pciPollInterval == 0 {
+		_, err := getPCIDeviceName(s, pciID)
+		return err
+	}
+
+	pciAddr, err := rescanPCIDevice(pciID)
+	if err != nil {
+		return err
+	}
+
+	_, err = waitPCIDevice(pciAddr)
+
+	return err
 }
 
 // device.Id should be the predicted device name (vda, vdb, ...)
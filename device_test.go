@@ -14,10 +14,14 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	pb "github.com/kata-containers/agent/protocols/grpc"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
 var (
@@ -728,3 +732,82 @@ func TestGetDeviceName(t *testing.T) {
 	assert.Nil(err)
 	assert.Equal(name, path.Join(devRootPath, devName))
 }
+
+func TestGetPCIDeviceNamePoll(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedSysBusPrefix := sysBusPrefix
+	savedRescanFile := pciBusRescanFile
+	savedFunc := getDevicePCIAddress
+	savedPollInterval := pciPollInterval
+	savedTimeout := hotplugTimeout
+	defer func() {
+		sysBusPrefix = savedSysBusPrefix
+		pciBusRescanFile = savedRescanFile
+		getDevicePCIAddress = savedFunc
+		pciPollInterval = savedPollInterval
+		hotplugTimeout = savedTimeout
+	}()
+
+	sysBusPrefix = filepath.Join(dir, "devices")
+	pciBusRescanFile = filepath.Join(dir, "rescan")
+	getDevicePCIAddress = func(pciID string) (string, error) {
+		return "0000:00:02.0/0000:01:" + path.Base(pciID) + ".0", nil
+	}
+	pciPollInterval = 5 * time.Millisecond
+	hotplugTimeout = 100 * time.Millisecond
+
+	drivers := filepath.Join(dir, "drivers")
+	devPath := filepath.Join(sysBusPrefix, "0000:01:03.0")
+	virtioPath := filepath.Join(devPath, "virtio1")
+
+	for _, d := range []string{filepath.Join(drivers, "virtio-pci"), filepath.Join(drivers, "virtio_blk"), virtioPath} {
+		assert.NoError(os.MkdirAll(d, testDirMode))
+	}
+
+	sb := &sandbox{}
+
+	// Device present but not bound
+	_, err = getPCIDeviceNameImpl(sb, "02/03")
+	assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err))
+
+	// The virtio device is still not bound
+	assert.NoError(os.Symlink(filepath.Join(drivers, "virtio-pci"), filepath.Join(devPath, "driver")))
+	_, err = getPCIDeviceNameImpl(sb, "02/03")
+	assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err))
+
+	// The virtio-blk driver binds late
+	hotplugTimeout = 5 * time.Second
+	var bound int32
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		os.MkdirAll(filepath.Join(virtioPath, "block", "vdb"), testDirMode)
+		atomic.StoreInt32(&bound, 1)
+		os.Symlink(filepath.Join(drivers, "virtio_blk"), filepath.Join(virtioPath, "driver"))
+	}()
+
+	devName, err := getPCIDeviceNameImpl(sb, "02/03")
+	assert.NoError(err)
+	assert.Equal(int32(1), atomic.LoadInt32(&bound))
+	assert.Equal(filepath.Join(systemDevPath, "vdb"), devName)
+
+	// A bound network device exposes no block device
+	netPath := filepath.Join(sysBusPrefix, "0000:01:04.0")
+	netVirtioPath := filepath.Join(netPath, "virtio2")
+	assert.NoError(os.MkdirAll(netVirtioPath, testDirMode))
+	assert.NoError(os.MkdirAll(filepath.Join(drivers, "virtio_net"), testDirMode))
+	assert.NoError(os.Symlink(filepath.Join(drivers, "virtio-pci"), filepath.Join(netPath, "driver")))
+	assert.NoError(os.Symlink(filepath.Join(drivers, "virtio_net"), filepath.Join(netVirtioPath, "driver")))
+
+	_, err = getPCIDeviceNameImpl(sb, "02/04")
+	assert.Equal(codes.NotFound, grpcStatus.Code(err))
+	assert.NoError(waitPCIDeviceReadyImpl(sb, "02/04"))
+
+	hotplugTimeout = 100 * time.Millisecond
+	err = waitPCIDeviceReadyImpl(sb, "02/05")
+	assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err))
+}
@@ -0,0 +1,140 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Value replacing the content of masked environment variables.
+	envMaskedValue = "***"
+
+	// PATH given to the processes started with a strict environment
+	// which do not provide one.
+	defaultEnvPath = "PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+)
+
+// sanitizeEnv strips the variables whose name matches envDenylist from the
+// environment of a container process, or masks their value if envMask is
+// set. Only the names of the affected variables are logged.
+func sanitizeEnv(env []string, procID string) []string {
+	if envDenylist == nil {
+		return env
+	}
+
+	var sanitized, matched []string
+
+	for _, e := range env {
+		name := strings.SplitN(e, "=", 2)[0]
+		if !envDenylist.MatchString(name) {
+			sanitized = append(sanitized, e)
+			continue
+		}
+
+		matched = append(matched, name)
+		if envMask {
+			sanitized = append(sanitized, name+"="+envMaskedValue)
+		}
+	}
+
+	if len(matched) > 0 {
+		agentLog.WithFields(logrus.Fields{
+			"exec-id":   procID,
+			"variables": strings.Join(matched, ","),
+			"masked":    envMask,
+		}).Info("Sanitized process environment")
+	}
+
+	return sanitized
+}
+
+// strictEnv returns the environment of a process started with the strict
+// policy, made of the variables of the process alone, the image defaults
+// not being merged: only well formed variables are kept, the last
+// definition of a variable overriding the previous ones, and PATH is always
+// set.
+func strictEnv(env []string) []string {
+	var strict []string
+	index := make(map[string]int)
+
+	for _, e := range env {
+		fields := strings.SplitN(e, "=", 2)
+		if len(fields) != 2 || fields[0] == "" {
+			continue
+		}
+
+		if i, ok := index[fields[0]]; ok {
+			strict[i] = e
+			continue
+		}
+
+		index[fields[0]] = len(strict)
+		strict = append(strict, e)
+	}
+
+	if _, ok := index["PATH"]; !ok {
+		strict = append(strict, defaultEnvPath)
+	}
+
+	return strict
+}
+
+// mergeImageEnv returns the environment of a process merged with the
+// default one of its image. The process variables override the image ones
+// in place, "NAME=" setting an empty value and "NAME" removing the image
+// default, while the variables the image does not define follow in their
+// order. The last definition of a variable wins.
+func mergeImageEnv(image, env []string) []string {
+	if len(image) == 0 {
+		return env
+	}
+
+	var merged []string
+	index := make(map[string]int)
+	removed := make(map[string]bool)
+
+	set := func(name, e string) {
+		if i, ok := index[name]; ok {
+			merged[i] = e
+			return
+		}
+
+		index[name] = len(merged)
+		merged = append(merged, e)
+	}
+
+	for _, e := range image {
+		set(strings.SplitN(e, "=", 2)[0], e)
+	}
+
+	for _, e := range env {
+		if !strings.Contains(e, "=") {
+			removed[e] = true
+			continue
+		}
+
+		name := strings.SplitN(e, "=", 2)[0]
+		delete(removed, name)
+		set(name, e)
+	}
+
+	if len(removed) == 0 {
+		return merged
+	}
+
+	result := make([]string, 0, len(merged))
+	for _, e := range merged {
+		if !removed[strings.SplitN(e, "=", 2)[0]] {
+			result = append(result, e)
+		}
+	}
+
+	return result
+}
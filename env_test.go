@@ -0,0 +1,213 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+	"syscall"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeEnv(t *testing.T) {
+	assert := assert.New(t)
+
+	savedEnvDenylist := envDenylist
+	savedEnvMask := envMask
+	defer func() {
+		envDenylist = savedEnvDenylist
+		envMask = savedEnvMask
+	}()
+
+	env := []string{"PATH=/bin", "AWS_SECRET=foo", "HOME=/root", "AWS_TOKEN=bar=baz", "LANG"}
+
+	type testData struct {
+		denylist    string
+		mask        bool
+		expectedEnv []string
+	}
+
+	data := []testData{
+		{"", false, env},
+		{"^AWS_", false, []string{"PATH=/bin", "HOME=/root", "LANG"}},
+		{"^AWS_", true, []string{"PATH=/bin", "AWS_SECRET=***", "HOME=/root", "AWS_TOKEN=***", "LANG"}},
+		{"^(LANG|HOME)$", false, []string{"PATH=/bin", "AWS_SECRET=foo", "AWS_TOKEN=bar=baz"}},
+		{"^/bin$", false, env},
+	}
+
+	for i, d := range data {
+		envDenylist = nil
+		if d.denylist != "" {
+			envDenylist = regexp.MustCompile(d.denylist)
+		}
+		envMask = d.mask
+
+		assert.Equal(d.expectedEnv, sanitizeEnv(env, "foo"), "test %d (%+v)", i, d)
+	}
+}
+
+func TestBuildProcessSanitizesEnv(t *testing.T) {
+	assert := assert.New(t)
+
+	savedEnvDenylist := envDenylist
+	defer func() {
+		envDenylist = savedEnvDenylist
+	}()
+
+	envDenylist = regexp.MustCompile("SECRET")
+
+	for _, init := range []bool{true, false} {
+		proc, err := buildProcess(&pb.Process{
+			Env:      []string{"PATH=/bin", "MY_SECRET=foo"},
+			User:     pb.User{},
+			Terminal: true,
+		}, "foo", init)
+		assert.NoError(err)
+		assert.Equal([]string{"PATH=/bin"}, proc.process.Env)
+	}
+}
+
+func TestStrictEnv(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		env         []string
+		expectedEnv []string
+	}
+
+	data := []testData{
+		{nil, []string{defaultEnvPath}},
+		{[]string{"PATH=/bin"}, []string{"PATH=/bin"}},
+		{[]string{"LANG", "=foo", "FOO=bar"}, []string{"FOO=bar", defaultEnvPath}},
+		{[]string{"FOO=bar", "PATH=/bin", "FOO=baz=qux"}, []string{"FOO=baz=qux", "PATH=/bin"}},
+		{[]string{"EMPTY="}, []string{"EMPTY=", defaultEnvPath}},
+	}
+
+	for i, d := range data {
+		assert.Equal(d.expectedEnv, strictEnv(d.env), "test %d (%+v)", i, d)
+	}
+}
+
+func TestMergeImageEnv(t *testing.T) {
+	assert := assert.New(t)
+
+	image := []string{"PATH=/usr/bin:/bin", "LANG=C", "HOME=/root", "DEBUG=1"}
+
+	type testData struct {
+		image       []string
+		env         []string
+		expectedEnv []string
+	}
+
+	data := []testData{
+		{nil, []string{"FOO=bar", "LANG"}, []string{"FOO=bar", "LANG"}},
+		{image, nil, image},
+		// Overridden in place, the other variables following
+		{image, []string{"FOO=bar", "LANG=C.UTF-8", "BAR=baz", "PATH=/opt/bin"},
+			[]string{"PATH=/opt/bin", "LANG=C.UTF-8", "HOME=/root", "DEBUG=1", "FOO=bar", "BAR=baz"}},
+		// Set empty, or removed
+		{image, []string{"HOME=", "DEBUG"},
+			[]string{"PATH=/usr/bin:/bin", "LANG=C", "HOME="}},
+		// The last definition wins
+		{image, []string{"FOO=bar", "DEBUG", "FOO=baz", "DEBUG=2", "LANG=en", "LANG"},
+			[]string{"PATH=/usr/bin:/bin", "HOME=/root", "DEBUG=2", "FOO=baz"}},
+		{[]string{"FOO=1", "FOO=2"}, []string{"BAR=1"}, []string{"FOO=2", "BAR=1"}},
+	}
+
+	for i, d := range data {
+		assert.Equal(d.expectedEnv, mergeImageEnv(d.image, d.env), "test %d (%+v)", i, d)
+	}
+}
+
+func TestStrictEnvRun(t *testing.T) {
+	assert := assert.New(t)
+
+	// Not expected to leak into the process
+	os.Setenv("KATA_AGENT_TEST_LEAK", "1")
+	defer os.Unsetenv("KATA_AGENT_TEST_LEAK")
+
+	proc, err := buildProcess(&pb.Process{
+		Args: []string{"env"},
+		Env:  []string{"FOO=bar", "LANG", "FOO=baz"},
+		User: pb.User{},
+	}, "foo", false)
+	assert.NoError(err)
+	defer proc.closePostStartFDs()
+	defer proc.closePostExitFDs()
+
+	cmd := exec.Command("env")
+	cmd.Env = strictEnv(proc.process.Env)
+	out, err := cmd.Output()
+	assert.NoError(err)
+
+	vars := strings.Split(strings.TrimSpace(string(out)), "\n")
+	sort.Strings(vars)
+	assert.Equal([]string{"FOO=baz", defaultEnvPath}, vars)
+}
+
+func TestExecProcessStrictEnv(t *testing.T) {
+	assert := assert.New(t)
+
+	savedSpawnProcess := spawnProcess
+	defer func() {
+		spawnProcess = savedSpawnProcess
+	}()
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: make(map[string]*container),
+			running:    true,
+			subreaper:  &mockreaper{},
+		},
+	}
+	a.sandbox.setContainer(context.Background(), "foo", &container{
+		id:        "foo",
+		container: &mockContainer{id: "foo", status: libcontainer.Running},
+		processes: make(map[string]*process),
+	})
+
+	var spawnEnv []string
+	spawnProcess = func(c libcontainer.Container, p *libcontainer.Process, createContainer bool) (int, error) {
+		spawnEnv = p.Env
+		return -1, syscall.EPERM
+	}
+
+	type testData struct {
+		strictEnv   bool
+		expectedEnv []string
+	}
+
+	data := []testData{
+		{false, []string{"PATH=/usr/bin:/bin", "LANG=en", "FOO=baz"}},
+		{true, []string{"FOO=baz", defaultEnvPath}},
+	}
+
+	for i, d := range data {
+		spawnEnv = nil
+
+		a.ExecProcess(context.Background(), &pb.ExecProcessRequest{
+			ContainerId: "foo",
+			ExecId:      fmt.Sprintf("exec%d", i),
+			Process: &pb.Process{
+				Args: []string{"env"},
+				Env:  []string{"FOO=bar", "FOO=baz"},
+			},
+			ImageEnv:  []string{"PATH=/usr/bin:/bin", "LANG=en"},
+			StrictEnv: d.strictEnv,
+		})
+		assert.Equal(d.expectedEnv, spawnEnv, "test %d (%+v)", i, d)
+	}
+}
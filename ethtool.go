@@ -0,0 +1,146 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"runtime"
+	"strings"
+	"unsafe"
+
+	"github.com/docker/docker/pkg/parsers"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// ethtool commands, see include/uapi/linux/ethtool.h.
+const (
+	ethtoolGetChannels = 0x3c
+	ethtoolSetChannels = 0x3d
+)
+
+// ethtoolChannels matches struct ethtool_channels.
+type ethtoolChannels struct {
+	cmd           uint32
+	maxRx         uint32
+	maxTx         uint32
+	maxOther      uint32
+	maxCombined   uint32
+	rxCount       uint32
+	txCount       uint32
+	otherCount    uint32
+	combinedCount uint32
+}
+
+// ethtoolIfreq matches struct ifreq, holding a pointer to the ethtool
+// command.
+type ethtoolIfreq struct {
+	name [unix.IFNAMSIZ]byte
+	data unsafe.Pointer
+	_    [16]byte
+}
+
+func ethtoolChannelsIoctl(ifName string, channels *ethtoolChannels) error {
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(fd)
+
+	ifr := ethtoolIfreq{
+		data: unsafe.Pointer(channels),
+	}
+	copy(ifr.name[:unix.IFNAMSIZ-1], ifName)
+
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, uintptr(fd), unix.SIOCETHTOOL, uintptr(unsafe.Pointer(&ifr)))
+	runtime.KeepAlive(channels)
+	if errno != 0 {
+		return errno
+	}
+
+	return nil
+}
+
+func getLinkChannels(ifName string) (*ethtoolChannels, error) {
+	channels := &ethtoolChannels{cmd: ethtoolGetChannels}
+	if err := ethtoolChannelsIoctl(ifName, channels); err != nil {
+		return nil, err
+	}
+
+	return channels, nil
+}
+
+// getOnlineCPUCount returns the number of online guest CPUs.
+func getOnlineCPUCount() (uint32, error) {
+	online, err := ioutil.ReadFile(sysfsConnectedCPUsPath)
+	if err != nil {
+		return 0, err
+	}
+
+	cpus, err := parsers.ParseUintList(strings.TrimSpace(string(online)))
+	if err != nil {
+		return 0, err
+	}
+
+	if len(cpus) == 0 {
+		return 0, grpcStatus.Errorf(codes.Internal, "No online CPU found in %s", sysfsConnectedCPUsPath)
+	}
+
+	return uint32(len(cpus)), nil
+}
+
+// setLinkQueues sets the number of combined queues of the interface,
+// clamped to the maximum supported by the device and to the number of
+// online CPUs, as extra queues would not be serviced in parallel. The
+// number of queues actually set is returned.
+func setLinkQueues(ifName string, queues uint32) (uint32, error) {
+	channels, err := getLinkChannels(ifName)
+	if err != nil {
+		return 0, grpcStatus.Errorf(codes.FailedPrecondition, "Could not get %s channels: %v", ifName, err)
+	}
+
+	if channels.maxCombined == 0 {
+		return 0, grpcStatus.Errorf(codes.FailedPrecondition, "Interface %s does not support combined queues", ifName)
+	}
+
+	cpus, err := getOnlineCPUCount()
+	if err != nil {
+		return 0, err
+	}
+
+	count := queues
+	if count > channels.maxCombined {
+		count = channels.maxCombined
+	}
+	if count > cpus {
+		count = cpus
+	}
+
+	if count != queues {
+		agentLog.WithFields(logrus.Fields{
+			"interface":     ifName,
+			"queues":        queues,
+			"max-queues":    channels.maxCombined,
+			"online-cpus":   cpus,
+			"queues-to-set": count,
+		}).Warn("Clamping the number of queues")
+	}
+
+	if count == channels.combinedCount {
+		return count, nil
+	}
+
+	channels.cmd = ethtoolSetChannels
+	channels.combinedCount = count
+	if err := ethtoolChannelsIoctl(ifName, channels); err != nil {
+		return 0, grpcStatus.Errorf(codes.Internal, "Could not set %s queues to %d: %v", ifName, count, err)
+	}
+
+	return count, nil
+}
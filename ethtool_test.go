@@ -0,0 +1,132 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+)
+
+func setupTestCPUOnline(t *testing.T, dir string, content string) func() {
+	file := filepath.Join(dir, "online")
+	err := ioutil.WriteFile(file, []byte(content), testFileMode)
+	assert.NoError(t, err)
+
+	savedPath := sysfsConnectedCPUsPath
+	sysfsConnectedCPUsPath = file
+
+	return func() {
+		sysfsConnectedCPUsPath = savedPath
+	}
+}
+
+func TestGetOnlineCPUCount(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	type testData struct {
+		online        string
+		expectedCount uint32
+		expectError   bool
+	}
+
+	data := []testData{
+		{"", 0, true},
+		{"foo", 0, true},
+		{"0\n", 1, false},
+		{"0-3\n", 4, false},
+		{"0-1,4,6-7\n", 5, false},
+	}
+
+	for i, d := range data {
+		restore := setupTestCPUOnline(t, dir, d.online)
+
+		count, err := getOnlineCPUCount()
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.Equal(d.expectedCount, count, "test %d (%+v)", i, d)
+		}
+
+		restore()
+	}
+}
+
+func TestSetLinkQueues(t *testing.T) {
+	tearDown := setupNetworkTest(t)
+	defer tearDown()
+
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	_, err = setLinkQueues("foo", 2)
+	assert.Error(err)
+
+	link := &netlink.Veth{
+		LinkAttrs: netlink.LinkAttrs{
+			Name:        "ifc-name",
+			NumTxQueues: 4,
+			NumRxQueues: 4,
+		},
+		PeerName: "ifc-peer",
+	}
+	assert.NoError(netlink.LinkAdd(link))
+	assert.NoError(netlink.LinkSetUp(link))
+
+	channels, err := getLinkChannels("ifc-name")
+	assert.NoError(err)
+
+	// veth devices may only expose separate rx and tx queues, combined
+	// queues being provided by virtio-net for instance.
+	if channels.maxCombined < 4 {
+		_, err = setLinkQueues("ifc-name", 2)
+		assert.Equal(channels.maxCombined == 0, err != nil)
+		t.Skip("Multi-queue device needed")
+	}
+
+	type testData struct {
+		online        string
+		queues        uint32
+		expectedCount uint32
+	}
+
+	data := []testData{
+		{"0-7", 2, 2},
+		{"0-7", 3, 3},
+		// Clamped to the device maximum
+		{"0-7", 64, channels.maxCombined},
+		// Clamped to the online CPUs
+		{"0-1", 4, 2},
+		{"0", 4, 1},
+	}
+
+	for i, d := range data {
+		restore := setupTestCPUOnline(t, dir, d.online)
+
+		count, err := setLinkQueues("ifc-name", d.queues)
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedCount, count, "test %d (%+v)", i, d)
+
+		channels, err := getLinkChannels("ifc-name")
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedCount, channels.combinedCount, "test %d (%+v)", i, d)
+
+		restore()
+	}
+}
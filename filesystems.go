@@ -0,0 +1,217 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// Prefix of the module aliases declared by filesystem modules, see
+	// MODULE_ALIAS_FS().
+	fsModuleAliasPrefix = "fs-"
+
+	overlayFSType  = "overlay"
+	virtioFSType   = "virtiofs"
+	virtioFSDaxOpt = "CONFIG_FUSE_DAX"
+
+	// mount_setattr(2), numbered the same on all the architectures.
+	sysMountSetattr = 442
+)
+
+var (
+	procFilesystemsPath = "/proc/filesystems"
+	procConfigPath      = "/proc/config.gz"
+	bootDir             = "/boot"
+
+	// Result of the previous probe of the guest filesystems.
+	guestFilesystems     *pb.GuestFilesystems
+	guestFilesystemsLock sync.Mutex
+)
+
+// set function in variable to overwrite for testing.
+var isMountSetattrSupported = func() bool {
+	// The call fails with EBADF, or EINVAL, when implemented.
+	_, _, errno := unix.Syscall6(sysMountSetattr, ^uintptr(0), 0, 0, 0, 0, 0)
+	return errno != unix.ENOSYS
+}
+
+// parseProcFilesystems returns the filesystems registered with the kernel,
+// see proc(5).
+func parseProcFilesystems(path string) (map[string]*pb.GuestFilesystem, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	filesystems := make(map[string]*pb.GuestFilesystem)
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+
+		var fs *pb.GuestFilesystem
+		switch {
+		case len(fields) == 1:
+			fs = &pb.GuestFilesystem{Type: fields[0]}
+		case len(fields) == 2 && fields[0] == "nodev":
+			fs = &pb.GuestFilesystem{Type: fields[1], Nodev: true}
+		default:
+			continue
+		}
+
+		fs.Registered = true
+		filesystems[fs.Type] = fs
+	}
+
+	return filesystems, scanner.Err()
+}
+
+// getFilesystemModules returns the filesystems provided by the modules
+// shipped with the guest kernel, per their aliases. A missing file is not
+// an error, minimal guests may not provide it.
+func getFilesystemModules(modulesDir string) ([]string, error) {
+	f, err := os.Open(filepath.Join(modulesDir, "modules.alias"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var filesystems []string
+
+	// Lines are made of "alias <alias> <module>".
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 || fields[0] != "alias" || !strings.HasPrefix(fields[1], fsModuleAliasPrefix) {
+			continue
+		}
+
+		filesystems = append(filesystems, strings.TrimPrefix(fields[1], fsModuleAliasPrefix))
+	}
+
+	return filesystems, scanner.Err()
+}
+
+// openKernelConfig opens the configuration of the running kernel, exposed
+// by the kernel itself or shipped with the guest.
+func openKernelConfig(release string) (io.ReadCloser, error) {
+	if f, err := os.Open(procConfigPath); err == nil {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+
+		return struct {
+			io.Reader
+			io.Closer
+		}{gz, f}, nil
+	}
+
+	return os.Open(filepath.Join(bootDir, "config-"+release))
+}
+
+// isKernelConfigEnabled returns whether the option is built in the kernel
+// or as a module. The option is considered disabled if the configuration
+// is not available.
+func isKernelConfigEnabled(release, option string) bool {
+	config, err := openKernelConfig(release)
+	if err != nil {
+		return false
+	}
+	defer config.Close()
+
+	scanner := bufio.NewScanner(config)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == option+"=y" || line == option+"=m" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// probeGuestFilesystems lists the filesystems the guest can mount, and the
+// mount features of its kernel.
+func probeGuestFilesystems() (*pb.GuestFilesystems, error) {
+	filesystems, err := parseProcFilesystems(procFilesystemsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := kernelRelease()
+	if err != nil {
+		return nil, err
+	}
+
+	modules, err := getFilesystemModules(filepath.Join(kernelModulesDir, release))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fsType := range modules {
+		if _, ok := filesystems[fsType]; !ok {
+			filesystems[fsType] = &pb.GuestFilesystem{Type: fsType, Loadable: true}
+		}
+	}
+
+	result := &pb.GuestFilesystems{
+		IdmappedMounts: isMountSetattrSupported(),
+	}
+
+	for _, fs := range filesystems {
+		result.Filesystems = append(result.Filesystems, fs)
+	}
+
+	sort.Slice(result.Filesystems, func(i, j int) bool {
+		return result.Filesystems[i].Type < result.Filesystems[j].Type
+	})
+
+	_, result.Overlayfs = filesystems[overlayFSType]
+
+	if _, ok := filesystems[virtioFSType]; ok {
+		result.VirtiofsDax = isKernelConfigEnabled(release, virtioFSDaxOpt)
+	}
+
+	return result, nil
+}
+
+// getGuestFilesystems returns the result of the previous probe of the
+// guest filesystems, probing them on first use or when refresh is set.
+func getGuestFilesystems(refresh bool) (*pb.GuestFilesystems, error) {
+	guestFilesystemsLock.Lock()
+	defer guestFilesystemsLock.Unlock()
+
+	if guestFilesystems != nil && !refresh {
+		return guestFilesystems, nil
+	}
+
+	result, err := probeGuestFilesystems()
+	if err != nil {
+		return nil, err
+	}
+
+	guestFilesystems = result
+
+	return result, nil
+}
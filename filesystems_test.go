@@ -0,0 +1,196 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+)
+
+const testProcFilesystems = `nodev	sysfs
+nodev	tmpfs
+nodev	proc
+	ext4
+nodev	overlay
+nodev	virtiofs
+`
+
+const testModulesAlias = `alias fs-xfs xfs
+alias fs-ext4 ext4
+alias crypto-sha256 sha256_generic
+alias fs-nfs4 nfsv4
+`
+
+func setupGuestFilesystemsTest(t *testing.T, dir string) func() {
+	assert := assert.New(t)
+
+	savedProcFilesystemsPath := procFilesystemsPath
+	savedProcConfigPath := procConfigPath
+	savedBootDir := bootDir
+	savedKernelModulesDir := kernelModulesDir
+	savedIsMountSetattrSupported := isMountSetattrSupported
+	savedGuestFilesystems := guestFilesystems
+
+	procFilesystemsPath = filepath.Join(dir, "filesystems")
+	procConfigPath = filepath.Join(dir, "config.gz")
+	bootDir = filepath.Join(dir, "boot")
+	kernelModulesDir = filepath.Join(dir, "modules")
+	isMountSetattrSupported = func() bool {
+		return true
+	}
+	guestFilesystems = nil
+
+	release, err := kernelRelease()
+	assert.NoError(err)
+
+	modulesDir := filepath.Join(kernelModulesDir, release)
+	assert.NoError(os.MkdirAll(modulesDir, testDirMode))
+	assert.NoError(os.MkdirAll(bootDir, testDirMode))
+
+	err = ioutil.WriteFile(filepath.Join(modulesDir, "modules.alias"), []byte(testModulesAlias), testFileMode)
+	assert.NoError(err)
+
+	return func() {
+		procFilesystemsPath = savedProcFilesystemsPath
+		procConfigPath = savedProcConfigPath
+		bootDir = savedBootDir
+		kernelModulesDir = savedKernelModulesDir
+		isMountSetattrSupported = savedIsMountSetattrSupported
+		guestFilesystems = savedGuestFilesystems
+	}
+}
+
+func TestParseProcFilesystems(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "filesystems")
+
+	_, err = parseProcFilesystems(file)
+	assert.Error(err)
+
+	err = ioutil.WriteFile(file, []byte(testProcFilesystems+"foo bar baz\n\n"), testFileMode)
+	assert.NoError(err)
+
+	filesystems, err := parseProcFilesystems(file)
+	assert.NoError(err)
+	assert.Len(filesystems, 6)
+	assert.Equal(&pb.GuestFilesystem{Type: "ext4", Registered: true}, filesystems["ext4"])
+	assert.Equal(&pb.GuestFilesystem{Type: "tmpfs", Nodev: true, Registered: true}, filesystems["tmpfs"])
+}
+
+func TestIsKernelConfigEnabled(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	restore := setupGuestFilesystemsTest(t, dir)
+	defer restore()
+
+	config := []byte("CONFIG_FOO=y\nCONFIG_BAR=m\n# CONFIG_BAZ is not set\n")
+
+	// No configuration available
+	assert.False(isKernelConfigEnabled("1.0", "CONFIG_FOO"))
+
+	err = ioutil.WriteFile(filepath.Join(bootDir, "config-1.0"), config, testFileMode)
+	assert.NoError(err)
+
+	assert.True(isKernelConfigEnabled("1.0", "CONFIG_FOO"))
+	assert.True(isKernelConfigEnabled("1.0", "CONFIG_BAR"))
+	assert.False(isKernelConfigEnabled("1.0", "CONFIG_BAZ"))
+	assert.False(isKernelConfigEnabled("2.0", "CONFIG_FOO"))
+
+	// The configuration exposed by the kernel is preferred
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err = gz.Write([]byte("CONFIG_BAZ=y\n"))
+	assert.NoError(err)
+	assert.NoError(gz.Close())
+
+	err = ioutil.WriteFile(procConfigPath, buf.Bytes(), testFileMode)
+	assert.NoError(err)
+
+	assert.False(isKernelConfigEnabled("1.0", "CONFIG_FOO"))
+	assert.True(isKernelConfigEnabled("1.0", "CONFIG_BAZ"))
+}
+
+func TestGetGuestFilesystems(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	restore := setupGuestFilesystemsTest(t, dir)
+	defer restore()
+
+	a := &agentGRPC{}
+
+	_, err = a.GetGuestFilesystems(context.Background(), &pb.GuestFilesystemsRequest{})
+	assert.Error(err)
+
+	err = ioutil.WriteFile(procFilesystemsPath, []byte(testProcFilesystems), testFileMode)
+	assert.NoError(err)
+
+	release, err := kernelRelease()
+	assert.NoError(err)
+	err = ioutil.WriteFile(filepath.Join(bootDir, "config-"+release), []byte(virtioFSDaxOpt+"=y\n"), testFileMode)
+	assert.NoError(err)
+
+	filesystems, err := a.GetGuestFilesystems(context.Background(), &pb.GuestFilesystemsRequest{})
+	assert.NoError(err)
+
+	expected := &pb.GuestFilesystems{
+		Filesystems: []*pb.GuestFilesystem{
+			{Type: "ext4", Registered: true},
+			{Type: "nfs4", Loadable: true},
+			{Type: "overlay", Nodev: true, Registered: true},
+			{Type: "proc", Nodev: true, Registered: true},
+			{Type: "sysfs", Nodev: true, Registered: true},
+			{Type: "tmpfs", Nodev: true, Registered: true},
+			{Type: "virtiofs", Nodev: true, Registered: true},
+			{Type: "xfs", Loadable: true},
+		},
+		IdmappedMounts: true,
+		Overlayfs:      true,
+		VirtiofsDax:    true,
+	}
+	assert.Equal(expected, filesystems)
+
+	// The result is cached
+	err = ioutil.WriteFile(procFilesystemsPath, []byte("nodev\tproc\n"), testFileMode)
+	assert.NoError(err)
+
+	filesystems, err = a.GetGuestFilesystems(context.Background(), &pb.GuestFilesystemsRequest{})
+	assert.NoError(err)
+	assert.Equal(expected, filesystems)
+
+	filesystems, err = a.GetGuestFilesystems(context.Background(), &pb.GuestFilesystemsRequest{Refresh: true})
+	assert.NoError(err)
+	assert.Equal(&pb.GuestFilesystems{
+		Filesystems: []*pb.GuestFilesystem{
+			{Type: "ext4", Loadable: true},
+			{Type: "nfs4", Loadable: true},
+			{Type: "proc", Nodev: true, Registered: true},
+			{Type: "xfs", Loadable: true},
+		},
+		IdmappedMounts: true,
+	}, filesystems)
+}
@@ -12,6 +12,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -30,6 +31,7 @@ import (
 	"github.com/opencontainers/runc/libcontainer/specconv"
 	"github.com/opencontainers/runc/libcontainer/utils"
 	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"golang.org/x/sys/unix"
@@ -64,8 +66,13 @@ var (
 	stopTracingCalled = false
 
 	modprobePath = "/sbin/modprobe"
+
+	procModulesPath  = "/proc/modules"
+	kernelModulesDir = "/lib/modules"
 )
 
+var kernelModuleNameRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
 type onlineResource struct {
 	sysfsOnlinePath string
 	regexpPattern   string
@@ -79,6 +86,10 @@ const onlineCPUMemWaitTime = 100 * time.Millisecond
 
 var onlineCPUMaxTries = uint32(100)
 
+// Time waited for a container init process killed after a start timeout
+// to terminate.
+var startKillTimeout = 10 * time.Second
+
 const cpusetMode = 0644
 
 // handleError will log the specified error if wait is false
@@ -312,9 +323,10 @@ func buildProcess(agentProcess *pb.Process, procID string, init bool) (*process,
 		process: libcontainer.Process{
 			Cwd:              agentProcess.Cwd,
 			Args:             agentProcess.Args,
-			Env:              agentProcess.Env,
+			Env:              sanitizeEnv(agentProcess.Env, procID),
 			User:             user,
 			AdditionalGroups: additionalGids,
+			AppArmorProfile:  apparmorProfileName(agentProcess.ApparmorProfile),
 			Init:             init,
 		},
 	}
@@ -364,6 +376,57 @@ func buildProcess(agentProcess *pb.Process, procID string, init bool) (*process,
 	return proc, nil
 }
 
+// setStdinFile replaces the stdin pipe of the process with the given guest
+// file. Only regular files are accepted, reading from a FIFO or a device
+// could block the process forever or have side effects.
+func (p *process) setStdinFile(path string) error {
+	if p.process.ConsoleSocket != nil {
+		return grpcStatus.Error(codes.InvalidArgument, "Cannot feed stdin from a file to a process using a terminal")
+	}
+
+	if !filepath.IsAbs(path) {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Stdin path %s is not absolute", path)
+	}
+
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return grpcStatus.Errorf(codes.NotFound, "Stdin file %s not found", path)
+		}
+		return err
+	}
+
+	if !fileInfo.Mode().IsRegular() {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Stdin path %s is not a regular file", path)
+	}
+
+	// Open without blocking and check the opened file again, in case the
+	// path has been replaced by a special file in the meantime.
+	file, err := os.OpenFile(path, os.O_RDONLY|unix.O_NONBLOCK, 0)
+	if err != nil {
+		return err
+	}
+
+	if fileInfo, err = file.Stat(); err != nil || !fileInfo.Mode().IsRegular() {
+		file.Close()
+		return grpcStatus.Errorf(codes.InvalidArgument, "Stdin path %s is not a regular file", path)
+	}
+
+	if p.process.Stdin != nil {
+		p.process.Stdin.(*os.File).Close()
+	}
+
+	if p.stdin != nil {
+		p.stdin.Close()
+		p.stdin = nil
+	}
+
+	p.process.Stdin = file
+	p.stdinClosed = true
+
+	return nil
+}
+
 func (a *agentGRPC) Check(ctx context.Context, req *pb.CheckRequest) (*pb.HealthCheckResponse, error) {
 	return &pb.HealthCheckResponse{Status: pb.HealthCheckResponse_SERVING}, nil
 }
@@ -389,8 +452,38 @@ func (a *agentGRPC) getContainer(cid string) (*container, error) {
 	return ctr, nil
 }
 
+// spawnProcess starts the process inside the container and returns its PID.
+// This is a variable so that unit tests can simulate spawn failures.
+var spawnProcess = func(ctr libcontainer.Container, proc *libcontainer.Process, createContainer bool) (int, error) {
+	var err error
+	if createContainer {
+		err = ctr.Start(proc)
+	} else {
+		err = ctr.Run(proc)
+	}
+	if err != nil {
+		return -1, err
+	}
+
+	return proc.Pid()
+}
+
+// isTransientSpawnError returns true if the process could not be spawned
+// because of a temporary lack of resources. libcontainer does not always
+// preserve the errno, hence the fallback on the error message.
+func isTransientSpawnError(err error) bool {
+	for _, errno := range []syscall.Errno{syscall.EAGAIN, syscall.ENOMEM} {
+		if errors.Cause(err) == errno || strings.Contains(err.Error(), errno.Error()) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Shared function between CreateContainer and ExecProcess, because those expect
-// a process to be run.
+// a process to be run. Spawning the process is retried with an exponential
+// backoff when clone/fork fails because of memory pressure.
 func (a *agentGRPC) execProcess(ctr *container, proc *process, createContainer bool) (err error) {
 	if ctr == nil {
 		return grpcStatus.Error(codes.InvalidArgument, "Container cannot be nil")
@@ -400,6 +493,66 @@ func (a *agentGRPC) execProcess(ctr *container, proc *process, createContainer b
 		return grpcStatus.Error(codes.InvalidArgument, "Process cannot be nil")
 	}
 
+	if ctr.timeOffsets != nil {
+		if err := proc.setTimeOffsets(ctr.timeOffsets); err != nil {
+			return err
+		}
+	}
+
+	// The sockets the umask and the seccomp notifications are handed off
+	// on are consumed by the init process, and set again for each attempt.
+	extraFiles := proc.process.ExtraFiles
+	defer func() {
+		if proc.umaskSock != nil {
+			proc.umaskSock.Close()
+		}
+	}()
+
+	delay := spawnRetryDelay
+	for attempt := 1; ; attempt++ {
+		if err := proc.setHandoffFds(ctr, extraFiles); err != nil {
+			proc.releaseHandoffFds(extraFiles)
+			return err
+		}
+
+		err = a.startProcess(ctr, proc, createContainer)
+
+		// The init process has its own end of the sockets once spawned.
+		if proc.seccompSockPeer != nil {
+			proc.seccompSockPeer.Close()
+		}
+		if proc.umaskSockPeer != nil {
+			proc.umaskSockPeer.Close()
+		}
+
+		if err == nil || attempt >= spawnAttempts || !isTransientSpawnError(err) {
+			break
+		}
+
+		agentLog.WithError(err).WithFields(logrus.Fields{
+			"exec-id": proc.id,
+			"attempt": attempt,
+			"delay":   delay,
+		}).Warn("Transient failure spawning process, retrying")
+
+		proc.releaseHandoffFds(extraFiles)
+
+		time.Sleep(delay)
+		delay *= 2
+	}
+
+	if err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not run process: %v", err)
+	}
+
+	if proc.umask != nil && createContainer {
+		return proc.applyUmask()
+	}
+
+	return nil
+}
+
+func (a *agentGRPC) startProcess(ctr *container, proc *process, createContainer bool) error {
 	// This lock is very important to avoid any race with reaper.reap().
 	// Indeed, if we don't lock this here, we could potentially get the
 	// SIGCHLD signal before the channel has been created, meaning we will
@@ -409,21 +562,34 @@ func (a *agentGRPC) execProcess(ctr *container, proc *process, createContainer b
 	a.sandbox.subreaper.lock()
 	defer a.sandbox.subreaper.unlock()
 
-	if createContainer {
-		err = ctr.container.Start(&proc.process)
-	} else {
-		err = ctr.container.Run(&(proc.process))
+	var pid int
+	spawn := func() (err error) {
+		pid, err = spawnProcess(ctr.container, &proc.process, createContainer)
+		return err
 	}
-	if err != nil {
-		return grpcStatus.Errorf(codes.Internal, "Could not run process: %v", err)
+
+	// The process inherits the personality of the spawning thread.
+	if ctr.personality != nil {
+		personalitySpawn := spawn
+		spawn = func() error {
+			return withPersonality(*ctr.personality, personalitySpawn)
+		}
 	}
 
-	// Get process PID
-	pid, err := proc.process.Pid()
-	if err != nil {
+	// The process inherits the nice value of the spawning thread.
+	if nice := processNice(ctr, proc); nice != nil {
+		niceSpawn := spawn
+		spawn = func() error {
+			return withNice(*nice, niceSpawn)
+		}
+	}
+
+	if err := spawn(); err != nil {
 		return err
 	}
 
+	auditProcessSpawn(ctr, proc, pid)
+
 	proc.exitCodeCh = make(chan int, 1)
 
 	// Create process channel to allow WaitProcess to wait on it.
@@ -447,6 +613,12 @@ func (a *agentGRPC) postExecProcess(ctr *container, proc *process) error {
 
 	defer proc.closePostStartFDs()
 
+	if proc.seccompErrCh != nil {
+		if err := <-proc.seccompErrCh; err != nil {
+			return err
+		}
+	}
+
 	// Setup terminal if enabled.
 	if proc.consoleSock != nil {
 		termMaster, err := utils.RecvFd(proc.consoleSock)
@@ -461,7 +633,7 @@ func (a *agentGRPC) postExecProcess(ctr *container, proc *process) error {
 		proc.termMaster = termMaster
 
 		// Get process PID
-		pid, err := proc.process.Pid()
+		pid, err := proc.pid()
 		if err != nil {
 			return err
 		}
@@ -489,9 +661,16 @@ func (a *agentGRPC) postExecProcess(ctr *container, proc *process) error {
 // sense to rely on the namespace path provided by the host since namespaces
 // are different inside the guest.
 func (a *agentGRPC) updateContainerConfigNamespaces(config *configs.Config, ctr *container) {
-	var ipcNs, utsNs bool
+	var ipcNs, utsNs, cgroupNs bool
 
 	for idx, ns := range config.Namespaces {
+		// A cgroup namespace is always created from the container's
+		// own cgroup, the path provided by the host is meaningless.
+		if ns.Type == configs.NEWCGROUP {
+			config.Namespaces[idx].Path = ""
+			cgroupNs = true
+		}
+
 		if ns.Type == configs.NEWIPC {
 			config.Namespaces[idx].Path = a.sandbox.sharedIPCNs.path
 			ipcNs = true
@@ -519,6 +698,15 @@ func (a *agentGRPC) updateContainerConfigNamespaces(config *configs.Config, ctr
 		config.Namespaces = append(config.Namespaces, newUTSNs)
 	}
 
+	// libcontainer unshares the cgroup namespace only after the init
+	// process has joined the container cgroups.
+	if ctr.useCgroupNs && !cgroupNs {
+		newCgroupNs := configs.Namespace{
+			Type: configs.NEWCGROUP,
+		}
+		config.Namespaces = append(config.Namespaces, newCgroupNs)
+	}
+
 	// Update PID namespace.
 	var pidNsPath string
 
@@ -549,6 +737,9 @@ func (a *agentGRPC) updateContainerConfigPrivileges(spec *specs.Spec, config *co
 	// Add the value for NoNewPrivileges option.
 	config.NoNewPrivileges = spec.Process.NoNewPrivileges
 
+	// Not converted by specconv, the profile being loaded beforehand.
+	config.AppArmorProfile = apparmorProfileName(spec.Process.ApparmorProfile)
+
 	return nil
 }
 
@@ -563,15 +754,31 @@ func (a *agentGRPC) updateContainerConfig(spec *specs.Spec, config *configs.Conf
 // - Delete the container from the agent internal map
 // - Unmount all mounts related to this container
 func (a *agentGRPC) rollbackFailingContainerCreation(ctr *container) {
+	ctr.stopWorkingSetSampling()
+	ctr.stopMemoryHighWatch()
+
 	if ctr.container != nil {
 		ctr.container.Destroy()
 	}
 
+	a.sandbox.deleteExitStatuses(ctr)
 	a.sandbox.deleteContainer(ctr.id)
 
+	if err := ctr.removeContainerQuota(); err != nil {
+		agentLog.WithError(err).Error("rollback failed removeContainerQuota()")
+	}
+
+	if ctr.initProcess != nil {
+		ctr.initProcess.closeOutputLog()
+	}
+
 	if err := removeMounts(ctr.mounts); err != nil {
 		agentLog.WithError(err).Error("rollback failed removeMounts()")
 	}
+
+	if err := removeContainerDNS(ctr.id); err != nil {
+		agentLog.WithError(err).Error("rollback failed removeContainerDNS()")
+	}
 }
 
 func (a *agentGRPC) finishCreateContainer(ctr *container, req *pb.CreateContainerRequest, config *configs.Config) (resp *gpb.Empty, err error) {
@@ -591,11 +798,38 @@ func (a *agentGRPC) finishCreateContainer(ctr *container, req *pb.CreateContaine
 	if err != nil {
 		return emptyResp, err
 	}
+	ctr.initProcess.umask = ctr.umask
+
+	if err = ctr.initProcess.setupOutputLog(req.OutputLog); err != nil {
+		return emptyResp, err
+	}
+	ctr.initProcess.setOutputTimestamps(req.OutputTimestamps)
 
 	if err = a.execProcess(ctr, ctr.initProcess, true); err != nil {
 		return emptyResp, err
 	}
 
+	if err = ctr.checkReadonlyRootfs(); err != nil {
+		return emptyResp, err
+	}
+
+	// The cgroup of the container exists once its init process is
+	// created.
+	if ctr.cpuBurst > 0 {
+		if err = ctr.applyCPUBurst(isCgroupV2(), ctr.cpuBurst); err != nil {
+			return emptyResp, err
+		}
+	}
+
+	if ctr.oomKillDisable {
+		if err = ctr.disableOOMKill(isCgroupV2()); err != nil {
+			return emptyResp, err
+		}
+	}
+
+	ctr.watchOOM()
+	ctr.startWorkingSetSampling()
+
 	// Make sure add Container to Sandbox, before call updateSharedPidNs
 	a.sandbox.setContainer(ctr.ctx, req.ContainerId, ctr)
 	if err := a.updateSharedPidNs(ctr); err != nil {
@@ -609,6 +843,7 @@ func (a *agentGRPC) CreateContainer(ctx context.Context, req *pb.CreateContainer
 	if err := a.createContainerChecks(req); err != nil {
 		return emptyResp, err
 	}
+	defer a.sandbox.releaseContainer(req.ContainerId)
 
 	// re-scan PCI bus
 	// looking for hidden devices
@@ -642,6 +877,7 @@ func (a *agentGRPC) CreateContainer(ctx context.Context, req *pb.CreateContainer
 		processes:       make(map[string]*process),
 		mounts:          mountList,
 		useSandboxPidNs: req.SandboxPidns,
+		useCgroupNs:     req.CgroupNs,
 		ctx:             ctx,
 	}
 
@@ -653,12 +889,57 @@ func (a *agentGRPC) CreateContainer(ctx context.Context, req *pb.CreateContainer
 		}
 	}()
 
+	if req.OCI.Process != nil {
+		req.OCI.Process.Env = mergeImageEnv(req.ImageEnv, req.OCI.Process.Env)
+	}
+
 	// Convert the spec to an actual OCI specification structure.
 	ociSpec, err := pb.GRPCtoOCI(req.OCI)
 	if err != nil {
 		return emptyResp, err
 	}
 
+	// Not part of the converted spec, applied when spawning processes.
+	if err := ctr.setContainerPersonality(req.OCI.Linux); err != nil {
+		return emptyResp, err
+	}
+
+	if err := ctr.setContainerStopSignal(ociSpec); err != nil {
+		return emptyResp, err
+	}
+
+	if err := ctr.setContainerStopPolicy(ociSpec); err != nil {
+		return emptyResp, err
+	}
+
+	if err := ctr.setContainerCPUBurst(ociSpec, req.OCI.Linux); err != nil {
+		return emptyResp, err
+	}
+
+	if err := ctr.setContainerOOMKillDisable(ociSpec); err != nil {
+		return emptyResp, err
+	}
+
+	if err := ctr.setContainerNice(ociSpec); err != nil {
+		return emptyResp, err
+	}
+
+	if err := ctr.setContainerTimeOffsets(ociSpec); err != nil {
+		return emptyResp, err
+	}
+
+	if err := ctr.setContainerSeccompNotify(ociSpec); err != nil {
+		return emptyResp, err
+	}
+
+	if err := ctr.setContainerStorageDeps(ociSpec, req.Storages); err != nil {
+		return emptyResp, err
+	}
+
+	if err := setupApparmorProfile(req.ApparmorProfile, ociSpec.Process.ApparmorProfile); err != nil {
+		return emptyResp, err
+	}
+
 	if err := a.handleCPUSet(ociSpec); err != nil {
 		return emptyResp, err
 	}
@@ -667,6 +948,18 @@ func (a *agentGRPC) CreateContainer(ctx context.Context, req *pb.CreateContainer
 		return emptyResp, err
 	}
 
+	if err := a.handleShmMount(ociSpec); err != nil {
+		return emptyResp, err
+	}
+
+	if err := a.sandbox.setupContainerDNS(ociSpec, req.ContainerId, req.DnsSearch, req.DnsOptions); err != nil {
+		return emptyResp, err
+	}
+
+	if err := a.sandbox.setupContainerQuota(ctr, ociSpec, req.DiskQuota); err != nil {
+		return emptyResp, err
+	}
+
 	if a.sandbox.guestHooksPresent {
 		// Add any custom OCI hooks to the spec
 		a.sandbox.addGuestHooks(ociSpec)
@@ -700,12 +993,24 @@ func (a *agentGRPC) CreateContainer(ctx context.Context, req *pb.CreateContainer
 	// apply rlimits
 	config.Rlimits = posixRlimitsToRlimits(ociSpec.Process.Rlimits)
 
+	if err = checkNofileLimit(config.Rlimits); err != nil {
+		return emptyResp, err
+	}
+
 	// Update libcontainer configuration for specific cases not handled
 	// by the specconv converter.
 	if err = a.updateContainerConfig(ociSpec, config, ctr); err != nil {
 		return emptyResp, err
 	}
 
+	if err = addProcSysWritableHook(config, req.ProcSysWritable); err != nil {
+		return emptyResp, err
+	}
+
+	if ctr.umask, err = containerUmask(ociSpec); err != nil {
+		return emptyResp, err
+	}
+
 	return a.finishCreateContainer(ctr, req, config)
 }
 
@@ -796,6 +1101,45 @@ func posixRlimitsToRlimits(posixRlimits []specs.POSIXRlimit) []configs.Rlimit {
 	return rlimits
 }
 
+// execProcessChecks verifies the container and the sandbox can hold one
+// more exec process, and reserves it a slot until released by releaseExec,
+// once the process is tracked by the container or failed to spawn. The
+// processes being spawned concurrently are counted against the maximums.
+func (a *agentGRPC) execProcessChecks(ctr *container) error {
+	a.sandbox.execLock.Lock()
+	defer a.sandbox.execLock.Unlock()
+
+	pending := 0
+	for _, count := range a.sandbox.pendingExecs {
+		pending += count
+	}
+
+	if maxContainerExecs > 0 && ctr.execCount()+a.sandbox.pendingExecs[ctr.id] >= maxContainerExecs {
+		return grpcStatus.Errorf(codes.ResourceExhausted, "Container %s already runs the maximum of %d exec processes", ctr.id, maxContainerExecs)
+	}
+
+	if maxExecs > 0 && a.sandbox.execCount()+pending >= maxExecs {
+		return grpcStatus.Errorf(codes.ResourceExhausted, "Sandbox already runs the maximum of %d exec processes, impossible to exec in container %s", maxExecs, ctr.id)
+	}
+
+	if a.sandbox.pendingExecs == nil {
+		a.sandbox.pendingExecs = make(map[string]int)
+	}
+	a.sandbox.pendingExecs[ctr.id]++
+
+	return nil
+}
+
+// releaseExec releases the slot reserved by execProcessChecks.
+func (a *agentGRPC) releaseExec(ctr *container) {
+	a.sandbox.execLock.Lock()
+	defer a.sandbox.execLock.Unlock()
+
+	if a.sandbox.pendingExecs[ctr.id]--; a.sandbox.pendingExecs[ctr.id] <= 0 {
+		delete(a.sandbox.pendingExecs, ctr.id)
+	}
+}
+
 func (a *agentGRPC) createContainerChecks(req *pb.CreateContainerRequest) (err error) {
 	if !a.sandbox.running {
 		return grpcStatus.Error(codes.FailedPrecondition, "Sandbox not started, impossible to run a new container")
@@ -809,7 +1153,22 @@ func (a *agentGRPC) createContainerChecks(req *pb.CreateContainerRequest) (err e
 		return grpcStatus.Errorf(codes.FailedPrecondition, "Unexpected PID namespace received for container %s, should have been cleared out", req.ContainerId)
 	}
 
-	return nil
+	// The init process sets up the container mounts, which a Landlock
+	// restricted process cannot do.
+	if req.OCI.Process != nil && req.OCI.Process.Landlock != nil {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Landlock ruleset not supported for the init process of container %s", req.ContainerId)
+	}
+
+	if err = validateProcSysWritable(req.ProcSysWritable); err != nil {
+		return err
+	}
+
+	if err = validateOutputLog(req.OutputLog); err != nil {
+		return err
+	}
+
+	// Released by the caller once the container is created.
+	return a.sandbox.reserveContainer(req.ContainerId)
 }
 
 func (a *agentGRPC) pidNsExists(grpcSpec *pb.Spec) bool {
@@ -830,7 +1189,7 @@ func (a *agentGRPC) updateSharedPidNs(ctr *container) error {
 	// first container created as the infra container in that case
 	// and use its pid namespace in case pid namespace needs to be shared.
 	if !a.sandbox.sandboxPidNs && len(a.sandbox.containers) == 1 {
-		pid, err := ctr.initProcess.process.Pid()
+		pid, err := ctr.initProcess.pid()
 		if err != nil {
 			return err
 		}
@@ -856,92 +1215,328 @@ func (a *agentGRPC) StartContainer(ctx context.Context, req *pb.StartContainerRe
 		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "Container %s status %s, should be %s", req.ContainerId, status.String(), libcontainer.Created.String())
 	}
 
-	if err := ctr.container.Exec(); err != nil {
-		return emptyResp, err
+	// The start timeout covers the waits for the container dependencies
+	// as well.
+	var deadline time.Time
+	if req.StartTimeout > 0 {
+		deadline = time.Now().Add(time.Duration(req.StartTimeout) * time.Second)
 	}
 
-	return emptyResp, nil
-}
+	if req.NetworkReadyTimeout > 0 {
+		timeout, err := startTimeout(ctr, time.Duration(req.NetworkReadyTimeout)*time.Second, deadline)
+		if err == nil {
+			err = a.sandbox.waitNetworkReady(timeout)
+		}
+		if err != nil {
+			return emptyResp, a.abortContainerStart(ctr, deadline, err)
+		}
+	}
 
-func (a *agentGRPC) ExecProcess(ctx context.Context, req *pb.ExecProcessRequest) (*gpb.Empty, error) {
-	ctr, err := a.getContainer(req.ContainerId)
+	timeout, err := startTimeout(ctr, storageReadyTimeout, deadline)
+	if err == nil {
+		err = ctr.waitStoragesReady(timeout)
+	}
 	if err != nil {
-		return emptyResp, err
+		return emptyResp, a.abortContainerStart(ctr, deadline, err)
 	}
 
-	status, err := ctr.container.Status()
-	if err != nil {
-		return nil, err
+	if err := ctr.execContainer(deadline); err != nil {
+		return emptyResp, a.abortContainerStart(ctr, deadline, err)
 	}
 
-	if status == libcontainer.Stopped {
-		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "Cannot exec in stopped container %s", req.ContainerId)
+	return emptyResp, nil
+}
+
+// startTimeout bounds the timeout of a step of the container start by the
+// start deadline, if any.
+func startTimeout(c *container, timeout time.Duration, deadline time.Time) (time.Duration, error) {
+	if deadline.IsZero() {
+		return timeout, nil
 	}
 
-	proc, err := buildProcess(req.Process, req.ExecId, false)
-	if err != nil {
-		return emptyResp, err
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return 0, grpcStatus.Errorf(codes.DeadlineExceeded, "Timeout reached starting container %s", c.id)
 	}
 
-	if err := a.execProcess(ctr, proc, false); err != nil {
-		return emptyResp, err
+	if timeout == 0 || timeout > remaining {
+		return remaining, nil
 	}
 
-	return emptyResp, a.postExecProcess(ctr, proc)
+	return timeout, nil
 }
 
-func (a *agentGRPC) SignalProcess(ctx context.Context, req *pb.SignalProcessRequest) (*gpb.Empty, error) {
-	if !a.sandbox.running {
-		return emptyResp, grpcStatus.Error(codes.FailedPrecondition, "Sandbox not started, impossible to signal the container")
+// abortContainerStart removes the container once its start deadline, if
+// any, is reached, its processes being killed. The container is locked by
+// libcontainer until the start completes, it is removed in the background
+// if that takes too long.
+func (a *agentGRPC) abortContainerStart(c *container, deadline time.Time, err error) error {
+	if deadline.IsZero() || time.Now().Before(deadline) {
+		return err
 	}
 
-	ctr, err := a.sandbox.getContainer(req.ContainerId)
-	if err != nil {
-		return emptyResp, grpcStatus.Errorf(codes.FailedPrecondition, "Could not signal process %s: %v", req.ExecId, err)
-	}
+	agentLog.WithError(err).WithField("container", c.id).Warn("Container not started in time, removing it")
 
-	status, err := ctr.container.Status()
-	if err != nil {
-		return emptyResp, err
+	c.killStartingProcesses()
+
+	if c.startDone != nil {
+		select {
+		case <-c.startDone:
+		case <-time.After(startKillTimeout):
+			agentLog.WithField("container", c.id).Warn("Container init process still not dead, removing the container in the background")
+			go func() {
+				<-c.startDone
+				a.removeAbortedContainer(c)
+			}()
+			return err
+		}
 	}
 
-	signal := syscall.Signal(req.Signal)
+	a.removeAbortedContainer(c)
 
-	if status == libcontainer.Stopped {
-		agentLog.WithFields(logrus.Fields{
-			"containerID": req.ContainerId,
-			"signal":      signal.String(),
-		}).Info("discarding signal as container stopped")
-		return emptyResp, nil
+	return err
+}
+
+// removeAbortedContainer removes a container whose start timed out, as
+// RemoveContainer does.
+func (a *agentGRPC) removeAbortedContainer(c *container) {
+	a.sandbox.Lock()
+	defer a.sandbox.Unlock()
+
+	if err := c.removeContainer(); err != nil {
+		agentLog.WithError(err).WithField("container", c.id).Error("Could not remove container whose start timed out")
+		return
 	}
 
-	// If the exec ID provided is empty, let's apply the signal to all
-	// processes inside the container.
-	// If the process is the container process, let's use the container
-	// API for that.
-	// Frozen processes are thawed when `all` is true, allowing them to receive and process signals.
-	if req.ExecId == "" || status == libcontainer.Paused {
-		return emptyResp, ctr.container.Signal(signal, true)
-	} else if ctr.initProcess.id == req.ExecId {
-		pid, err := ctr.initProcess.process.Pid()
-		if err != nil {
-			return emptyResp, err
-		}
-		// For container initProcess, if it hasn't installed handler for "SIGTERM" signal,
-		// it will ignore the "SIGTERM" signal sent to it, thus send it "SIGKILL" signal
-		// instead of "SIGTERM" to terminate it.
-		if signal == syscall.SIGTERM && !isSignalHandled(pid, syscall.SIGTERM) {
-			signal = syscall.SIGKILL
+	for _, path := range c.mounts {
+		if _, ok := a.sandbox.storages[path]; ok {
+			if err := a.sandbox.unsetAndRemoveSandboxStorage(path); err != nil {
+				agentLog.WithError(err).WithField("container", c.id).Error("Could not remove container storage")
+			}
 		}
-		return emptyResp, ctr.container.Signal(signal, false)
 	}
 
-	proc, err := ctr.getProcess(req.ExecId)
+	a.sandbox.deleteExitStatuses(c)
+	delete(a.sandbox.containers, c.id)
+}
+
+// killStartingProcesses kills the init process of the container and the
+// processes it spawned, for the start of the container to complete. The
+// init process keeps the container locked by libcontainer until then.
+func (c *container) killStartingProcesses() {
+	if err := c.initProcess.signal(syscall.SIGKILL); err != nil {
+		agentLog.WithError(err).WithField("container", c.id).Warn("Could not kill container init process")
+	}
+
+	pids, err := c.container.Processes()
+	if err != nil {
+		agentLog.WithError(err).WithField("container", c.id).Warn("Could not list container processes")
+		return
+	}
+
+	for _, pid := range pids {
+		if err := unix.Kill(pid, unix.SIGKILL); err != nil && err != unix.ESRCH {
+			agentLog.WithError(err).WithFields(logrus.Fields{
+				"container": c.id,
+				"pid":       pid,
+			}).Warn("Could not kill container process")
+		}
+	}
+}
+
+// execContainer starts the container init process, failing once the
+// deadline, if any, is reached. The start then completes in the
+// background, signaled by startDone.
+func (c *container) execContainer(deadline time.Time) error {
+	if deadline.IsZero() {
+		return c.container.Exec()
+	}
+
+	done := make(chan struct{})
+	var err error
+	go func() {
+		err = c.container.Exec()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return err
+	case <-time.After(time.Until(deadline)):
+		c.startDone = done
+	}
+
+	return grpcStatus.Errorf(codes.DeadlineExceeded, "Timeout reached starting container %s", c.id)
+}
+
+func (a *agentGRPC) ExecProcess(ctx context.Context, req *pb.ExecProcessRequest) (*pb.ExecProcessResponse, error) {
+	ctr, err := a.getContainer(req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := ctr.container.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	if status == libcontainer.Stopped {
+		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "Cannot exec in stopped container %s", req.ContainerId)
+	}
+
+	// The process is only counted once tracked by the container, its
+	// slot being reserved meanwhile, and given back if it fails to spawn.
+	if maxExecs > 0 || maxContainerExecs > 0 {
+		if err := a.execProcessChecks(ctr); err != nil {
+			return nil, err
+		}
+		defer a.releaseExec(ctr)
+	}
+
+	var umask *uint32
+	if req.Umask != "" {
+		value, err := parseUmask(req.Umask)
+		if err != nil {
+			return nil, err
+		}
+		umask = &value
+	}
+
+	var nice *int
+	if req.Nice != "" {
+		value, err := parseNice(req.Nice)
+		if err != nil {
+			return nil, err
+		}
+		nice = &value
+	}
+
+	if err := setupApparmorProfile("", req.Process.ApparmorProfile); err != nil {
+		return nil, err
+	}
+
+	// The image defaults are not inherited by a process started with a
+	// strict environment.
+	if !req.StrictEnv {
+		req.Process.Env = mergeImageEnv(req.ImageEnv, req.Process.Env)
+	}
+
+	proc, err := buildProcess(req.Process, req.ExecId, false)
+	if err != nil {
+		return nil, err
+	}
+	proc.umask = umask
+	proc.nice = nice
+
+	if req.StrictEnv {
+		proc.process.Env = strictEnv(proc.process.Env)
+	}
+
+	if err := proc.setOutputBuffering(req.OutputBuffering, req.FlushIntervalMs); err != nil {
+		proc.closePostStartFDs()
+		proc.closePostExitFDs()
+		return nil, err
+	}
+	proc.setOutputTimestamps(req.OutputTimestamps)
+
+	if req.Process.Landlock != nil {
+		if err := proc.setLandlockRuleset(ctr, req.Process.Landlock); err != nil {
+			proc.closePostStartFDs()
+			proc.closePostExitFDs()
+			return nil, err
+		}
+	}
+
+	if req.StdinPath != "" {
+		if err := proc.setStdinFile(req.StdinPath); err != nil {
+			proc.closePostStartFDs()
+			proc.closePostExitFDs()
+			return nil, err
+		}
+	}
+
+	if err := a.execProcess(ctr, proc, false); err != nil {
+		return nil, err
+	}
+
+	if err := a.postExecProcess(ctr, proc); err != nil {
+		return nil, err
+	}
+
+	pid, err := proc.pid()
+	if err != nil {
+		return nil, err
+	}
+
+	// Every process leads a new session, see getProcessSession.
+	pgid, sid, err := getProcessSession(pid)
+	if err != nil {
+		// The process may already be gone.
+		agentLog.WithError(err).WithField("pid", pid).Debug("Could not read the session of the process")
+		return &pb.ExecProcessResponse{Pid: int32(pid)}, nil
+	}
+
+	return &pb.ExecProcessResponse{
+		Pid:  int32(pid),
+		Pgid: int32(pgid),
+		Sid:  int32(sid),
+	}, nil
+}
+
+func (a *agentGRPC) SignalProcess(ctx context.Context, req *pb.SignalProcessRequest) (*gpb.Empty, error) {
+	if !a.sandbox.running {
+		return emptyResp, grpcStatus.Error(codes.FailedPrecondition, "Sandbox not started, impossible to signal the container")
+	}
+
+	ctr, err := a.sandbox.getContainer(req.ContainerId)
+	if err != nil {
+		return emptyResp, grpcStatus.Errorf(codes.FailedPrecondition, "Could not signal process %s: %v", req.ExecId, err)
+	}
+
+	status, err := ctr.container.Status()
+	if err != nil {
+		return emptyResp, err
+	}
+
+	signal := syscall.Signal(req.Signal)
+	if req.Stop {
+		signal = ctr.getStopSignal()
+	}
+
+	if status == libcontainer.Stopped {
+		agentLog.WithFields(logrus.Fields{
+			"containerID": req.ContainerId,
+			"signal":      signal.String(),
+		}).Info("discarding signal as container stopped")
+		return emptyResp, nil
+	}
+
+	// Stopping the container signals the processes selected by its stop
+	// policy, the init process by default, whatever the exec ID.
+	if req.Stop && (req.ExecId == "" || ctr.initProcess.id == req.ExecId) && status != libcontainer.Paused {
+		if ctr.stopPolicy == stopPolicyGroup {
+			return emptyResp, ctr.container.Signal(signal, true)
+		}
+		return emptyResp, ctr.signalInit(signal, true)
+	}
+
+	// If the exec ID provided is empty, let's apply the signal to all
+	// processes inside the container.
+	// If the process is the container process, let's use the container
+	// API for that.
+	// Frozen processes are thawed when `all` is true, allowing them to receive and process signals.
+	if req.ExecId == "" || status == libcontainer.Paused {
+		return emptyResp, ctr.container.Signal(signal, true)
+	} else if ctr.initProcess.id == req.ExecId {
+		return emptyResp, ctr.signalInit(signal, false)
+	}
+
+	proc, err := ctr.getProcess(req.ExecId)
 	if err != nil {
 		return emptyResp, grpcStatus.Errorf(grpcStatus.Convert(err).Code(), "Could not signal process: %v", err)
 	}
 
-	if err := proc.process.Signal(signal); err != nil {
+	if err := proc.signal(signal); err != nil {
 		return emptyResp, err
 	}
 
@@ -998,15 +1593,24 @@ func (a *agentGRPC) WaitProcess(ctx context.Context, req *pb.WaitProcessRequest)
 	if err != nil {
 		return &pb.WaitProcessResponse{}, err
 	}
+
+	// Only the first caller can retrieve the exit status from the
+	// subreaper, others get the reason once the channel is refilled.
+	reason, signal := proc.getExitReason(a.sandbox.subreaper, ctr)
+
+	resp := &pb.WaitProcessResponse{
+		Status: int32(exitCode),
+		Reason: reason,
+		Signal: signal,
+	}
+
 	//refill the exitCodeCh with the exitcode which can be read out
 	//by another WaitProcess(). Since this channel isn't be closed,
 	//here the refill will always success and it will be free by GC
 	//once the process exits.
 	proc.exitCodeCh <- exitCode
 
-	return &pb.WaitProcessResponse{
-		Status: int32(exitCode),
-	}, nil
+	return resp, nil
 }
 
 func getPIDIndex(title string) int {
@@ -1100,6 +1704,121 @@ func (a *agentGRPC) ListProcesses(ctx context.Context, req *pb.ListProcessesRequ
 	return resp, nil
 }
 
+// getInspectedProcess returns the process of the container inspected by a
+// request, the init one unless the exec ID is given. Only the processes
+// started by the agent, whose PID is known, can be inspected, not arbitrary
+// processes of the container.
+func (a *agentGRPC) getInspectedProcess(containerID, execID string) (*process, error) {
+	ctr, err := a.getContainer(containerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if execID != "" {
+		return ctr.getProcess(execID)
+	}
+
+	// Until the container is started, its init process is still the runc
+	// init one, not running the container process.
+	status, err := ctr.container.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	if status == libcontainer.Created || ctr.initProcess == nil {
+		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "Container %s not started", containerID)
+	}
+
+	return ctr.initProcess, nil
+}
+
+// GetProcessEnv returns the environment of a process of the container, the
+// init one unless the exec ID is given.
+func (a *agentGRPC) GetProcessEnv(ctx context.Context, req *pb.GetProcessEnvRequest) (*pb.ProcessEnv, error) {
+	proc, err := a.getInspectedProcess(req.ContainerId, req.ExecId)
+	if err != nil {
+		return nil, err
+	}
+
+	pid, err := proc.pid()
+	if err != nil {
+		return nil, err
+	}
+
+	env, err := readProcessEnv(pid)
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.Internal, "Could not read the environment of process %s: %v", proc.id, err)
+	}
+
+	if env, err = redactEnv(env, req.Redact); err != nil {
+		return nil, err
+	}
+
+	return &pb.ProcessEnv{Env: env}, nil
+}
+
+// GetProcessFiles returns the open files of a process of the container, the
+// init one unless the exec ID is given.
+func (a *agentGRPC) GetProcessFiles(ctx context.Context, req *pb.GetProcessFilesRequest) (*pb.ProcessFiles, error) {
+	proc, err := a.getInspectedProcess(req.ContainerId, req.ExecId)
+	if err != nil {
+		return nil, err
+	}
+
+	pid, err := proc.pid()
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := readProcessFiles(pid, req.Details)
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.Internal, "Could not read the open files of process %s: %v", proc.id, err)
+	}
+
+	return files, nil
+}
+
+func (a *agentGRPC) ListContainerMounts(ctx context.Context, req *pb.ListContainerMountsRequest) (*pb.ContainerMounts, error) {
+	ctr, err := a.getContainer(req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return ctr.listMounts()
+}
+
+func (a *agentGRPC) ExposeContainerRootfs(ctx context.Context, req *pb.ExposeContainerRootfsRequest) (*pb.ExposeContainerRootfsResponse, error) {
+	ctr, err := a.sandbox.getContainer(req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := ctr.exposeRootfs()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ExposeContainerRootfsResponse{Path: path}, nil
+}
+
+func (a *agentGRPC) UnexposeContainerRootfs(ctx context.Context, req *pb.UnexposeContainerRootfsRequest) (*gpb.Empty, error) {
+	ctr, err := a.sandbox.getContainer(req.ContainerId)
+	if err != nil {
+		return emptyResp, err
+	}
+
+	return emptyResp, ctr.unexposeRootfs()
+}
+
+func (a *agentGRPC) ReadContainerRootfs(ctx context.Context, req *pb.ReadContainerRootfsRequest) (*pb.ContainerRootfsFile, error) {
+	ctr, err := a.sandbox.getContainer(req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return ctr.readRootfs(req)
+}
+
 func (a *agentGRPC) UpdateContainer(ctx context.Context, req *pb.UpdateContainerRequest) (*gpb.Empty, error) {
 	if req.Resources == nil {
 		return emptyResp, fmt.Errorf("Resources in the request are nil")
@@ -1125,9 +1844,21 @@ func (a *agentGRPC) UpdateContainer(ctx context.Context, req *pb.UpdateContainer
 		resources.BlkioWeight = uint16(req.Resources.BlockIO.Weight)
 	}
 
+	burst := c.cpuBurst
 	if req.Resources.CPU != nil {
+		// The burst and quota are left unchanged when not set, the
+		// burst being validated against the resulting quota.
+		if req.Resources.CPU.Burst != 0 {
+			burst = req.Resources.CPU.Burst
+		}
+		if req.Resources.CPU.Quota != 0 {
+			resources.CpuQuota = req.Resources.CPU.Quota
+		}
+		if err := validateCPUBurst(burst, resources.CpuQuota); err != nil {
+			return emptyResp, err
+		}
+
 		resources.CpuPeriod = req.Resources.CPU.Period
-		resources.CpuQuota = req.Resources.CPU.Quota
 		resources.CpuShares = req.Resources.CPU.Shares
 		resources.CpuRtPeriod = req.Resources.CPU.RealtimePeriod
 		resources.CpuRtRuntime = req.Resources.CPU.RealtimeRuntime
@@ -1170,7 +1901,46 @@ func (a *agentGRPC) UpdateContainer(ctx context.Context, req *pb.UpdateContainer
 	}
 	cgroupsCopy.Resources = &resources
 	config.Cgroups = &cgroupsCopy
-	return emptyResp, c.container.Set(config)
+
+	if err := c.setResources(config, burst); err != nil {
+		return emptyResp, err
+	}
+
+	// The memory high threshold follows the memory limit.
+	if req.Resources.Memory != nil {
+		if err := c.updateMemoryHigh(); err != nil {
+			return emptyResp, err
+		}
+	}
+
+	return emptyResp, nil
+}
+
+// setResources updates the cgroups of the container along with its CPU
+// burst.
+func (c *container) setResources(config configs.Config, burst uint64) error {
+	if burst == c.cpuBurst {
+		return c.container.Set(config)
+	}
+
+	// The kernel rejects a quota lower than the burst, which is reset
+	// meanwhile.
+	cgroupV2 := isCgroupV2()
+	if err := c.applyCPUBurst(cgroupV2, 0); err != nil {
+		return err
+	}
+
+	if err := c.container.Set(config); err != nil {
+		c.applyCPUBurst(cgroupV2, c.cpuBurst)
+		return err
+	}
+
+	if err := c.applyCPUBurst(cgroupV2, burst); err != nil {
+		return err
+	}
+	c.cpuBurst = burst
+
+	return nil
 }
 
 func (a *agentGRPC) StatsContainer(ctx context.Context, req *pb.StatsContainerRequest) (*pb.StatsContainerResponse, error) {
@@ -1210,10 +1980,81 @@ func (a *agentGRPC) StatsContainer(ctx context.Context, req *pb.StatsContainerRe
 		NetworkStats: networkStats,
 	}
 
+	if c.workingSet != nil {
+		resp.WorkingSet = c.workingSet.stats()
+	}
+
+	cgroupV2 := isCgroupV2()
+	if dir, err := c.getMemoryCgroupPath(cgroupV2); err == nil {
+		if resp.PageCache, err = getPageCacheStats(dir, cgroupV2); err != nil {
+			agentLog.WithError(err).WithField("container", c.id).Warn("Could not get the container page cache statistics")
+		}
+
+		if resp.OomControl, err = getOOMControl(dir, cgroupV2); err != nil {
+			agentLog.WithError(err).WithField("container", c.id).Warn("Could not get the container OOM control")
+		}
+	}
+
+	if resp.SandboxPageCache, err = getSandboxPageCacheStats(); err != nil {
+		agentLog.WithError(err).Warn("Could not get the sandbox page cache statistics")
+	}
+
 	return resp, nil
 
 }
 
+func (a *agentGRPC) StatsSandbox(ctx context.Context, req *pb.StatsSandboxRequest) (*pb.StatsSandboxResponse, error) {
+	stats, err := a.sandbox.networkStats(nil)
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.Internal, "Could not get the sandbox network statistics: %v", err)
+	}
+
+	return &pb.StatsSandboxResponse{NetworkStats: stats}, nil
+}
+
+func (a *agentGRPC) MemoryStatContainer(ctx context.Context, req *pb.MemoryStatContainerRequest) (*pb.MemoryStatContainerResponse, error) {
+	c, err := a.sandbox.getContainer(req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	cgroupV2 := isCgroupV2()
+
+	if req.ResetPeak && cgroupV2 {
+		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "Could not reset peak memory usage of container %s: not supported with cgroup v2", c.id)
+	}
+
+	dir, err := c.getMemoryCgroupPath(cgroupV2)
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := parseMemoryStat(filepath.Join(dir, memoryStatFile))
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.Internal, "Could not read memory stats of container %s: %v", c.id, err)
+	}
+
+	peak, err := getMemoryPeak(dir, cgroupV2)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, grpcStatus.Errorf(codes.Internal, "Could not read peak memory usage of container %s: %v", c.id, err)
+		}
+		agentLog.WithField("container", c.id).Debug("Peak memory usage not supported by the kernel")
+	}
+
+	if req.ResetPeak {
+		if err := resetMemoryPeak(dir, cgroupV2); err != nil {
+			return nil, grpcStatus.Errorf(codes.Internal, "Could not reset peak memory usage of container %s: %v", c.id, err)
+		}
+	}
+
+	return &pb.MemoryStatContainerResponse{
+		Stat:     stat,
+		Peak:     peak,
+		CgroupV2: cgroupV2,
+	}, nil
+}
+
 func (a *agentGRPC) PauseContainer(ctx context.Context, req *pb.PauseContainerRequest) (*gpb.Empty, error) {
 	c, err := a.sandbox.getContainer(req.ContainerId)
 	if err != nil {
@@ -1294,6 +2135,7 @@ func (a *agentGRPC) RemoveContainer(ctx context.Context, req *pb.RemoveContainer
 		}
 	}
 
+	a.sandbox.deleteExitStatuses(ctr)
 	delete(a.sandbox.containers, ctr.id)
 
 	return emptyResp, nil
@@ -1306,8 +2148,12 @@ func (a *agentGRPC) WriteStdin(ctx context.Context, req *pb.WriteStreamRequest)
 	}
 
 	proc.RLock()
-	defer proc.RUnlock()
 	stdinClosed := proc.stdinClosed
+	file := proc.stdin
+	if proc.termMaster != nil {
+		file = proc.termMaster
+	}
+	proc.RUnlock()
 
 	// Ignore this call to WriteStdin() if STDIN has already been closed
 	// earlier.
@@ -1315,18 +2161,22 @@ func (a *agentGRPC) WriteStdin(ctx context.Context, req *pb.WriteStreamRequest)
 		return &pb.WriteStreamResponse{}, nil
 	}
 
-	var file *os.File
-	if proc.termMaster != nil {
-		file = proc.termMaster
-	} else {
-		file = proc.stdin
-	}
-
+	// The write is not done under the process lock, closing STDIN must
+	// not wait for a write blocked on a full pipe, it interrupts it.
 	n, err := file.Write(req.Data)
 	if err != nil {
+		if proc.isStdinClosed() {
+			return &pb.WriteStreamResponse{}, nil
+		}
 		return &pb.WriteStreamResponse{}, err
 	}
 
+	if req.Eof && proc.termMaster == nil {
+		if err := proc.closeStdin(); err != nil {
+			return &pb.WriteStreamResponse{}, err
+		}
+	}
+
 	return &pb.WriteStreamResponse{
 		Len: uint32(n),
 	}, nil
@@ -1360,22 +2210,7 @@ func (a *agentGRPC) CloseStdin(ctx context.Context, req *pb.CloseStdinRequest) (
 		return emptyResp, err
 	}
 
-	// If stdin is nil, which can be the case when using a terminal,
-	// there is nothing to do.
-	if proc.stdin == nil {
-		return emptyResp, nil
-	}
-
-	proc.Lock()
-	defer proc.Unlock()
-
-	if err := proc.stdin.Close(); err != nil {
-		return emptyResp, err
-	}
-
-	proc.stdinClosed = true
-
-	return emptyResp, nil
+	return emptyResp, proc.closeStdin()
 }
 
 func (a *agentGRPC) TtyWinResize(ctx context.Context, req *pb.TtyWinResizeRequest) (*gpb.Empty, error) {
@@ -1430,15 +2265,169 @@ func loadKernelModule(module *pb.KernelModule) error {
 	return nil
 }
 
+// normalizeKernelModuleName follows the kernel convention, under which
+// dashes and underscores are interchangeable in module names.
+func normalizeKernelModuleName(name string) string {
+	return strings.Replace(name, "-", "_", -1)
+}
+
+// kernelModuleFileName returns the normalized module name of a module
+// file path, e.g. kernel/fs/fuse/virtiofs.ko.xz.
+func kernelModuleFileName(path string) string {
+	name := filepath.Base(path)
+	if idx := strings.Index(name, ".ko"); idx >= 0 {
+		name = name[:idx]
+	}
+	return normalizeKernelModuleName(name)
+}
+
+// findKernelModule looks for the module in a file listing one module per
+// line, the module being extracted from each line by the given function.
+// A missing file is not an error, minimal guests may not provide it.
+func findKernelModule(path, name string, moduleFromLine func(string) string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if moduleFromLine(scanner.Text()) == name {
+			return true, nil
+		}
+	}
+
+	return false, scanner.Err()
+}
+
+func kernelRelease() (string, error) {
+	var uts unix.Utsname
+	if err := unix.Uname(&uts); err != nil {
+		return "", err
+	}
+
+	return string(bytes.TrimRight(uts.Release[:], "\x00")), nil
+}
+
+// getKernelModuleStatus reports whether the module is built into the
+// kernel, shipped with the guest, and currently loaded.
+func getKernelModuleStatus(name string) (*pb.KernelModuleStatus, error) {
+	if !kernelModuleNameRegex.MatchString(name) {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "Invalid kernel module name %q", name)
+	}
+
+	release, err := kernelRelease()
+	if err != nil {
+		return nil, err
+	}
+
+	module := normalizeKernelModuleName(name)
+	modulesDir := filepath.Join(kernelModulesDir, release)
+	status := &pb.KernelModuleStatus{Name: name}
+
+	status.Loaded, err = findKernelModule(procModulesPath, module, func(line string) string {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			return ""
+		}
+		return normalizeKernelModuleName(fields[0])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	status.Builtin, err = findKernelModule(filepath.Join(modulesDir, "modules.builtin"), module, kernelModuleFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	status.Available, err = findKernelModule(filepath.Join(modulesDir, "modules.dep"), module, func(line string) string {
+		return kernelModuleFileName(strings.SplitN(line, ":", 2)[0])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func (a *agentGRPC) CheckKernelModule(ctx context.Context, req *pb.CheckKernelModuleRequest) (*pb.KernelModuleStatus, error) {
+	if req.Module == nil {
+		return nil, grpcStatus.Error(codes.InvalidArgument, "Kernel module is nil")
+	}
+
+	status, err := getKernelModuleStatus(req.Module.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.Load || status.Loaded || status.Builtin {
+		return status, nil
+	}
+
+	log := agentLog.WithField("module-name", req.Module.Name)
+
+	if !status.Available {
+		log.Warn("Kernel module not available")
+		return nil, grpcStatus.Errorf(codes.NotFound, "Kernel module %q not available", req.Module.Name)
+	}
+
+	if err := loadKernelModule(req.Module); err != nil {
+		log.WithError(err).Warn("Failed to load kernel module")
+		return nil, grpcStatus.Errorf(codes.Internal, "Could not load kernel module %q: %v", req.Module.Name, err)
+	}
+
+	status.Loaded = true
+
+	return status, nil
+}
+
+func (a *agentGRPC) RestartAgent(ctx context.Context, req *pb.RestartAgentRequest) (*gpb.Empty, error) {
+	if req.Path != "" {
+		return emptyResp, grpcStatus.Error(codes.InvalidArgument, "Only the running agent binary can be restarted")
+	}
+
+	// The state is saved right before the re-execution, check it can be
+	// saved so that failures are reported.
+	a.sandbox.Lock()
+	_, err := a.sandbox.saveState()
+	a.sandbox.Unlock()
+	if err != nil {
+		return emptyResp, grpcStatus.Errorf(codes.Internal, "Could not save agent state: %v", err)
+	}
+
+	go a.sandbox.restart()
+
+	return emptyResp, nil
+}
+
 func (a *agentGRPC) CreateSandbox(ctx context.Context, req *pb.CreateSandboxRequest) (*gpb.Empty, error) {
+	start := time.Now()
+
+	a.sandbox.createLock.Lock()
+	defer a.sandbox.createLock.Unlock()
+
 	if a.sandbox.running {
-		return emptyResp, grpcStatus.Error(codes.AlreadyExists, "Sandbox already started, impossible to start again")
+		return emptyResp, a.sandbox.checkReplayedCreate(req.SandboxId)
+	}
+
+	if req.DnsStub != "" && net.ParseIP(req.DnsStub) == nil {
+		return emptyResp, grpcStatus.Errorf(codes.InvalidArgument, "Invalid DNS stub address %q", req.DnsStub)
+	}
+
+	if err := validateSandboxSysctls(req.Sysctls); err != nil {
+		return emptyResp, err
 	}
 
 	a.sandbox.hostname = req.Hostname
 	a.sandbox.containers = make(map[string]*container)
 	a.sandbox.network.ifaces = make(map[string]*types.Interface)
 	a.sandbox.network.dns = req.Dns
+	a.sandbox.network.dnsStub = req.DnsStub
 	a.sandbox.running = true
 	a.sandbox.sandboxPidNs = req.SandboxPidns
 	a.sandbox.storages = make(map[string]*sandboxStorage)
@@ -1451,6 +2440,11 @@ func (a *agentGRPC) CreateSandbox(ctx context.Context, req *pb.CreateSandboxRequ
 		}
 	}
 
+	// Applied once the modules providing them are loaded.
+	if err := applySandboxSysctls(req.Sysctls); err != nil {
+		return emptyResp, err
+	}
+
 	if req.GuestHookPath != "" {
 		a.sandbox.scanGuestHooks(req.GuestHookPath)
 	}
@@ -1482,9 +2476,33 @@ func (a *agentGRPC) CreateSandbox(ctx context.Context, req *pb.CreateSandboxRequ
 		return emptyResp, err
 	}
 
+	a.sandbox.created = true
+
+	if a.sandbox.initTimings != nil {
+		a.sandbox.initTimings.phaseDone(initPhaseSandbox, start)
+	}
+
 	return emptyResp, nil
 }
 
+// checkReplayedCreate returns whether a CreateSandbox request received for
+// the running sandbox is the replay of the request that created it, which
+// succeeds without setting up the sandbox again. A request for another
+// sandbox, or replaying a failed one, is a conflict.
+func (s *sandbox) checkReplayedCreate(id string) error {
+	if !sandboxReplay || id == "" || !s.created {
+		return grpcStatus.Error(codes.AlreadyExists, "Sandbox already started, impossible to start again")
+	}
+
+	if id != s.id {
+		return grpcStatus.Errorf(codes.AlreadyExists, "Sandbox %s already started, cannot create sandbox %s", s.id, id)
+	}
+
+	agentLog.Info("Sandbox already created, ignoring the replayed request")
+
+	return nil
+}
+
 func (a *agentGRPC) DestroySandbox(ctx context.Context, req *pb.DestroySandboxRequest) (*gpb.Empty, error) {
 	if !a.sandbox.running {
 		agentLog.Info("Sandbox not started, this is a no-op")
@@ -1536,8 +2554,11 @@ func (a *agentGRPC) DestroySandbox(ctx context.Context, req *pb.DestroySandboxRe
 	a.sandbox.id = ""
 	a.sandbox.containers = make(map[string]*container)
 	a.sandbox.running = false
+	a.sandbox.created = false
 	a.sandbox.network = network{}
 	a.sandbox.mounts = []string{}
+	a.sandbox.shmMounted = false
+	a.sandbox.shmSize = 0
 	a.sandbox.storages = make(map[string]*sandboxStorage)
 
 	// Synchronize the caches on the system. This is needed to ensure
@@ -1555,6 +2576,18 @@ func (a *agentGRPC) UpdateRoutes(ctx context.Context, req *pb.UpdateRoutesReques
 	return a.sandbox.updateRoutes(nil, req.Routes)
 }
 
+func (a *agentGRPC) UpdateSourceRouting(ctx context.Context, req *pb.UpdateSourceRoutingRequest) (*pb.SourceRouting, error) {
+	return a.sandbox.updateSourceRouting(nil, req.Interfaces)
+}
+
+func (a *agentGRPC) SetTransparentProxy(ctx context.Context, req *pb.SetTransparentProxyRequest) (*gpb.Empty, error) {
+	return emptyResp, a.sandbox.setTransparentProxy(req)
+}
+
+func (a *agentGRPC) RemoveTransparentProxy(ctx context.Context, req *pb.RemoveTransparentProxyRequest) (*gpb.Empty, error) {
+	return emptyResp, a.sandbox.removeTransparentProxy()
+}
+
 func (a *agentGRPC) ListInterfaces(ctx context.Context, req *pb.ListInterfacesRequest) (*pb.Interfaces, error) {
 	return a.sandbox.listInterfaces(nil)
 }
@@ -1612,6 +2645,47 @@ func (a *agentGRPC) GetGuestDetails(ctx context.Context, req *pb.GuestDetailsReq
 	return &details, nil
 }
 
+func (a *agentGRPC) GetAPIVersion(ctx context.Context, req *pb.GetAPIVersionRequest) (*pb.APIVersionResponse, error) {
+	if a.sandbox.server == nil {
+		return nil, grpcStatus.Error(codes.FailedPrecondition, "gRPC server not started")
+	}
+
+	resp := &pb.APIVersionResponse{
+		Version:         pb.APIVersion,
+		DisabledMethods: getDisabledMethods(),
+	}
+
+	for _, m := range getAgentMethods(a.sandbox.server) {
+		if isMethodDisabled(m) {
+			continue
+		}
+
+		resp.Methods = append(resp.Methods, m)
+		if _, ok := deprecatedMethods[m]; ok {
+			resp.DeprecatedMethods = append(resp.DeprecatedMethods, m)
+		}
+	}
+
+	return resp, nil
+}
+
+func (a *agentGRPC) GetGuestFilesystems(ctx context.Context, req *pb.GuestFilesystemsRequest) (*pb.GuestFilesystems, error) {
+	filesystems, err := getGuestFilesystems(req.Refresh)
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.Internal, "Could not probe the guest filesystems: %v", err)
+	}
+
+	return filesystems, nil
+}
+
+func (a *agentGRPC) GetInitTimings(ctx context.Context, req *pb.InitTimingsRequest) (*pb.InitTimings, error) {
+	if a.sandbox.initTimings == nil {
+		return &pb.InitTimings{}, nil
+	}
+
+	return a.sandbox.initTimings.toGRPC(), nil
+}
+
 func (a *agentGRPC) MemHotplugByProbe(ctx context.Context, req *pb.MemHotplugByProbeRequest) (*gpb.Empty, error) {
 	for _, addr := range req.MemHotplugProbeAddr {
 		if err := ioutil.WriteFile(sysfsMemoryHotplugProbePath, []byte(fmt.Sprintf("0x%x", addr)), 0600); err != nil {
@@ -1674,7 +2748,7 @@ func (a *agentGRPC) CopyFile(ctx context.Context, req *pb.CopyFileRequest) (*gpb
 		return emptyResp, fmt.Errorf("Only is possible to copy files into the %s directory", containersRootfsPath)
 	}
 
-	if err := os.MkdirAll(filepath.Dir(path), os.FileMode(req.DirMode)); err != nil {
+	if err := mkdirParents(containersRootfsPath, filepath.Dir(path), os.FileMode(req.DirMode), int(req.Uid), int(req.Gid)); err != nil {
 		return emptyResp, err
 	}
 
@@ -1727,9 +2801,60 @@ func (a *agentGRPC) CopyFile(ctx context.Context, req *pb.CopyFileRequest) (*gpb
 		return emptyResp, err
 	}
 
+	if err := checkCopiedFile(path, os.FileMode(req.FileMode), int(req.Uid), int(req.Gid)); err != nil {
+		return emptyResp, err
+	}
+
 	return emptyResp, nil
 }
 
+// ExtractLayer receives a container image layer in chunks, written in a
+// temporary file next to the destination directory (req.Path). Once the
+// temporary file reaches the layer size (req.LayerSize), the layer is
+// extracted into the directory and the temporary file removed.
+func (a *agentGRPC) ExtractLayer(ctx context.Context, req *pb.ExtractLayerRequest) (*gpb.Empty, error) {
+	path, err := filepath.Abs(req.Path)
+	if err != nil {
+		return emptyResp, err
+	}
+
+	// Only the rootfs of the containers, below /run, can be extracted.
+	if !strings.HasPrefix(path, containersRootfsPath+"/") {
+		return emptyResp, grpcStatus.Errorf(codes.InvalidArgument, "Only is possible to extract layers into the %s directory", containersRootfsPath)
+	}
+
+	if err := mkdirParents(containersRootfsPath, path, layerDirMode, 0, 0); err != nil {
+		return emptyResp, err
+	}
+
+	layerPath := path + ".layer"
+	complete, err := a.sandbox.receiveLayer(layerPath, req.LayerSize, req.Offset, req.Data)
+	if err != nil {
+		os.Remove(layerPath)
+		return emptyResp, err
+	}
+
+	// The extraction waits for the next chunks.
+	if !complete {
+		return emptyResp, nil
+	}
+
+	defer os.Remove(layerPath)
+
+	layer, err := os.Open(layerPath)
+	if err != nil {
+		return emptyResp, err
+	}
+	defer layer.Close()
+
+	agentLog.WithFields(logrus.Fields{
+		"layer-size": req.LayerSize,
+		"des-path":   path,
+	}).Debug("Extracting layer")
+
+	return emptyResp, extractLayer(layer, path)
+}
+
 func (a *agentGRPC) StartTracing(ctx context.Context, req *pb.StartTracingRequest) (*gpb.Empty, error) {
 	// We chould check 'tracing' too and error if already set. But
 	// instead, we permit that scenario, making this call a NOP if tracing
@@ -1774,3 +2899,12 @@ func (a *agentGRPC) StopTracing(ctx context.Context, req *pb.StopTracingRequest)
 
 	return emptyResp, nil
 }
+
+func (a *agentGRPC) GetProfile(ctx context.Context, req *pb.GetProfileRequest) (*pb.Profile, error) {
+	data, err := getProfile(req.Name, time.Duration(req.Seconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Profile{Data: data}, nil
+}
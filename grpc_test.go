@@ -11,25 +11,32 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"reflect"
 	"sort"
 	"strconv"
+	"strings"
 	"syscall"
 	"testing"
 	"time"
 
 	"sync"
+	"sync/atomic"
 
 	pb "github.com/kata-containers/agent/protocols/grpc"
 	"github.com/opencontainers/runc/libcontainer"
 	"github.com/opencontainers/runc/libcontainer/cgroups"
 	"github.com/opencontainers/runc/libcontainer/configs"
 	"github.com/opencontainers/runc/libcontainer/seccomp"
+	"github.com/opencontainers/runc/libcontainer/specconv"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
 var testSharedPidNs = "testSharedPidNs"
@@ -169,6 +176,110 @@ func TestUpdateContainerConfigNamespacesEmptyConfig(t *testing.T) {
 	testUpdateContainerConfigNamespacesNonSharedPid(t, testSharedPidNs, testSharedUTSNs, testSharedIPCNs, configs.Config{}, expectedConfig)
 }
 
+func TestUpdateContainerConfigNamespacesCgroupNs(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			sharedIPCNs: namespace{path: testSharedIPCNs},
+			sharedUTSNs: namespace{path: testSharedUTSNs},
+		},
+	}
+
+	expected := []configs.Namespace{
+		{Type: configs.NEWIPC, Path: testSharedIPCNs},
+		{Type: configs.NEWUTS, Path: testSharedUTSNs},
+		{Type: configs.NEWCGROUP},
+		{Type: configs.NEWPID},
+	}
+
+	config := configs.Config{}
+	a.updateContainerConfigNamespaces(&config, &container{useCgroupNs: true})
+	assert.Equal(expected, []configs.Namespace(config.Namespaces))
+
+	// A cgroup namespace path coming from the host must be dropped
+	config = configs.Config{
+		Namespaces: []configs.Namespace{
+			{Type: configs.NEWCGROUP, Path: "/proc/1/ns/cgroup"},
+		},
+	}
+	a.updateContainerConfigNamespaces(&config, &container{useCgroupNs: true})
+	assert.Len(config.Namespaces, 4)
+	assert.Equal(configs.Namespace{Type: configs.NEWCGROUP}, config.Namespaces[0])
+
+	config = configs.Config{}
+	a.updateContainerConfigNamespaces(&config, &container{})
+	assert.False(config.Namespaces.Contains(configs.NEWCGROUP))
+}
+
+func TestCgroupNsContainerConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			sharedIPCNs: namespace{path: testSharedIPCNs},
+			sharedUTSNs: namespace{path: testSharedUTSNs},
+		},
+	}
+
+	type testData struct {
+		specNsPath     *string
+		useCgroupNs    bool
+		expectCgroupNs bool
+	}
+
+	emptyPath := ""
+	hostPath := "/proc/1/ns/cgroup"
+
+	data := []testData{
+		{nil, false, false},
+		{nil, true, true},
+		{&emptyPath, false, true},
+		{&hostPath, false, true},
+		{&hostPath, true, true},
+	}
+
+	for i, d := range data {
+		spec := specconv.Example()
+		spec.Root.Path = "/rootfs"
+		spec.Linux.CgroupsPath = "/kata/foo"
+		if d.specNsPath != nil {
+			spec.Linux.Namespaces = append(spec.Linux.Namespaces, specs.LinuxNamespace{
+				Type: specs.CgroupNamespace,
+				Path: *d.specNsPath,
+			})
+		}
+
+		config, err := specconv.CreateLibcontainerConfig(&specconv.CreateOpts{
+			CgroupName:   "foo",
+			NoNewKeyring: true,
+			Spec:         spec,
+		})
+		if !assert.NoError(err, "test %d (%+v)", i, d) {
+			continue
+		}
+
+		err = a.updateContainerConfig(spec, config, &container{useCgroupNs: d.useCgroupNs})
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		// The namespace is unshared by the init process from the
+		// container cgroup, never joined from a host path.
+		var cgroupNs []configs.Namespace
+		for _, ns := range config.Namespaces {
+			if ns.Type == configs.NEWCGROUP {
+				cgroupNs = append(cgroupNs, ns)
+			}
+		}
+
+		if d.expectCgroupNs {
+			assert.Equal([]configs.Namespace{{Type: configs.NEWCGROUP}}, cgroupNs, "test %d (%+v)", i, d)
+		} else {
+			assert.Empty(cgroupNs, "test %d (%+v)", i, d)
+		}
+		assert.Equal("/kata/foo", config.Cgroups.Path, "test %d (%+v)", i, d)
+	}
+}
+
 func testUpdateContainerConfigPrivileges(t *testing.T, spec *specs.Spec, config, expected configs.Config) {
 	a := &agentGRPC{}
 
@@ -204,6 +315,44 @@ func TestUpdateContainerConfigPrivilegesNoNewPrivileges(t *testing.T) {
 	}
 }
 
+func TestUpdateContainerConfigPrivilegesApparmorProfile(t *testing.T) {
+	savedApparmorProfiles := apparmorProfiles
+	savedIsApparmorEnabled := isApparmorEnabled
+	defer func() {
+		apparmorProfiles = savedApparmorProfiles
+		isApparmorEnabled = savedIsApparmorEnabled
+	}()
+
+	spec := &specs.Spec{
+		Process: &specs.Process{
+			ApparmorProfile: "foo",
+		},
+	}
+
+	type testData struct {
+		applied  bool
+		enabled  bool
+		expected string
+	}
+
+	data := []testData{
+		{false, false, ""},
+		{false, true, ""},
+		{true, false, ""},
+		{true, true, "foo"},
+	}
+
+	for _, d := range data {
+		apparmorProfiles = d.applied
+		enabled := d.enabled
+		isApparmorEnabled = func() bool {
+			return enabled
+		}
+
+		testUpdateContainerConfigPrivileges(t, spec, configs.Config{}, configs.Config{AppArmorProfile: d.expected})
+	}
+}
+
 func TestOnlineCPUMem(t *testing.T) {
 	assert := assert.New(t)
 	a := &agentGRPC{
@@ -709,6 +858,8 @@ func TestSingleWaitProcess(t *testing.T) {
 
 	resp, _ := a.WaitProcess(context.TODO(), req)
 	assert.Equal(resp.Status, int32(exitCode))
+	assert.Equal(pb.WaitProcessResponse_EXITED, resp.Reason)
+	assert.Zero(resp.Signal)
 }
 
 func TestMultiWaitProcess(t *testing.T) {
@@ -1204,6 +1355,125 @@ func TestPrivateExecProcess(t *testing.T) {
 	}
 }
 
+func TestExecProcessSpawnRetry(t *testing.T) {
+	assert := assert.New(t)
+
+	savedSpawnProcess := spawnProcess
+	savedSpawnAttempts := spawnAttempts
+	savedSpawnRetryDelay := spawnRetryDelay
+	defer func() {
+		spawnProcess = savedSpawnProcess
+		spawnAttempts = savedSpawnAttempts
+		spawnRetryDelay = savedSpawnRetryDelay
+	}()
+
+	spawnAttempts = 3
+	spawnRetryDelay = time.Millisecond
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			subreaper: &mockreaper{},
+		},
+	}
+
+	ctr := &container{
+		container: &mockContainer{},
+	}
+
+	type testData struct {
+		failures         []error
+		expectedAttempts int
+		expectError      bool
+	}
+
+	data := []testData{
+		{nil, 1, false},
+		{[]error{syscall.EAGAIN}, 2, false},
+		{[]error{errors.New("container_linux.go:348: starting container process caused \"fork/exec /proc/self/exe: cannot allocate memory\"")}, 2, false},
+		{[]error{syscall.EAGAIN, syscall.ENOMEM}, 3, false},
+		{[]error{syscall.EAGAIN, syscall.EAGAIN, syscall.EAGAIN}, 3, true},
+		{[]error{syscall.EPERM}, 1, true},
+		{[]error{syscall.EAGAIN, syscall.ENOENT}, 2, true},
+	}
+
+	for i, d := range data {
+		attempts := 0
+
+		// Shim failing with the given errors before succeeding
+		spawnProcess = func(c libcontainer.Container, p *libcontainer.Process, createContainer bool) (int, error) {
+			attempts++
+			if attempts <= len(d.failures) {
+				return -1, d.failures[attempts-1]
+			}
+			return 1234, nil
+		}
+
+		proc := &process{}
+		err := a.execProcess(ctr, proc, false)
+		assert.Equal(d.expectedAttempts, attempts, "test %d (%+v)", i, d)
+
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+			assert.Nil(proc.exitCodeCh, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.NotNil(proc.exitCodeCh, "test %d (%+v)", i, d)
+		}
+	}
+}
+
+func TestExecProcessSpawnRetryHandoff(t *testing.T) {
+	assert := assert.New(t)
+
+	savedSpawnProcess := spawnProcess
+	savedSpawnRetryDelay := spawnRetryDelay
+	defer func() {
+		spawnProcess = savedSpawnProcess
+		spawnRetryDelay = savedSpawnRetryDelay
+	}()
+
+	spawnRetryDelay = time.Millisecond
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			subreaper: &mockreaper{},
+		},
+	}
+
+	ctr := &container{
+		container: &mockContainer{},
+	}
+
+	// Each init process reads the umask from the socket it is passed,
+	// before failing to spawn the first time.
+	var umasks []string
+	spawnProcess = func(c libcontainer.Container, p *libcontainer.Process, createContainer bool) (int, error) {
+		list := p.ExtraFiles[len(p.ExtraFiles)-1]
+		fd, err := lookupInitFd(int(list.Fd()), umaskFdEnv)
+		if err != nil {
+			return -1, err
+		}
+
+		buf := make([]byte, 16)
+		n, _, err := unix.Recvfrom(int(p.ExtraFiles[fd-stdioFdCount].Fd()), buf, unix.MSG_DONTWAIT)
+		if err != nil {
+			return -1, err
+		}
+		umasks = append(umasks, string(buf[:n]))
+
+		if len(umasks) == 1 {
+			return -1, syscall.EAGAIN
+		}
+		return 1234, nil
+	}
+
+	umask := uint32(0027)
+	proc := &process{umask: &umask}
+	assert.NoError(a.execProcess(ctr, proc, false))
+	assert.Equal([]string{"27", "27"}, umasks)
+	assert.Len(proc.process.ExtraFiles, 2)
+}
+
 func TestPostExecProcess(t *testing.T) {
 	assert := assert.New(t)
 
@@ -1369,6 +1639,21 @@ func TestCreateContainerChecks(t *testing.T) {
 			},
 			false,
 		},
+		{
+			&sandbox{
+				containers: make(map[string]*container),
+				running:    true,
+			},
+			&pb.CreateContainerRequest{
+				ContainerId: "foo",
+				OCI: &pb.Spec{
+					Process: &pb.Process{
+						Landlock: &pb.Landlock{},
+					},
+				},
+			},
+			true,
+		},
 	}
 
 	for i, d := range data {
@@ -1389,6 +1674,309 @@ func TestCreateContainerChecks(t *testing.T) {
 	}
 }
 
+func TestProcessSetStdinFile(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	stdinFile := filepath.Join(dir, "stdin")
+	err = ioutil.WriteFile(stdinFile, []byte("line1\nline2\nline3\n"), testFileMode)
+	assert.NoError(err)
+
+	fifo := filepath.Join(dir, "fifo")
+	assert.NoError(unix.Mkfifo(fifo, 0600))
+
+	for _, path := range []string{"relative/path", filepath.Join(dir, "missing"), dir, fifo, "/dev/null"} {
+		proc, err := buildProcess(&pb.Process{}, "foo", false)
+		assert.NoError(err)
+
+		err = proc.setStdinFile(path)
+		assert.Error(err, "path %s", path)
+
+		proc.closePostStartFDs()
+		proc.closePostExitFDs()
+	}
+
+	proc, err := buildProcess(&pb.Process{Terminal: true}, "foo", false)
+	assert.NoError(err)
+	assert.Error(proc.setStdinFile(stdinFile))
+	proc.closePostStartFDs()
+	proc.closePostExitFDs()
+
+	proc, err = buildProcess(&pb.Process{}, "foo", false)
+	assert.NoError(err)
+	assert.NoError(proc.setStdinFile(stdinFile))
+	assert.Nil(proc.stdin)
+	assert.True(proc.stdinClosed)
+
+	// Run the process the way libcontainer would, with the stdio set up
+	// by the agent.
+	cmd := exec.Command("wc", "-l")
+	cmd.Stdin = proc.process.Stdin
+	cmd.Stdout = proc.process.Stdout
+	cmd.Stderr = proc.process.Stderr
+	assert.NoError(cmd.Run())
+	proc.closePostStartFDs()
+
+	output, err := ioutil.ReadAll(proc.stdout)
+	assert.NoError(err)
+	assert.Equal("3", strings.TrimSpace(string(output)))
+
+	proc.closePostExitFDs()
+}
+
+func TestCreateContainerChecksMaxContainers(t *testing.T) {
+	assert := assert.New(t)
+
+	savedMaxContainers := maxContainers
+	defer func() {
+		maxContainers = savedMaxContainers
+	}()
+	maxContainers = 3
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: make(map[string]*container),
+			running:    true,
+		},
+	}
+
+	for i := 0; i < maxContainers-1; i++ {
+		id := fmt.Sprintf("foo%d", i)
+		err := a.createContainerChecks(&pb.CreateContainerRequest{ContainerId: id, OCI: &pb.Spec{}})
+		assert.NoError(err)
+
+		a.sandbox.setContainer(context.Background(), id, &container{id: id})
+		a.sandbox.releaseContainer(id)
+	}
+
+	// The container being created holds the last slot, and its ID
+	err := a.createContainerChecks(&pb.CreateContainerRequest{ContainerId: "foo2", OCI: &pb.Spec{}})
+	assert.NoError(err)
+
+	err = a.createContainerChecks(&pb.CreateContainerRequest{ContainerId: "foo2", OCI: &pb.Spec{}})
+	assert.Equal(codes.AlreadyExists, grpcStatus.Code(err))
+
+	err = a.createContainerChecks(&pb.CreateContainerRequest{ContainerId: "bar", OCI: &pb.Spec{}})
+	assert.Equal(codes.ResourceExhausted, grpcStatus.Code(err))
+
+	// A failed creation releases its slot
+	a.sandbox.releaseContainer("foo2")
+	err = a.createContainerChecks(&pb.CreateContainerRequest{ContainerId: "foo2", OCI: &pb.Spec{}})
+	assert.NoError(err)
+
+	a.sandbox.setContainer(context.Background(), "foo2", &container{id: "foo2"})
+	a.sandbox.releaseContainer("foo2")
+
+	err = a.createContainerChecks(&pb.CreateContainerRequest{ContainerId: "bar", OCI: &pb.Spec{}})
+	assert.Equal(codes.ResourceExhausted, grpcStatus.Code(err))
+
+	err = a.createContainerChecks(&pb.CreateContainerRequest{ContainerId: "foo0", OCI: &pb.Spec{}})
+	assert.Equal(codes.AlreadyExists, grpcStatus.Code(err))
+
+	// Removing a container makes room for a new one
+	a.sandbox.deleteContainer("foo0")
+	err = a.createContainerChecks(&pb.CreateContainerRequest{ContainerId: "bar", OCI: &pb.Spec{}})
+	assert.NoError(err)
+	a.sandbox.releaseContainer("bar")
+
+	// Unlimited
+	maxContainers = 0
+	a.sandbox.setContainer(context.Background(), "foo0", &container{id: "foo0"})
+	err = a.createContainerChecks(&pb.CreateContainerRequest{ContainerId: "bar", OCI: &pb.Spec{}})
+	assert.NoError(err)
+}
+
+func TestCreateContainerChecksConcurrent(t *testing.T) {
+	assert := assert.New(t)
+
+	savedMaxContainers := maxContainers
+	defer func() {
+		maxContainers = savedMaxContainers
+	}()
+	maxContainers = 3
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: make(map[string]*container),
+			running:    true,
+		},
+	}
+
+	// Concurrent creations cannot exceed the maximum
+	var reserved int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			err := a.createContainerChecks(&pb.CreateContainerRequest{ContainerId: fmt.Sprintf("foo%d", i), OCI: &pb.Spec{}})
+			if err == nil {
+				atomic.AddInt32(&reserved, 1)
+			} else {
+				assert.Equal(codes.ResourceExhausted, grpcStatus.Code(err))
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(int32(maxContainers), reserved)
+}
+
+func TestExecProcessChecks(t *testing.T) {
+	assert := assert.New(t)
+
+	savedMaxExecs := maxExecs
+	savedMaxContainerExecs := maxContainerExecs
+	defer func() {
+		maxExecs = savedMaxExecs
+		maxContainerExecs = savedMaxContainerExecs
+	}()
+	maxExecs = 3
+	maxContainerExecs = 2
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: make(map[string]*container),
+			running:    true,
+		},
+	}
+
+	for _, id := range []string{"foo", "bar"} {
+		initProc := &process{id: id}
+		a.sandbox.setContainer(context.Background(), id, &container{
+			id:          id,
+			initProcess: initProc,
+			processes:   map[string]*process{id: initProc},
+		})
+	}
+
+	foo, err := a.sandbox.getContainer("foo")
+	assert.NoError(err)
+	bar, err := a.sandbox.getContainer("bar")
+	assert.NoError(err)
+
+	// The init process is not an exec process.
+	for i := 0; i < maxContainerExecs; i++ {
+		assert.NoError(a.execProcessChecks(foo))
+		foo.setProcess(&process{id: fmt.Sprintf("exec%d", i)})
+		a.releaseExec(foo)
+	}
+
+	err = a.execProcessChecks(foo)
+	assert.Equal(codes.ResourceExhausted, grpcStatus.Code(err))
+
+	assert.NoError(a.execProcessChecks(bar))
+	bar.setProcess(&process{id: "exec0"})
+	a.releaseExec(bar)
+
+	err = a.execProcessChecks(bar)
+	assert.Equal(codes.ResourceExhausted, grpcStatus.Code(err))
+
+	// Waiting for an exec process makes room for a new one
+	foo.deleteProcess("exec0")
+	assert.NoError(a.execProcessChecks(foo))
+
+	// The processes being spawned are counted
+	err = a.execProcessChecks(foo)
+	assert.Equal(codes.ResourceExhausted, grpcStatus.Code(err))
+	err = a.execProcessChecks(bar)
+	assert.Equal(codes.ResourceExhausted, grpcStatus.Code(err))
+
+	// A failed spawn gives its slot back
+	a.releaseExec(foo)
+	assert.Empty(a.sandbox.pendingExecs)
+	assert.NoError(a.execProcessChecks(bar))
+	a.releaseExec(bar)
+
+	// Unlimited
+	maxExecs = 0
+	maxContainerExecs = 0
+	foo.setProcess(&process{id: "exec0"})
+	bar.setProcess(&process{id: "exec1"})
+	assert.NoError(a.execProcessChecks(foo))
+}
+
+func TestExecProcessReservation(t *testing.T) {
+	assert := assert.New(t)
+
+	savedMaxExecs := maxExecs
+	savedSpawnProcess := spawnProcess
+	defer func() {
+		maxExecs = savedMaxExecs
+		spawnProcess = savedSpawnProcess
+	}()
+	maxExecs = 2
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: make(map[string]*container),
+			running:    true,
+			subreaper:  &mockreaper{},
+		},
+	}
+
+	for _, id := range []string{"foo", "bar"} {
+		a.sandbox.setContainer(context.Background(), id, &container{
+			id:        id,
+			container: &mockContainer{id: id, status: libcontainer.Running},
+			processes: make(map[string]*process),
+		})
+	}
+
+	// Spawns block until failed.
+	spawning := make(chan struct{})
+	failSpawn := make(chan struct{})
+	spawnProcess = func(c libcontainer.Container, p *libcontainer.Process, createContainer bool) (int, error) {
+		spawning <- struct{}{}
+		<-failSpawn
+		return -1, syscall.EPERM
+	}
+
+	exec := func(containerID, execID string) chan error {
+		done := make(chan error, 1)
+		go func() {
+			_, err := a.ExecProcess(context.Background(), &pb.ExecProcessRequest{
+				ContainerId: containerID,
+				ExecId:      execID,
+				Process:     &pb.Process{Args: []string{"sh"}},
+			})
+			done <- err
+		}()
+		return done
+	}
+
+	// The reservations do not serialize the spawns, the exec processes
+	// of both containers being spawned concurrently.
+	fooDone := exec("foo", "exec0")
+	<-spawning
+	barDone := exec("bar", "exec0")
+	<-spawning
+
+	// Both slots are reserved
+	_, err := a.ExecProcess(context.Background(), &pb.ExecProcessRequest{
+		ContainerId: "foo",
+		ExecId:      "exec1",
+		Process:     &pb.Process{Args: []string{"sh"}},
+	})
+	assert.Equal(codes.ResourceExhausted, grpcStatus.Code(err))
+
+	// The failed spawns give their slots back
+	failSpawn <- struct{}{}
+	assert.Equal(codes.Internal, grpcStatus.Code(<-fooDone))
+	failSpawn <- struct{}{}
+	assert.Equal(codes.Internal, grpcStatus.Code(<-barDone))
+	assert.Empty(a.sandbox.pendingExecs)
+
+	fooDone = exec("foo", "exec1")
+	<-spawning
+	failSpawn <- struct{}{}
+	assert.Equal(codes.Internal, grpcStatus.Code(<-fooDone))
+}
+
 func TestCreateContainer(t *testing.T) {
 	assert := assert.New(t)
 
@@ -1439,6 +2027,62 @@ func TestCreateSandbox(t *testing.T) {
 	assert.Error(err)
 }
 
+func TestCreateSandboxReplay(t *testing.T) {
+	assert := assert.New(t)
+
+	savedSandboxReplay := sandboxReplay
+	defer func() {
+		sandboxReplay = savedSandboxReplay
+	}()
+
+	ctr := &container{id: "ctr"}
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			id:         "foo",
+			containers: map[string]*container{"ctr": ctr},
+			running:    true,
+			created:    true,
+			hostname:   "foo",
+		},
+	}
+
+	type testData struct {
+		replay       bool
+		created      bool
+		sandboxID    string
+		expectedCode codes.Code
+	}
+
+	data := []testData{
+		// The request creating the sandbox is replayed
+		{true, true, "foo", codes.OK},
+		{true, true, "bar", codes.AlreadyExists},
+		{true, true, "", codes.AlreadyExists},
+		// The original request failed
+		{true, false, "foo", codes.AlreadyExists},
+		{false, true, "foo", codes.AlreadyExists},
+	}
+
+	for i, d := range data {
+		sandboxReplay = d.replay
+		a.sandbox.created = d.created
+
+		req := &pb.CreateSandboxRequest{
+			SandboxId: d.sandboxID,
+			Hostname:  "bar",
+		}
+
+		_, err := a.CreateSandbox(context.Background(), req)
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+
+		// The sandbox is left untouched
+		assert.Equal("foo", a.sandbox.id, "test %d (%+v)", i, d)
+		assert.Equal("foo", a.sandbox.hostname, "test %d (%+v)", i, d)
+		assert.Equal(map[string]*container{"ctr": ctr}, a.sandbox.containers, "test %d (%+v)", i, d)
+		assert.True(a.sandbox.running, "test %d (%+v)", i, d)
+	}
+}
+
 func TestDestroySandbox(t *testing.T) {
 	assert := assert.New(t)
 
@@ -1472,6 +2116,206 @@ func TestStartContainer(t *testing.T) {
 	assert.Error(err)
 }
 
+// execRecorderContainer records when the container is started.
+type execRecorderContainer struct {
+	mockContainer
+	execTime time.Time
+}
+
+func (c *execRecorderContainer) Exec() error {
+	c.execTime = time.Now()
+	return nil
+}
+
+func TestStartContainerNetworkReady(t *testing.T) {
+	assert := assert.New(t)
+
+	savedIsNetworkReady := isNetworkReady
+	savedPollInterval := networkReadyPollInterval
+	defer func() {
+		isNetworkReady = savedIsNetworkReady
+		networkReadyPollInterval = savedPollInterval
+	}()
+	networkReadyPollInterval = 10 * time.Millisecond
+
+	var readyTime time.Time
+	isNetworkReady = func(s *sandbox) (bool, error) {
+		return !readyTime.IsZero() && time.Now().After(readyTime), nil
+	}
+
+	type testData struct {
+		readyDelay  time.Duration
+		timeout     uint32
+		expectError bool
+	}
+
+	data := []testData{
+		// Gate disabled
+		{-1, 0, false},
+		{0, 1, false},
+		{200 * time.Millisecond, 1, false},
+		{-1, 1, true},
+	}
+
+	for i, d := range data {
+		ctr := &execRecorderContainer{
+			mockContainer: mockContainer{
+				status: libcontainer.Created,
+			},
+		}
+
+		a := &agentGRPC{
+			sandbox: &sandbox{
+				containers: map[string]*container{
+					"foo": {
+						id:        "foo",
+						container: ctr,
+					},
+				},
+				running: true,
+			},
+		}
+
+		readyTime = time.Time{}
+		if d.readyDelay >= 0 {
+			readyTime = time.Now().Add(d.readyDelay)
+		}
+
+		_, err := a.StartContainer(context.Background(), &pb.StartContainerRequest{
+			ContainerId:         "foo",
+			NetworkReadyTimeout: d.timeout,
+		})
+		if d.expectError {
+			assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err), "test %d (%+v)", i, d)
+			assert.True(ctr.execTime.IsZero(), "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.False(ctr.execTime.IsZero(), "test %d (%+v)", i, d)
+		if d.timeout > 0 {
+			assert.True(ctr.execTime.After(readyTime), "test %d (%+v)", i, d)
+		}
+	}
+}
+
+// hangingContainer stands for a container whose init process hangs before
+// being started, Exec() only returning once the process holding the exec
+// fifo is dead.
+type hangingContainer struct {
+	mockContainer
+	cmd       *exec.Cmd
+	destroyed bool
+}
+
+func (c *hangingContainer) Exec() error {
+	c.cmd.Wait()
+	return errors.New("container process is already dead")
+}
+
+func (c *hangingContainer) Destroy() error {
+	c.destroyed = true
+	return nil
+}
+
+func TestStartContainerTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		// The process holding the exec fifo is a child of the init
+		// process, which cannot be killed.
+		initKillFails bool
+	}
+
+	data := []testData{
+		{false},
+		{true},
+	}
+
+	for i, d := range data {
+		cmd := exec.Command("sleep", "60")
+		assert.NoError(cmd.Start(), "test %d (%+v)", i, d)
+
+		initPid := cmd.Process.Pid
+		if d.initKillFails {
+			initPid = math.MaxInt32
+		}
+
+		libContainer := &hangingContainer{
+			mockContainer: mockContainer{
+				status:    libcontainer.Created,
+				processes: []int{cmd.Process.Pid},
+			},
+			cmd: cmd,
+		}
+
+		a := &agentGRPC{
+			sandbox: &sandbox{
+				containers: map[string]*container{
+					"foo": {
+						id: "foo",
+						initProcess: &process{
+							id:          "foo",
+							restoredPid: initPid,
+						},
+						container: libContainer,
+					},
+				},
+				running: true,
+			},
+		}
+
+		start := time.Now()
+		_, err := a.StartContainer(context.Background(), &pb.StartContainerRequest{
+			ContainerId:  "foo",
+			StartTimeout: 1,
+		})
+		assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		assert.True(time.Since(start) >= time.Second, "test %d (%+v)", i, d)
+		assert.True(time.Since(start) < startKillTimeout, "test %d (%+v)", i, d)
+
+		// The processes have been killed and waited for
+		assert.NotNil(cmd.ProcessState, "test %d (%+v)", i, d)
+		if cmd.ProcessState != nil {
+			status := cmd.ProcessState.Sys().(syscall.WaitStatus)
+			assert.True(status.Signaled(), "test %d (%+v)", i, d)
+			assert.Equal(syscall.SIGKILL, status.Signal(), "test %d (%+v)", i, d)
+		} else {
+			cmd.Process.Kill()
+		}
+
+		// The container has been removed
+		assert.True(libContainer.destroyed, "test %d (%+v)", i, d)
+		_, err = a.sandbox.getContainer("foo")
+		assert.Equal(codes.NotFound, grpcStatus.Code(err), "test %d (%+v)", i, d)
+	}
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: make(map[string]*container),
+			running:    true,
+		},
+	}
+
+	// Containers started in time are not affected
+	ctr := &execRecorderContainer{
+		mockContainer: mockContainer{
+			status: libcontainer.Created,
+		},
+	}
+	a.sandbox.containers["bar"] = &container{
+		id:        "bar",
+		container: ctr,
+	}
+
+	_, err := a.StartContainer(context.Background(), &pb.StartContainerRequest{
+		ContainerId:  "bar",
+		StartTimeout: 1,
+	})
+	assert.NoError(err)
+	assert.False(ctr.execTime.IsZero())
+}
+
 func TestExecProcess(t *testing.T) {
 	assert := assert.New(t)
 
@@ -1737,6 +2581,125 @@ func TestCloseStdin(t *testing.T) {
 	assert.Error(err)
 }
 
+// startStdinProcess runs the command as the process of a container of the
+// agent sandbox, with the pipes of a non-terminal process.
+func startStdinProcess(assert *assert.Assertions, name string, arg ...string) (*agentGRPC, *process, *exec.Cmd) {
+	proc, err := buildProcess(&pb.Process{}, "bar", false)
+	assert.NoError(err)
+
+	cmd := exec.Command(name, arg...)
+	cmd.Stdin = proc.process.Stdin
+	cmd.Stdout = proc.process.Stdout
+	cmd.Stderr = proc.process.Stderr
+	assert.NoError(cmd.Start())
+	proc.closePostStartFDs()
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			running: true,
+			containers: map[string]*container{
+				"foo": {
+					id: "foo",
+					processes: map[string]*process{
+						"bar": proc,
+					},
+				},
+			},
+		},
+	}
+
+	return a, proc, cmd
+}
+
+func waitStdinProcess(cmd *exec.Cmd) bool {
+	done := make(chan struct{})
+	go func() {
+		cmd.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(5 * time.Second):
+		cmd.Process.Kill()
+		<-done
+		return false
+	}
+}
+
+func TestWriteStdinEOF(t *testing.T) {
+	assert := assert.New(t)
+
+	a, proc, cmd := startStdinProcess(assert, "cat")
+	defer proc.closePostExitFDs()
+
+	req := &pb.WriteStreamRequest{
+		ContainerId: "foo",
+		ExecId:      "bar",
+		Data:        []byte("foo"),
+	}
+
+	resp, err := a.WriteStdin(context.Background(), req)
+	assert.NoError(err)
+	assert.Equal(uint32(3), resp.Len)
+
+	req.Data = []byte("bar")
+	req.Eof = true
+	resp, err = a.WriteStdin(context.Background(), req)
+	assert.NoError(err)
+	assert.Equal(uint32(3), resp.Len)
+
+	// cat exits on EOF, once everything has been read
+	assert.True(waitStdinProcess(cmd), "process still running after stdin EOF")
+
+	output, err := ioutil.ReadAll(proc.stdout)
+	assert.NoError(err)
+	assert.Equal("foobar", string(output))
+
+	// Writes after EOF are ignored, closing again is not an error
+	resp, err = a.WriteStdin(context.Background(), req)
+	assert.NoError(err)
+	assert.Zero(resp.Len)
+
+	_, err = a.CloseStdin(context.Background(), &pb.CloseStdinRequest{ContainerId: "foo", ExecId: "bar"})
+	assert.NoError(err)
+}
+
+func TestCloseStdinBlockedWrite(t *testing.T) {
+	assert := assert.New(t)
+
+	// The process never reads its stdin, writes block once the pipe is full.
+	a, proc, cmd := startStdinProcess(assert, "sleep", "10")
+	defer proc.closePostExitFDs()
+
+	writeErr := make(chan error, 1)
+	go func() {
+		_, err := a.WriteStdin(context.Background(), &pb.WriteStreamRequest{
+			ContainerId: "foo",
+			ExecId:      "bar",
+			Data:        make([]byte, 1024*1024),
+		})
+		writeErr <- err
+	}()
+
+	// Let the write fill the pipe and block.
+	time.Sleep(100 * time.Millisecond)
+
+	_, err := a.CloseStdin(context.Background(), &pb.CloseStdinRequest{ContainerId: "foo", ExecId: "bar"})
+	assert.NoError(err)
+
+	select {
+	case err := <-writeErr:
+		assert.NoError(err)
+	case <-time.After(5 * time.Second):
+		assert.Fail("write not interrupted by closing stdin")
+	}
+
+	cmd.Process.Kill()
+	waitStdinProcess(cmd)
+}
+
 func TestTtyWinResize(t *testing.T) {
 	assert := assert.New(t)
 
@@ -1773,3 +2736,89 @@ func TestLoadKernelModule(t *testing.T) {
 	err = loadKernelModule(m)
 	assert.NoError(err)
 }
+
+func TestCheckKernelModule(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedProcModulesPath := procModulesPath
+	savedKernelModulesDir := kernelModulesDir
+	savedModprobePath := modprobePath
+	defer func() {
+		procModulesPath = savedProcModulesPath
+		kernelModulesDir = savedKernelModulesDir
+		modprobePath = savedModprobePath
+	}()
+
+	release, err := kernelRelease()
+	assert.NoError(err)
+
+	procModulesPath = filepath.Join(dir, "modules")
+	kernelModulesDir = dir
+	modprobePath = "/bin/true"
+
+	modulesDir := filepath.Join(dir, release)
+	assert.NoError(os.MkdirAll(modulesDir, testDirMode))
+
+	files := map[string]string{
+		procModulesPath: "vhost_vsock 20480 0 - Live 0x0000000000000000\n",
+		filepath.Join(modulesDir, "modules.builtin"): "kernel/fs/ext4/ext4.ko\nkernel/net/packet/af_packet.ko\n",
+		filepath.Join(modulesDir, "modules.dep"): "kernel/fs/fuse/virtiofs.ko.xz: kernel/fs/fuse/fuse.ko.xz\n" +
+			"kernel/fs/fuse/fuse.ko.xz:\nkernel/drivers/vhost/vhost_vsock.ko:\n",
+	}
+	for path, content := range files {
+		assert.NoError(ioutil.WriteFile(path, []byte(content), testFileMode))
+	}
+
+	a := &agentGRPC{}
+
+	type testData struct {
+		name           string
+		load           bool
+		expectedStatus *pb.KernelModuleStatus
+		expectError    bool
+	}
+
+	data := []testData{
+		{"", false, nil, true},
+		{"../fuse", false, nil, true},
+		{"ext4", false, &pb.KernelModuleStatus{Name: "ext4", Builtin: true}, false},
+		{"af-packet", false, &pb.KernelModuleStatus{Name: "af-packet", Builtin: true}, false},
+		{"virtiofs", false, &pb.KernelModuleStatus{Name: "virtiofs", Available: true}, false},
+		{"vhost-vsock", false, &pb.KernelModuleStatus{Name: "vhost-vsock", Available: true, Loaded: true}, false},
+		{"virtiofs", true, &pb.KernelModuleStatus{Name: "virtiofs", Available: true, Loaded: true}, false},
+		{"ext4", true, &pb.KernelModuleStatus{Name: "ext4", Builtin: true}, false},
+		{"made_up_module", false, &pb.KernelModuleStatus{Name: "made_up_module"}, false},
+		{"made_up_module", true, nil, true},
+	}
+
+	for i, d := range data {
+		req := &pb.CheckKernelModuleRequest{
+			Module: &pb.KernelModule{Name: d.name},
+			Load:   d.load,
+		}
+
+		status, err := a.CheckKernelModule(context.Background(), req)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedStatus, status, "test %d (%+v)", i, d)
+	}
+
+	// A failing modprobe is reported
+	modprobePath = "/bin/false"
+	_, err = a.CheckKernelModule(context.Background(), &pb.CheckKernelModuleRequest{
+		Module: &pb.KernelModule{Name: "fuse"},
+		Load:   true,
+	})
+	assert.Error(err)
+
+	_, err = a.CheckKernelModule(context.Background(), &pb.CheckKernelModuleRequest{})
+	assert.Error(err)
+}
@@ -0,0 +1,167 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// The environment of the libcontainer init process being set by
+	// libcontainer alone, the descriptors the agent passes to it are
+	// listed in an extra file, identified by its name. As the
+	// _LIBCONTAINER_* variables, each line of the file sets a variable to
+	// the number of a descriptor.
+	initFdsName = "kata-init-fds"
+	initFdsLink = "/memfd:" + initFdsName + " (deleted)"
+
+	// The standard descriptors are followed by the extra files.
+	stdioFdCount = 3
+)
+
+// createMemfd returns an anonymous file, named for the descriptor links
+// to identify it.
+func createMemfd(name string) (*os.File, error) {
+	bytes, err := unix.BytePtrFromString(name)
+	if err != nil {
+		return nil, err
+	}
+
+	fd, _, errno := unix.Syscall(unix.SYS_MEMFD_CREATE, uintptr(unsafe.Pointer(bytes)), memfdCloexec, 0)
+	if errno != 0 {
+		return nil, errno
+	}
+
+	return os.NewFile(fd, name), nil
+}
+
+// addInitFd passes the file to the libcontainer init process, setting the
+// variable name to its descriptor number.
+func (p *process) addInitFd(name string, file *os.File) {
+	p.process.ExtraFiles = append(p.process.ExtraFiles, file)
+	p.initFds = append(p.initFds, fmt.Sprintf("%s=%d", name, stdioFdCount+len(p.process.ExtraFiles)-1))
+}
+
+// setInitFds passes the list of the descriptors added to the libcontainer
+// init process, if any. This must be called once all the extra files of
+// the process are set.
+func (p *process) setInitFds() error {
+	if len(p.initFds) == 0 {
+		return nil
+	}
+
+	file, err := createMemfd(initFdsName)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.WriteString(strings.Join(p.initFds, "\n")); err != nil {
+		file.Close()
+		return err
+	}
+
+	p.process.ExtraFiles = append(p.process.ExtraFiles, file)
+
+	return nil
+}
+
+// initFd returns the descriptor passed by the agent to the libcontainer
+// init process as the variable name, -1 if none.
+func initFd(name string) (int, error) {
+	fds, err := initExtraFds()
+	if err != nil {
+		return -1, err
+	}
+
+	for _, fd := range fds {
+		link, err := os.Readlink(fmt.Sprintf("/proc/self/fd/%d", fd))
+		if err != nil || link != initFdsLink {
+			continue
+		}
+
+		// Looked up by each agent hook, the list is read in place, and
+		// is not inherited by the container process.
+		unix.CloseOnExec(fd)
+
+		return lookupInitFd(fd, name)
+	}
+
+	return -1, nil
+}
+
+func lookupInitFd(listFd int, name string) (int, error) {
+	buf := make([]byte, 4096)
+	n, err := unix.Pread(listFd, buf, 0)
+	if err != nil {
+		return -1, err
+	}
+
+	for _, line := range strings.Split(string(buf[:n]), "\n") {
+		fields := strings.SplitN(line, "=", 2)
+		if len(fields) != 2 || fields[0] != name {
+			continue
+		}
+
+		fd, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return -1, fmt.Errorf("invalid descriptor %q", line)
+		}
+
+		return fd, nil
+	}
+
+	return -1, nil
+}
+
+// setHandoffFds sets the extra files of the process for an attempt to spawn
+// it, the files handing the umask and the seccomp notifications off to the
+// libcontainer init process following the given ones.
+func (p *process) setHandoffFds(ctr *container, extraFiles []*os.File) error {
+	p.process.ExtraFiles = append([]*os.File(nil), extraFiles...)
+	p.initFds = nil
+
+	if ctr.seccompNotify != nil {
+		if err := p.setSeccompNotify(ctr.seccompNotify); err != nil {
+			return err
+		}
+		p.startSeccompSupervision(ctr)
+	}
+
+	if p.umask != nil {
+		if err := p.setUmask(); err != nil {
+			return err
+		}
+	}
+
+	return p.setInitFds()
+}
+
+// releaseHandoffFds closes the files set for a failed attempt to spawn the
+// process, the supervision of its seccomp notifications being over once
+// the init process is gone.
+func (p *process) releaseHandoffFds(extraFiles []*os.File) {
+	for _, f := range p.process.ExtraFiles[len(extraFiles):] {
+		f.Close()
+	}
+	p.process.ExtraFiles = extraFiles
+
+	if p.seccompErrCh != nil {
+		<-p.seccompErrCh
+		p.seccompErrCh = nil
+	}
+
+	if p.umaskSock != nil {
+		p.umaskSock.Close()
+		p.umaskSock = nil
+	}
+}
@@ -0,0 +1,59 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetInitFds(t *testing.T) {
+	assert := assert.New(t)
+
+	proc := &process{}
+	assert.NoError(proc.setInitFds())
+	assert.Empty(proc.process.ExtraFiles)
+
+	foo, err := os.Open(os.DevNull)
+	assert.NoError(err)
+	bar, err := os.Open(os.DevNull)
+	assert.NoError(err)
+
+	proc.process.ExtraFiles = []*os.File{foo}
+	proc.addInitFd("_FOO", bar)
+	assert.NoError(proc.setInitFds())
+	defer proc.closePostStartFDs()
+
+	if !assert.Len(proc.process.ExtraFiles, 3) {
+		return
+	}
+
+	list := proc.process.ExtraFiles[2]
+	link, err := os.Readlink(fmt.Sprintf("/proc/self/fd/%d", list.Fd()))
+	assert.NoError(err)
+	assert.Equal(initFdsLink, link)
+
+	type testData struct {
+		name       string
+		expectedFd int
+	}
+
+	data := []testData{
+		{"_FOO", 4},
+		{"_BAR", -1},
+		{"", -1},
+	}
+
+	for i, d := range data {
+		fd, err := lookupInitFd(int(list.Fd()), d.name)
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedFd, fd, "test %d (%+v)", i, d)
+	}
+}
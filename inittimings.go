@@ -0,0 +1,105 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"sync"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+)
+
+// Phases of the agent initialization. The startup ones follow each other
+// until the gRPC server is started, the sandbox one being the
+// CreateSandbox request.
+const (
+	initPhaseInit     = "init"
+	initPhaseTracing  = "tracing"
+	initPhaseLogger   = "logger"
+	initPhaseRestore  = "restore"
+	initPhaseSetup    = "setup"
+	initPhaseChannels = "channels"
+	initPhaseGRPC     = "grpc"
+	initPhaseSandbox  = "sandbox"
+)
+
+// Time the agent started at, as close to its execution as possible.
+var agentStartTime = time.Now()
+
+type initPhase struct {
+	name  string
+	start time.Time
+	end   time.Time
+}
+
+// initTimings records the phases of the agent initialization as they
+// complete.
+type initTimings struct {
+	sync.Mutex
+
+	start  time.Time
+	phases []initPhase
+
+	// End of the last startup phase, the next one starting there.
+	last time.Time
+	// Set once the startup is complete.
+	startup time.Duration
+
+	// set function in variable to overwrite for testing.
+	now func() time.Time
+}
+
+func newInitTimings(start time.Time) *initTimings {
+	return &initTimings{
+		start: start,
+		last:  start,
+		now:   time.Now,
+	}
+}
+
+// startupPhaseDone records a startup phase, from the end of the previous
+// one until now. The startup is complete once the gRPC server is started.
+func (t *initTimings) startupPhaseDone(name string) {
+	t.Lock()
+	defer t.Unlock()
+
+	end := t.now()
+	t.phases = append(t.phases, initPhase{name, t.last, end})
+	t.last = end
+
+	if name == initPhaseGRPC {
+		t.startup = end.Sub(t.start)
+	}
+}
+
+// phaseDone records a phase started out of the startup sequence.
+func (t *initTimings) phaseDone(name string, start time.Time) {
+	t.Lock()
+	defer t.Unlock()
+
+	t.phases = append(t.phases, initPhase{name, start, t.now()})
+}
+
+// toGRPC returns the phases completed so far.
+func (t *initTimings) toGRPC() *pb.InitTimings {
+	t.Lock()
+	defer t.Unlock()
+
+	timings := &pb.InitTimings{
+		StartupUs: uint64(t.startup / time.Microsecond),
+	}
+
+	for _, p := range t.phases {
+		timings.Phases = append(timings.Phases, &pb.InitPhase{
+			Name:       p.name,
+			StartUs:    uint64(p.start.Sub(t.start) / time.Microsecond),
+			DurationUs: uint64(p.end.Sub(p.start) / time.Microsecond),
+		})
+	}
+
+	return timings
+}
@@ -0,0 +1,81 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInitTimings(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Unix(1500000000, 0)
+	now := start
+
+	timings := newInitTimings(start)
+	timings.now = func() time.Time { return now }
+
+	type testData struct {
+		name     string
+		duration time.Duration
+	}
+
+	data := []testData{
+		{initPhaseInit, 3 * time.Millisecond},
+		{initPhaseTracing, 0},
+		{initPhaseLogger, 1500 * time.Microsecond},
+		{initPhaseSetup, 2 * time.Millisecond},
+		{initPhaseChannels, 40 * time.Millisecond},
+		{initPhaseGRPC, 500 * time.Microsecond},
+	}
+
+	var expected []*pb.InitPhase
+	var startup time.Duration
+	for i, d := range data {
+		now = now.Add(d.duration)
+		timings.startupPhaseDone(d.name)
+
+		expected = append(expected, &pb.InitPhase{
+			Name:       d.name,
+			StartUs:    uint64(startup / time.Microsecond),
+			DurationUs: uint64(d.duration / time.Microsecond),
+		})
+		startup += d.duration
+
+		// The startup is only complete with the gRPC server started
+		if d.name != initPhaseGRPC {
+			assert.Zero(timings.toGRPC().StartupUs, "test %d (%+v)", i, d)
+		}
+	}
+
+	// The sandbox is created once the runtime connects
+	now = now.Add(time.Second)
+	sandboxStart := now
+	now = now.Add(20 * time.Millisecond)
+	timings.phaseDone(initPhaseSandbox, sandboxStart)
+
+	expected = append(expected, &pb.InitPhase{
+		Name:       initPhaseSandbox,
+		StartUs:    uint64((startup + time.Second) / time.Microsecond),
+		DurationUs: 20000,
+	})
+
+	a := &agentGRPC{sandbox: &sandbox{initTimings: timings}}
+	resp, err := a.GetInitTimings(context.Background(), &pb.InitTimingsRequest{})
+	assert.NoError(err)
+	assert.Equal(&pb.InitTimings{Phases: expected, StartupUs: 47000}, resp)
+
+	a.sandbox.initTimings = nil
+	resp, err = a.GetInitTimings(context.Background(), &pb.InitTimingsRequest{})
+	assert.NoError(err)
+	assert.Equal(&pb.InitTimings{}, resp)
+}
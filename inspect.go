@@ -0,0 +1,413 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unsafe"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	// Subdirectory of the shared directory holding the exposed rootfs.
+	rootfsInspectSubdir = "inspect"
+
+	rootfsInspectMountFlags = unix.MS_RDONLY | unix.MS_NOSUID | unix.MS_NODEV | unix.MS_NOEXEC
+
+	// Maximum length of the content read from a file of the container
+	// rootfs at once, also used when no length is requested.
+	rootfsReadMaxLength = 1024 * 1024
+
+	// openat2(2) system call, numbered the same on all the
+	// architectures, and its resolve flags, see
+	// include/uapi/linux/openat2.h.
+	sysOpenat2          = 437
+	resolveNoMagiclinks = 0x02
+	resolveInRoot       = 0x10
+
+	// Maximum number of symbolic links followed when resolving a path,
+	// as MAXSYMLINKS in the kernel.
+	rootfsMaxSymlinks = 40
+)
+
+// Argument of openat2(2), as struct open_how.
+type openHow struct {
+	flags   uint64
+	mode    uint64
+	resolve uint64
+}
+
+// Directory shared with the host, holding the container rootfs.
+var sharedContainersDir = "/run/kata-containers/shared/containers"
+
+// openat2 opens path relative to dirfd with the openat2(2) system call.
+// Overridden in unit tests to exercise the fallback for the kernels not
+// supporting it.
+var openat2 = func(dirfd int, path string, flags, resolve uint64) (int, error) {
+	pathp, err := unix.BytePtrFromString(path)
+	if err != nil {
+		return -1, err
+	}
+
+	how := openHow{flags: flags, resolve: resolve}
+
+	fd, _, errno := unix.Syscall6(sysOpenat2, uintptr(dirfd), uintptr(unsafe.Pointer(pathp)),
+		uintptr(unsafe.Pointer(&how)), unsafe.Sizeof(how), 0, 0)
+	if errno != 0 {
+		return -1, errno
+	}
+
+	return int(fd), nil
+}
+
+// exposeRootfs bind mounts the rootfs of the container read-only into the
+// shared directory, returning its path relative to the shared directory.
+// The bind mount is not recursive, so that the volumes mounted in the
+// container are not exposed, and private, so that no later mount is.
+func (c *container) exposeRootfs() (string, error) {
+	if !rootfsInspect {
+		return "", grpcStatus.Errorf(codes.PermissionDenied, "Exposing the container rootfs requires %s", rootfsInspectFlag)
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	path := filepath.Join(rootfsInspectSubdir, c.id)
+	if c.exposedRootfs != "" {
+		return path, nil
+	}
+
+	if c.id == "" || c.id == "." || c.id == ".." || filepath.Base(c.id) != c.id {
+		return "", grpcStatus.Errorf(codes.InvalidArgument, "Invalid container ID %q", c.id)
+	}
+
+	rootfs := c.config.Rootfs
+	if rootfs == "" {
+		return "", grpcStatus.Errorf(codes.FailedPrecondition, "Container %s has no rootfs", c.id)
+	}
+
+	target := filepath.Join(sharedContainersDir, path)
+	if err := os.MkdirAll(target, 0755); err != nil {
+		return "", grpcStatus.Errorf(codes.Internal, "Could not create %s: %v", target, err)
+	}
+
+	if err := mountExposedRootfs(rootfs, target); err != nil {
+		os.Remove(target)
+		return "", grpcStatus.Errorf(codes.Internal, "Could not expose rootfs of container %s: %v", c.id, err)
+	}
+
+	c.exposedRootfs = target
+
+	agentLog.WithFields(logrus.Fields{
+		"container": c.id,
+		"rootfs":    rootfs,
+		"path":      target,
+	}).Info("Container rootfs exposed")
+
+	return path, nil
+}
+
+func mountExposedRootfs(rootfs, target string) error {
+	if err := unix.Mount(rootfs, target, "", unix.MS_BIND, ""); err != nil {
+		return err
+	}
+
+	for _, flags := range []uintptr{unix.MS_PRIVATE, unix.MS_BIND | unix.MS_REMOUNT | rootfsInspectMountFlags} {
+		if err := unix.Mount("", target, "", flags, ""); err != nil {
+			unix.Unmount(target, unix.MNT_DETACH)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unexposeRootfs removes the rootfs exposed by exposeRootfs, if any. The
+// mount is detached, so that the files still being read do not keep it
+// busy.
+func (c *container) unexposeRootfs() error {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.exposedRootfs == "" {
+		return nil
+	}
+
+	if err := unix.Unmount(c.exposedRootfs, unix.MNT_DETACH); err != nil && err != unix.EINVAL && err != unix.ENOENT {
+		return grpcStatus.Errorf(codes.Internal, "Could not unmount rootfs of container %s exposed at %s: %v", c.id, c.exposedRootfs, err)
+	}
+
+	if err := os.Remove(c.exposedRootfs); err != nil && !os.IsNotExist(err) {
+		return grpcStatus.Errorf(codes.Internal, "Could not remove %s: %v", c.exposedRootfs, err)
+	}
+
+	agentLog.WithField("container", c.id).Info("Container rootfs no longer exposed")
+
+	c.exposedRootfs = ""
+
+	return nil
+}
+
+// openRootfsDir returns an O_PATH descriptor of the directory path, the
+// symbolic links being resolved within the directory root refers to, the
+// magic links of procfs being rejected.
+func openRootfsDir(root int, path string) (int, error) {
+	fd, err := openat2(root, path, unix.O_PATH|unix.O_DIRECTORY|unix.O_CLOEXEC, resolveInRoot|resolveNoMagiclinks)
+	if err != unix.ENOSYS {
+		return fd, err
+	}
+
+	return walkRootfsDir(root, path)
+}
+
+// walkRootfsDir resolves path as openRootfsDir does on the kernels
+// without openat2(2), opening each component without following it. The
+// symbolic links are read and their targets walked the same way, from
+// root if absolute, and ".." never goes past root.
+func walkRootfsDir(root int, path string) (int, error) {
+	// Descriptors of the directories walked, root excluded.
+	var dirs []int
+	defer func() {
+		for _, dir := range dirs {
+			unix.Close(dir)
+		}
+	}()
+
+	current := func() int {
+		if len(dirs) == 0 {
+			return root
+		}
+		return dirs[len(dirs)-1]
+	}
+
+	components := strings.Split(path, "/")
+	links := 0
+
+	for len(components) > 0 {
+		name := components[0]
+		components = components[1:]
+
+		switch name {
+		case "", ".":
+			continue
+		case "..":
+			if len(dirs) > 0 {
+				unix.Close(dirs[len(dirs)-1])
+				dirs = dirs[:len(dirs)-1]
+			}
+			continue
+		}
+
+		fd, err := unix.Openat(current(), name, unix.O_PATH|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0)
+		if err != nil {
+			return -1, err
+		}
+
+		var st unix.Stat_t
+		if err := unix.Fstat(fd, &st); err != nil {
+			unix.Close(fd)
+			return -1, err
+		}
+
+		if st.Mode&unix.S_IFMT == unix.S_IFDIR {
+			dirs = append(dirs, fd)
+			continue
+		}
+
+		if st.Mode&unix.S_IFMT != unix.S_IFLNK {
+			unix.Close(fd)
+			return -1, unix.ENOTDIR
+		}
+
+		target, err := readlinkat(fd, "")
+		unix.Close(fd)
+		if err != nil {
+			return -1, err
+		}
+
+		if links++; links > rootfsMaxSymlinks {
+			return -1, unix.ELOOP
+		}
+
+		if filepath.IsAbs(target) {
+			for _, dir := range dirs {
+				unix.Close(dir)
+			}
+			dirs = nil
+		}
+
+		components = append(strings.Split(target, "/"), components...)
+	}
+
+	return unix.Openat(current(), ".", unix.O_PATH|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+}
+
+// readlinkat returns the target of the symbolic link name relative to
+// dirfd, or of dirfd itself when name is empty.
+func readlinkat(dirfd int, name string) (string, error) {
+	for size := 256; ; size *= 2 {
+		buf := make([]byte, size)
+		n, err := unix.Readlinkat(dirfd, name, buf)
+		if err != nil {
+			return "", err
+		}
+		if n < size {
+			return string(buf[:n]), nil
+		}
+	}
+}
+
+// readRootfsDir lists the entries of the directory name relative to
+// dirfd, sorted by name.
+func readRootfsDir(dirfd int, name string) ([]*pb.RootfsEntry, error) {
+	fd, err := unix.Openat(dirfd, name, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := os.NewFile(uintptr(fd), name)
+	defer dir.Close()
+
+	names, err := dir.Readdirnames(-1)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	var entries []*pb.RootfsEntry
+	for _, name := range names {
+		var st unix.Stat_t
+		// Entries removed meanwhile are skipped.
+		if err := unix.Fstatat(fd, name, &st, unix.AT_SYMLINK_NOFOLLOW); err != nil {
+			continue
+		}
+
+		entries = append(entries, &pb.RootfsEntry{
+			Name:  name,
+			Mode:  st.Mode,
+			Size_: uint64(st.Size),
+		})
+	}
+
+	return entries, nil
+}
+
+// readRootfsFile reads length bytes from offset of the regular file name
+// relative to dirfd. The file is opened non-blocking, not to block on a
+// FIFO if it is not a regular file anymore.
+func readRootfsFile(dirfd int, name string, offset uint64, length uint32) ([]byte, error) {
+	fd, err := unix.Openat(dirfd, name, unix.O_RDONLY|unix.O_NOFOLLOW|unix.O_NONBLOCK|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	f := os.NewFile(uintptr(fd), name)
+	defer f.Close()
+
+	st, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if !st.Mode().IsRegular() {
+		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "%s is not a regular file anymore", name)
+	}
+
+	if length == 0 || length > rootfsReadMaxLength {
+		length = rootfsReadMaxLength
+	}
+
+	data := make([]byte, length)
+	n, err := f.ReadAt(data, int64(offset))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	return data[:n], nil
+}
+
+// readRootfs returns a file of the exposed container rootfs, the content
+// of a regular file, the entries of a directory or the target of a
+// symbolic link. The path is resolved relative to a descriptor of the
+// exposed rootfs, and the last component is never followed, so that the
+// container processes cannot make the agent read outside of it.
+func (c *container) readRootfs(req *pb.ReadContainerRootfsRequest) (*pb.ContainerRootfsFile, error) {
+	if !rootfsInspect {
+		return nil, grpcStatus.Errorf(codes.PermissionDenied, "Reading the container rootfs requires %s", rootfsInspectFlag)
+	}
+
+	c.RLock()
+	exposedRootfs := c.exposedRootfs
+	c.RUnlock()
+
+	if exposedRootfs == "" {
+		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "Rootfs of container %s is not exposed", c.id)
+	}
+
+	root, err := unix.Open(exposedRootfs, unix.O_PATH|unix.O_DIRECTORY|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.Internal, "Could not open rootfs of container %s exposed at %s: %v", c.id, exposedRootfs, err)
+	}
+	defer unix.Close(root)
+
+	path := filepath.Clean(string(filepath.Separator) + req.Path)
+	name := "."
+	if path != string(filepath.Separator) {
+		name = filepath.Base(path)
+	}
+
+	dirfd, err := openRootfsDir(root, filepath.Dir(path))
+	if err != nil {
+		switch err {
+		case unix.ENOENT, unix.ENOTDIR:
+			return nil, grpcStatus.Errorf(codes.NotFound, "%s not found in container %s rootfs", req.Path, c.id)
+		case unix.ELOOP, unix.EXDEV:
+			return nil, grpcStatus.Errorf(codes.InvalidArgument, "Invalid rootfs path %q: %v", req.Path, err)
+		}
+		return nil, grpcStatus.Errorf(codes.Internal, "Could not resolve %s in container %s rootfs: %v", req.Path, c.id, err)
+	}
+	defer unix.Close(dirfd)
+
+	var st unix.Stat_t
+	if err := unix.Fstatat(dirfd, name, &st, unix.AT_SYMLINK_NOFOLLOW); err != nil {
+		if err == unix.ENOENT {
+			return nil, grpcStatus.Errorf(codes.NotFound, "%s not found in container %s rootfs", req.Path, c.id)
+		}
+		return nil, grpcStatus.Errorf(codes.Internal, "Could not stat %s in container %s rootfs: %v", req.Path, c.id, err)
+	}
+
+	file := &pb.ContainerRootfsFile{
+		Mode:  st.Mode,
+		Size_: uint64(st.Size),
+	}
+
+	switch st.Mode & unix.S_IFMT {
+	case unix.S_IFREG:
+		file.Data, err = readRootfsFile(dirfd, name, req.Offset, req.Length)
+	case unix.S_IFDIR:
+		file.Entries, err = readRootfsDir(dirfd, name)
+	case unix.S_IFLNK:
+		file.LinkTarget, err = readlinkat(dirfd, name)
+	}
+
+	if err != nil {
+		if _, ok := grpcStatus.FromError(err); ok {
+			return nil, err
+		}
+		return nil, grpcStatus.Errorf(codes.Internal, "Could not read %s in container %s rootfs: %v", req.Path, c.id, err)
+	}
+
+	return file, nil
+}
@@ -0,0 +1,321 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestExposeContainerRootfsChecks(t *testing.T) {
+	assert := assert.New(t)
+
+	savedRootfsInspect := rootfsInspect
+	defer func() {
+		rootfsInspect = savedRootfsInspect
+	}()
+
+	type testData struct {
+		enabled      bool
+		id           string
+		rootfs       string
+		expectedCode codes.Code
+	}
+
+	data := []testData{
+		{false, "foo", "/", codes.PermissionDenied},
+		{true, "", "/", codes.InvalidArgument},
+		{true, ".", "/", codes.InvalidArgument},
+		{true, "..", "/", codes.InvalidArgument},
+		{true, "../foo", "/", codes.InvalidArgument},
+		{true, "foo", "", codes.FailedPrecondition},
+	}
+
+	for i, d := range data {
+		rootfsInspect = d.enabled
+
+		ctr := &container{
+			id:     d.id,
+			config: configs.Config{Rootfs: d.rootfs},
+		}
+
+		_, err := ctr.exposeRootfs()
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		assert.Empty(ctr.exposedRootfs, "test %d (%+v)", i, d)
+	}
+}
+
+func TestExposeContainerRootfs(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedRootfsInspect := rootfsInspect
+	savedSharedContainersDir := sharedContainersDir
+	defer func() {
+		rootfsInspect = savedRootfsInspect
+		sharedContainersDir = savedSharedContainersDir
+	}()
+
+	rootfsInspect = true
+	sharedContainersDir = filepath.Join(dir, "shared")
+
+	rootfs := filepath.Join(dir, "rootfs")
+	assert.NoError(os.MkdirAll(filepath.Join(rootfs, "etc"), testDirMode))
+	assert.NoError(ioutil.WriteFile(filepath.Join(rootfs, "etc", "hostname"), []byte("foo"), testFileMode))
+
+	containerID := "foo"
+	ctr := &container{
+		ctx:       context.Background(),
+		id:        containerID,
+		config:    configs.Config{Rootfs: rootfs},
+		container: &mockContainer{id: containerID},
+		processes: make(map[string]*process),
+	}
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: map[string]*container{
+				containerID: ctr,
+			},
+		},
+	}
+
+	resp, err := a.ExposeContainerRootfs(context.Background(), &pb.ExposeContainerRootfsRequest{ContainerId: containerID})
+	if !assert.NoError(err) {
+		return
+	}
+	assert.Equal(filepath.Join(rootfsInspectSubdir, containerID), resp.Path)
+
+	exposed := filepath.Join(sharedContainersDir, resp.Path)
+	defer ctr.unexposeRootfs()
+
+	content, err := ioutil.ReadFile(filepath.Join(exposed, "etc", "hostname"))
+	assert.NoError(err)
+	assert.Equal("foo", string(content))
+
+	file, err := a.ReadContainerRootfs(context.Background(), &pb.ReadContainerRootfsRequest{ContainerId: containerID, Path: "etc/hostname"})
+	assert.NoError(err)
+	assert.Equal("foo", string(file.Data))
+
+	// The rootfs is exposed read-only
+	err = ioutil.WriteFile(filepath.Join(exposed, "etc", "hostname"), []byte("bar"), testFileMode)
+	assert.Error(err)
+
+	// Exposing it again returns the same path
+	resp, err = a.ExposeContainerRootfs(context.Background(), &pb.ExposeContainerRootfsRequest{ContainerId: containerID})
+	assert.NoError(err)
+	assert.Equal(filepath.Join(rootfsInspectSubdir, containerID), resp.Path)
+
+	_, err = a.UnexposeContainerRootfs(context.Background(), &pb.UnexposeContainerRootfsRequest{ContainerId: containerID})
+	assert.NoError(err)
+
+	_, err = os.Stat(exposed)
+	assert.True(os.IsNotExist(err))
+
+	_, err = a.ReadContainerRootfs(context.Background(), &pb.ReadContainerRootfsRequest{ContainerId: containerID, Path: "etc/hostname"})
+	assert.Equal(codes.FailedPrecondition, grpcStatus.Code(err))
+
+	_, err = a.ExposeContainerRootfs(context.Background(), &pb.ExposeContainerRootfsRequest{ContainerId: containerID})
+	assert.NoError(err)
+
+	_, err = os.Stat(filepath.Join(exposed, "etc", "hostname"))
+	assert.NoError(err)
+
+	// The exposed rootfs is removed with the container
+	assert.NoError(ctr.removeContainer())
+	assert.Empty(ctr.exposedRootfs)
+
+	_, err = os.Stat(exposed)
+	assert.True(os.IsNotExist(err))
+
+	mounts, err := getGuestMounts()
+	assert.NoError(err)
+	for _, m := range mounts {
+		assert.NotEqual(exposed, m.Mountpoint)
+	}
+
+	// The rootfs itself is left untouched
+	content, err = ioutil.ReadFile(filepath.Join(rootfs, "etc", "hostname"))
+	assert.NoError(err)
+	assert.Equal("foo", string(content))
+}
+
+func TestReadContainerRootfs(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedRootfsInspect := rootfsInspect
+	savedSharedContainersDir := sharedContainersDir
+	savedOpenat2 := openat2
+	defer func() {
+		rootfsInspect = savedRootfsInspect
+		sharedContainersDir = savedSharedContainersDir
+		openat2 = savedOpenat2
+	}()
+
+	sharedContainersDir = filepath.Join(dir, "shared")
+
+	rootfs := filepath.Join(dir, "rootfs")
+	assert.NoError(os.MkdirAll(filepath.Join(rootfs, "etc"), testDirMode))
+	assert.NoError(ioutil.WriteFile(filepath.Join(rootfs, "etc", "hostname"), []byte("foobar"), testFileMode))
+	assert.NoError(os.Symlink("/etc/hostname", filepath.Join(rootfs, "hostname")))
+	assert.NoError(os.Symlink("/etc", filepath.Join(rootfs, "abs")))
+	assert.NoError(os.Symlink("../../..", filepath.Join(rootfs, "etc", "up")))
+	assert.NoError(unix.Mkfifo(filepath.Join(rootfs, "fifo"), 0600))
+
+	// A file outside of the rootfs, reachable through symbolic links from
+	// the agent point of view.
+	assert.NoError(os.MkdirAll(filepath.Join(dir, "outside"), testDirMode))
+	assert.NoError(ioutil.WriteFile(filepath.Join(dir, "outside", "secret"), []byte("secret"), testFileMode))
+	assert.NoError(os.Symlink(filepath.Join(dir, "outside", "secret"), filepath.Join(rootfs, "etc", "secret")))
+	assert.NoError(os.Symlink(filepath.Join(dir, "outside"), filepath.Join(rootfs, "outside")))
+	assert.NoError(os.Symlink("/proc/self/root"+dir, filepath.Join(rootfs, "proc")))
+
+	containerID := "foo"
+	ctr := &container{
+		id:     containerID,
+		config: configs.Config{Rootfs: rootfs},
+	}
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: map[string]*container{
+				containerID: ctr,
+				"bar":       {id: "bar"},
+			},
+		},
+	}
+
+	read := func(path string, offset uint64, length uint32) (*pb.ContainerRootfsFile, error) {
+		return a.ReadContainerRootfs(context.Background(), &pb.ReadContainerRootfsRequest{
+			ContainerId: containerID,
+			Path:        path,
+			Offset:      offset,
+			Length:      length,
+		})
+	}
+
+	rootfsInspect = false
+	_, err = read("etc/hostname", 0, 0)
+	assert.Equal(codes.PermissionDenied, grpcStatus.Code(err))
+
+	rootfsInspect = true
+
+	// The rootfs must be exposed first
+	_, err = read("etc/hostname", 0, 0)
+	assert.Equal(codes.FailedPrecondition, grpcStatus.Code(err))
+
+	_, err = ctr.exposeRootfs()
+	if !assert.NoError(err) {
+		return
+	}
+	defer ctr.unexposeRootfs()
+
+	// Both with openat2(2) and with the fallback walking the path
+	for _, supported := range []bool{true, false} {
+		openat2 = savedOpenat2
+		if !supported {
+			openat2 = func(dirfd int, path string, flags, resolve uint64) (int, error) {
+				return -1, unix.ENOSYS
+			}
+		}
+
+		file, err := read("etc/hostname", 0, 0)
+		assert.NoError(err, "openat2: %v", supported)
+		assert.Equal(uint32(unix.S_IFREG), file.Mode&unix.S_IFMT, "openat2: %v", supported)
+		assert.Equal(uint64(6), file.Size_, "openat2: %v", supported)
+		assert.Equal("foobar", string(file.Data), "openat2: %v", supported)
+
+		file, err = read("/etc/hostname", 3, 2)
+		assert.NoError(err, "openat2: %v", supported)
+		assert.Equal("ba", string(file.Data), "openat2: %v", supported)
+
+		// Reading past the end returns no data
+		file, err = read("etc/hostname", 6, 0)
+		assert.NoError(err, "openat2: %v", supported)
+		assert.Empty(file.Data, "openat2: %v", supported)
+
+		// The last component is not followed
+		file, err = read("hostname", 0, 0)
+		assert.NoError(err, "openat2: %v", supported)
+		assert.Equal(uint32(unix.S_IFLNK), file.Mode&unix.S_IFMT, "openat2: %v", supported)
+		assert.Equal("/etc/hostname", file.LinkTarget, "openat2: %v", supported)
+		assert.Empty(file.Data, "openat2: %v", supported)
+
+		file, err = read("etc/secret", 0, 0)
+		assert.NoError(err, "openat2: %v", supported)
+		assert.Equal(uint32(unix.S_IFLNK), file.Mode&unix.S_IFMT, "openat2: %v", supported)
+		assert.Empty(file.Data, "openat2: %v", supported)
+
+		// The parents are resolved within the rootfs
+		file, err = read("abs/hostname", 0, 0)
+		assert.NoError(err, "openat2: %v", supported)
+		assert.Equal("foobar", string(file.Data), "openat2: %v", supported)
+
+		file, err = read("etc/up/etc/hostname", 0, 0)
+		assert.NoError(err, "openat2: %v", supported)
+		assert.Equal("foobar", string(file.Data), "openat2: %v", supported)
+
+		file, err = read("/etc/../../../etc/hostname", 0, 0)
+		assert.NoError(err, "openat2: %v", supported)
+		assert.Equal("foobar", string(file.Data), "openat2: %v", supported)
+
+		for _, path := range []string{"outside/secret", "etc/secret/foo", "proc/outside/secret"} {
+			_, err = read(path, 0, 0)
+			assert.Error(err, "openat2: %v, path: %s", supported, path)
+			assert.NotEqual(codes.Internal, grpcStatus.Code(err), "openat2: %v, path: %s", supported, path)
+		}
+
+		file, err = read("/", 0, 0)
+		assert.NoError(err, "openat2: %v", supported)
+		assert.Equal(uint32(unix.S_IFDIR), file.Mode&unix.S_IFMT, "openat2: %v", supported)
+		var names []string
+		for _, entry := range file.Entries {
+			names = append(names, entry.Name)
+		}
+		assert.Equal([]string{"abs", "etc", "fifo", "hostname", "outside", "proc"}, names, "openat2: %v", supported)
+		assert.Equal(uint32(unix.S_IFIFO), file.Entries[2].Mode&unix.S_IFMT, "openat2: %v", supported)
+
+		// FIFOs are reported but not opened
+		file, err = read("fifo", 0, 0)
+		assert.NoError(err, "openat2: %v", supported)
+		assert.Equal(uint32(unix.S_IFIFO), file.Mode&unix.S_IFMT, "openat2: %v", supported)
+		assert.Empty(file.Data, "openat2: %v", supported)
+
+		_, err = read("missing", 0, 0)
+		assert.Equal(codes.NotFound, grpcStatus.Code(err), "openat2: %v", supported)
+
+		_, err = read("etc/hostname/foo", 0, 0)
+		assert.Equal(codes.NotFound, grpcStatus.Code(err), "openat2: %v", supported)
+	}
+
+	_, err = a.ReadContainerRootfs(context.Background(), &pb.ReadContainerRootfsRequest{ContainerId: "bar"})
+	assert.Equal(codes.FailedPrecondition, grpcStatus.Code(err))
+
+	_, err = a.ReadContainerRootfs(context.Background(), &pb.ReadContainerRootfsRequest{ContainerId: "baz"})
+	assert.Error(err)
+}
@@ -0,0 +1,324 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"unsafe"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Landlock system calls, numbered the same on all the architectures, and
+// their constants, see include/uapi/linux/landlock.h.
+const (
+	sysLandlockCreateRuleset = 444
+	sysLandlockAddRule       = 445
+	sysLandlockRestrictSelf  = 446
+
+	landlockCreateRulesetVersion = 1 << 0
+	landlockRulePathBeneath      = 1
+
+	landlockAccessFSExecute    = 1 << 0
+	landlockAccessFSWriteFile  = 1 << 1
+	landlockAccessFSReadFile   = 1 << 2
+	landlockAccessFSReadDir    = 1 << 3
+	landlockAccessFSRemoveDir  = 1 << 4
+	landlockAccessFSRemoveFile = 1 << 5
+	landlockAccessFSMakeChar   = 1 << 6
+	landlockAccessFSMakeDir    = 1 << 7
+	landlockAccessFSMakeReg    = 1 << 8
+	landlockAccessFSMakeSock   = 1 << 9
+	landlockAccessFSMakeFifo   = 1 << 10
+	landlockAccessFSMakeBlock  = 1 << 11
+	landlockAccessFSMakeSym    = 1 << 12
+	landlockAccessFSRefer      = 1 << 13
+	landlockAccessFSTruncate   = 1 << 14
+	landlockAccessFSIoctlDev   = 1 << 15
+
+	// Access rights which can be granted on files, the others only
+	// applying to directories.
+	landlockAccessFSFile = landlockAccessFSExecute | landlockAccessFSWriteFile |
+		landlockAccessFSReadFile | landlockAccessFSTruncate | landlockAccessFSIoctlDev
+
+	// Target of the descriptors of the rulesets.
+	landlockRulesetLink = "anon_inode:landlock-ruleset"
+
+	// The ruleset is passed to the libcontainer init process as its first
+	// extra file.
+	landlockRulesetFd = 3
+)
+
+var landlockAccessFS = map[string]uint64{
+	"execute":     landlockAccessFSExecute,
+	"write_file":  landlockAccessFSWriteFile,
+	"read_file":   landlockAccessFSReadFile,
+	"read_dir":    landlockAccessFSReadDir,
+	"remove_dir":  landlockAccessFSRemoveDir,
+	"remove_file": landlockAccessFSRemoveFile,
+	"make_char":   landlockAccessFSMakeChar,
+	"make_dir":    landlockAccessFSMakeDir,
+	"make_reg":    landlockAccessFSMakeReg,
+	"make_sock":   landlockAccessFSMakeSock,
+	"make_fifo":   landlockAccessFSMakeFifo,
+	"make_block":  landlockAccessFSMakeBlock,
+	"make_sym":    landlockAccessFSMakeSym,
+	"refer":       landlockAccessFSRefer,
+	"truncate":    landlockAccessFSTruncate,
+	"ioctl_dev":   landlockAccessFSIoctlDev,
+}
+
+// Filesystem access rights handled by each Landlock ABI version, the
+// later versions not adding any.
+var landlockABIAccessFS = []uint64{
+	0,
+	landlockAccessFSRefer - 1,
+	landlockAccessFSTruncate - 1,
+	landlockAccessFSIoctlDev - 1,
+	landlockAccessFSIoctlDev - 1,
+	landlockAccessFSIoctlDev<<1 - 1,
+}
+
+// landlock_ruleset_attr, truncated to the filesystem access rights handled
+// by all the ABI versions.
+type landlockRulesetAttr struct {
+	handledAccessFS uint64
+}
+
+// landlock_path_beneath_attr, packed by the kernel.
+type landlockPathBeneathAttr struct {
+	allowedAccess uint64
+	parentFd      int32
+}
+
+// set function in variable to overwrite for testing.
+var landlockABIVersion = func() int {
+	// Fails with ENOSYS, or EOPNOTSUPP when disabled at boot time.
+	abi, _, errno := unix.Syscall(sysLandlockCreateRuleset, 0, 0, landlockCreateRulesetVersion)
+	if errno != 0 {
+		return 0
+	}
+	return int(abi)
+}
+
+// landlockSupportedAccessFS returns the filesystem access rights supported
+// by the Landlock ABI version.
+func landlockSupportedAccessFS(abi int) uint64 {
+	if abi >= len(landlockABIAccessFS) {
+		abi = len(landlockABIAccessFS) - 1
+	}
+	return landlockABIAccessFS[abi]
+}
+
+// parseLandlockAccessFS converts the access rights names into their
+// Landlock flags.
+func parseLandlockAccessFS(names []string) (uint64, error) {
+	var access uint64
+
+	for _, name := range names {
+		value, ok := landlockAccessFS[name]
+		if !ok {
+			return 0, grpcStatus.Errorf(codes.InvalidArgument, "Unknown Landlock access right %q", name)
+		}
+		access |= value
+	}
+
+	return access, nil
+}
+
+// landlockAddPathBeneath adds the rule granting the access rights beneath
+// the opened file to the ruleset.
+func landlockAddPathBeneath(ruleset uintptr, parent *os.File, allowed uint64) error {
+	attr := landlockPathBeneathAttr{
+		allowedAccess: allowed,
+		parentFd:      int32(parent.Fd()),
+	}
+
+	_, _, errno := unix.Syscall6(sysLandlockAddRule, ruleset, landlockRulePathBeneath,
+		uintptr(unsafe.Pointer(&attr)), 0, 0, 0)
+	if errno != 0 {
+		return errno
+	}
+
+	return nil
+}
+
+// addLandlockPathRule grants the access rights beneath the path, opened
+// relative to root. Only the access rights applying to files are granted
+// if the path is not a directory.
+func addLandlockPathRule(ruleset uintptr, root, path string, allowed uint64) error {
+	fullPath, err := securejoin.SecureJoin(root, path)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(fullPath, unix.O_PATH|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Could not open Landlock path %q: %v", path, err)
+	}
+	defer f.Close()
+
+	fileInfo, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	if !fileInfo.IsDir() {
+		allowed &= landlockAccessFSFile
+	}
+
+	// Adding a rule granting nothing fails with ENOMSG.
+	if allowed == 0 {
+		return nil
+	}
+
+	if err := landlockAddPathBeneath(ruleset, f, allowed); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not add Landlock rule for %q: %v", path, err)
+	}
+
+	return nil
+}
+
+// createLandlockRuleset creates the Landlock ruleset, its paths being
+// relative to root. The access rights the kernel does not support are
+// ignored in best effort mode, nil being returned if nothing is left to
+// restrict.
+func createLandlockRuleset(landlock *pb.Landlock, root string) (*os.File, error) {
+	abi := landlockABIVersion()
+	supported := landlockSupportedAccessFS(abi)
+
+	handled := supported
+	if len(landlock.HandledAccessFS) > 0 {
+		var err error
+		if handled, err = parseLandlockAccessFS(landlock.HandledAccessFS); err != nil {
+			return nil, err
+		}
+	}
+
+	if abi == 0 && landlock.DisableBestEffort {
+		return nil, grpcStatus.Error(codes.FailedPrecondition, "Landlock not supported by the kernel")
+	}
+
+	allowed := make([]uint64, len(landlock.PathBeneath))
+	for i, rule := range landlock.PathBeneath {
+		access, err := parseLandlockAccessFS(rule.AllowedAccess)
+		if err != nil {
+			return nil, err
+		}
+
+		if access&^handled != 0 {
+			return nil, grpcStatus.Errorf(codes.InvalidArgument,
+				"Landlock rule for %v grants access rights not handled by the ruleset", rule.Paths)
+		}
+
+		allowed[i] = access
+	}
+
+	if handled&^supported != 0 {
+		if landlock.DisableBestEffort {
+			return nil, grpcStatus.Errorf(codes.FailedPrecondition,
+				"Landlock access rights %#x not supported by the kernel (ABI version %d)", handled&^supported, abi)
+		}
+
+		agentLog.WithFields(logrus.Fields{
+			"landlock-abi":   abi,
+			"ignored-access": fmt.Sprintf("%#x", handled&^supported),
+		}).Warn("Landlock access rights not supported by the kernel, ignored")
+
+		handled &= supported
+	}
+
+	if handled == 0 {
+		return nil, nil
+	}
+
+	attr := landlockRulesetAttr{handledAccessFS: handled}
+	fd, _, errno := unix.Syscall(sysLandlockCreateRuleset, uintptr(unsafe.Pointer(&attr)), unsafe.Sizeof(attr), 0)
+	if errno != 0 {
+		return nil, grpcStatus.Errorf(codes.Internal, "Could not create Landlock ruleset: %v", errno)
+	}
+
+	ruleset := os.NewFile(fd, "landlock-ruleset")
+
+	for i, rule := range landlock.PathBeneath {
+		for _, path := range rule.Paths {
+			if err := addLandlockPathRule(ruleset.Fd(), root, path, allowed[i]&handled); err != nil {
+				ruleset.Close()
+				return nil, err
+			}
+		}
+	}
+
+	return ruleset, nil
+}
+
+// setLandlockRuleset sets the Landlock ruleset to be enforced by the
+// libcontainer init process before executing the process, its paths being
+// resolved in the container.
+func (p *process) setLandlockRuleset(ctr *container, landlock *pb.Landlock) error {
+	pid, err := ctr.initProcess.pid()
+	if err != nil {
+		return err
+	}
+
+	ruleset, err := createLandlockRuleset(landlock, fmt.Sprintf("/proc/%d/root", pid))
+	if err != nil {
+		return err
+	}
+
+	if ruleset == nil {
+		agentLog.WithField("exec-id", p.id).Warn("Landlock not supported by the kernel, process not restricted")
+		return nil
+	}
+
+	p.process.ExtraFiles = []*os.File{ruleset}
+
+	return nil
+}
+
+// restrictSelfLandlock enforces the Landlock ruleset on the calling thread,
+// and the processes it executes.
+func restrictSelfLandlock(ruleset uintptr) error {
+	_, _, errno := unix.Syscall(sysLandlockRestrictSelf, ruleset, 0, 0)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// restrictLandlockInit enforces the Landlock ruleset passed by the agent
+// to the libcontainer init process, if any. This is called once the init
+// process joined the container namespaces, and before any file access of
+// the process executed.
+func restrictLandlockInit() error {
+	link, err := os.Readlink(fmt.Sprintf("/proc/self/fd/%d", landlockRulesetFd))
+	if err != nil || link != landlockRulesetLink {
+		return nil
+	}
+	defer unix.Close(landlockRulesetFd)
+
+	// libcontainer lists the descriptors of the init process before
+	// executing the process. Granting this fails with EINVAL if reading
+	// directories is not restricted.
+	fdDir, err := os.OpenFile("/proc/self/fd", unix.O_PATH|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	defer fdDir.Close()
+
+	if err := landlockAddPathBeneath(landlockRulesetFd, fdDir, landlockAccessFSReadDir); err != nil && err != unix.EINVAL {
+		return err
+	}
+
+	return restrictSelfLandlock(landlockRulesetFd)
+}
@@ -0,0 +1,211 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestParseLandlockAccessFS(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		names          []string
+		expectedAccess uint64
+		expectError    bool
+	}
+
+	data := []testData{
+		{nil, 0, false},
+		{[]string{"read_file"}, landlockAccessFSReadFile, false},
+		{[]string{"execute", "read_dir", "refer"}, landlockAccessFSExecute | landlockAccessFSReadDir | landlockAccessFSRefer, false},
+		{[]string{"read_file", "foo"}, 0, true},
+		{[]string{"READ_FILE"}, 0, true},
+	}
+
+	for i, d := range data {
+		access, err := parseLandlockAccessFS(d.names)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.Equal(d.expectedAccess, access, "test %d (%+v)", i, d)
+		}
+	}
+}
+
+func TestLandlockSupportedAccessFS(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Zero(landlockSupportedAccessFS(0))
+	assert.Equal(uint64(landlockAccessFSMakeSym<<1-1), landlockSupportedAccessFS(1))
+	assert.Zero(landlockSupportedAccessFS(2) & landlockAccessFSTruncate)
+	assert.NotZero(landlockSupportedAccessFS(2) & landlockAccessFSRefer)
+	assert.NotZero(landlockSupportedAccessFS(3) & landlockAccessFSTruncate)
+	assert.Equal(landlockSupportedAccessFS(5), landlockSupportedAccessFS(42))
+	assert.NotZero(landlockSupportedAccessFS(42) & landlockAccessFSIoctlDev)
+}
+
+func TestCreateLandlockRulesetChecks(t *testing.T) {
+	assert := assert.New(t)
+
+	savedLandlockABIVersion := landlockABIVersion
+	defer func() {
+		landlockABIVersion = savedLandlockABIVersion
+	}()
+
+	type testData struct {
+		abi          int
+		landlock     *pb.Landlock
+		expectedCode codes.Code
+	}
+
+	data := []testData{
+		{0, &pb.Landlock{}, codes.OK},
+		{0, &pb.Landlock{DisableBestEffort: true}, codes.FailedPrecondition},
+		{0, &pb.Landlock{HandledAccessFS: []string{"foo"}}, codes.InvalidArgument},
+		{1, &pb.Landlock{HandledAccessFS: []string{"truncate"}}, codes.OK},
+		{1, &pb.Landlock{HandledAccessFS: []string{"truncate"}, DisableBestEffort: true}, codes.FailedPrecondition},
+		{
+			1,
+			&pb.Landlock{
+				HandledAccessFS: []string{"read_file"},
+				PathBeneath: []pb.LandlockPathBeneath{
+					{AllowedAccess: []string{"read_file", "write_file"}, Paths: []string{"/"}},
+				},
+			},
+			codes.InvalidArgument,
+		},
+		{
+			1,
+			&pb.Landlock{
+				PathBeneath: []pb.LandlockPathBeneath{
+					{AllowedAccess: []string{"bar"}, Paths: []string{"/"}},
+				},
+			},
+			codes.InvalidArgument,
+		},
+	}
+
+	for i, d := range data {
+		abi := d.abi
+		landlockABIVersion = func() int {
+			return abi
+		}
+
+		ruleset, err := createLandlockRuleset(d.landlock, "/")
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		assert.Nil(ruleset, "test %d (%+v)", i, d)
+	}
+}
+
+func TestRestrictLandlockInitWithoutRuleset(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(restrictLandlockInit())
+}
+
+func TestLandlockRuleset(t *testing.T) {
+	if landlockABIVersion() == 0 {
+		t.Skip("Landlock support needed")
+	}
+
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	allowedFile := filepath.Join(dir, "allowed", "foo")
+	deniedFile := filepath.Join(dir, "denied", "foo")
+	for _, file := range []string{allowedFile, deniedFile} {
+		assert.NoError(os.MkdirAll(filepath.Dir(file), testDirMode))
+		assert.NoError(ioutil.WriteFile(file, []byte("bar"), testFileMode))
+	}
+
+	// Paths are relative to the root, symbolic links not escaping it
+	assert.NoError(os.Symlink("/denied", filepath.Join(dir, "link")))
+
+	ruleset, err := createLandlockRuleset(&pb.Landlock{
+		HandledAccessFS: []string{"read_file", "write_file"},
+		PathBeneath: []pb.LandlockPathBeneath{
+			{AllowedAccess: []string{"read_file"}, Paths: []string{"/allowed"}},
+			{AllowedAccess: []string{"write_file"}, Paths: []string{"/link/foo"}},
+		},
+	}, dir)
+	assert.NoError(err)
+	if !assert.NotNil(ruleset) {
+		return
+	}
+	defer ruleset.Close()
+
+	_, err = createLandlockRuleset(&pb.Landlock{
+		PathBeneath: []pb.LandlockPathBeneath{
+			{AllowedAccess: []string{"read_file"}, Paths: []string{"/missing"}},
+		},
+	}, dir)
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+
+	type result struct {
+		restrictErr error
+		allowedErr  error
+		deniedErr   error
+		writeErr    error
+	}
+
+	resultCh := make(chan result, 1)
+
+	// The ruleset is enforced on a thread only used for the test, which
+	// terminates with the goroutine.
+	go func() {
+		runtime.LockOSThread()
+
+		var r result
+		defer func() {
+			resultCh <- r
+		}()
+
+		if r.restrictErr = unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); r.restrictErr != nil {
+			return
+		}
+
+		if r.restrictErr = restrictSelfLandlock(ruleset.Fd()); r.restrictErr != nil {
+			return
+		}
+
+		_, r.allowedErr = ioutil.ReadFile(allowedFile)
+		_, r.deniedErr = ioutil.ReadFile(deniedFile)
+		r.writeErr = ioutil.WriteFile(deniedFile, []byte("baz"), testFileMode)
+	}()
+
+	r := <-resultCh
+	if !assert.NoError(r.restrictErr) {
+		return
+	}
+
+	assert.NoError(r.allowedErr)
+	assert.NoError(r.writeErr)
+
+	pathErr, ok := r.deniedErr.(*os.PathError)
+	if assert.True(ok, "unexpected error %v", r.deniedErr) {
+		assert.Equal(unix.EACCES, pathErr.Err)
+	}
+
+	// Other threads are not restricted
+	_, err = ioutil.ReadFile(deniedFile)
+	assert.NoError(err)
+}
@@ -0,0 +1,389 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/klauspost/compress/zstd"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	// Prefix of the entries removing the lower layers entry of the same
+	// name, see the OCI image layer specification.
+	whiteoutPrefix = ".wh."
+
+	// Entry hiding the lower layers entries of its directory.
+	opaqueWhiteout = whiteoutPrefix + whiteoutPrefix + ".opq"
+
+	// Prefix of the PAX records holding the extended attributes.
+	paxXattrPrefix = "SCHILY.xattr."
+
+	// Mode of the parent directories missing from a layer.
+	layerDirMode = 0755
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// layerExtractor applies the entries of a layer to a root directory.
+type layerExtractor struct {
+	root string
+
+	// Entries of the layer already applied, not hidden by an opaque
+	// whiteout of their directory.
+	added map[string]bool
+
+	// Directories of the layer, their times being set once their content
+	// is extracted.
+	dirs []*tar.Header
+}
+
+// extractLayer extracts the layer read from r, a tar archive possibly gzip
+// or zstd compressed, into root. The whiteouts of the layer remove the
+// entries of the lower layers, and the entries can neither be written
+// outside of root nor through its symbolic links.
+func extractLayer(r io.Reader, root string) error {
+	br := bufio.NewReader(r)
+
+	// Short layers are left to the tar reader.
+	magic, _ := br.Peek(len(zstdMagic))
+
+	var ar io.Reader
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		gr, err := gzip.NewReader(br)
+		if err != nil {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid gzip layer: %v", err)
+		}
+		defer gr.Close()
+		ar = gr
+	case bytes.HasPrefix(magic, zstdMagic):
+		zr, err := zstd.NewReader(br, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid zstd layer: %v", err)
+		}
+		defer zr.Close()
+		ar = zr
+	default:
+		ar = br
+	}
+
+	tr := tar.NewReader(ar)
+
+	l := &layerExtractor{
+		root:  root,
+		added: make(map[string]bool),
+	}
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid layer: %v", err)
+		}
+
+		if err := l.apply(hdr, tr); err != nil {
+			return err
+		}
+	}
+
+	// The tar reader stops at the end of archive marker, the checksum
+	// of the compressed layer is only verified once fully read.
+	if _, err := io.Copy(ioutil.Discard, ar); err != nil {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Invalid layer: %v", err)
+	}
+
+	// Children are extracted after their parent, whose times they
+	// modify.
+	for i := len(l.dirs) - 1; i >= 0; i-- {
+		path, err := l.resolve(l.dirs[i].Name)
+		if err != nil {
+			return err
+		}
+
+		// Possibly removed by a whiteout.
+		if err := setEntryTimes(path, l.dirs[i]); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolve returns the path of the entry name below the root, the symbolic
+// links of its parent directories being resolved within the root.
+func (l *layerExtractor) resolve(name string) (string, error) {
+	name = filepath.Clean("/" + name)
+	if name == "/" {
+		return l.root, nil
+	}
+
+	parent, err := securejoin.SecureJoin(l.root, filepath.Dir(name))
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(parent, filepath.Base(name)), nil
+}
+
+// apply applies the entry of the layer, whose content is read from r.
+func (l *layerExtractor) apply(hdr *tar.Header, r io.Reader) error {
+	name := filepath.Clean("/" + hdr.Name)
+	dir, base := filepath.Split(name)
+
+	// The root directory is left untouched.
+	if name == "/" {
+		return nil
+	}
+
+	if base == opaqueWhiteout {
+		return l.removeOpaque(filepath.Clean(dir))
+	}
+
+	if strings.HasPrefix(base, whiteoutPrefix) {
+		path, err := l.resolve(filepath.Join(dir, strings.TrimPrefix(base, whiteoutPrefix)))
+		if err != nil {
+			return err
+		}
+		return os.RemoveAll(path)
+	}
+
+	path, err := l.resolve(name)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), layerDirMode); err != nil {
+		return err
+	}
+
+	// Existing entries are replaced, but for directories which are
+	// merged.
+	if st, err := os.Lstat(path); err == nil && !(st.IsDir() && hdr.Typeflag == tar.TypeDir) {
+		if err := os.RemoveAll(path); err != nil {
+			return err
+		}
+	}
+
+	mode := uint32(hdr.Mode) & 07777
+
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		if err := os.Mkdir(path, os.FileMode(mode)); err != nil && !os.IsExist(err) {
+			return err
+		}
+		l.dirs = append(l.dirs, hdr)
+	case tar.TypeReg:
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(f, r)
+		f.Close()
+		if err != nil {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Could not extract %s: %v", hdr.Name, err)
+		}
+	case tar.TypeSymlink:
+		if err := os.Symlink(hdr.Linkname, path); err != nil {
+			return err
+		}
+	case tar.TypeLink:
+		target, err := l.resolve(hdr.Linkname)
+		if err != nil {
+			return err
+		}
+		if err := os.Link(target, path); err != nil {
+			return err
+		}
+	case tar.TypeChar, tar.TypeBlock, tar.TypeFifo:
+		fileType := map[byte]uint32{
+			tar.TypeChar:  unix.S_IFCHR,
+			tar.TypeBlock: unix.S_IFBLK,
+			tar.TypeFifo:  unix.S_IFIFO,
+		}[hdr.Typeflag]
+		dev := unix.Mkdev(uint32(hdr.Devmajor), uint32(hdr.Devminor))
+		if err := unix.Mknod(path, fileType|mode, int(dev)); err != nil {
+			return err
+		}
+	default:
+		agentLog.WithFields(logrus.Fields{
+			"name": hdr.Name,
+			"type": hdr.Typeflag,
+		}).Warn("Skipping layer entry of unsupported type")
+		return nil
+	}
+
+	l.added[name] = true
+
+	return setEntryMetadata(path, hdr)
+}
+
+// removeOpaque removes the entries of the directory coming from the lower
+// layers.
+func (l *layerExtractor) removeOpaque(dir string) error {
+	path, err := l.resolve(dir)
+	if err != nil {
+		return err
+	}
+
+	entries, err := ioutil.ReadDir(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if l.added[filepath.Join(dir, entry.Name())] {
+			continue
+		}
+
+		if err := os.RemoveAll(filepath.Join(path, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setEntryMetadata sets the ownership, mode, extended attributes and times
+// of the extracted entry.
+func setEntryMetadata(path string, hdr *tar.Header) error {
+	if err := os.Lchown(path, hdr.Uid, hdr.Gid); err != nil {
+		return err
+	}
+
+	// The mode of symbolic links is not used, and changing it would
+	// follow them. It is set after the ownership, clearing the setuid
+	// and setgid bits.
+	if hdr.Typeflag != tar.TypeSymlink {
+		if err := os.Chmod(path, hdr.FileInfo().Mode()); err != nil {
+			return err
+		}
+	}
+
+	for key, value := range hdr.PAXRecords {
+		if !strings.HasPrefix(key, paxXattrPrefix) {
+			continue
+		}
+
+		if err := unix.Lsetxattr(path, strings.TrimPrefix(key, paxXattrPrefix), []byte(value), 0); err != nil {
+			return grpcStatus.Errorf(codes.FailedPrecondition, "Could not set extended attribute %s of %s: %v", key, hdr.Name, err)
+		}
+	}
+
+	return setEntryTimes(path, hdr)
+}
+
+// setEntryTimes sets the access and modification times of the extracted
+// entry, not following symbolic links.
+func setEntryTimes(path string, hdr *tar.Header) error {
+	atime := hdr.AccessTime
+	if atime.IsZero() {
+		atime = hdr.ModTime
+	}
+
+	times := []unix.Timespec{
+		timespec(atime),
+		timespec(hdr.ModTime),
+	}
+
+	return unix.UtimesNanoAt(unix.AT_FDCWD, path, times, unix.AT_SYMLINK_NOFOLLOW)
+}
+
+func timespec(t time.Time) unix.Timespec {
+	if t.IsZero() {
+		return unix.Timespec{Nsec: unix.UTIME_OMIT}
+	}
+
+	return unix.NsecToTimespec(t.UnixNano())
+}
+
+// layerTransfer tracks the chunks received of a layer, whose offsets and
+// ends are recorded.
+type layerTransfer struct {
+	chunks   [][2]int64
+	received int64
+}
+
+// receiveLayer writes the chunk of the layer into the file, returning
+// whether the layer is complete. A transfer starts with the chunk at offset
+// 0, replacing the file of an aborted transfer, the other chunks being
+// possibly received in any order.
+func (s *sandbox) receiveLayer(file string, size, offset int64, data []byte) (complete bool, err error) {
+	s.layersLock.Lock()
+	defer s.layersLock.Unlock()
+
+	if s.layers == nil {
+		s.layers = make(map[string]*layerTransfer)
+	}
+
+	defer func() {
+		if err != nil || complete {
+			delete(s.layers, file)
+		}
+	}()
+
+	transfer := s.layers[file]
+	flags := os.O_WRONLY
+	if offset == 0 {
+		flags |= os.O_CREATE | os.O_TRUNC
+		transfer = &layerTransfer{}
+	} else if transfer == nil {
+		return false, grpcStatus.Errorf(codes.FailedPrecondition, "Layer transfer to %s not started", file)
+	}
+
+	end := offset + int64(len(data))
+	if offset < 0 || end > size {
+		return false, grpcStatus.Errorf(codes.InvalidArgument, "Layer larger than %d bytes", size)
+	}
+
+	// Overlapping chunks would leave holes in the layer.
+	for _, chunk := range transfer.chunks {
+		if offset < chunk[1] && chunk[0] < end {
+			return false, grpcStatus.Errorf(codes.InvalidArgument, "Layer chunk at offset %d overlaps a received one", offset)
+		}
+	}
+
+	f, err := os.OpenFile(file, flags, 0600)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	if _, err := f.WriteAt(data, offset); err != nil {
+		return false, err
+	}
+
+	if len(data) > 0 {
+		transfer.chunks = append(transfer.chunks, [2]int64{offset, end})
+		transfer.received += int64(len(data))
+	}
+	s.layers[file] = transfer
+
+	return transfer.received == size, nil
+}
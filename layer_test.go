@@ -0,0 +1,380 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// layerEntry is an entry of a test layer, a regular file when its type is
+// not set.
+type layerEntry struct {
+	name     string
+	typeflag byte
+	mode     int64
+	content  string
+	linkname string
+}
+
+// buildLayer returns the tar archive of the entries, gzip-compressed when
+// requested.
+func buildLayer(entries []layerEntry, compress bool) ([]byte, error) {
+	var buf bytes.Buffer
+
+	var gw *gzip.Writer
+	tw := tar.NewWriter(&buf)
+	if compress {
+		gw = gzip.NewWriter(&buf)
+		tw = tar.NewWriter(gw)
+	}
+
+	for _, e := range entries {
+		hdr := &tar.Header{
+			Name:     e.name,
+			Typeflag: e.typeflag,
+			Mode:     e.mode,
+			Linkname: e.linkname,
+			Size:     int64(len(e.content)),
+			Uid:      1000,
+			Gid:      1000,
+			ModTime:  time.Unix(1500000000, 0),
+		}
+		if hdr.Typeflag == 0 {
+			hdr.Typeflag = tar.TypeReg
+		}
+		if hdr.Mode == 0 {
+			hdr.Mode = 0644
+		}
+
+		if err := tw.WriteHeader(hdr); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write([]byte(e.content)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if gw != nil {
+		if err := gw.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+func TestExtractLayer(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "layer")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	root := filepath.Join(dir, "rootfs")
+	outside := filepath.Join(dir, "outside")
+	assert.NoError(os.MkdirAll(outside, testDirMode))
+
+	lower, err := buildLayer([]layerEntry{
+		{name: "etc/", typeflag: tar.TypeDir, mode: 0755},
+		{name: "etc/passwd", content: "root:x:0:0::/root:/bin/sh\n"},
+		{name: "etc/shadow", content: "root:*::0:::::\n", mode: 0600},
+		{name: "opaque/", typeflag: tar.TypeDir, mode: 0755},
+		{name: "opaque/old", content: "old"},
+		{name: "bin/sh", content: "sh", mode: 04755},
+	}, false)
+	assert.NoError(err)
+
+	upper, err := buildLayer([]layerEntry{
+		// Whiteouts of a file and of the content of a directory
+		{name: "etc/.wh.shadow"},
+		{name: "opaque/", typeflag: tar.TypeDir, mode: 0700},
+		{name: "opaque/.wh..wh..opq"},
+		{name: "opaque/new", content: "new"},
+		{name: "etc/hostname", content: "foo\n"},
+		{name: "etc/hosts", typeflag: tar.TypeLink, linkname: "etc/hostname"},
+		{name: "bin/bash", typeflag: tar.TypeSymlink, linkname: "/bin/sh"},
+		// Escapes from the root
+		{name: "../escape", content: "escape"},
+		{name: "evil", typeflag: tar.TypeSymlink, linkname: outside},
+		{name: "evil/file", content: "evil"},
+	}, true)
+	assert.NoError(err)
+
+	for _, layer := range [][]byte{lower, upper} {
+		assert.NoError(extractLayer(bytes.NewReader(layer), root))
+	}
+
+	type testData struct {
+		path    string
+		mode    os.FileMode
+		content string
+	}
+
+	data := []testData{
+		{"etc", os.ModeDir | 0755, ""},
+		{"etc/passwd", 0644, "root:x:0:0::/root:/bin/sh\n"},
+		{"etc/hostname", 0644, "foo\n"},
+		{"etc/hosts", 0644, "foo\n"},
+		{"opaque", os.ModeDir | 0700, ""},
+		{"opaque/new", 0644, "new"},
+		{"bin", os.ModeDir | layerDirMode, ""},
+		{"bin/sh", os.ModeSetuid | 0755, "sh"},
+		{"bin/bash", os.ModeSymlink | 0777, ""},
+		{"escape", 0644, "escape"},
+		{"evil", os.ModeSymlink | 0777, ""},
+		{filepath.Join(outside, "file"), 0644, "evil"},
+	}
+
+	for i, d := range data {
+		path := filepath.Join(root, d.path)
+
+		st, err := os.Lstat(path)
+		if !assert.NoError(err, "test %d (%+v)", i, d) {
+			continue
+		}
+		assert.Equal(d.mode, st.Mode(), "test %d (%+v)", i, d)
+
+		// The parent directories missing from the layers are owned by
+		// root, and the upper layer modifies etc.
+		sys := st.Sys().(*syscall.Stat_t)
+		if d.path != "bin" {
+			assert.Equal(uint32(1000), sys.Uid, "test %d (%+v)", i, d)
+			assert.Equal(uint32(1000), sys.Gid, "test %d (%+v)", i, d)
+		}
+		if d.path != "bin" && d.path != "etc" {
+			assert.Equal(int64(1500000000), st.ModTime().Unix(), "test %d (%+v)", i, d)
+		}
+
+		if st.Mode().IsRegular() {
+			content, err := ioutil.ReadFile(path)
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.Equal(d.content, string(content), "test %d (%+v)", i, d)
+		}
+	}
+
+	// Removed entries
+	for _, path := range []string{
+		filepath.Join(root, "etc/shadow"),
+		filepath.Join(root, "etc/.wh.shadow"),
+		filepath.Join(root, "opaque/old"),
+		filepath.Join(root, "opaque/.wh..wh..opq"),
+		filepath.Join(dir, "escape"),
+		filepath.Join(outside, "file"),
+	} {
+		_, err := os.Lstat(path)
+		assert.True(os.IsNotExist(err), path)
+	}
+
+	// Hard links share the inode
+	hostname, err := os.Stat(filepath.Join(root, "etc/hostname"))
+	assert.NoError(err)
+	hosts, err := os.Stat(filepath.Join(root, "etc/hosts"))
+	assert.NoError(err)
+	assert.True(os.SameFile(hostname, hosts))
+
+	target, err := os.Readlink(filepath.Join(root, "bin/bash"))
+	assert.NoError(err)
+	assert.Equal("/bin/sh", target)
+}
+
+func TestExtractLayerZstd(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := ioutil.TempDir("", "layer")
+	assert.NoError(err)
+	defer os.RemoveAll(root)
+
+	// zstd compressed tar archive of etc/hostname and etc/hosts, with
+	// a checksum.
+	layer := "\x28\xb5\x2f\xfd\x64\x00\x27\x55\x03\x00\x32\xc5\x0f\x11\x90\xcf" +
+		"\x01\xc0\xa4\x33\xb7\xaa\xba\x3e\x4a\xbe\x47\xb1\xac\xbb\x14\x74" +
+		"\xc6\xe6\x92\x8a\x2e\x90\x0b\xb4\xe4\xec\x41\x5f\xdb\xd3\x20\x46" +
+		"\xe6\xdf\x32\xd1\x59\xfd\xe0\x7d\x39\xb9\x7f\x83\xf6\x5b\x48\x21" +
+		"\x36\xb7\x31\xe7\x5e\x2f\x41\x3a\x63\x1b\x8a\xae\x0f\x20\x80\xb7" +
+		"\x3c\x07\xed\x4f\x0f\xf4\x27\x10\xd5\x17\xd0\x63\xaa\xea\x98\x55" +
+		"\xca\x01\xde\x80\x5f\xd5\x73\xea\x00\xa6\x01\xcc\x9c\x60\x50\x80" +
+		"\xa5\xa9\x7e\x01\xea\x5a\x1a\x0c"
+
+	assert.NoError(extractLayer(bytes.NewReader([]byte(layer)), root))
+
+	content, err := ioutil.ReadFile(filepath.Join(root, "etc/hostname"))
+	assert.NoError(err)
+	assert.Equal("foo\n", string(content))
+
+	content, err = ioutil.ReadFile(filepath.Join(root, "etc/hosts"))
+	assert.NoError(err)
+	assert.Equal("127.0.0.1 localhost\n", string(content))
+
+	// Corrupted checksum
+	corrupted := []byte(layer)
+	corrupted[len(corrupted)-1] ^= 0xff
+	err = extractLayer(bytes.NewReader(corrupted), root)
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+}
+
+func TestReceiveLayer(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "layer")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "rootfs.layer")
+	s := &sandbox{}
+
+	// A chunk received before the first one
+	_, err = s.receiveLayer(file, 6, 3, []byte("bar"))
+	assert.Equal(codes.FailedPrecondition, grpcStatus.Code(err))
+
+	// A stale layer is replaced
+	assert.NoError(ioutil.WriteFile(file, []byte("stalestale"), testFileMode))
+
+	complete, err := s.receiveLayer(file, 9, 0, []byte("foo"))
+	assert.NoError(err)
+	assert.False(complete)
+
+	// The last chunk does not complete the layer
+	complete, err = s.receiveLayer(file, 9, 6, []byte("baz"))
+	assert.NoError(err)
+	assert.False(complete)
+
+	complete, err = s.receiveLayer(file, 9, 3, []byte("bar"))
+	assert.NoError(err)
+	assert.True(complete)
+
+	content, err := ioutil.ReadFile(file)
+	assert.NoError(err)
+	assert.Equal("foobarbaz", string(content))
+	assert.Empty(s.layers)
+
+	type testData struct {
+		size   int64
+		offset int64
+		data   string
+	}
+
+	// The transfer is aborted by invalid chunks
+	data := []testData{
+		{3, 0, "foobar"},
+		{6, -3, "foo"},
+		{6, 4, "bar"},
+		// Overlapping chunks
+		{6, 2, "foo"},
+	}
+
+	for i, d := range data {
+		_, err = s.receiveLayer(file, 6, 0, []byte("foo"))
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		_, err = s.receiveLayer(file, d.size, d.offset, []byte(d.data))
+		assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		assert.Empty(s.layers, "test %d (%+v)", i, d)
+	}
+
+	// Empty layer
+	complete, err = s.receiveLayer(file, 0, 0, nil)
+	assert.NoError(err)
+	assert.True(complete)
+}
+
+func TestExtractLayerInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := ioutil.TempDir("", "layer")
+	assert.NoError(err)
+	defer os.RemoveAll(root)
+
+	// Truncated zstd frame
+	err = extractLayer(bytes.NewReader([]byte{0x28, 0xb5, 0x2f, 0xfd, 0x64, 0x00, 0x27, 0x55, 0x03}), root)
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+
+	err = extractLayer(bytes.NewReader([]byte{0x1f, 0x8b, 0x00}), root)
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+
+	err = extractLayer(bytes.NewReader(bytes.Repeat([]byte("foo"), 200)), root)
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+
+	// Empty layer
+	assert.NoError(extractLayer(bytes.NewReader(nil), root))
+}
+
+func TestExtractLayerRPC(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	root, err := ioutil.TempDir("", "run")
+	assert.NoError(err)
+	defer os.RemoveAll(root)
+
+	oldContainersRootfsPath := containersRootfsPath
+	containersRootfsPath = root
+	defer func() {
+		containersRootfsPath = oldContainersRootfsPath
+	}()
+
+	layer, err := buildLayer([]layerEntry{
+		{name: "etc/hostname", content: "foo\n"},
+	}, true)
+	assert.NoError(err)
+
+	a := &agentGRPC{sandbox: &sandbox{}}
+	path := filepath.Join(root, "ctr", "rootfs")
+
+	_, err = a.ExtractLayer(context.Background(), &pb.ExtractLayerRequest{
+		Path:      "/etc",
+		LayerSize: int64(len(layer)),
+		Data:      layer,
+	})
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+
+	// The layer is extracted once all its chunks are received
+	chunkSize := len(layer) / 2
+	for offset := 0; offset < len(layer); offset += chunkSize {
+		end := offset + chunkSize
+		if end > len(layer) {
+			end = len(layer)
+		}
+
+		_, err = os.Stat(filepath.Join(path, "etc/hostname"))
+		assert.True(os.IsNotExist(err))
+
+		_, err = a.ExtractLayer(context.Background(), &pb.ExtractLayerRequest{
+			Path:      path,
+			LayerSize: int64(len(layer)),
+			Offset:    int64(offset),
+			Data:      layer[offset:end],
+		})
+		assert.NoError(err)
+	}
+
+	content, err := ioutil.ReadFile(filepath.Join(path, "etc/hostname"))
+	assert.NoError(err)
+	assert.Equal("foo\n", string(content))
+
+	_, err = os.Stat(path + ".layer")
+	assert.True(os.IsNotExist(err))
+}
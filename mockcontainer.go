@@ -20,6 +20,8 @@ type mockContainer struct {
 	status    libcontainer.Status
 	stats     libcontainer.Stats
 	processes []int
+	memory    int64
+	cpuQuota  int64
 }
 
 func (m *mockContainer) ID() string {
@@ -42,7 +44,7 @@ func (m *mockContainer) Config() configs.Config {
 	return configs.Config{
 		Capabilities: &configs.Capabilities{},
 		Cgroups: &configs.Cgroup{
-			Resources: &configs.Resources{},
+			Resources: &configs.Resources{Memory: m.memory, CpuQuota: m.cpuQuota},
 			Path:      fmt.Sprintf("/cgroup/%s", m.id),
 		},
 		Seccomp: &configs.Seccomp{},
@@ -58,6 +60,10 @@ func (m *mockContainer) Stats() (*libcontainer.Stats, error) {
 }
 
 func (m *mockContainer) Set(config configs.Config) error {
+	if config.Cgroups != nil && config.Cgroups.Resources != nil {
+		m.memory = config.Cgroups.Resources.Memory
+		m.cpuQuota = config.Cgroups.Resources.CpuQuota
+	}
 	return nil
 }
 
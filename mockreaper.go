@@ -11,6 +11,8 @@ import (
 	"errors"
 	"fmt"
 	"os/exec"
+
+	"golang.org/x/sys/unix"
 )
 
 type mockreaper struct {
@@ -39,6 +41,13 @@ func (r *mockreaper) setExitCodeCh(pid int, exitCodeCh chan<- int) {
 func (r *mockreaper) deleteExitCodeCh(pid int) {
 }
 
+func (r *mockreaper) getExitStatus(pid int) (unix.WaitStatus, error) {
+	return 0, nil
+}
+
+func (r *mockreaper) deleteExitStatus(pid int) {
+}
+
 func (r *mockreaper) reap() error {
 	return nil
 }
@@ -10,14 +10,17 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	pb "github.com/kata-containers/agent/protocols/grpc"
+	mountinfo "github.com/opencontainers/runc/libcontainer/mount"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sys/unix"
@@ -26,14 +29,37 @@ import (
 )
 
 const (
-	type9pFs       = "9p"
-	typeVirtioFS   = "virtio_fs"
-	typeRootfs     = "rootfs"
-	typeTmpFs      = "tmpfs"
-	procMountStats = "/proc/self/mountstats"
-	mountPerm      = os.FileMode(0755)
+	type9pFs     = "9p"
+	typeVirtioFS = "virtio_fs"
+	typeRootfs   = "rootfs"
+	typeTmpFs    = "tmpfs"
+	mountPerm    = os.FileMode(0755)
 )
 
+var (
+	// Paths overridden in unit tests
+	virtio9pTagsPath = sysfsDir + "/bus/virtio/drivers/9pnet_virtio"
+	virtioFSTagsPath = sysfsDir + "/fs/virtiofs"
+
+	// Mount statistics of a process, formatted with either a PID or "self"
+	procMountStats = "/proc/%s/mountstats"
+
+	// Root directory of a process, formatted with its PID
+	procRoot = "/proc/%d/root"
+
+	// Mount table of the agent, overridden in unit tests
+	getGuestMounts = mountinfo.GetMounts
+
+	// Time after which an unresponsive mount point is considered unreachable
+	pathReachableTimeout = 5 * time.Second
+
+	// Overridden in unit tests to simulate a hung mount
+	statPath = os.Stat
+)
+
+// Refer to fs/proc_namespace.c:show_vfsstat() for the mountstats file format.
+var mountStatsRegexp = regexp.MustCompile(`^(?:no device|device \S+) mounted on (\S+) with fstype (\S+)`)
+
 var flagList = map[string]int{
 	"acl":         unix.MS_POSIXACL,
 	"bind":        unix.MS_BIND,
@@ -102,6 +128,10 @@ func mount(source, destination, fsType string, flags int, options string) error
 		return fmt.Errorf("need mount FS type")
 	}
 
+	if err := validateMountSource(source, fsType, flags); err != nil {
+		return err
+	}
+
 	var err error
 	switch fsType {
 	case type9pFs, typeVirtioFS:
@@ -132,6 +162,60 @@ func mount(source, destination, fsType string, flags int, options string) error
 	return nil
 }
 
+// validateMountSource checks the source of a mount exists before trying to
+// mount it, so that a missing device, tag or path is reported explicitly
+// instead of surfacing as a generic mount failure.
+func validateMountSource(source, fsType string, flags int) error {
+	switch {
+	case fsType == type9pFs:
+		return checkMountTag(source, virtio9pTagsPath, "mount_tag")
+	case fsType == typeVirtioFS:
+		return checkMountTag(source, virtioFSTagsPath, "tag")
+	case fsType == typeTmpFs:
+		return nil
+	case fsType == "bind" || flags&unix.MS_BIND != 0:
+		if _, err := os.Stat(source); err != nil {
+			return grpcStatus.Errorf(codes.NotFound, "Bind mount source %v not found: %v", source, err)
+		}
+	case strings.HasPrefix(source, "/dev/"):
+		fileInfo, err := os.Stat(source)
+		if err != nil {
+			return grpcStatus.Errorf(codes.NotFound, "Block device %v not found: %v", source, err)
+		}
+
+		mode := fileInfo.Mode()
+		if mode&os.ModeDevice == 0 || mode&os.ModeCharDevice != 0 {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Mount source %v is not a block device", source)
+		}
+	}
+
+	return nil
+}
+
+// checkMountTag looks for the given mount tag among the virtio devices listed
+// under tagsPath. The check is skipped if the guest kernel does not expose the
+// tags through sysfs.
+func checkMountTag(tag, tagsPath, tagFile string) error {
+	entries, err := ioutil.ReadDir(tagsPath)
+	if err != nil {
+		agentLog.WithError(err).WithField("mount-tag", tag).Debug("Cannot list mount tags, skipping check")
+		return nil
+	}
+
+	for _, entry := range entries {
+		data, err := ioutil.ReadFile(filepath.Join(tagsPath, entry.Name(), tagFile))
+		if err != nil {
+			continue
+		}
+
+		if strings.TrimRight(string(data), "\n\x00") == tag {
+			return nil
+		}
+	}
+
+	return grpcStatus.Errorf(codes.NotFound, "Mount tag %v not found in %v", tag, tagsPath)
+}
+
 // ensureDestinationExists will recursively create a given mountpoint. If directories
 // are created, their permissions are initialized to mountPerm
 func ensureDestinationExists(source, destination string, fsType string) error {
@@ -195,7 +279,7 @@ func parseOptions(optionList []string) map[string]string {
 
 func removeMounts(mounts []string) error {
 	for _, mount := range mounts {
-		if err := syscall.Unmount(mount, 0); err != nil {
+		if err := unmountWithTimeout(mount); err != nil {
 			return err
 		}
 	}
@@ -203,6 +287,47 @@ func removeMounts(mounts []string) error {
 	return nil
 }
 
+// Function variable so that unit tests can simulate a hung unmount.
+var unmountFunc = unix.Unmount
+
+// unmountEscalation lists the unmount attempts performed in turn whenever
+// the previous one did not complete within unmountTimeout.
+var unmountEscalation = []struct {
+	name  string
+	flags int
+}{
+	{"regular", 0},
+	{"lazy", unix.MNT_DETACH},
+	{"force", unix.MNT_DETACH | unix.MNT_FORCE},
+}
+
+// unmountWithTimeout unmounts the given path without letting a hung mount,
+// e.g. an unreachable network filesystem or a broken block device, block
+// the caller forever. An attempt which does not complete in time cannot be
+// interrupted, it is left behind while the next one is tried.
+func unmountWithTimeout(path string) error {
+	for _, attempt := range unmountEscalation {
+		errCh := make(chan error, 1)
+
+		go func(flags int) {
+			errCh <- unmountFunc(path, flags)
+		}(attempt.flags)
+
+		select {
+		case err := <-errCh:
+			return err
+		case <-time.After(unmountTimeout):
+			agentLog.WithFields(logrus.Fields{
+				"path":    path,
+				"attempt": attempt.name,
+				"timeout": unmountTimeout,
+			}).Warn("Unmount timed out, escalating")
+		}
+	}
+
+	return grpcStatus.Errorf(codes.DeadlineExceeded, "Could not unmount %s within %v", path, unmountTimeout)
+}
+
 // storageHandler is the type of callback to be defined to handle every
 // type of storage driver.
 type storageHandler func(ctx context.Context, storage pb.Storage, s *sandbox) (string, error)
@@ -217,6 +342,7 @@ var storageHandlerList = map[string]storageHandler{
 	driverSCSIType:      virtioSCSIStorageHandler,
 	driverEphemeralType: ephemeralStorageHandler,
 	driverLocalType:     localStorageHandler,
+	driverVerityType:    verityStorageHandler,
 }
 
 func ephemeralStorageHandler(_ context.Context, storage pb.Storage, s *sandbox) (string, error) {
@@ -418,28 +544,212 @@ func getMountFSType(mountPoint string) (string, error) {
 		return "", errors.Errorf("Invalid mount point '%s'", mountPoint)
 	}
 
-	mountstats, err := os.Open(procMountStats)
+	fsTypes, err := parseMountStats("self")
+	if err != nil {
+		return "", err
+	}
+
+	if fsType, ok := fsTypes[mountPoint]; ok {
+		return fsType, nil
+	}
+
+	return "", errors.Errorf("Failed to find FS type for mount point '%s'", mountPoint)
+}
+
+// unescapeMountPath decodes the octal escapes used by the kernel for
+// spaces, tabs, newlines and backslashes in mount paths.
+func unescapeMountPath(path string) string {
+	if !strings.Contains(path, "\\") {
+		return path
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		if path[i] == '\\' && i+4 <= len(path) {
+			if c, err := strconv.ParseUint(path[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(c))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(path[i])
+	}
+
+	return b.String()
+}
+
+// parseMountStats returns the FS types of the mount points listed in the
+// mountstats file of the given process, indexed by mount point.
+func parseMountStats(pid string) (map[string]string, error) {
+	path := fmt.Sprintf(procMountStats, pid)
+
+	mountstats, err := os.Open(path)
 	if err != nil {
-		return "", errors.Wrapf(err, "Failed to open file '%s'", procMountStats)
+		return nil, errors.Wrapf(err, "Failed to open file '%s'", path)
 	}
 	defer mountstats.Close()
 
-	// Refer to fs/proc_namespace.c:show_vfsstat() for
-	// the file format.
-	re := regexp.MustCompile(fmt.Sprintf(`device .+ mounted on %s with fstype (.+)`, mountPoint))
+	fsTypes := make(map[string]string)
 
 	scanner := bufio.NewScanner(mountstats)
 	for scanner.Scan() {
-		line := scanner.Text()
-		matches := re.FindStringSubmatch(line)
-		if len(matches) > 1 {
-			return matches[1], nil
+		matches := mountStatsRegexp.FindStringSubmatch(scanner.Text())
+		if matches == nil {
+			continue
 		}
+
+		// A mount point can be stacked, the last mount hiding the
+		// previous ones.
+		fsTypes[unescapeMountPath(matches[1])] = matches[2]
 	}
 
 	if err := scanner.Err(); err != nil {
-		return "", errors.Wrapf(err, "Failed to parse proc mount stats file %s", procMountStats)
+		return nil, errors.Wrapf(err, "Failed to parse proc mount stats file %s", path)
 	}
 
-	return "", errors.Errorf("Failed to find FS type for mount point '%s'", mountPoint)
+	return fsTypes, nil
+}
+
+// guestMountPoints returns the mount table of the agent, indexed by mount
+// point.
+func guestMountPoints() (map[string]*mountinfo.Info, error) {
+	mounts, err := getGuestMounts()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get the guest mounts")
+	}
+
+	mountPoints := make(map[string]*mountinfo.Info)
+	for _, m := range mounts {
+		// A mount point can be stacked, the last mount hiding the
+		// previous ones.
+		mountPoints[unescapeMountPath(m.Mountpoint)] = m
+	}
+
+	return mountPoints, nil
+}
+
+// containerInitPid returns the PID of the container init process, from
+// which the container mount namespace is inspected.
+var containerInitPid = func(ctr *container) (int, error) {
+	state, err := ctr.container.State()
+	if err != nil {
+		return -1, err
+	}
+
+	return state.InitProcessPid, nil
+}
+
+// pathReachable returns false when the path disappeared or when its
+// backing filesystem is gone, e.g. a stale 9p or virtio-fs mount. As the
+// stat of a hung mount may never return, the path is also considered
+// unreachable when it does not answer within pathReachableTimeout.
+func pathReachable(path string) bool {
+	// Buffered so that a late stat does not leak its goroutine.
+	result := make(chan error, 1)
+	go func() {
+		_, err := statPath(path)
+		result <- err
+	}()
+
+	select {
+	case err := <-result:
+		return err == nil
+	case <-time.After(pathReachableTimeout):
+		agentLog.WithField("path", path).Warn("Timeout checking path reachability")
+		return false
+	}
+}
+
+// listMounts reports the mounts of the container along with their health,
+// so that the runtime can reconcile its state after a restart.
+func (c *container) listMounts() (*pb.ContainerMounts, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	pid, err := containerInitPid(c)
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "Could not get container %s init process: %v", c.id, err)
+	}
+
+	containerMounts, err := parseMountStats(strconv.Itoa(pid))
+	if err != nil {
+		return nil, err
+	}
+
+	guestMounts, err := guestMountPoints()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ContainerMounts{}
+
+	for _, m := range c.config.Mounts {
+		_, mounted := containerMounts[filepath.Clean(m.Destination)]
+
+		// Only sources referring to a guest path can disappear,
+		// pseudo filesystems sources such as "proc" are just labels.
+		sourceExists := true
+		if filepath.IsAbs(m.Source) {
+			sourceExists = pathReachable(m.Source)
+		}
+
+		resp.Mounts = append(resp.Mounts, &pb.ContainerMount{
+			Source:       m.Source,
+			Destination:  m.Destination,
+			Type:         m.Device,
+			Mounted:      mounted,
+			SourceExists: sourceExists,
+			Healthy:      mounted && sourceExists,
+		})
+	}
+
+	for _, mountPoint := range c.mounts {
+		var source, fsType string
+		info, mounted := guestMounts[mountPoint]
+		if mounted {
+			source, fsType = unescapeMountPath(info.Source), info.Fstype
+		}
+
+		// The storage source is only known through the mount table,
+		// its reachability is checked through the mount point itself.
+		sourceExists := pathReachable(mountPoint)
+
+		resp.Storages = append(resp.Storages, &pb.ContainerMount{
+			Source:       source,
+			Destination:  mountPoint,
+			Type:         fsType,
+			Mounted:      mounted,
+			SourceExists: sourceExists,
+			Healthy:      mounted && sourceExists,
+		})
+	}
+
+	return resp, nil
+}
+
+// checkReadonlyRootfs verifies that the rootfs of the container is read-only
+// once its init process set up its mounts, when requested by the spec. The
+// rootfs is remounted read-only by libcontainer after the submounts, which
+// stay writable, but only when the container has its own mount namespace.
+func (c *container) checkReadonlyRootfs() error {
+	if !c.config.Readonlyfs {
+		return nil
+	}
+
+	pid, err := containerInitPid(c)
+	if err != nil {
+		return grpcStatus.Errorf(codes.FailedPrecondition, "Could not get container %s init process: %v", c.id, err)
+	}
+
+	// The root of the process is the top mount of its rootfs.
+	var st unix.Statfs_t
+	if err := unix.Statfs(fmt.Sprintf(procRoot, pid), &st); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not stat container %s rootfs: %v", c.id, err)
+	}
+
+	if st.Flags&unix.ST_RDONLY == 0 {
+		return grpcStatus.Errorf(codes.FailedPrecondition, "Rootfs of container %s is not read-only", c.id)
+	}
+
+	return nil
 }
@@ -12,11 +12,21 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
 	"syscall"
 	"testing"
+	"time"
 
 	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/opencontainers/runc/libcontainer/configs"
+	mountinfo "github.com/opencontainers/runc/libcontainer/mount"
+	"github.com/opencontainers/runc/libcontainer/specconv"
+	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
 func createSafeAndFakeStorage() (pb.Storage, error) {
@@ -466,6 +476,146 @@ func TestMount(t *testing.T) {
 	}
 }
 
+func TestValidateMountSource(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpdir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpdir)
+
+	savedVirtio9pTagsPath := virtio9pTagsPath
+	savedVirtioFSTagsPath := virtioFSTagsPath
+	defer func() {
+		virtio9pTagsPath = savedVirtio9pTagsPath
+		virtioFSTagsPath = savedVirtioFSTagsPath
+	}()
+
+	virtio9pTagsPath = filepath.Join(tmpdir, "9pnet_virtio")
+	virtioFSTagsPath = filepath.Join(tmpdir, "virtiofs")
+
+	err = os.MkdirAll(filepath.Join(virtio9pTagsPath, "virtio0"), mountPerm)
+	assert.NoError(err)
+	err = ioutil.WriteFile(filepath.Join(virtio9pTagsPath, "virtio0", "mount_tag"), []byte("kataShared\x00"), testFileMode)
+	assert.NoError(err)
+
+	err = os.MkdirAll(filepath.Join(virtioFSTagsPath, "0"), mountPerm)
+	assert.NoError(err)
+	err = ioutil.WriteFile(filepath.Join(virtioFSTagsPath, "0", "tag"), []byte("myfs\n"), testFileMode)
+	assert.NoError(err)
+
+	existsFile := filepath.Join(tmpdir, "exists-file")
+	err = createEmptyFile(existsFile)
+	assert.NoError(err)
+
+	type testData struct {
+		source  string
+		fsType  string
+		flags   int
+		errCode codes.Code
+	}
+
+	data := []testData{
+		{"kataShared", type9pFs, 0, codes.OK},
+		{"unknownTag", type9pFs, 0, codes.NotFound},
+		{"myfs", typeVirtioFS, 0, codes.OK},
+		{"kataShared", typeVirtioFS, 0, codes.NotFound},
+		{"tmpfs", typeTmpFs, 0, codes.OK},
+		{existsFile, "bind", 0, codes.OK},
+		{existsFile, "", unix.MS_BIND, codes.OK},
+		{filepath.Join(tmpdir, "missing"), "bind", 0, codes.NotFound},
+		{filepath.Join(tmpdir, "missing"), "ext4", unix.MS_BIND, codes.NotFound},
+		{"/dev/does-not-exist", "ext4", 0, codes.NotFound},
+		{"/dev/null", "ext4", 0, codes.InvalidArgument},
+		{"proc", "proc", 0, codes.OK},
+	}
+
+	for i, d := range data {
+		err := validateMountSource(d.source, d.fsType, d.flags)
+		assert.Equalf(d.errCode, grpcStatus.Code(err), "test %d (%+v): %v", i, d, err)
+
+		if d.errCode != codes.OK {
+			assert.Containsf(err.Error(), d.source, "test %d (%+v)", i, d)
+		}
+	}
+
+	// Tags cannot be checked if sysfs does not expose them
+	virtio9pTagsPath = filepath.Join(tmpdir, "does-not-exist")
+	assert.NoError(validateMountSource("unknownTag", type9pFs, 0))
+}
+
+func TestUnmountWithTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	savedUnmountFunc := unmountFunc
+	savedUnmountTimeout := unmountTimeout
+	defer func() {
+		unmountFunc = savedUnmountFunc
+		unmountTimeout = savedUnmountTimeout
+	}()
+
+	unmountTimeout = 50 * time.Millisecond
+
+	release := make(chan struct{})
+	defer close(release)
+
+	var attempts []int
+	var attemptsLock sync.Mutex
+
+	// Shim blocking every unmount attempt using one of the given flags
+	blockingUnmount := func(blocked ...int) func(string, int) error {
+		return func(target string, flags int) error {
+			attemptsLock.Lock()
+			attempts = append(attempts, flags)
+			attemptsLock.Unlock()
+
+			for _, f := range blocked {
+				if f == flags {
+					<-release
+				}
+			}
+
+			return nil
+		}
+	}
+
+	type testData struct {
+		blocked          []int
+		expectedAttempts []int
+		expectError      bool
+	}
+
+	data := []testData{
+		{nil, []int{0}, false},
+		{[]int{0}, []int{0, unix.MNT_DETACH}, false},
+		{[]int{0, unix.MNT_DETACH}, []int{0, unix.MNT_DETACH, unix.MNT_DETACH | unix.MNT_FORCE}, false},
+		{[]int{0, unix.MNT_DETACH, unix.MNT_DETACH | unix.MNT_FORCE}, []int{0, unix.MNT_DETACH, unix.MNT_DETACH | unix.MNT_FORCE}, true},
+	}
+
+	for i, d := range data {
+		attempts = nil
+		unmountFunc = blockingUnmount(d.blocked...)
+
+		err := unmountWithTimeout("/foo")
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+			assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err))
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+
+		attemptsLock.Lock()
+		assert.Equal(d.expectedAttempts, attempts, "test %d (%+v)", i, d)
+		attemptsLock.Unlock()
+	}
+
+	// Errors from the unmount are returned as is
+	unmountFunc = func(target string, flags int) error {
+		return unix.EINVAL
+	}
+	err := removeMounts([]string{"/foo"})
+	assert.Equal(unix.EINVAL, err)
+}
+
 func TestMountParseMountFlagsAndOptions(t *testing.T) {
 	assert := assert.New(t)
 
@@ -679,3 +829,346 @@ func TestGetMountFSType(t *testing.T) {
 		assert.Equal(d.expectedResult, result, msg)
 	}
 }
+
+func TestParseMountStats(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedProcMountStats := procMountStats
+	defer func() {
+		procMountStats = savedProcMountStats
+	}()
+	procMountStats = filepath.Join(dir, "%s")
+
+	_, err = parseMountStats("self")
+	assert.Error(err)
+
+	content := `device /dev/sda1 mounted on / with fstype ext4
+device proc mounted on /proc with fstype proc
+no device mounted on /mnt/none with fstype tmpfs
+device kataShared mounted on /mnt/with\040space with fstype 9p
+device server:/export mounted on /mnt/nfs with fstype nfs4 statvers=1.1
+	opts:	rw,vers=4.2
+device tmpfs mounted on /proc with fstype tmpfs
+device broken mounted on /broken
+`
+	err = ioutil.WriteFile(filepath.Join(dir, "self"), []byte(content), testFileMode)
+	assert.NoError(err)
+
+	fsTypes, err := parseMountStats("self")
+	assert.NoError(err)
+	assert.Equal(map[string]string{
+		"/":               "ext4",
+		"/proc":           "tmpfs",
+		"/mnt/none":       "tmpfs",
+		"/mnt/with space": "9p",
+		"/mnt/nfs":        "nfs4",
+	}, fsTypes)
+}
+
+func TestPathReachable(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedPathReachableTimeout := pathReachableTimeout
+	defer func() {
+		pathReachableTimeout = savedPathReachableTimeout
+	}()
+	pathReachableTimeout = 100 * time.Millisecond
+
+	assert.True(pathReachable(dir))
+	assert.False(pathReachable(filepath.Join(dir, "missing")))
+
+	// The stat of a hung mount never returns
+	savedStatPath := statPath
+	defer func() {
+		statPath = savedStatPath
+	}()
+
+	hung := make(chan struct{})
+	defer close(hung)
+	statPath = func(path string) (os.FileInfo, error) {
+		<-hung
+		return nil, nil
+	}
+
+	start := time.Now()
+	assert.False(pathReachable(dir))
+	assert.True(time.Since(start) >= pathReachableTimeout)
+}
+
+func TestContainerListMounts(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedProcMountStats := procMountStats
+	savedGetGuestMounts := getGuestMounts
+	savedContainerInitPid := containerInitPid
+	defer func() {
+		procMountStats = savedProcMountStats
+		getGuestMounts = savedGetGuestMounts
+		containerInitPid = savedContainerInitPid
+	}()
+
+	procMountStats = filepath.Join(dir, "%s")
+	containerInitPid = func(ctr *container) (int, error) {
+		return 1234, nil
+	}
+
+	source1 := filepath.Join(dir, "source1")
+	source2 := filepath.Join(dir, "source2")
+	storage := filepath.Join(dir, "storage")
+	for _, d := range []string{source1, source2, storage} {
+		assert.NoError(os.MkdirAll(d, testDirMode))
+	}
+
+	containerMountStats := `device overlay mounted on / with fstype overlay
+device proc mounted on /proc with fstype proc
+device /dev/sda1 mounted on /data1 with fstype ext4
+device /dev/sda1 mounted on /data2 with fstype ext4
+`
+	err = ioutil.WriteFile(filepath.Join(dir, "1234"), []byte(containerMountStats), testFileMode)
+	assert.NoError(err)
+
+	getGuestMounts = func() ([]*mountinfo.Info, error) {
+		return []*mountinfo.Info{
+			{Mountpoint: storage, Fstype: "9p", Source: "kataShared"},
+		}, nil
+	}
+
+	ctr := &container{
+		id: "foo",
+		config: configs.Config{
+			Mounts: []*configs.Mount{
+				{Source: "proc", Destination: "/proc", Device: "proc"},
+				{Source: source1, Destination: "/data1", Device: "bind"},
+				{Source: source2, Destination: "/data2/", Device: "bind"},
+				{Source: source1, Destination: "/data3", Device: "bind"},
+			},
+		},
+		mounts: []string{storage},
+	}
+
+	// Break the source of the second bind mount
+	assert.NoError(os.RemoveAll(source2))
+
+	resp, err := ctr.listMounts()
+	assert.NoError(err)
+
+	type testData struct {
+		destination  string
+		mounted      bool
+		sourceExists bool
+		healthy      bool
+	}
+
+	data := []testData{
+		{"/proc", true, true, true},
+		{"/data1", true, true, true},
+		{"/data2/", true, false, false},
+		{"/data3", false, true, false},
+	}
+
+	assert.Len(resp.Mounts, len(data))
+	for i, d := range data {
+		m := resp.Mounts[i]
+		assert.Equal(d.destination, m.Destination, "test %d (%+v)", i, d)
+		assert.Equal(d.mounted, m.Mounted, "test %d (%+v)", i, d)
+		assert.Equal(d.sourceExists, m.SourceExists, "test %d (%+v)", i, d)
+		assert.Equal(d.healthy, m.Healthy, "test %d (%+v)", i, d)
+	}
+
+	assert.Len(resp.Storages, 1)
+	assert.Equal(&pb.ContainerMount{
+		Source:       "kataShared",
+		Destination:  storage,
+		Type:         "9p",
+		Mounted:      true,
+		SourceExists: true,
+		Healthy:      true,
+	}, resp.Storages[0])
+
+	// The init process is gone
+	containerInitPid = func(ctr *container) (int, error) {
+		return -1, fmt.Errorf("no init process")
+	}
+
+	_, err = ctr.listMounts()
+	assert.Error(err)
+}
+
+func TestCheckReadonlyRootfs(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedProcRoot := procRoot
+	savedContainerInitPid := containerInitPid
+	defer func() {
+		procRoot = savedProcRoot
+		containerInitPid = savedContainerInitPid
+	}()
+
+	procRoot = filepath.Join(dir, "%d")
+	containerInitPid = func(ctr *container) (int, error) {
+		return 1234, nil
+	}
+
+	type testData struct {
+		readonlyfs   bool
+		root         string
+		expectedCode codes.Code
+	}
+
+	data := []testData{
+		{false, "", codes.OK},
+		{true, "", codes.Internal},
+		{true, filepath.Join(dir, "1234"), codes.FailedPrecondition},
+	}
+
+	for i, d := range data {
+		if d.root != "" {
+			assert.NoError(os.MkdirAll(d.root, testDirMode), "test %d (%+v)", i, d)
+		}
+
+		ctr := &container{
+			id: "foo",
+			config: configs.Config{
+				Readonlyfs: d.readonlyfs,
+			},
+		}
+
+		err = ctr.checkReadonlyRootfs()
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v): %v", i, d, err)
+	}
+}
+
+// addGuestBinaries makes the shell of the guest runnable in a container
+// using the spec, the guest directories holding it being mounted read-only
+// in its rootfs, or linked.
+func addGuestBinaries(spec *specs.Spec) error {
+	for _, d := range []string{"/bin", "/lib", "/lib64", "/usr"} {
+		info, err := os.Lstat(d)
+		if err != nil {
+			continue
+		}
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			target, err := os.Readlink(d)
+			if err != nil {
+				return err
+			}
+			if err := os.Symlink(target, filepath.Join(spec.Root.Path, d)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		spec.Mounts = append(spec.Mounts, specs.Mount{
+			Source:      d,
+			Destination: d,
+			Type:        "bind",
+			Options:     []string{"rbind", "ro"},
+		})
+	}
+
+	return nil
+}
+
+func TestReadonlyRootfs(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	rootfs := filepath.Join(dir, "rootfs")
+	volume := filepath.Join(dir, "volume")
+	for _, d := range []string{rootfs, volume} {
+		assert.NoError(os.MkdirAll(d, testDirMode))
+	}
+
+	spec := specconv.Example()
+	spec.Root = &specs.Root{
+		Path:     rootfs,
+		Readonly: true,
+	}
+	spec.Hostname = ""
+	spec.Linux.Resources = nil
+	spec.Linux.MaskedPaths = nil
+	spec.Linux.ReadonlyPaths = nil
+	spec.Linux.Namespaces = []specs.LinuxNamespace{
+		{Type: specs.MountNamespace},
+		{Type: specs.PIDNamespace},
+	}
+
+	assert.NoError(addGuestBinaries(spec))
+
+	spec.Mounts = append(spec.Mounts, specs.Mount{
+		Source:      volume,
+		Destination: "/volume",
+		Type:        "bind",
+		Options:     []string{"rbind", "rw"},
+	})
+
+	config, err := specconv.CreateLibcontainerConfig(&specconv.CreateOpts{
+		CgroupName:   "foo",
+		NoNewKeyring: true,
+		Spec:         spec,
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	factory, err := libcontainer.New(filepath.Join(dir, "state"), libcontainer.Cgroupfs)
+	if !assert.NoError(err) {
+		return
+	}
+
+	libContainer, err := factory.Create("foo", config)
+	if !assert.NoError(err) {
+		return
+	}
+	defer libContainer.Destroy()
+
+	ctr := &container{
+		id:        "foo",
+		container: libContainer,
+		config:    *config,
+	}
+
+	proc := &libcontainer.Process{
+		Args: []string{"/bin/sh", "-c", "touch /rootfs-file; touch /volume/volume-file"},
+		Env:  []string{"PATH=/usr/bin:/bin"},
+		Cwd:  "/",
+		Init: true,
+	}
+	if !assert.NoError(libContainer.Start(proc)) {
+		return
+	}
+
+	assert.NoError(ctr.checkReadonlyRootfs())
+
+	assert.NoError(libContainer.Exec())
+	_, err = proc.Wait()
+	assert.NoError(err)
+
+	// The rootfs rejected the write, the volume accepted it
+	_, err = os.Stat(filepath.Join(rootfs, "rootfs-file"))
+	assert.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(volume, "volume-file"))
+	assert.NoError(err)
+}
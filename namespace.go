@@ -47,6 +47,12 @@ func getCurrentThreadNSPath(nType nsType) string {
 // setupPersistentNs creates persistent namespace without switchin to it.
 // Note, pid namespaces cannot be persisted.
 func setupPersistentNs(namespaceType nsType) (*namespace, error) {
+	return setupNamedPersistentNs(namespaceType, string(namespaceType))
+}
+
+// setupNamedPersistentNs creates a persistent namespace under the given name
+// in persistentNsDir, without switching to it.
+func setupNamedPersistentNs(namespaceType nsType, name string) (*namespace, error) {
 
 	err := os.MkdirAll(persistentNsDir, 0755)
 	if err != nil {
@@ -54,7 +60,7 @@ func setupPersistentNs(namespaceType nsType) (*namespace, error) {
 	}
 
 	// Create an empty file at the mount point.
-	nsPath := filepath.Join(persistentNsDir, string(namespaceType))
+	nsPath := filepath.Join(persistentNsDir, name)
 
 	mountFd, err := os.Create(nsPath)
 	if err != nil {
@@ -104,3 +110,39 @@ func setupPersistentNs(namespaceType nsType) (*namespace, error) {
 
 	return &namespace{path: nsPath}, nil
 }
+
+// run calls fn from a thread joined to the persistent namespace. The thread
+// is never switched back, it exits along with its locked goroutine.
+func (ns *namespace) run(namespaceType nsType, fn func() error) error {
+	errCh := make(chan error, 1)
+
+	go func() {
+		runtime.LockOSThread()
+
+		nsFd, err := os.Open(ns.path)
+		if err != nil {
+			errCh <- err
+			return
+		}
+		defer nsFd.Close()
+
+		if err := unix.Setns(int(nsFd.Fd()), cloneFlagsTable[namespaceType]); err != nil {
+			errCh <- err
+			return
+		}
+
+		errCh <- fn()
+	}()
+
+	return <-errCh
+}
+
+// remove unmounts the persistent namespace and removes its mount point. The
+// namespace lives on as long as it is used.
+func (ns *namespace) remove() error {
+	if err := unix.Unmount(ns.path, unix.MNT_DETACH); err != nil {
+		return err
+	}
+
+	return os.Remove(ns.path)
+}
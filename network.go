@@ -8,18 +8,22 @@ package main
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net"
 	"os"
 	"path/filepath"
 	"reflect"
+	"regexp"
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"golang.org/x/sys/unix"
 
 	"github.com/kata-containers/agent/pkg/types"
 	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/sirupsen/logrus"
 	"github.com/vishvananda/netlink"
 	"google.golang.org/grpc/codes"
@@ -34,8 +38,17 @@ var (
 	errNoRoutes             = grpcStatus.Errorf(codes.InvalidArgument, "Need network routes")
 	guestDNSFile            = "/etc/resolv.conf"
 	kataGuestSandboxDNSFile = "/run/kata-containers/sandbox/resolv.conf"
+
+	// Directory holding the per-container resolv.conf files
+	kataGuestContainerDNSDir = "/run/kata-containers/dns"
 )
 
+// Path of the resolv.conf inside the containers
+const containerDNSFile = "/etc/resolv.conf"
+
+// Container IDs accepted by libcontainer.
+var containerIDRegex = regexp.MustCompile(`^[\w+-\.]+$`)
+
 const (
 	// ipvlan plugin adds a route of the format "default dev eth0 scope link"
 	// Here since source, dest and gateway are empty, netlink will complain.
@@ -46,8 +59,39 @@ const (
 
 	// Use the below address for ipv6 gateway once ipv6 support is added
 	// defaultV6RouteIP = "::"
+
+	// Routing table and rule priority assigned to the first interface of
+	// a source based routing setup, when not explicitly requested.
+	sourceRoutingBaseTable    = 100
+	sourceRoutingBasePriority = 1000
 )
 
+// Policies followed when the name requested for an interface is used by
+// another interface of the guest.
+const (
+	// The update fails, leaving both interfaces untouched.
+	ifaceNameCollisionError = "error"
+	// The other interface is set down, renamed to a temporary name and
+	// no longer tracked.
+	ifaceNameCollisionRename = "rename"
+)
+
+var ifaceNameCollisionPolicies = map[string]bool{
+	ifaceNameCollisionError:  true,
+	ifaceNameCollisionRename: true,
+}
+
+// Prefix of the temporary name of an interface whose name is requested for
+// another one, followed by its index.
+const collidingIfacePrefix = "kata-old"
+
+// Priorities of the local, main and default rules set up by the kernel.
+var reservedRulePriorities = map[int]bool{
+	0:     true,
+	32766: true,
+	32767: true,
+}
+
 // Network fully describes a sandbox network with its interfaces, routes and dns
 // related information.
 type network struct {
@@ -57,7 +101,16 @@ type network struct {
 	routesLock sync.Mutex
 	routes     []types.Route
 
-	dns []string
+	// Rules and routes installed for source based routing.
+	sourceRules  []netlink.Rule
+	sourceRoutes []netlink.Route
+
+	dns     []string
+	dnsStub string
+
+	// Set once the transparent proxy rules are installed.
+	proxyLock      sync.Mutex
+	proxyInstalled bool
 }
 
 ////////////////
@@ -139,11 +192,6 @@ func updateLink(netHandle *netlink.Handle, link netlink.Link, iface *types.Inter
 		}
 	}
 
-	// set the interface name:
-	if err := netHandle.LinkSetName(link, iface.Name); err != nil {
-		return grpcStatus.Errorf(codes.Internal, "Could not set name %s for interface %v: %v", iface.Name, link, err)
-	}
-
 	// set the interface MTU:
 	if err := netHandle.LinkSetMTU(link, int(iface.Mtu)); err != nil {
 		return grpcStatus.Errorf(codes.Internal, "Could not set MTU %d for interface %v: %v", iface.Mtu, link, err)
@@ -160,6 +208,87 @@ func updateLink(netHandle *netlink.Handle, link netlink.Link, iface *types.Inter
 	return nil
 }
 
+// setLinkName renames the link. The name being used by another link is
+// handled according to ifaceNameCollisionPolicy, the other link being given
+// back its name and state if the link cannot be renamed. It returns whether
+// the other link was renamed out of the way.
+func setLinkName(netHandle *netlink.Handle, link netlink.Link, name string) (bool, error) {
+	other, err := netHandle.LinkByName(name)
+	if err != nil {
+		if _, ok := err.(netlink.LinkNotFoundError); !ok {
+			return false, grpcStatus.Errorf(codes.Internal, "Could not look up interface %s: %v", name, err)
+		}
+		other = nil
+	}
+
+	if other != nil {
+		if other.Attrs().Index == link.Attrs().Index {
+			return false, nil
+		}
+
+		if ifaceNameCollisionPolicy != ifaceNameCollisionRename {
+			return false, grpcStatus.Errorf(codes.AlreadyExists, "Interface name %s already used by interface %d", name, other.Attrs().Index)
+		}
+	}
+
+	var otherUp bool
+	if other != nil {
+		tmpName := fmt.Sprintf("%s%d", collidingIfacePrefix, other.Attrs().Index)
+
+		// The kernel only renames the links down.
+		otherUp = other.Attrs().Flags&net.FlagUp != 0
+		if otherUp {
+			if err := netHandle.LinkSetDown(other); err != nil {
+				return false, grpcStatus.Errorf(codes.Internal, "Could not set interface %s down: %v", name, err)
+			}
+		}
+
+		if err := netHandle.LinkSetName(other, tmpName); err != nil {
+			if otherUp {
+				netHandle.LinkSetUp(other)
+			}
+			return false, grpcStatus.Errorf(codes.Internal, "Could not rename interface %s to %s: %v", name, tmpName, err)
+		}
+
+		agentLog.WithFields(logrus.Fields{
+			"interface-name": name,
+			"renamed-to":     tmpName,
+		}).Warn("Interface name requested for another interface, renamed it")
+	}
+
+	if err := netHandle.LinkSetName(link, name); err != nil {
+		if other != nil {
+			if restoreErr := netHandle.LinkSetName(other, name); restoreErr != nil {
+				agentLog.WithError(restoreErr).WithField("interface-name", name).Warn("Could not restore interface name")
+			} else if otherUp {
+				netHandle.LinkSetUp(other)
+			}
+		}
+		return false, grpcStatus.Errorf(codes.Internal, "Could not set name %s for interface %v: %v", name, link, err)
+	}
+
+	return other != nil, nil
+}
+
+// untrackInterface forgets the interface and the routes tracked under the
+// name, which was taken over by another interface. The renamed interface
+// is left down, its routes being removed by the kernel, and is no longer
+// managed by the agent. Must be called with ifacesLock held.
+func (s *sandbox) untrackInterface(name string) {
+	delete(s.network.ifaces, name)
+
+	s.network.routesLock.Lock()
+	defer s.network.routesLock.Unlock()
+
+	routes := s.network.routes[:0]
+	for _, route := range s.network.routes {
+		if route.Device != name {
+			routes = append(routes, route)
+		}
+	}
+	s.network.routes = routes
+}
+
 func (s *sandbox) removeInterface(netHandle *netlink.Handle, iface *types.Interface) (resultingIfc *types.Interface, err error) {
 	if iface == nil {
 		return nil, errNoIF
@@ -227,8 +356,7 @@ func (s *sandbox) updateInterface(netHandle *netlink.Handle, iface *types.Interf
 	// to be available first
 	if iface.PciAddr != "" {
 		// iface.PciAddr is in the format bridgeAddr/deviceAddr eg. 05/06
-		_, err := getPCIDeviceName(s, iface.PciAddr)
-		if err != nil {
+		if err := waitPCIDeviceReady(s, iface.PciAddr); err != nil {
 			return nil, err
 		}
 	}
@@ -262,6 +390,18 @@ func (s *sandbox) updateInterface(netHandle *netlink.Handle, iface *types.Interf
 		if err == nil {
 			err = retErr
 		}
+
+		// The queues can only be set once the link is up.
+		if err == nil && iface.Queues > 0 {
+			err = updateLinkQueues(netHandle, link, iface.Queues)
+		}
+
+		if err == nil {
+			if s.network.ifaces == nil {
+				s.network.ifaces = make(map[string]*types.Interface)
+			}
+			s.network.ifaces[iface.Name] = iface
+		}
 	}()
 
 	fieldLogger.WithField("link", fmt.Sprintf("%+v", link)).Info("Link found")
@@ -275,12 +415,43 @@ func (s *sandbox) updateInterface(netHandle *netlink.Handle, iface *types.Interf
 		}
 	}
 
+	// The name is set first, a collision leaving the link untouched.
+	renamedOther, err := setLinkName(netHandle, link, iface.Name)
+	if err != nil {
+		return
+	}
+
+	if renamedOther {
+		s.untrackInterface(iface.Name)
+	}
+
 	err = updateLink(netHandle, link, iface)
 
 	return
 
 }
 
+// updateLinkQueues sets the number of combined queues of a multi-queue
+// link, looking it up again as it may have been renamed.
+func updateLinkQueues(netHandle *netlink.Handle, link netlink.Link, queues uint32) error {
+	link, err := netHandle.LinkByIndex(link.Attrs().Index)
+	if err != nil {
+		return err
+	}
+
+	count, err := setLinkQueues(link.Attrs().Name, queues)
+	if err != nil {
+		return err
+	}
+
+	agentLog.WithFields(logrus.Fields{
+		"interface": link.Attrs().Name,
+		"queues":    count,
+	}).Info("Link queues set")
+
+	return nil
+}
+
 // getInterface will retrieve interface details from the provided link
 func getInterface(netHandle *netlink.Handle, link netlink.Link) (*types.Interface, error) {
 	if netHandle == nil {
@@ -341,6 +512,73 @@ func (s *sandbox) listInterfaces(netHandle *netlink.Handle) (*pb.Interfaces, err
 	return &interfaces, nil
 }
 
+// Types of the links stacked on a lower link, whose traffic is also
+// accounted for by the lower link.
+var stackedLinkTypes = map[string]bool{
+	"ipvlan":  true,
+	"macvlan": true,
+	"macvtap": true,
+	"vlan":    true,
+}
+
+// networkStats sums the counters of the sandbox interfaces. The containers
+// sharing the sandbox network namespace, its interfaces are only counted
+// once, unlike when summing the statistics of the containers.
+func (s *sandbox) networkStats(netHandle *netlink.Handle) (*pb.NetworkStats, error) {
+	var err error
+	if netHandle == nil {
+		netHandle, err = netlink.NewHandle(unix.NETLINK_ROUTE)
+		if err != nil {
+			return nil, err
+		}
+		defer netHandle.Delete()
+	}
+
+	links, err := netHandle.LinkList()
+	if err != nil {
+		return nil, err
+	}
+
+	return sumLinkStats(links), nil
+}
+
+// sumLinkStats sums the counters of the links, skipping the loopback, whose
+// traffic never leaves the sandbox, and the links stacked on another one.
+func sumLinkStats(links []netlink.Link) *pb.NetworkStats {
+	indexes := make(map[int]bool)
+	for _, link := range links {
+		indexes[link.Attrs().Index] = true
+	}
+
+	stats := &pb.NetworkStats{}
+	counted := make(map[int]bool)
+
+	for _, link := range links {
+		attrs := link.Attrs()
+
+		if counted[attrs.Index] || attrs.Statistics == nil || attrs.Flags&net.FlagLoopback != 0 {
+			continue
+		}
+
+		if stackedLinkTypes[link.Type()] && indexes[attrs.ParentIndex] {
+			continue
+		}
+
+		counted[attrs.Index] = true
+
+		stats.RxBytes += attrs.Statistics.RxBytes
+		stats.RxPackets += attrs.Statistics.RxPackets
+		stats.RxErrors += attrs.Statistics.RxErrors
+		stats.RxDropped += attrs.Statistics.RxDropped
+		stats.TxBytes += attrs.Statistics.TxBytes
+		stats.TxPackets += attrs.Statistics.TxPackets
+		stats.TxErrors += attrs.Statistics.TxErrors
+		stats.TxDropped += attrs.Statistics.TxDropped
+	}
+
+	return stats
+}
+
 ////////////
 // Routes //
 ////////////
@@ -609,6 +847,178 @@ func (s *sandbox) updateRoute(netHandle *netlink.Handle, route *types.Route, add
 	return nil
 }
 
+//////////////////////////
+// Source based routing //
+//////////////////////////
+
+// buildSourceRouting assigns a routing table and a rule priority to each of
+// the interfaces which don't have them set, and validates that they don't
+// collide with each other or with the priorities of the existing rules.
+func buildSourceRouting(ifaces []*pb.SourceRoutingInterface, existingRules []netlink.Rule) ([]*pb.SourceRoutingInterface, error) {
+	usedPriorities := make(map[int]string)
+	for _, rule := range existingRules {
+		usedPriorities[rule.Priority] = "existing rule"
+	}
+
+	usedTables := make(map[uint32]string)
+
+	var result []*pb.SourceRoutingInterface
+	for idx, iface := range ifaces {
+		if iface == nil || iface.Device == "" {
+			return nil, errNoIF
+		}
+
+		if _, _, err := net.ParseCIDR(iface.Subnet); err != nil {
+			return nil, grpcStatus.Errorf(codes.InvalidArgument, "Invalid subnet %q for interface %s: %v", iface.Subnet, iface.Device, err)
+		}
+
+		if iface.Gateway != "" && net.ParseIP(iface.Gateway) == nil {
+			return nil, grpcStatus.Errorf(codes.InvalidArgument, "Invalid gateway %q for interface %s", iface.Gateway, iface.Device)
+		}
+
+		r := *iface
+		if r.Table == 0 {
+			r.Table = uint32(sourceRoutingBaseTable + idx)
+		}
+		if r.Priority == 0 {
+			r.Priority = uint32(sourceRoutingBasePriority + idx)
+		}
+
+		if r.Table >= unix.RT_TABLE_COMPAT {
+			return nil, grpcStatus.Errorf(codes.InvalidArgument, "Reserved routing table %d for interface %s", r.Table, r.Device)
+		}
+
+		if other, ok := usedTables[r.Table]; ok {
+			return nil, grpcStatus.Errorf(codes.InvalidArgument, "Routing table %d of interface %s already used by %s", r.Table, r.Device, other)
+		}
+
+		priority := int(r.Priority)
+		if reservedRulePriorities[priority] {
+			return nil, grpcStatus.Errorf(codes.InvalidArgument, "Reserved rule priority %d for interface %s", priority, r.Device)
+		}
+
+		if other, ok := usedPriorities[priority]; ok {
+			return nil, grpcStatus.Errorf(codes.AlreadyExists, "Rule priority %d of interface %s collides with %s", priority, r.Device, other)
+		}
+
+		usedTables[r.Table] = r.Device
+		usedPriorities[priority] = r.Device
+		result = append(result, &r)
+	}
+
+	return result, nil
+}
+
+// deleteSourceRouting removes the rules and routes previously installed for
+// source based routing.
+func (s *sandbox) deleteSourceRouting(netHandle *netlink.Handle) error {
+	for len(s.network.sourceRules) > 0 {
+		rule := s.network.sourceRules[0]
+		if err := netHandle.RuleDel(&rule); err != nil && err != unix.ENOENT {
+			return grpcStatus.Errorf(codes.Internal, "Could not remove rule %v: %v", rule, err)
+		}
+		s.network.sourceRules = s.network.sourceRules[1:]
+	}
+
+	// Routes are gone already if their link has been removed
+	for len(s.network.sourceRoutes) > 0 {
+		route := s.network.sourceRoutes[0]
+		if err := netHandle.RouteDel(&route); err != nil && err != unix.ESRCH && err != unix.ENODEV {
+			return grpcStatus.Errorf(codes.Internal, "Could not remove route %v: %v", route, err)
+		}
+		s.network.sourceRoutes = s.network.sourceRoutes[1:]
+	}
+
+	return nil
+}
+
+// updateSourceRouting replaces the source based routing setup with one
+// routing table and one "from" rule per requested interface, so that the
+// traffic coming from the subnet of an interface egresses through it.
+func (s *sandbox) updateSourceRouting(netHandle *netlink.Handle, ifaces []*pb.SourceRoutingInterface) (result *pb.SourceRouting, err error) {
+	s.network.routesLock.Lock()
+	defer s.network.routesLock.Unlock()
+
+	if netHandle == nil {
+		netHandle, err = netlink.NewHandle(unix.NETLINK_ROUTE)
+		if err != nil {
+			return nil, err
+		}
+		defer netHandle.Delete()
+	}
+
+	if err = s.deleteSourceRouting(netHandle); err != nil {
+		return nil, err
+	}
+
+	existingRules, err := netHandle.RuleList(netlink.FAMILY_ALL)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := buildSourceRouting(ifaces, existingRules)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, iface := range resolved {
+		link, err := netHandle.LinkByName(iface.Device)
+		if err != nil {
+			return nil, grpcStatus.Errorf(codes.NotFound, "Could not find link from device %s: %v", iface.Device, err)
+		}
+
+		_, subnet, _ := net.ParseCIDR(iface.Subnet)
+
+		routes := []netlink.Route{
+			{
+				LinkIndex: link.Attrs().Index,
+				Dst:       subnet,
+				Scope:     netlink.SCOPE_LINK,
+				Table:     int(iface.Table),
+			},
+		}
+
+		if iface.Gateway != "" {
+			routes = append(routes, netlink.Route{
+				LinkIndex: link.Attrs().Index,
+				Gw:        net.ParseIP(iface.Gateway),
+				Table:     int(iface.Table),
+			})
+		}
+
+		for _, route := range routes {
+			if err := netHandle.RouteAdd(&route); err != nil {
+				return nil, grpcStatus.Errorf(codes.Internal, "Could not add route %v to table %d: %v", route, iface.Table, err)
+			}
+			s.network.sourceRoutes = append(s.network.sourceRoutes, route)
+		}
+
+		rule := netlink.NewRule()
+		rule.Src = subnet
+		rule.Table = int(iface.Table)
+		rule.Priority = int(iface.Priority)
+		if subnet.IP.To4() == nil {
+			rule.Family = netlink.FAMILY_V6
+		} else {
+			rule.Family = netlink.FAMILY_V4
+		}
+
+		if err := netHandle.RuleAdd(rule); err != nil {
+			return nil, grpcStatus.Errorf(codes.Internal, "Could not add rule %v: %v", rule, err)
+		}
+		s.network.sourceRules = append(s.network.sourceRules, *rule)
+
+		agentLog.WithFields(logrus.Fields{
+			"device":   iface.Device,
+			"subnet":   iface.Subnet,
+			"table":    iface.Table,
+			"priority": iface.Priority,
+		}).Debug("Source based routing set up")
+	}
+
+	return &pb.SourceRouting{Interfaces: resolved}, nil
+}
+
 /////////
 // DNS //
 /////////
@@ -644,6 +1054,98 @@ func setupDNS(dns []string) (err error) {
 	return mount(kataGuestSandboxDNSFile, guestDNSFile, "bind", syscall.MS_BIND, "")
 }
 
+// containerResolvConf builds the content of a container resolv.conf using
+// the sandbox DNS stub as the only nameserver.
+func containerResolvConf(stub string, search, options []string) ([]byte, error) {
+	for _, entry := range append(append([]string{}, search...), options...) {
+		if entry == "" || strings.ContainsAny(entry, " \t\n") {
+			return nil, grpcStatus.Errorf(codes.InvalidArgument, "Invalid DNS search domain or option %q", entry)
+		}
+	}
+
+	content := fmt.Sprintf("nameserver %s\n", stub)
+	if len(search) > 0 {
+		content += fmt.Sprintf("search %s\n", strings.Join(search, " "))
+	}
+	if len(options) > 0 {
+		content += fmt.Sprintf("options %s\n", strings.Join(options, " "))
+	}
+
+	return []byte(content), nil
+}
+
+// setupContainerDNS writes a resolv.conf dedicated to the container when the
+// sandbox runs a DNS stub resolver, and mounts it over the container
+// /etc/resolv.conf in place of the sandbox one.
+func (s *sandbox) setupContainerDNS(spec *specs.Spec, containerID string, search, options []string) error {
+	if s.network.dnsStub == "" {
+		if len(search) > 0 || len(options) > 0 {
+			agentLog.WithField("container", containerID).Warn("Ignoring DNS search domains and options, no DNS stub configured")
+		}
+		return nil
+	}
+
+	content, err := containerResolvConf(s.network.dnsStub, search, options)
+	if err != nil {
+		return err
+	}
+
+	dir, err := containerDNSDir(containerID)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not create DNS directory of container %s: %v", containerID, err)
+	}
+
+	file := filepath.Join(dir, filepath.Base(containerDNSFile))
+	if err := ioutil.WriteFile(file, content, 0644); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not write resolv.conf of container %s: %v", containerID, err)
+	}
+
+	var mounts []specs.Mount
+	for _, m := range spec.Mounts {
+		if filepath.Clean(m.Destination) != containerDNSFile {
+			mounts = append(mounts, m)
+		}
+	}
+
+	spec.Mounts = append(mounts, specs.Mount{
+		Destination: containerDNSFile,
+		Source:      file,
+		Type:        "bind",
+		Options:     []string{"rbind", "ro"},
+	})
+
+	return nil
+}
+
+// removeContainerDNS removes the resolv.conf dedicated to the container.
+func removeContainerDNS(containerID string) error {
+	dir, err := containerDNSDir(containerID)
+	if err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not remove DNS directory of container %s: %v", containerID, err)
+	}
+
+	return nil
+}
+
+// containerDNSDir returns the directory holding the resolv.conf dedicated
+// to the container. The container ID is validated as libcontainer does, not
+// to escape the DNS directory.
+func containerDNSDir(containerID string) (string, error) {
+	if !containerIDRegex.MatchString(containerID) || containerID == "." || containerID == ".." {
+		return "", grpcStatus.Errorf(codes.InvalidArgument, "Invalid container ID %q", containerID)
+	}
+
+	return filepath.Join(kataGuestContainerDNSDir, containerID), nil
+}
+
 ////////////
 // Global //
 ////////////
@@ -656,6 +1158,13 @@ func (s *sandbox) removeNetwork() error {
 	}
 	defer netHandle.Delete()
 
+	s.network.routesLock.Lock()
+	err = s.deleteSourceRouting(netHandle)
+	s.network.routesLock.Unlock()
+	if err != nil {
+		return err
+	}
+
 	for _, iface := range s.network.ifaces {
 		if _, err := s.removeInterface(netHandle, iface); err != nil {
 			return grpcStatus.Errorf(codes.Internal, "Could not remove network interface %v: %v",
@@ -684,3 +1193,77 @@ func (s *sandbox) handleLocalhost() error {
 
 	return netlink.LinkSetUp(lo)
 }
+
+// Interval between two checks of the sandbox network readiness.
+var networkReadyPollInterval = 100 * time.Millisecond
+
+// isNetworkReady returns true once the sandbox network is configured: the
+// interfaces configured through UpdateInterface are up and a default route
+// is present. The other interfaces, such as the ones created by the
+// containers, are ignored. Overridden in unit tests.
+var isNetworkReady = func(s *sandbox) (bool, error) {
+	s.network.ifacesLock.Lock()
+	var names []string
+	for name := range s.network.ifaces {
+		names = append(names, name)
+	}
+	s.network.ifacesLock.Unlock()
+
+	if len(names) == 0 {
+		return false, nil
+	}
+
+	netHandle, err := netlink.NewHandle(unix.NETLINK_ROUTE)
+	if err != nil {
+		return false, err
+	}
+	defer netHandle.Delete()
+
+	for _, name := range names {
+		link, err := netHandle.LinkByName(name)
+		if err != nil {
+			if _, ok := err.(netlink.LinkNotFoundError); ok {
+				return false, nil
+			}
+			return false, err
+		}
+		if link.Attrs().Flags&net.FlagUp == 0 {
+			return false, nil
+		}
+	}
+
+	routes, err := netHandle.RouteList(nil, netlink.FAMILY_ALL)
+	if err != nil {
+		return false, err
+	}
+
+	for _, route := range routes {
+		if route.Dst == nil {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// waitNetworkReady waits for the sandbox network to be configured by the
+// runtime through UpdateInterface and UpdateRoutes.
+func (s *sandbox) waitNetworkReady(timeout time.Duration) error {
+	deadline := time.After(timeout)
+
+	for {
+		ready, err := isNetworkReady(s)
+		if err != nil {
+			return err
+		}
+		if ready {
+			return nil
+		}
+
+		select {
+		case <-deadline:
+			return grpcStatus.Errorf(codes.DeadlineExceeded, "Timeout reached after %s waiting for the sandbox network", timeout)
+		case <-time.After(networkReadyPollInterval):
+		}
+	}
+}
@@ -7,19 +7,26 @@
 package main
 
 import (
+	"fmt"
 	"io/ioutil"
 	"net"
 	"os"
+	"path/filepath"
 	"reflect"
 	"runtime"
+	"sort"
 	"strings"
 	"testing"
 
 	"github.com/kata-containers/agent/pkg/types"
 	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/vishvananda/netlink"
 	"github.com/vishvananda/netns"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 )
 
 func TestUpdateRemoveInterface(t *testing.T) {
@@ -111,18 +118,135 @@ func setupNetworkTest(t *testing.T) teardownNetworkTest {
 	// new temporary namespace so we don't pollute the host
 	// lock thread since the namespace is thread local
 	runtime.LockOSThread()
-	var err error
+	origNs, err := netns.Get()
+	if err != nil {
+		t.Fatal("Failed to get current netns", err)
+	}
+
 	ns, err := netns.New()
 	if err != nil {
 		t.Fatal("Failed to create newns", ns)
 	}
 
+	// The thread goes back to the original namespace before being
+	// unlocked, not to leave other tests running in the temporary one.
 	return func() {
+		netns.Set(origNs)
+		origNs.Close()
 		ns.Close()
 		runtime.UnlockOSThread()
 	}
 }
 
+func TestUpdateInterfaceNameCollision(t *testing.T) {
+	tearDown := setupNetworkTest(t)
+	defer tearDown()
+
+	assert := assert.New(t)
+
+	savedPolicy := ifaceNameCollisionPolicy
+	defer func() {
+		ifaceNameCollisionPolicy = savedPolicy
+	}()
+
+	netHandle, err := netlink.NewHandle()
+	assert.NoError(err)
+	defer netHandle.Delete()
+
+	// The hotplugged interface, and the one using the requested name
+	link := &netlink.Veth{
+		LinkAttrs: netlink.LinkAttrs{
+			Name:         "hotplugged",
+			HardwareAddr: net.HardwareAddr{0x02, 0x00, 0xCA, 0xFE, 0x00, 0x48},
+		},
+		PeerName: "eth0",
+	}
+	assert.NoError(netHandle.LinkAdd(link))
+
+	other, err := netHandle.LinkByName("eth0")
+	assert.NoError(err)
+	assert.NoError(netHandle.LinkSetUp(other))
+
+	// The interface and routes tracked for the other interface
+	otherIfc := &types.Interface{Device: "eth0", Name: "eth0"}
+	otherRoute := types.Route{Dest: "10.0.0.0/24", Device: "eth0"}
+	loRoute := types.Route{Dest: "127.0.0.0/8", Device: "lo"}
+
+	s := sandbox{
+		network: network{
+			ifaces: map[string]*types.Interface{"eth0": otherIfc},
+			routes: []types.Route{otherRoute, loRoute},
+		},
+	}
+	ifc := &types.Interface{
+		Name:   "eth0",
+		Mtu:    1500,
+		HwAddr: "02:00:ca:fe:00:48",
+		IPAddresses: []*types.IPAddress{
+			{Address: "192.168.0.101", Mask: "24"},
+		},
+	}
+
+	linkNames := func() []string {
+		links, err := netHandle.LinkList()
+		assert.NoError(err)
+
+		var names []string
+		for _, l := range links {
+			names = append(names, l.Attrs().Name)
+		}
+		sort.Strings(names)
+
+		return names
+	}
+
+	// Both interfaces are left untouched
+	ifaceNameCollisionPolicy = ifaceNameCollisionError
+	_, err = s.updateInterface(netHandle, ifc)
+	assert.Equal(codes.AlreadyExists, grpcStatus.Code(err))
+	assert.Equal([]string{"eth0", "hotplugged", "lo"}, linkNames())
+
+	addrs, err := netlink.AddrList(link, netlink.FAMILY_V4)
+	assert.NoError(err)
+	assert.Empty(addrs)
+
+	assert.Equal(map[string]*types.Interface{"eth0": otherIfc}, s.network.ifaces)
+	assert.Equal([]types.Route{otherRoute, loRoute}, s.network.routes)
+
+	// The other interface is renamed out of the way
+	ifaceNameCollisionPolicy = ifaceNameCollisionRename
+	resultingIfc, err := s.updateInterface(netHandle, ifc)
+	assert.NoError(err)
+	assert.Equal(ifc, resultingIfc)
+
+	renamed := fmt.Sprintf("%s%d", collidingIfacePrefix, other.Attrs().Index)
+	assert.Equal([]string{"eth0", renamed, "lo"}, linkNames())
+
+	updated, err := netHandle.LinkByName("eth0")
+	assert.NoError(err)
+	assert.Equal(link.Attrs().HardwareAddr, updated.Attrs().HardwareAddr)
+
+	addrs, err = netlink.AddrList(updated, netlink.FAMILY_V4)
+	assert.NoError(err)
+	if assert.Len(addrs, 1) {
+		assert.Equal("192.168.0.101/24", addrs[0].IPNet.String())
+	}
+
+	other, err = netHandle.LinkByName(renamed)
+	assert.NoError(err)
+	assert.Zero(other.Attrs().Flags & net.FlagUp)
+
+	// The renamed interface is no longer tracked, the updated one is
+	assert.Equal(map[string]*types.Interface{"eth0": ifc}, s.network.ifaces)
+	assert.Equal([]types.Route{loRoute}, s.network.routes)
+
+	// Updating the interface again is not a collision
+	ifaceNameCollisionPolicy = ifaceNameCollisionError
+	_, err = s.updateInterface(netHandle, ifc)
+	assert.NoError(err)
+	assert.Equal([]string{"eth0", renamed, "lo"}, linkNames())
+}
+
 func TestUpdateRoutes(t *testing.T) {
 	tearDown := setupNetworkTest(t)
 	defer tearDown()
@@ -426,6 +550,188 @@ func TestListRoutesWithTwoInterfacesSameSubnet(t *testing.T) {
 
 }
 
+func TestBuildSourceRouting(t *testing.T) {
+	assert := assert.New(t)
+
+	existingRules := []netlink.Rule{
+		{Priority: 0, Table: unix.RT_TABLE_LOCAL},
+		{Priority: 500, Table: 50},
+		{Priority: 32766, Table: unix.RT_TABLE_MAIN},
+		{Priority: 32767, Table: unix.RT_TABLE_DEFAULT},
+	}
+
+	type testData struct {
+		ifaces      []*pb.SourceRoutingInterface
+		expected    []*pb.SourceRoutingInterface
+		expectError bool
+	}
+
+	data := []testData{
+		{nil, nil, false},
+		{[]*pb.SourceRoutingInterface{{Subnet: "10.0.0.0/24"}}, nil, true},
+		{[]*pb.SourceRoutingInterface{{Device: "eth0", Subnet: "10.0.0.1"}}, nil, true},
+		{[]*pb.SourceRoutingInterface{{Device: "eth0", Subnet: "10.0.0.0/24", Gateway: "foo"}}, nil, true},
+		{
+			[]*pb.SourceRoutingInterface{
+				{Device: "eth0", Subnet: "10.0.0.0/24", Gateway: "10.0.0.1"},
+				{Device: "eth1", Subnet: "10.1.0.0/24", Gateway: "10.1.0.1"},
+			},
+			[]*pb.SourceRoutingInterface{
+				{Device: "eth0", Subnet: "10.0.0.0/24", Gateway: "10.0.0.1", Table: 100, Priority: 1000},
+				{Device: "eth1", Subnet: "10.1.0.0/24", Gateway: "10.1.0.1", Table: 101, Priority: 1001},
+			},
+			false,
+		},
+		{
+			[]*pb.SourceRoutingInterface{
+				{Device: "eth0", Subnet: "10.0.0.0/24", Table: 10, Priority: 20},
+				{Device: "eth1", Subnet: "10.1.0.0/24"},
+			},
+			[]*pb.SourceRoutingInterface{
+				{Device: "eth0", Subnet: "10.0.0.0/24", Table: 10, Priority: 20},
+				{Device: "eth1", Subnet: "10.1.0.0/24", Table: 101, Priority: 1001},
+			},
+			false,
+		},
+		// priority collision between interfaces
+		{
+			[]*pb.SourceRoutingInterface{
+				{Device: "eth0", Subnet: "10.0.0.0/24"},
+				{Device: "eth1", Subnet: "10.1.0.0/24", Priority: 1000},
+			},
+			nil,
+			true,
+		},
+		// priority collision with an existing rule
+		{[]*pb.SourceRoutingInterface{{Device: "eth0", Subnet: "10.0.0.0/24", Priority: 500}}, nil, true},
+		// reserved priority
+		{[]*pb.SourceRoutingInterface{{Device: "eth0", Subnet: "10.0.0.0/24", Priority: 32766}}, nil, true},
+		// table collision between interfaces
+		{
+			[]*pb.SourceRoutingInterface{
+				{Device: "eth0", Subnet: "10.0.0.0/24", Table: 101},
+				{Device: "eth1", Subnet: "10.1.0.0/24"},
+			},
+			nil,
+			true,
+		},
+		// reserved table
+		{[]*pb.SourceRoutingInterface{{Device: "eth0", Subnet: "10.0.0.0/24", Table: unix.RT_TABLE_MAIN}}, nil, true},
+	}
+
+	for i, d := range data {
+		result, err := buildSourceRouting(d.ifaces, existingRules)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expected, result, "test %d (%+v)", i, d)
+	}
+}
+
+func TestUpdateSourceRouting(t *testing.T) {
+	tearDown := setupNetworkTest(t)
+	defer tearDown()
+
+	assert := assert.New(t)
+
+	s := sandbox{}
+
+	netHandle, err := netlink.NewHandle()
+	assert.NoError(err)
+	defer netHandle.Delete()
+
+	// Two interfaces on different subnets, as on a multi-homed guest
+	for i, name := range []string{"eth-a", "eth-b"} {
+		link := &netlink.Veth{
+			LinkAttrs: netlink.LinkAttrs{Name: name},
+			PeerName:  name + "-peer",
+		}
+		if err := netHandle.LinkAdd(link); err != nil {
+			t.Skipf("Could not create veth link: %v", err)
+		}
+		assert.NoError(netHandle.LinkSetUp(link))
+
+		addr, err := netlink.ParseAddr(fmt.Sprintf("10.%d.0.2/24", i))
+		assert.NoError(err)
+		assert.NoError(netHandle.AddrAdd(link, addr))
+	}
+
+	ifaces := []*pb.SourceRoutingInterface{
+		{Device: "eth-a", Subnet: "10.0.0.0/24", Gateway: "10.0.0.1"},
+		{Device: "eth-b", Subnet: "10.1.0.0/24", Gateway: "10.1.0.1"},
+	}
+
+	checkSetup := func(expected []*pb.SourceRoutingInterface) {
+		rules, err := netHandle.RuleList(netlink.FAMILY_V4)
+		assert.NoError(err)
+
+		for _, iface := range expected {
+			found := false
+			for _, rule := range rules {
+				if rule.Priority == int(iface.Priority) {
+					assert.Equal(int(iface.Table), rule.Table)
+					assert.Equal(iface.Subnet, rule.Src.String())
+					found = true
+				}
+			}
+			assert.True(found, "no rule for %+v", iface)
+
+			link, err := netHandle.LinkByName(iface.Device)
+			assert.NoError(err)
+
+			routes, err := netHandle.RouteListFiltered(netlink.FAMILY_V4,
+				&netlink.Route{Table: int(iface.Table)}, netlink.RT_FILTER_TABLE)
+			assert.NoError(err)
+			assert.Len(routes, 2)
+
+			for _, route := range routes {
+				// Everything in the table egresses through the interface
+				assert.Equal(link.Attrs().Index, route.LinkIndex)
+				if route.Dst == nil {
+					assert.Equal(iface.Gateway, route.Gw.String())
+				} else {
+					assert.Equal(iface.Subnet, route.Dst.String())
+				}
+			}
+		}
+	}
+
+	result, err := s.updateSourceRouting(netHandle, ifaces)
+	assert.NoError(err)
+	assert.Len(result.Interfaces, 2)
+	checkSetup(result.Interfaces)
+
+	// Updating replaces the previous setup, its priorities can be reused
+	result, err = s.updateSourceRouting(netHandle, ifaces[1:])
+	assert.NoError(err)
+	assert.Equal(uint32(1000), result.Interfaces[0].Priority)
+	checkSetup(result.Interfaces)
+
+	rules, err := netHandle.RuleList(netlink.FAMILY_V4)
+	assert.NoError(err)
+	for _, rule := range rules {
+		assert.NotEqual(101, rule.Table)
+	}
+
+	// A priority used by a rule not managed by the agent is rejected
+	rule := netlink.NewRule()
+	rule.Priority = 2000
+	rule.Table = 200
+	assert.NoError(netHandle.RuleAdd(rule))
+
+	_, err = s.updateSourceRouting(netHandle, []*pb.SourceRoutingInterface{
+		{Device: "eth-a", Subnet: "10.0.0.0/24", Priority: 2000},
+	})
+	assert.Error(err)
+
+	assert.NoError(s.removeNetwork())
+	assert.Empty(s.network.sourceRules)
+	assert.Empty(s.network.sourceRoutes)
+}
+
 // As mounting errors out in permission denied, so test kataGuestSandboxDNSFile contents only.
 func TestSetupDNS(t *testing.T) {
 	skipUnlessRoot(t)
@@ -455,3 +761,278 @@ func TestSetupDNS(t *testing.T) {
 	expectedDNS := strings.Split(string(content), "\n")
 	assert.Equal(t, dns, expectedDNS)
 }
+
+func TestSetupContainerDNS(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedDNSDir := kataGuestContainerDNSDir
+	defer func() {
+		kataGuestContainerDNSDir = savedDNSDir
+	}()
+	kataGuestContainerDNSDir = dir
+
+	newSpec := func() *specs.Spec {
+		return &specs.Spec{
+			Mounts: []specs.Mount{
+				{Destination: "/proc", Source: "proc", Type: "proc"},
+				{Destination: "/etc/resolv.conf", Source: kataGuestSandboxDNSFile, Type: "bind"},
+			},
+		}
+	}
+
+	s := &sandbox{}
+
+	// Without a DNS stub, the sandbox resolv.conf is kept
+	spec := newSpec()
+	err = s.setupContainerDNS(spec, "foo", []string{"foo.svc"}, nil)
+	assert.NoError(err)
+	assert.Equal(newSpec(), spec)
+
+	s.network.dnsStub = "127.0.0.53"
+
+	err = s.setupContainerDNS(newSpec(), "foo", []string{"foo bar"}, nil)
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+	err = s.setupContainerDNS(newSpec(), "foo", nil, []string{"ndots:5\nnameserver 1.1.1.1"})
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+
+	// The DNS directory cannot be created
+	kataGuestContainerDNSDir = filepath.Join(dir, "file")
+	assert.NoError(ioutil.WriteFile(kataGuestContainerDNSDir, nil, testFileMode))
+	err = s.setupContainerDNS(newSpec(), "foo", nil, nil)
+	assert.Equal(codes.Internal, grpcStatus.Code(err))
+	assert.NoError(os.Remove(kataGuestContainerDNSDir))
+	kataGuestContainerDNSDir = dir
+
+	// The container ID cannot escape the DNS directory
+	for _, id := range []string{"", ".", "..", "../..", "foo/bar"} {
+		err = s.setupContainerDNS(newSpec(), id, nil, nil)
+		assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "container ID %q", id)
+		err = removeContainerDNS(id)
+		assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "container ID %q", id)
+	}
+	_, err = os.Stat(dir)
+	assert.NoError(err)
+
+	type testData struct {
+		containerID     string
+		search          []string
+		options         []string
+		expectedContent string
+	}
+
+	data := []testData{
+		{"foo", []string{"foo.svc.cluster.local", "cluster.local"}, []string{"ndots:5"},
+			"nameserver 127.0.0.53\nsearch foo.svc.cluster.local cluster.local\noptions ndots:5\n"},
+		{"bar", []string{"bar.svc.cluster.local"}, []string{"ndots:2", "timeout:1"},
+			"nameserver 127.0.0.53\nsearch bar.svc.cluster.local\noptions ndots:2 timeout:1\n"},
+		{"baz", nil, nil, "nameserver 127.0.0.53\n"},
+	}
+
+	for i, d := range data {
+		spec := newSpec()
+		err := s.setupContainerDNS(spec, d.containerID, d.search, d.options)
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		file := filepath.Join(dir, d.containerID, "resolv.conf")
+		content, err := ioutil.ReadFile(file)
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedContent, string(content), "test %d (%+v)", i, d)
+
+		// The sandbox resolv.conf is replaced by the container one
+		assert.Len(spec.Mounts, 2)
+		assert.Equal(specs.Mount{
+			Destination: "/etc/resolv.conf",
+			Source:      file,
+			Type:        "bind",
+			Options:     []string{"rbind", "ro"},
+		}, spec.Mounts[1])
+	}
+
+	assert.NoError(removeContainerDNS("foo"))
+	_, err = os.Stat(filepath.Join(dir, "foo"))
+	assert.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, "bar", "resolv.conf"))
+	assert.NoError(err)
+}
+
+func TestIsNetworkReady(t *testing.T) {
+	tearDown := setupNetworkTest(t)
+	defer tearDown()
+
+	assert := assert.New(t)
+
+	s := &sandbox{
+		network: network{
+			ifaces: make(map[string]*types.Interface),
+		},
+	}
+
+	// Only the loopback
+	ready, err := isNetworkReady(s)
+	assert.NoError(err)
+	assert.False(ready)
+
+	link := &netlink.Bridge{
+		LinkAttrs: netlink.LinkAttrs{
+			Name: "ifc-name",
+		},
+	}
+	assert.NoError(netlink.LinkAdd(link))
+
+	// An interface not configured through UpdateInterface, which stays
+	// down, as created by a container
+	unrelated := &netlink.Bridge{
+		LinkAttrs: netlink.LinkAttrs{
+			Name: "unrelated",
+		},
+	}
+	assert.NoError(netlink.LinkAdd(unrelated))
+
+	assert.NoError(netlink.LinkSetUp(link))
+	addr, err := netlink.ParseAddr("192.168.0.2/24")
+	assert.NoError(err)
+	assert.NoError(netlink.AddrAdd(link, addr))
+	assert.NoError(netlink.RouteAdd(&netlink.Route{
+		LinkIndex: link.Attrs().Index,
+		Gw:        net.ParseIP("192.168.0.1"),
+	}))
+
+	// No interface configured yet
+	ready, err = isNetworkReady(s)
+	assert.NoError(err)
+	assert.False(ready)
+
+	s.network.ifaces["ifc-name"] = &types.Interface{Name: "ifc-name"}
+
+	ready, err = isNetworkReady(s)
+	assert.NoError(err)
+	assert.True(ready)
+
+	// Interface down
+	assert.NoError(netlink.LinkSetDown(link))
+	ready, err = isNetworkReady(s)
+	assert.NoError(err)
+	assert.False(ready)
+
+	assert.NoError(netlink.LinkSetUp(link))
+	assert.NoError(netlink.RouteAdd(&netlink.Route{
+		LinkIndex: link.Attrs().Index,
+		Gw:        net.ParseIP("192.168.0.1"),
+	}))
+
+	ready, err = isNetworkReady(s)
+	assert.NoError(err)
+	assert.True(ready)
+
+	// No default route
+	assert.NoError(netlink.RouteDel(&netlink.Route{
+		LinkIndex: link.Attrs().Index,
+		Gw:        net.ParseIP("192.168.0.1"),
+	}))
+	ready, err = isNetworkReady(s)
+	assert.NoError(err)
+	assert.False(ready)
+
+	// Configured interface missing
+	s.network.ifaces["missing"] = &types.Interface{Name: "missing"}
+	assert.NoError(netlink.RouteAdd(&netlink.Route{
+		LinkIndex: link.Attrs().Index,
+		Gw:        net.ParseIP("192.168.0.1"),
+	}))
+	ready, err = isNetworkReady(s)
+	assert.NoError(err)
+	assert.False(ready)
+}
+
+func TestSumLinkStats(t *testing.T) {
+	assert := assert.New(t)
+
+	newLink := func(index int, name string, stats netlink.LinkStatistics) netlink.LinkAttrs {
+		return netlink.LinkAttrs{
+			Index:      index,
+			Name:       name,
+			Statistics: &stats,
+		}
+	}
+
+	lo := &netlink.Device{LinkAttrs: newLink(1, "lo", netlink.LinkStatistics{RxBytes: 1000, TxBytes: 1000})}
+	lo.Flags = net.FlagLoopback | net.FlagUp
+
+	eth0 := &netlink.Device{LinkAttrs: newLink(2, "eth0", netlink.LinkStatistics{
+		RxBytes: 100, RxPackets: 10, RxErrors: 1, RxDropped: 2,
+		TxBytes: 200, TxPackets: 20, TxErrors: 3, TxDropped: 4,
+	})}
+	eth1 := &netlink.Device{LinkAttrs: newLink(3, "eth1", netlink.LinkStatistics{
+		RxBytes: 1000, RxPackets: 100, RxErrors: 10, RxDropped: 20,
+		TxBytes: 2000, TxPackets: 200, TxErrors: 30, TxDropped: 40,
+	})}
+
+	// Accounted for by eth0
+	vlan := &netlink.Vlan{LinkAttrs: newLink(4, "eth0.10", netlink.LinkStatistics{RxBytes: 50, TxBytes: 50})}
+	vlan.ParentIndex = 2
+
+	expected := &pb.NetworkStats{
+		RxBytes: 1100, RxPackets: 110, RxErrors: 11, RxDropped: 22,
+		TxBytes: 2200, TxPackets: 220, TxErrors: 33, TxDropped: 44,
+	}
+
+	assert.Equal(expected, sumLinkStats([]netlink.Link{eth0, eth1}))
+	assert.Equal(expected, sumLinkStats([]netlink.Link{lo, eth0, eth1, vlan}))
+
+	// Listed twice, counted once
+	assert.Equal(expected, sumLinkStats([]netlink.Link{eth0, eth1, eth1}))
+
+	// A link stacked on a link of another namespace is counted
+	vlan.ParentIndex = 10
+	stats := sumLinkStats([]netlink.Link{eth0, eth1, vlan})
+	assert.Equal(uint64(1150), stats.RxBytes)
+
+	assert.Equal(&pb.NetworkStats{}, sumLinkStats(nil))
+}
+
+func TestSandboxNetworkStats(t *testing.T) {
+	tearDown := setupNetworkTest(t)
+	defer tearDown()
+
+	assert := assert.New(t)
+
+	netHandle, err := netlink.NewHandle()
+	assert.NoError(err)
+	defer netHandle.Delete()
+
+	veth := &netlink.Veth{
+		LinkAttrs: netlink.LinkAttrs{Name: "veth-stats0"},
+		PeerName:  "veth-stats1",
+	}
+	if err := netHandle.LinkAdd(veth); err != nil {
+		t.Skip("veth support needed")
+	}
+
+	s := sandbox{}
+	stats, err := s.networkStats(netHandle)
+	assert.NoError(err)
+
+	expected := &pb.NetworkStats{}
+	for _, name := range []string{veth.Name, veth.PeerName} {
+		link, err := netHandle.LinkByName(name)
+		if !assert.NoError(err) {
+			return
+		}
+
+		counters := link.Attrs().Statistics
+		expected.RxBytes += counters.RxBytes
+		expected.RxPackets += counters.RxPackets
+		expected.RxErrors += counters.RxErrors
+		expected.RxDropped += counters.RxDropped
+		expected.TxBytes += counters.TxBytes
+		expected.TxPackets += counters.TxPackets
+		expected.TxErrors += counters.TxErrors
+		expected.TxDropped += counters.TxDropped
+	}
+
+	assert.Equal(expected, stats)
+}
@@ -0,0 +1,99 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"runtime"
+	"strconv"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Annotation used to request the nice value of the container processes,
+// overriding the sandbox default.
+const niceAnnotation = "io.katacontainers.container.nice"
+
+// Range of the nice values, see setpriority(2).
+const (
+	minNice = -20
+	maxNice = 19
+)
+
+// parseNice converts a nice value, validating its range.
+func parseNice(s string) (int, error) {
+	nice, err := strconv.Atoi(s)
+	if err != nil || nice < minNice || nice > maxNice {
+		return 0, grpcStatus.Errorf(codes.InvalidArgument, "Invalid nice value %q", s)
+	}
+
+	return nice, nil
+}
+
+// getNice returns the nice value of the calling thread. The raw syscall
+// returns 20 - nice, to avoid negative values.
+func getNice() (int, error) {
+	prio, err := unix.Getpriority(unix.PRIO_PROCESS, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	return 20 - prio, nil
+}
+
+// withNice calls fn with the nice value of the current thread set to
+// nice, so that the processes it forks inherit it. The nice value being
+// a thread attribute on Linux, the goroutine is locked to the thread
+// meanwhile.
+func withNice(nice int, fn func() error) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	old, err := getNice()
+	if err != nil {
+		return err
+	}
+
+	if err := unix.Setpriority(unix.PRIO_PROCESS, 0, nice); err != nil {
+		return err
+	}
+	defer unix.Setpriority(unix.PRIO_PROCESS, 0, old)
+
+	return fn()
+}
+
+// processNice returns the nice value of the process, the one requested for
+// the process itself taking precedence over the container one.
+func processNice(ctr *container, proc *process) *int {
+	if proc.nice != nil {
+		return proc.nice
+	}
+
+	return ctr.nice
+}
+
+// setContainerNice records the nice value of the container processes,
+// the one requested by the spec annotations taking precedence over the
+// sandbox default.
+func (c *container) setContainerNice(spec *specs.Spec) error {
+	value, ok := spec.Annotations[niceAnnotation]
+	if !ok {
+		c.nice = defaultNice
+		return nil
+	}
+
+	nice, err := parseNice(value)
+	if err != nil {
+		return err
+	}
+
+	c.nice = &nice
+
+	return nil
+}
@@ -0,0 +1,142 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNice(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		nice         string
+		expectedNice int
+		expectError  bool
+	}
+
+	data := []testData{
+		{"", 0, true},
+		{"foo", 0, true},
+		{"-21", 0, true},
+		{"20", 0, true},
+		{"-20", -20, false},
+		{"0", 0, false},
+		{"19", 19, false},
+	}
+
+	for i, d := range data {
+		nice, err := parseNice(d.nice)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.Equal(d.expectedNice, nice, "test %d (%+v)", i, d)
+		}
+	}
+}
+
+func TestSetContainerNice(t *testing.T) {
+	assert := assert.New(t)
+
+	savedDefaultNice := defaultNice
+	defer func() {
+		defaultNice = savedDefaultNice
+	}()
+
+	defaultNice = nil
+
+	ctr := &container{}
+	assert.NoError(ctr.setContainerNice(&specs.Spec{}))
+	assert.Nil(ctr.nice)
+
+	baseline := 10
+	defaultNice = &baseline
+
+	// Without annotation, the sandbox baseline is inherited.
+	ctr = &container{}
+	assert.NoError(ctr.setContainerNice(&specs.Spec{}))
+	if assert.NotNil(ctr.nice) {
+		assert.Equal(10, *ctr.nice)
+	}
+
+	// An explicit value takes precedence, even the default one.
+	ctr = &container{}
+	spec := &specs.Spec{
+		Annotations: map[string]string{niceAnnotation: "0"},
+	}
+	assert.NoError(ctr.setContainerNice(spec))
+	if assert.NotNil(ctr.nice) {
+		assert.Equal(0, *ctr.nice)
+	}
+
+	ctr = &container{}
+	spec.Annotations[niceAnnotation] = "foo"
+	assert.Error(ctr.setContainerNice(spec))
+	assert.Nil(ctr.nice)
+}
+
+func TestWithNice(t *testing.T) {
+	assert := assert.New(t)
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	saved, err := getNice()
+	assert.NoError(err)
+
+	// Lowering the priority does not require privileges.
+	nice := saved + 5
+	if nice > maxNice {
+		nice = maxNice
+	}
+
+	var out []byte
+	err = withNice(nice, func() (err error) {
+		out, err = exec.Command("nice").Output()
+		return err
+	})
+	assert.NoError(err)
+	assert.Equal(strconv.Itoa(nice), strings.TrimSpace(string(out)))
+
+	current, err := getNice()
+	assert.NoError(err)
+	assert.Equal(saved, current)
+}
+
+func TestProcessNice(t *testing.T) {
+	assert := assert.New(t)
+
+	ctrNice := 10
+	procNice := -5
+
+	type testData struct {
+		ctrNice      *int
+		procNice     *int
+		expectedNice *int
+	}
+
+	data := []testData{
+		{nil, nil, nil},
+		{&ctrNice, nil, &ctrNice},
+		{nil, &procNice, &procNice},
+		{&ctrNice, &procNice, &procNice},
+	}
+
+	for i, d := range data {
+		ctr := &container{nice: d.ctrNice}
+		proc := &process{nice: d.procNice}
+		assert.Equal(d.expectedNice, processNice(ctr, proc), "test %d (%+v)", i, d)
+	}
+}
@@ -0,0 +1,94 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	nrOpenSysctl = "fs.nr_open"
+
+	// Highest fs.nr_open accepted by 64-bit kernels, see sysctl_nr_open_max
+	// in fs/file.c.
+	nrOpenMax = 1073741816
+)
+
+// Serializes the raises of fs.nr_open, which must never be lowered.
+var nrOpenLock sync.Mutex
+
+// getNrOpen returns the ceiling of the RLIMIT_NOFILE hard limits.
+func getNrOpen() (uint64, error) {
+	content, err := ioutil.ReadFile(filepath.Join(procSysDir, "fs", "nr_open"))
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+}
+
+// checkNofileLimit checks that the RLIMIT_NOFILE hard limit of the rlimits,
+// the last one set by libcontainer, can be set: setrlimit(2) fails with
+// EPERM if it exceeds fs.nr_open. fs.nr_open is raised as needed when
+// enabled by raiseNrOpen.
+func checkNofileLimit(rlimits []configs.Rlimit) error {
+	var nofile *configs.Rlimit
+	for i := range rlimits {
+		if rlimits[i].Type == unix.RLIMIT_NOFILE {
+			nofile = &rlimits[i]
+		}
+	}
+
+	if nofile == nil {
+		return nil
+	}
+
+	nrOpenLock.Lock()
+	defer nrOpenLock.Unlock()
+
+	nrOpen, err := getNrOpen()
+	if err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not read %s: %v", nrOpenSysctl, err)
+	}
+
+	if nofile.Hard <= nrOpen {
+		return nil
+	}
+
+	if nofile.Hard > nrOpenMax {
+		return grpcStatus.Errorf(codes.InvalidArgument,
+			"RLIMIT_NOFILE hard limit %d exceeds the highest %s %d allowed by the kernel",
+			nofile.Hard, nrOpenSysctl, uint64(nrOpenMax))
+	}
+
+	if !raiseNrOpen {
+		return grpcStatus.Errorf(codes.FailedPrecondition,
+			"RLIMIT_NOFILE hard limit %d exceeds %s %d, set the sandbox sysctl %s or enable %s to raise it",
+			nofile.Hard, nrOpenSysctl, nrOpen, nrOpenSysctl, raiseNrOpenFlag)
+	}
+
+	if err := writeSystemProperty(nrOpenSysctl, strconv.FormatUint(nofile.Hard, 10)); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not raise %s to %d: %v", nrOpenSysctl, nofile.Hard, err)
+	}
+
+	agentLog.WithFields(logrus.Fields{
+		"nr-open":          nofile.Hard,
+		"previous-nr-open": nrOpen,
+	}).Info("Raised fs.nr_open for RLIMIT_NOFILE")
+
+	return nil
+}
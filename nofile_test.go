@@ -0,0 +1,89 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestCheckNofileLimit(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedProcSysDir := procSysDir
+	savedRaiseNrOpen := raiseNrOpen
+	defer func() {
+		procSysDir = savedProcSysDir
+		raiseNrOpen = savedRaiseNrOpen
+	}()
+	procSysDir = dir
+
+	nofile := func(hard uint64) []configs.Rlimit {
+		return []configs.Rlimit{
+			{Type: unix.RLIMIT_NPROC, Hard: 1 << 20, Soft: 1 << 20},
+			{Type: unix.RLIMIT_NOFILE, Hard: hard, Soft: 1024},
+		}
+	}
+
+	// Without fs.nr_open, only a missing RLIMIT_NOFILE is accepted
+	assert.NoError(checkNofileLimit(nil))
+	assert.Error(checkNofileLimit(nofile(1024)))
+
+	file := filepath.Join(dir, "fs", "nr_open")
+	assert.NoError(os.MkdirAll(filepath.Dir(file), testDirMode))
+	assert.NoError(ioutil.WriteFile(file, []byte("1048576\n"), testFileMode))
+
+	type testData struct {
+		raise          bool
+		hard           uint64
+		expectedCode   codes.Code
+		expectedNrOpen string
+	}
+
+	data := []testData{
+		{false, 1024, codes.OK, "1048576\n"},
+		{false, 1048576, codes.OK, "1048576\n"},
+		{false, 1048577, codes.FailedPrecondition, "1048576\n"},
+		{false, nrOpenMax + 1, codes.InvalidArgument, "1048576\n"},
+		{true, 1024, codes.OK, "1048576\n"},
+		{true, unix.RLIM_INFINITY, codes.InvalidArgument, "1048576\n"},
+		{true, 2097152, codes.OK, "2097152"},
+	}
+
+	for i, d := range data {
+		raiseNrOpen = d.raise
+
+		err := checkNofileLimit(nofile(d.hard))
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+
+		content, err := ioutil.ReadFile(file)
+		assert.NoError(err)
+		assert.Equal(d.expectedNrOpen, string(content), "test %d (%+v)", i, d)
+	}
+
+	// The error explains how to raise the limit
+	raiseNrOpen = false
+	err = checkNofileLimit(nofile(4194304))
+	if assert.Error(err) {
+		msg := grpcStatus.Convert(err).Message()
+		assert.True(strings.Contains(msg, "fs.nr_open 2097152"), msg)
+		assert.True(strings.Contains(msg, raiseNrOpenFlag), msg)
+	}
+}
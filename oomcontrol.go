@@ -0,0 +1,354 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Annotation used to disable the OOM killer for the container, for the
+// agent to intervene instead.
+const oomKillDisableAnnotation = "io.katacontainers.container.oom_kill_disable"
+
+const (
+	oomControlFileV1   = "memory.oom_control"
+	memoryEventsFileV2 = "memory.events"
+
+	// oom_control entries, and memory.events one counting the times the
+	// usage went above memory.high.
+	oomKillDisableControlV1 = "oom_kill_disable"
+	underOOMControlV1       = "under_oom"
+	highEventV2             = "high"
+
+	// cgroup v2 cannot disable the OOM killer, the usage is throttled and
+	// reclaimed above this percentage of the memory limit instead.
+	oomKillDisableHighPercent = 90
+)
+
+// Time the memory cgroup of a container with the OOM killer disabled is
+// left to recover from an OOM, before the agent kills its largest process.
+// Set in variable to overwrite for testing.
+var oomInterventionDelay = 5 * time.Second
+
+// setContainerOOMKillDisable records whether the spec annotations request
+// to disable the OOM killer for the container.
+func (c *container) setContainerOOMKillDisable(spec *specs.Spec) error {
+	value, ok := spec.Annotations[oomKillDisableAnnotation]
+	if !ok {
+		return nil
+	}
+
+	disable, err := strconv.ParseBool(value)
+	if err != nil {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Invalid %s annotation %q", oomKillDisableAnnotation, value)
+	}
+
+	c.oomKillDisable = disable
+
+	return nil
+}
+
+// disableOOMKill disables the OOM killer for the memory cgroup of the
+// container, the agent intervening on OOM instead. With cgroup v2,
+// memory.high is set below the memory limit instead, the usage going above
+// it being reported.
+func (c *container) disableOOMKill(cgroupV2 bool) error {
+	dir, err := c.getMemoryCgroupPath(cgroupV2)
+	if err != nil {
+		return err
+	}
+
+	if !cgroupV2 {
+		if err := ioutil.WriteFile(filepath.Join(dir, oomControlFileV1), []byte("1"), cgroupFileWriteMode); err != nil {
+			return grpcStatus.Errorf(codes.Internal, "Could not disable the OOM killer of container %s: %v", c.id, err)
+		}
+		return nil
+	}
+
+	if err := c.setMemoryHigh(dir); err != nil {
+		return err
+	}
+
+	if err := c.watchMemoryHigh(dir); err != nil {
+		c.oomLog().WithError(err).Warn("Could not watch the container memory high threshold")
+	}
+
+	return nil
+}
+
+// setMemoryHigh sets the memory high threshold of the cgroup v2 of the
+// container below its current memory limit, unlimited without limit.
+func (c *container) setMemoryHigh(dir string) error {
+	high := "max"
+
+	config := c.container.Config()
+	if config.Cgroups != nil && config.Cgroups.Resources != nil && config.Cgroups.Resources.Memory > 0 {
+		high = strconv.FormatInt(config.Cgroups.Resources.Memory/100*oomKillDisableHighPercent, 10)
+	} else {
+		c.oomLog().Warn("Container without memory limit, not throttling it before OOM")
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, memoryHighFileV2), []byte(high), cgroupFileWriteMode); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not set the memory high threshold of container %s: %v", c.id, err)
+	}
+
+	return nil
+}
+
+// updateMemoryHigh sets the memory high threshold again after the memory
+// limit of the container was updated, if set instead of disabling the OOM
+// killer.
+func (c *container) updateMemoryHigh() error {
+	if !c.oomKillDisable || !isCgroupV2() {
+		return nil
+	}
+
+	dir, err := c.getMemoryCgroupPath(true)
+	if err != nil {
+		return err
+	}
+
+	return c.setMemoryHigh(dir)
+}
+
+// restoreMemoryHighWatch watches the memory high threshold of a container
+// restored over an agent restart, if set instead of disabling the OOM
+// killer.
+func (c *container) restoreMemoryHighWatch() {
+	if !c.oomKillDisable || !isCgroupV2() {
+		return
+	}
+
+	dir, err := c.getMemoryCgroupPath(true)
+	if err == nil {
+		err = c.watchMemoryHigh(dir)
+	}
+	if err != nil {
+		c.oomLog().WithError(err).Warn("Could not watch the container memory high threshold")
+	}
+}
+
+// oomLog returns the logger reporting the OOM events of the container.
+func (c *container) oomLog() *logrus.Entry {
+	return agentLog.WithFields(logrus.Fields{
+		"subsystem": "oom",
+		"container": c.id,
+	})
+}
+
+// watchMemoryHigh reports the usage of the cgroup going above its memory
+// high threshold, as memory.events is modified, until the watch is
+// stopped.
+func (c *container) watchMemoryHigh(dir string) error {
+	path := filepath.Join(dir, memoryEventsFileV2)
+	events, err := parseMemoryStat(path)
+	if err != nil {
+		return err
+	}
+
+	fd, err := unix.InotifyInit1(unix.IN_NONBLOCK | unix.IN_CLOEXEC)
+	if err != nil {
+		return err
+	}
+
+	if _, err := unix.InotifyAddWatch(fd, path, unix.IN_MODIFY|unix.IN_DELETE_SELF); err != nil {
+		unix.Close(fd)
+		return err
+	}
+
+	c.memoryHighWatch = os.NewFile(uintptr(fd), "memory-events")
+	go reportMemoryHigh(c.oomLog(), path, c.memoryHighWatch, events[highEventV2])
+
+	return nil
+}
+
+// stopMemoryHighWatch stops the memory high watch of the container, the
+// kernel not notifying the removal of the cgroup.
+func (c *container) stopMemoryHighWatch() {
+	if c.memoryHighWatch != nil {
+		c.memoryHighWatch.Close()
+	}
+}
+
+func reportMemoryHigh(log *logrus.Entry, path string, watch *os.File, last uint64) {
+	defer watch.Close()
+
+	buf := make([]byte, 4096)
+	for {
+		n, err := watch.Read(buf)
+		if err != nil {
+			log.WithError(err).Debug("Stopping memory high watch")
+			return
+		}
+
+		var mask uint32
+		for offset := 0; offset+unix.SizeofInotifyEvent <= n; {
+			event := (*unix.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+			mask |= event.Mask
+			offset += unix.SizeofInotifyEvent + int(event.Len)
+		}
+
+		if mask&(unix.IN_DELETE_SELF|unix.IN_IGNORED) != 0 {
+			log.Debug("Container cgroup gone, stopping memory high watch")
+			return
+		}
+
+		events, err := parseMemoryStat(path)
+		if os.IsNotExist(err) {
+			log.Debug("Container cgroup gone, stopping memory high watch")
+			return
+		}
+		if err != nil {
+			log.WithError(err).Warn("Could not read the container memory events")
+			continue
+		}
+
+		if events[highEventV2] > last {
+			log.WithField("high-events", events[highEventV2]).Warn("Container memory usage above its high threshold")
+		}
+		last = events[highEventV2]
+	}
+}
+
+// interveneOOM kills the process of the container using the most memory
+// for as long as its memory cgroup v1 remains under OOM, its OOM killer
+// being disabled. The container is first left some time to recover, e.g.
+// through an update of its memory limit.
+func (c *container) interveneOOM() {
+	if !atomic.CompareAndSwapUint32(&c.oomIntervening, 0, 1) {
+		return
+	}
+	defer atomic.StoreUint32(&c.oomIntervening, 0)
+
+	log := c.oomLog()
+
+	dir, err := c.getMemoryCgroupPath(false)
+	if err != nil {
+		log.WithError(err).Warn("Could not intervene on container OOM")
+		return
+	}
+
+	for {
+		time.Sleep(oomInterventionDelay)
+
+		control, err := getOOMControl(dir, false)
+		if err != nil {
+			log.WithError(err).Debug("Could not check the container OOM state, stopping OOM intervention")
+			return
+		}
+
+		if !control.UnderOom {
+			log.Info("Container recovered from OOM")
+			return
+		}
+
+		pids, err := c.container.Processes()
+		if err != nil {
+			log.WithError(err).Warn("Could not list the processes of the container under OOM")
+			return
+		}
+
+		pid, rss := largestProcess(pids)
+		if pid == 0 {
+			log.Warn("No process to kill in the container under OOM")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"pid": pid,
+			"rss": rss,
+		}).Warn("Killing the largest process of the container under OOM")
+
+		atomic.StoreUint32(&c.oomKilled, 1)
+		if err := unix.Kill(pid, unix.SIGKILL); err != nil && err != unix.ESRCH {
+			log.WithError(err).WithField("pid", pid).Warn("Could not kill the process of the container under OOM")
+			return
+		}
+	}
+}
+
+// largestProcess returns the process using the most memory, and its
+// resident set size in bytes. Processes gone meanwhile are skipped.
+func largestProcess(pids []int) (int, uint64) {
+	var largest int
+	var largestRSS uint64
+
+	for _, pid := range pids {
+		content, err := ioutil.ReadFile(filepath.Join("/proc", strconv.Itoa(pid), "statm"))
+		if err != nil {
+			continue
+		}
+
+		fields := strings.Fields(string(content))
+		if len(fields) < 2 {
+			continue
+		}
+
+		pages, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		if rss := pages * uint64(os.Getpagesize()); largest == 0 || rss > largestRSS {
+			largest, largestRSS = pid, rss
+		}
+	}
+
+	return largest, largestRSS
+}
+
+// getOOMControl returns how the OOM killer applies to the memory cgroup.
+func getOOMControl(dir string, cgroupV2 bool) (*pb.OOMControl, error) {
+	if !cgroupV2 {
+		// oom_control is made of "name value" lines, as memory.stat.
+		control, err := parseMemoryStat(filepath.Join(dir, oomControlFileV1))
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.OOMControl{
+			KillDisabled: control[oomKillDisableControlV1] != 0,
+			UnderOom:     control[underOOMControlV1] != 0,
+		}, nil
+	}
+
+	content, err := ioutil.ReadFile(filepath.Join(dir, memoryHighFileV2))
+	if err != nil {
+		return nil, err
+	}
+
+	var high uint64
+	if value := strings.TrimSpace(string(content)); value != "max" {
+		if high, err = strconv.ParseUint(value, 10, 64); err != nil {
+			return nil, grpcStatus.Errorf(codes.Internal, "Invalid memory high threshold %q: %v", value, err)
+		}
+	}
+
+	events, err := parseMemoryStat(filepath.Join(dir, memoryEventsFileV2))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.OOMControl{
+		MemoryHigh: high,
+		HighEvents: events[highEventV2],
+	}, nil
+}
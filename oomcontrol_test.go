@@ -0,0 +1,352 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestSetContainerOOMKillDisable(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		annotations     map[string]string
+		expectedDisable bool
+		expectError     bool
+	}
+
+	data := []testData{
+		{nil, false, false},
+		{map[string]string{oomKillDisableAnnotation: "true"}, true, false},
+		{map[string]string{oomKillDisableAnnotation: "false"}, false, false},
+		{map[string]string{oomKillDisableAnnotation: "foo"}, false, true},
+	}
+
+	for i, d := range data {
+		ctr := &container{}
+		err := ctr.setContainerOOMKillDisable(&specs.Spec{Annotations: d.annotations})
+		if d.expectError {
+			assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+		assert.Equal(d.expectedDisable, ctr.oomKillDisable, "test %d (%+v)", i, d)
+	}
+}
+
+func TestDisableOOMKillV1(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "cgroup")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedCgroupMemoryPath := cgroupMemoryPath
+	defer func() {
+		cgroupMemoryPath = savedCgroupMemoryPath
+	}()
+	cgroupMemoryPath = dir
+
+	ctr := &container{
+		id:        "foo",
+		container: &mockContainer{id: "foo"},
+	}
+
+	cgroupDir := filepath.Join(dir, "cgroup", "foo")
+	assert.NoError(os.MkdirAll(cgroupDir, testDirMode))
+	controlFile := filepath.Join(cgroupDir, oomControlFileV1)
+
+	assert.NoError(ctr.disableOOMKill(false))
+	content, err := ioutil.ReadFile(controlFile)
+	assert.NoError(err)
+	assert.Equal("1", string(content))
+
+	// The kernel reports the setting along with the OOM state
+	assert.NoError(ioutil.WriteFile(controlFile, []byte("oom_kill_disable 1\nunder_oom 1\noom_kill 0\n"), testFileMode))
+	control, err := getOOMControl(cgroupDir, false)
+	assert.NoError(err)
+	assert.Equal(&pb.OOMControl{KillDisabled: true, UnderOom: true}, control)
+
+	assert.NoError(ioutil.WriteFile(controlFile, []byte("oom_kill_disable 0\nunder_oom 0\noom_kill 0\n"), testFileMode))
+	control, err = getOOMControl(cgroupDir, false)
+	assert.NoError(err)
+	assert.Equal(&pb.OOMControl{}, control)
+
+	// Not a memory cgroup
+	assert.NoError(os.Remove(controlFile))
+	_, err = getOOMControl(cgroupDir, false)
+	assert.Error(err)
+}
+
+func TestDisableOOMKillV2(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "cgroup")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedCgroupPath := cgroupPath
+	savedIsCgroupV2 := isCgroupV2
+	defer func() {
+		cgroupPath = savedCgroupPath
+		isCgroupV2 = savedIsCgroupV2
+	}()
+
+	cgroupPath = dir
+	isCgroupV2 = func() bool {
+		return true
+	}
+
+	cgroupDir := filepath.Join(dir, "cgroup", "foo")
+	assert.NoError(os.MkdirAll(cgroupDir, testDirMode))
+	highFile := filepath.Join(cgroupDir, memoryHighFileV2)
+	eventsFile := filepath.Join(cgroupDir, memoryEventsFileV2)
+	assert.NoError(ioutil.WriteFile(eventsFile, []byte("low 0\nhigh 0\nmax 0\noom 0\noom_kill 0\n"), testFileMode))
+
+	// Without memory limit, the usage is not throttled
+	ctr := &container{
+		id:             "foo",
+		container:      &mockContainer{id: "foo"},
+		oomKillDisable: true,
+	}
+	assert.NoError(ctr.disableOOMKill(true))
+	defer ctr.stopMemoryHighWatch()
+	content, err := ioutil.ReadFile(highFile)
+	assert.NoError(err)
+	assert.Equal("max", string(content))
+
+	// The threshold follows the memory limit updates
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: map[string]*container{"foo": ctr},
+		},
+	}
+
+	for _, limit := range []int64{1 << 30, -1, 1 << 31} {
+		_, err = a.UpdateContainer(context.Background(), &pb.UpdateContainerRequest{
+			ContainerId: "foo",
+			Resources: &pb.LinuxResources{
+				Memory: &pb.LinuxMemory{Limit: limit},
+			},
+		})
+		assert.NoError(err, limit)
+	}
+	content, err = ioutil.ReadFile(highFile)
+	assert.NoError(err)
+	assert.Equal("1932735240", string(content))
+
+	ctr.stopMemoryHighWatch()
+	ctr.container = &mockContainer{id: "foo", memory: 1 << 30}
+	assert.NoError(ctr.disableOOMKill(true))
+	content, err = ioutil.ReadFile(highFile)
+	assert.NoError(err)
+	assert.Equal("966367620", string(content))
+
+	assert.NoError(ioutil.WriteFile(eventsFile, []byte("low 0\nhigh 2\nmax 0\noom 0\noom_kill 0\n"), testFileMode))
+	control, err := getOOMControl(cgroupDir, true)
+	assert.NoError(err)
+	assert.Equal(&pb.OOMControl{MemoryHigh: 966367620, HighEvents: 2}, control)
+
+	// Unlimited
+	assert.NoError(ioutil.WriteFile(highFile, []byte("max\n"), testFileMode))
+	control, err = getOOMControl(cgroupDir, true)
+	assert.NoError(err)
+	assert.Equal(&pb.OOMControl{HighEvents: 2}, control)
+}
+
+// captureOOMLog redirects the agent logs to the returned buffer, as JSON
+// entries.
+func captureOOMLog() (*bytes.Buffer, func()) {
+	savedLog := agentLog
+
+	buf := &bytes.Buffer{}
+	logger := logrus.New()
+	logger.Out = buf
+	logger.Formatter = &logrus.JSONFormatter{}
+	agentLog = logrus.NewEntry(logger)
+
+	return buf, func() {
+		agentLog = savedLog
+	}
+}
+
+// oomLogEntries returns the entries of the level logged into buf.
+func oomLogEntries(t *testing.T, buf *bytes.Buffer, level string) []map[string]interface{} {
+	var entries []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var entry map[string]interface{}
+		if assert.NoError(t, json.Unmarshal([]byte(line), &entry), line) && entry["level"] == level {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+// writeMemoryEvents updates the high events counter of the memory.events
+// file in place, as the kernel does.
+func writeMemoryEvents(path, high string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.WriteString("low 0\nhigh " + high + "\nmax 0\noom 0\noom_kill 0\n")
+	return err
+}
+
+func TestWatchMemoryHigh(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "cgroup")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	eventsFile := filepath.Join(dir, memoryEventsFileV2)
+	assert.NoError(ioutil.WriteFile(eventsFile, []byte("low 0\nhigh 1\nmax 0\noom 0\noom_kill 0\n"), testFileMode))
+
+	buf, restoreLog := captureOOMLog()
+	ctr := &container{id: "foo"}
+	err = ctr.watchMemoryHigh(dir)
+	restoreLog()
+	if !assert.NoError(err) {
+		return
+	}
+
+	// Reported as the file is modified, once per increase of the
+	// counter past the one at the start of the watch.
+	for _, high := range []string{"1", "3", "3"} {
+		assert.NoError(writeMemoryEvents(eventsFile, high))
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	ctr.stopMemoryHighWatch()
+	time.Sleep(50 * time.Millisecond)
+
+	// Not reported once stopped
+	assert.NoError(writeMemoryEvents(eventsFile, "5"))
+	time.Sleep(50 * time.Millisecond)
+
+	warnings := oomLogEntries(t, buf, "warning")
+	if assert.Len(warnings, 1) {
+		assert.Equal("oom", warnings[0]["subsystem"])
+		assert.Equal("foo", warnings[0]["container"])
+		assert.Equal(float64(3), warnings[0]["high-events"])
+	}
+
+	// The watch stops once the cgroup goes away
+	ctr = &container{id: "foo"}
+	assert.NoError(ctr.watchMemoryHigh(dir))
+	defer ctr.stopMemoryHighWatch()
+	assert.NoError(os.Remove(eventsFile))
+	time.Sleep(50 * time.Millisecond)
+
+	// Closed by the watch
+	_, err = ctr.memoryHighWatch.Read(make([]byte, 4096))
+	if pathErr, ok := err.(*os.PathError); assert.True(ok, "%v", err) {
+		assert.Equal(os.ErrClosed, pathErr.Err)
+	}
+}
+
+func TestLargestProcess(t *testing.T) {
+	assert := assert.New(t)
+
+	cmd := exec.Command("sleep", "100")
+	if !assert.NoError(cmd.Start()) {
+		return
+	}
+	defer func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	}()
+
+	pid, rss := largestProcess([]int{cmd.Process.Pid, os.Getpid(), 0})
+	assert.Equal(os.Getpid(), pid)
+	assert.NotZero(rss)
+
+	pid, _ = largestProcess(nil)
+	assert.Zero(pid)
+}
+
+func TestInterveneOOM(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "cgroup")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedCgroupMemoryPath := cgroupMemoryPath
+	savedDelay := oomInterventionDelay
+	defer func() {
+		cgroupMemoryPath = savedCgroupMemoryPath
+		oomInterventionDelay = savedDelay
+	}()
+	cgroupMemoryPath = dir
+	oomInterventionDelay = 10 * time.Millisecond
+
+	cgroupDir := filepath.Join(dir, "cgroup", "foo")
+	assert.NoError(os.MkdirAll(cgroupDir, testDirMode))
+	controlFile := filepath.Join(cgroupDir, oomControlFileV1)
+
+	cmd := exec.Command("sleep", "100")
+	if !assert.NoError(cmd.Start()) {
+		return
+	}
+	defer cmd.Process.Kill()
+
+	ctr := &container{
+		id:             "foo",
+		container:      &mockContainer{id: "foo", processes: []int{cmd.Process.Pid}},
+		oomKillDisable: true,
+	}
+
+	// Left alone once recovered
+	assert.NoError(ioutil.WriteFile(controlFile, []byte("oom_kill_disable 1\nunder_oom 0\noom_kill 0\n"), testFileMode))
+	ctr.interveneOOM()
+	assert.NoError(cmd.Process.Signal(syscall.Signal(0)))
+	assert.Zero(ctr.oomKilled)
+
+	// The largest process is killed while under OOM
+	assert.NoError(ioutil.WriteFile(controlFile, []byte("oom_kill_disable 1\nunder_oom 1\noom_kill 0\n"), testFileMode))
+	done := make(chan struct{})
+	go func() {
+		ctr.interveneOOM()
+		close(done)
+	}()
+
+	err = cmd.Wait()
+	assert.NoError(ioutil.WriteFile(controlFile, []byte("oom_kill_disable 1\nunder_oom 0\noom_kill 0\n"), testFileMode))
+	if exitErr, ok := err.(*exec.ExitError); assert.True(ok, "%v", err) {
+		status := exitErr.Sys().(syscall.WaitStatus)
+		assert.Equal(syscall.SIGKILL, status.Signal())
+	}
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		assert.Fail("OOM intervention not stopped")
+	}
+	assert.Equal(uint32(1), ctr.oomKilled)
+}
@@ -0,0 +1,158 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"sync"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Delay before a partial line of a line-buffered output is delivered, when
+// not requested.
+const defaultOutputFlushInterval = 100 * time.Millisecond
+
+// outputBuffer holds the output read from a process stream, but not
+// delivered yet.
+type outputBuffer struct {
+	// Serializes the reads of the stream, held while waiting for output.
+	readLock sync.Mutex
+
+	sync.Mutex
+	data []byte
+
+	// Time the pending data started to be read at.
+	since time.Time
+}
+
+// setOutputBuffering sets how the output of the process is delivered.
+func (p *process) setOutputBuffering(mode pb.ExecProcessRequest_OutputBuffering, flushIntervalMs uint32) error {
+	if _, ok := pb.ExecProcessRequest_OutputBuffering_name[int32(mode)]; !ok {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Invalid output buffering %d", mode)
+	}
+
+	if mode == pb.ExecProcessRequest_NONE {
+		return nil
+	}
+
+	if p.process.ConsoleSocket != nil {
+		return grpcStatus.Error(codes.InvalidArgument, "Cannot buffer the output of a process using a terminal")
+	}
+
+	p.outputBuffering = mode
+	p.flushInterval = defaultOutputFlushInterval
+	if flushIntervalMs > 0 {
+		p.flushInterval = time.Duration(flushIntervalMs) * time.Millisecond
+	}
+	p.stdoutBuffer = &outputBuffer{}
+	p.stderrBuffer = &outputBuffer{}
+
+	return nil
+}
+
+// take removes and returns the first n bytes of the pending data. The
+// remaining partial line starts waiting for its flush interval.
+func (b *outputBuffer) take(n int) []byte {
+	data := b.data[:n:n]
+	b.data = append([]byte(nil), b.data[n:]...)
+	if len(b.data) > 0 {
+		b.since = time.Now()
+	}
+
+	return data
+}
+
+// pending returns a copy of the pending data.
+func (b *outputBuffer) pending() []byte {
+	b.Lock()
+	defer b.Unlock()
+
+	return append([]byte(nil), b.data...)
+}
+
+// flush returns the pending data, up to length bytes.
+func (b *outputBuffer) flush(length int) []byte {
+	if len(b.data) < length {
+		length = len(b.data)
+	}
+
+	return b.take(length)
+}
+
+// next returns the data to deliver without reading more output, if any.
+func (b *outputBuffer) next(mode pb.ExecProcessRequest_OutputBuffering, length int) []byte {
+	if len(b.data) >= length {
+		return b.take(length)
+	}
+
+	if mode == pb.ExecProcessRequest_LINE {
+		if i := bytes.LastIndexByte(b.data, '\n'); i >= 0 {
+			return b.take(i + 1)
+		}
+	}
+
+	return nil
+}
+
+// readBuffered reads up to length bytes of the output of the process from
+// the file, as complete lines or blocks of the length. With line buffering,
+// reads wait for a partial line to be completed until the flush interval
+// elapsed since it started to be read. The pending data is returned before
+// any error, which is returned by the next read. Files inherited over an
+// agent restart are not pollable, their pending data is returned without
+// waiting.
+func (p *process) readBuffered(file *os.File, buf *outputBuffer, length int) ([]byte, error) {
+	buf.readLock.Lock()
+	defer buf.readLock.Unlock()
+
+	buf.Lock()
+	defer buf.Unlock()
+
+	chunk := make([]byte, length)
+
+	for {
+		if data := buf.next(p.outputBuffering, length); data != nil {
+			return data, nil
+		}
+
+		var deadline time.Time
+		if p.outputBuffering == pb.ExecProcessRequest_LINE && len(buf.data) > 0 {
+			deadline = buf.since.Add(p.flushInterval)
+		}
+
+		if err := file.SetReadDeadline(deadline); err != nil && len(buf.data) > 0 {
+			return buf.flush(length), nil
+		}
+
+		// The pending data can be saved while waiting for output.
+		buf.Unlock()
+		n, err := file.Read(chunk)
+		buf.Lock()
+
+		if n > 0 {
+			if len(buf.data) == 0 {
+				buf.since = time.Now()
+			}
+			buf.data = append(buf.data, chunk[:n]...)
+		}
+
+		if err != nil {
+			if len(buf.data) > 0 {
+				return buf.flush(length), nil
+			}
+			if os.IsTimeout(err) {
+				continue
+			}
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,184 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestSetOutputBuffering(t *testing.T) {
+	assert := assert.New(t)
+
+	proc := &process{}
+	assert.NoError(proc.setOutputBuffering(pb.ExecProcessRequest_NONE, 10))
+	assert.Equal(pb.ExecProcessRequest_NONE, proc.outputBuffering)
+	assert.Nil(proc.stdoutBuffer)
+
+	err := proc.setOutputBuffering(pb.ExecProcessRequest_OutputBuffering(3), 0)
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+
+	assert.NoError(proc.setOutputBuffering(pb.ExecProcessRequest_LINE, 0))
+	assert.Equal(pb.ExecProcessRequest_LINE, proc.outputBuffering)
+	assert.Equal(defaultOutputFlushInterval, proc.flushInterval)
+	assert.NotNil(proc.stdoutBuffer)
+	assert.NotNil(proc.stderrBuffer)
+
+	assert.NoError(proc.setOutputBuffering(pb.ExecProcessRequest_BLOCK, 500))
+	assert.Equal(pb.ExecProcessRequest_BLOCK, proc.outputBuffering)
+	assert.Equal(500*time.Millisecond, proc.flushInterval)
+
+	// Terminals are not buffered
+	proc = &process{
+		process: libcontainer.Process{
+			ConsoleSocket: os.Stdin,
+		},
+	}
+	err = proc.setOutputBuffering(pb.ExecProcessRequest_LINE, 0)
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+}
+
+func TestReadStdioBuffering(t *testing.T) {
+	assert := assert.New(t)
+
+	// The output is made of partial lines, completed later on.
+	const script = "printf foo; sleep 0.3; printf 'bar\\nbaz'; sleep 0.3; printf 'qux\\n'"
+
+	type chunk struct {
+		data string
+		// Bounds of the delay since the previous chunk.
+		minDelay time.Duration
+		maxDelay time.Duration
+	}
+
+	type testData struct {
+		buffering     pb.ExecProcessRequest_OutputBuffering
+		flushInterval uint32
+		length        int
+		chunks        []chunk
+	}
+
+	data := []testData{
+		// Partial lines are delivered as soon as read
+		{pb.ExecProcessRequest_NONE, 0, 32, []chunk{
+			{"foo", 0, 250 * time.Millisecond},
+			{"bar\nbaz", 250 * time.Millisecond, 550 * time.Millisecond},
+			{"qux\n", 250 * time.Millisecond, 550 * time.Millisecond},
+		}},
+		// Partial lines wait for their end
+		{pb.ExecProcessRequest_LINE, 1000, 32, []chunk{
+			{"foobar\n", 250 * time.Millisecond, 550 * time.Millisecond},
+			{"bazqux\n", 250 * time.Millisecond, 550 * time.Millisecond},
+		}},
+		// Or for the flush interval
+		{pb.ExecProcessRequest_LINE, 100, 32, []chunk{
+			{"foo", 100 * time.Millisecond, 250 * time.Millisecond},
+			{"bar\n", 100 * time.Millisecond, 350 * time.Millisecond},
+			{"baz", 100 * time.Millisecond, 250 * time.Millisecond},
+			{"qux\n", 100 * time.Millisecond, 350 * time.Millisecond},
+		}},
+		// Blocks are filled, but for the last one
+		{pb.ExecProcessRequest_BLOCK, 0, 8, []chunk{
+			{"foobar\nb", 250 * time.Millisecond, 550 * time.Millisecond},
+			{"azqux\n", 250 * time.Millisecond, 550 * time.Millisecond},
+		}},
+	}
+
+	for i, d := range data {
+		proc, err := buildProcess(&pb.Process{}, "exec", false)
+		if !assert.NoError(err, "test %d (%+v)", i, d) {
+			continue
+		}
+		assert.NoError(proc.setOutputBuffering(d.buffering, d.flushInterval), "test %d (%+v)", i, d)
+
+		s := &sandbox{
+			running: true,
+			containers: map[string]*container{
+				"ctr": {
+					id:        "ctr",
+					processes: map[string]*process{"exec": proc},
+				},
+			},
+		}
+
+		cmd := exec.Command("sh", "-c", script)
+		cmd.Stdout = proc.process.Stdout
+		if !assert.NoError(cmd.Start(), "test %d (%+v)", i, d) {
+			continue
+		}
+		proc.closePostStartFDs()
+
+		last := time.Now()
+		for j, c := range d.chunks {
+			out, err := s.readStdio("ctr", "exec", d.length, true)
+			delay := time.Since(last)
+			last = time.Now()
+
+			assert.NoError(err, "test %d (%+v) chunk %d", i, d, j)
+			assert.Equal(c.data, string(out), "test %d (%+v) chunk %d", i, d, j)
+			assert.True(delay >= c.minDelay, "test %d (%+v) chunk %d: %s < %s", i, d, j, delay, c.minDelay)
+			assert.True(delay < c.maxDelay, "test %d (%+v) chunk %d: %s >= %s", i, d, j, delay, c.maxDelay)
+		}
+
+		_, err = s.readStdio("ctr", "exec", d.length, true)
+		assert.Equal(io.EOF, err, "test %d (%+v)", i, d)
+
+		assert.NoError(cmd.Wait(), "test %d (%+v)", i, d)
+		proc.closePostExitFDs()
+	}
+}
+
+func TestReadBufferedPartialLine(t *testing.T) {
+	assert := assert.New(t)
+
+	r, w, err := os.Pipe()
+	assert.NoError(err)
+	defer r.Close()
+	defer w.Close()
+
+	proc := &process{
+		outputBuffering: pb.ExecProcessRequest_LINE,
+		flushInterval:   200 * time.Millisecond,
+	}
+	buf := &outputBuffer{}
+
+	// The pending data can be saved while a read waits for output
+	read := make(chan []byte)
+	go func() {
+		data, _ := proc.readBuffered(r, buf, 32)
+		read <- data
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	assert.Empty(buf.pending())
+
+	// The remaining partial line waits for the flush interval from the
+	// delivery of the previous line.
+	_, err = w.WriteString("foo")
+	assert.NoError(err)
+	time.Sleep(100 * time.Millisecond)
+	_, err = w.WriteString("bar\nbaz")
+	assert.NoError(err)
+
+	assert.Equal("foobar\n", string(<-read))
+	last := time.Now()
+	assert.Equal([]byte("baz"), buf.pending())
+
+	data, err := proc.readBuffered(r, buf, 32)
+	assert.NoError(err)
+	assert.Equal("baz", string(data))
+	assert.True(time.Since(last) >= 150*time.Millisecond, "%s", time.Since(last))
+}
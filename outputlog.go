@@ -0,0 +1,248 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	defaultOutputLogMaxSize = 10 * 1024 * 1024
+
+	outputLogDirMode  = os.FileMode(0750)
+	outputLogFileMode = os.FileMode(0640)
+
+	stdoutLogName = "stdout.log"
+	stderrLogName = "stderr.log"
+)
+
+// Paths of the open log files, the rotation of a file shared by several
+// processes corrupting it.
+var (
+	rotatingFilesLock sync.Mutex
+	rotatingFiles     = make(map[string]bool)
+)
+
+// rotatingFile is a log file renamed with a .0 suffix once it reaches its
+// maximum size, the previous backups being shifted and the oldest one
+// dropped.
+type rotatingFile struct {
+	sync.Mutex
+
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+	closed     bool
+}
+
+func newRotatingFile(path string, maxSize int64, maxBackups int) (*rotatingFile, error) {
+	rotatingFilesLock.Lock()
+	defer rotatingFilesLock.Unlock()
+
+	if rotatingFiles[path] {
+		return nil, grpcStatus.Errorf(codes.AlreadyExists, "Output log %s already used by another process", path)
+	}
+
+	r := &rotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+
+	rotatingFiles[path] = true
+
+	return r, nil
+}
+
+func (r *rotatingFile) open() error {
+	f, err := os.OpenFile(r.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, outputLogFileMode)
+	if err != nil {
+		return err
+	}
+
+	st, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	r.file = f
+	r.size = st.Size()
+
+	return nil
+}
+
+func (r *rotatingFile) backupPath(index int) string {
+	return fmt.Sprintf("%s.%d", r.path, index)
+}
+
+// rotate must be called with the lock held.
+func (r *rotatingFile) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+	r.file = nil
+
+	if r.maxBackups == 0 {
+		if err := os.Remove(r.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	} else {
+		if err := os.Remove(r.backupPath(r.maxBackups - 1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		for i := r.maxBackups - 2; i >= 0; i-- {
+			if err := os.Rename(r.backupPath(i), r.backupPath(i+1)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+
+		if err := os.Rename(r.path, r.backupPath(0)); err != nil {
+			return err
+		}
+	}
+
+	return r.open()
+}
+
+// Write appends data to the file, rotating it first if the data would not
+// fit. Data larger than the maximum size is written to a file on its own.
+func (r *rotatingFile) Write(data []byte) (int, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.closed {
+		return 0, os.ErrClosed
+	}
+
+	if r.file == nil {
+		// A previous rotation failed, try again.
+		if err := r.open(); err != nil {
+			return 0, err
+		}
+	}
+
+	if r.size > 0 && r.size+int64(len(data)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := r.file.Write(data)
+	r.size += int64(n)
+
+	return n, err
+}
+
+func (r *rotatingFile) Close() error {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+
+	rotatingFilesLock.Lock()
+	delete(rotatingFiles, r.path)
+	rotatingFilesLock.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+
+	err := r.file.Close()
+	r.file = nil
+
+	return err
+}
+
+func validateOutputLog(outputLog *pb.OutputLog) error {
+	if outputLog == nil {
+		return nil
+	}
+
+	dir := outputLog.Directory
+	if !filepath.IsAbs(dir) || filepath.Clean(dir) != dir {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Invalid output log directory %q", dir)
+	}
+
+	return nil
+}
+
+// setupOutputLog creates the files receiving a copy of the process
+// output.
+func (p *process) setupOutputLog(outputLog *pb.OutputLog) error {
+	if outputLog == nil {
+		return nil
+	}
+
+	if err := os.MkdirAll(outputLog.Directory, outputLogDirMode); err != nil {
+		return err
+	}
+
+	maxSize := int64(outputLog.MaxSize)
+	if maxSize == 0 {
+		maxSize = defaultOutputLogMaxSize
+	}
+
+	stdoutLog, err := newRotatingFile(filepath.Join(outputLog.Directory, stdoutLogName), maxSize, int(outputLog.MaxBackups))
+	if err != nil {
+		return err
+	}
+
+	stderrLog, err := newRotatingFile(filepath.Join(outputLog.Directory, stderrLogName), maxSize, int(outputLog.MaxBackups))
+	if err != nil {
+		stdoutLog.Close()
+		return err
+	}
+
+	p.stdoutLog = stdoutLog
+	p.stderrLog = stderrLog
+
+	return nil
+}
+
+// teeOutput copies data read from the process output to its log file, if
+// any. Failing to do so must not prevent the runtime from getting the
+// output, hence errors are only logged.
+func (p *process) teeOutput(data []byte, stdout bool) {
+	log := p.stderrLog
+	if stdout {
+		log = p.stdoutLog
+	}
+
+	if log == nil || len(data) == 0 {
+		return
+	}
+
+	if _, err := log.Write(data); err != nil {
+		agentLog.WithError(err).WithField("path", log.path).Warn("Could not write output log")
+	}
+}
+
+func (p *process) closeOutputLog() {
+	for _, log := range []*rotatingFile{p.stdoutLog, p.stderrLog} {
+		if log != nil {
+			log.Close()
+		}
+	}
+}
@@ -0,0 +1,204 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestValidateOutputLog(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		outputLog   *pb.OutputLog
+		expectError bool
+	}
+
+	data := []testData{
+		{nil, false},
+		{&pb.OutputLog{Directory: "/run/logs"}, false},
+		{&pb.OutputLog{}, true},
+		{&pb.OutputLog{Directory: "logs"}, true},
+		{&pb.OutputLog{Directory: "/run/../logs"}, true},
+		{&pb.OutputLog{Directory: "/run/logs/"}, true},
+	}
+
+	for i, d := range data {
+		err := validateOutputLog(d.outputLog)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+	}
+}
+
+func TestRotatingFile(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "out.log")
+
+	r, err := newRotatingFile(path, 10, 2)
+	assert.NoError(err)
+
+	// The file cannot be shared by another process
+	_, err = newRotatingFile(path, 10, 2)
+	assert.Equal(codes.AlreadyExists, grpcStatus.Code(err))
+
+	for _, s := range []string{"aaaa", "bbbb", "cccc", "dddd", "eeee", "ffffffffffff", "gg"} {
+		n, err := r.Write([]byte(s))
+		assert.NoError(err)
+		assert.Equal(len(s), n)
+	}
+	assert.NoError(r.Close())
+
+	type testData struct {
+		path     string
+		expected string
+	}
+
+	data := []testData{
+		{path, "gg"},
+		{path + ".0", "ffffffffffff"},
+		{path + ".1", "eeee"},
+	}
+
+	for i, d := range data {
+		content, err := ioutil.ReadFile(d.path)
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expected, string(content), "test %d (%+v)", i, d)
+	}
+
+	// Oldest data dropped
+	_, err = os.Stat(path + ".2")
+	assert.True(os.IsNotExist(err))
+
+	// Reopening appends to the existing file
+	r, err = newRotatingFile(path, 10, 0)
+	assert.NoError(err)
+	_, err = r.Write([]byte("hhhh"))
+	assert.NoError(err)
+	content, err := ioutil.ReadFile(path)
+	assert.NoError(err)
+	assert.Equal("gghhhh", string(content))
+
+	// Without backups, the file is truncated
+	_, err = r.Write([]byte("iiiiiii"))
+	assert.NoError(err)
+	assert.NoError(r.Close())
+	content, err = ioutil.ReadFile(path)
+	assert.NoError(err)
+	assert.Equal("iiiiiii", string(content))
+
+	// A closed file is not reopened by later writes
+	assert.NoError(os.Remove(path))
+	_, err = r.Write([]byte("jjjj"))
+	assert.Equal(os.ErrClosed, err)
+	_, err = os.Stat(path)
+	assert.True(os.IsNotExist(err))
+	assert.NoError(r.Close())
+}
+
+func TestRotatingFileConcurrentWrites(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "out.log")
+
+	const writers = 4
+	const writes = 100
+	line := []byte("0123456789\n")
+
+	r, err := newRotatingFile(path, int64(len(line)*writes), writers*2)
+	assert.NoError(err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < writes; j++ {
+				_, err := r.Write(line)
+				assert.NoError(err)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.NoError(r.Close())
+
+	// No data lost nor interleaved, all the files being full
+	files, err := filepath.Glob(path + "*")
+	assert.NoError(err)
+	assert.Len(files, writers)
+
+	for _, f := range files {
+		content, err := ioutil.ReadFile(f)
+		assert.NoError(err)
+		assert.Equal(bytes.Repeat(line, writes), content, f)
+	}
+}
+
+func TestProcessTeeOutput(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	logDir := filepath.Join(dir, "logs")
+
+	p := &process{}
+
+	// No log configured
+	assert.NoError(p.setupOutputLog(nil))
+	p.teeOutput([]byte("foo"), true)
+	p.closeOutputLog()
+
+	assert.NoError(p.setupOutputLog(&pb.OutputLog{
+		Directory:  logDir,
+		MaxSize:    4,
+		MaxBackups: 1,
+	}))
+	p.teeOutput([]byte("out1"), true)
+	p.teeOutput([]byte("err1"), false)
+	p.teeOutput([]byte("out2"), true)
+	p.closeOutputLog()
+
+	type testData struct {
+		name     string
+		expected string
+	}
+
+	data := []testData{
+		{stdoutLogName, "out2"},
+		{stdoutLogName + ".0", "out1"},
+		{stderrLogName, "err1"},
+	}
+
+	for i, d := range data {
+		content, err := ioutil.ReadFile(filepath.Join(logDir, d.name))
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expected, string(content), "test %d (%+v)", i, d)
+	}
+}
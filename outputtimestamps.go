@@ -0,0 +1,102 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+)
+
+// Layout of the timestamps prefixing the output lines.
+const outputTimestampLayout = time.RFC3339Nano
+
+// outputTimestamper prefixes the lines read from a process stream with the
+// time they were read at. Its exported fields are kept over an agent
+// restart.
+type outputTimestamper struct {
+	sync.Mutex
+
+	// Set when the last data read ended in the middle of a line, the
+	// next data read continuing it.
+	MidLine bool
+
+	// Output stamped but not delivered yet, the timestamps making it
+	// longer than the length read.
+	Pending []byte
+}
+
+// setOutputTimestamps sets the streams of the process output prefixed with
+// timestamps.
+func (p *process) setOutputTimestamps(timestamps *pb.OutputTimestamps) {
+	if timestamps == nil {
+		return
+	}
+
+	if timestamps.Stdout {
+		p.stdoutTimestamper = &outputTimestamper{}
+	}
+	if timestamps.Stderr {
+		p.stderrTimestamper = &outputTimestamper{}
+	}
+}
+
+// stamp returns the data with a timestamp inserted at the start of each
+// line, a line continued from the previous data not being stamped again.
+func (t *outputTimestamper) stamp(data []byte, now time.Time) []byte {
+	timestamp := now.UTC().Format(outputTimestampLayout) + " "
+	stamped := make([]byte, 0, len(data)+len(timestamp))
+
+	for len(data) > 0 {
+		if !t.MidLine {
+			stamped = append(stamped, timestamp...)
+		}
+
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			stamped = append(stamped, data...)
+			t.MidLine = true
+			break
+		}
+
+		stamped = append(stamped, data[:i+1]...)
+		data = data[i+1:]
+		t.MidLine = false
+	}
+
+	return stamped
+}
+
+// deliver returns up to length bytes of the stamped data, the rest being
+// kept pending.
+func (t *outputTimestamper) deliver(stamped []byte, length int) []byte {
+	if len(stamped) <= length {
+		return stamped
+	}
+
+	t.Pending = append(t.Pending, stamped[length:]...)
+
+	return stamped[:length]
+}
+
+// next returns up to length bytes of the pending output, if any.
+func (t *outputTimestamper) next(length int) []byte {
+	if len(t.Pending) == 0 {
+		return nil
+	}
+
+	if len(t.Pending) < length {
+		length = len(t.Pending)
+	}
+
+	data := t.Pending[:length:length]
+	t.Pending = append([]byte(nil), t.Pending[length:]...)
+
+	return data
+}
@@ -0,0 +1,186 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutputTimestamperStamp(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Unix(1500000000, 123456789)
+	ts := "2017-07-14T02:40:00.123456789Z "
+
+	type testData struct {
+		midLine         bool
+		data            string
+		expected        string
+		expectedMidLine bool
+	}
+
+	data := []testData{
+		{false, "", "", false},
+		{false, "foo", ts + "foo", true},
+		{true, "foo", "foo", true},
+		{false, "foo\n", ts + "foo\n", false},
+		{true, "foo\nbar\n", "foo\n" + ts + "bar\n", false},
+		{false, "foo\n\nbar", ts + "foo\n" + ts + "\n" + ts + "bar", true},
+		{true, "\n", "\n", false},
+	}
+
+	for i, d := range data {
+		timestamper := &outputTimestamper{MidLine: d.midLine}
+		stamped := timestamper.stamp([]byte(d.data), now)
+		assert.Equal(d.expected, string(stamped), "test %d (%+v)", i, d)
+		assert.Equal(d.expectedMidLine, timestamper.MidLine, "test %d (%+v)", i, d)
+	}
+}
+
+func TestReadStdioTimestamps(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "output")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	// Lines written across several writes, stderr not being stamped.
+	const script = "printf foo; sleep 0.2; printf 'bar\\nbaz'; sleep 0.2; printf 'qux\\n\\n'; echo err >&2"
+
+	proc, err := buildProcess(&pb.Process{}, "exec", false)
+	assert.NoError(err)
+	assert.NoError(proc.setupOutputLog(&pb.OutputLog{Directory: dir}))
+	defer proc.closeOutputLog()
+	proc.setOutputTimestamps(&pb.OutputTimestamps{Stdout: true})
+	assert.NotNil(proc.stdoutTimestamper)
+	assert.Nil(proc.stderrTimestamper)
+
+	s := &sandbox{
+		running: true,
+		containers: map[string]*container{
+			"ctr": {
+				id:        "ctr",
+				processes: map[string]*process{"exec": proc},
+			},
+		},
+	}
+
+	cmd := exec.Command("sh", "-c", script)
+	cmd.Stdout = proc.process.Stdout
+	cmd.Stderr = proc.process.Stderr
+	assert.NoError(cmd.Start())
+	proc.closePostStartFDs()
+
+	// Reads shorter than a timestamp return the stamped output in
+	// several parts.
+	readAll := func(stdout bool) string {
+		var out []byte
+		for {
+			data, err := s.readStdio("ctr", "exec", 16, stdout)
+			if err == io.EOF {
+				return string(out)
+			}
+			assert.NoError(err)
+			assert.True(len(data) <= 16)
+			out = append(out, data...)
+		}
+	}
+
+	stdout := readAll(true)
+	stderr := readAll(false)
+	assert.NoError(cmd.Wait())
+	proc.closePostExitFDs()
+
+	assert.Equal("err\n", stderr)
+
+	lines := strings.SplitAfter(stdout, "\n")
+	if !assert.Len(lines, 4, stdout) {
+		return
+	}
+	assert.Equal("", lines[3])
+
+	var times []time.Time
+	for i, expected := range []string{"foobar\n", "bazqux\n", "\n"} {
+		fields := strings.SplitN(lines[i], " ", 2)
+		if !assert.Len(fields, 2, lines[i]) {
+			return
+		}
+		assert.Equal(expected, fields[1])
+
+		ts, err := time.Parse(outputTimestampLayout, fields[0])
+		assert.NoError(err)
+		times = append(times, ts)
+	}
+
+	// Lines are stamped when they start, not when they are completed
+	assert.True(times[1].Sub(times[0]) >= 200*time.Millisecond, "%v", times)
+	assert.True(times[2].Sub(times[1]) >= 200*time.Millisecond, "%v", times)
+
+	// The output log gets the same stamped output
+	content, err := ioutil.ReadFile(filepath.Join(dir, stdoutLogName))
+	assert.NoError(err)
+	assert.Equal(stdout, string(content))
+}
+
+func TestSaveOutputTimestamper(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(saveOutputTimestamper(nil))
+
+	proc, err := buildProcess(&pb.Process{}, "exec", false)
+	assert.NoError(err)
+	proc.setOutputTimestamps(&pb.OutputTimestamps{Stdout: true})
+	defer proc.closePostStartFDs()
+
+	s := &sandbox{
+		running: true,
+		containers: map[string]*container{
+			"ctr": {
+				id:        "ctr",
+				processes: map[string]*process{"exec": proc},
+			},
+		},
+	}
+
+	// The timestamper is saved while a read waits for output
+	readCh := make(chan []byte)
+	go func() {
+		data, _ := s.readStdio("ctr", "exec", 1024, true)
+		readCh <- data
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	savedCh := make(chan *outputTimestamper)
+	go func() {
+		savedCh <- saveOutputTimestamper(proc.stdoutTimestamper)
+	}()
+
+	select {
+	case saved := <-savedCh:
+		assert.Equal(&outputTimestamper{}, saved)
+	case <-time.After(5 * time.Second):
+		assert.Fail("timestamper not saved while reading")
+	}
+
+	_, err = proc.process.Stdout.(*os.File).Write([]byte("foo"))
+	assert.NoError(err)
+	assert.Contains(string(<-readCh), " foo")
+
+	saved := saveOutputTimestamper(proc.stdoutTimestamper)
+	assert.True(saved.MidLine)
+	assert.False(saved == proc.stdoutTimestamper)
+}
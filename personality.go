@@ -0,0 +1,100 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"runtime"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Execution domains, see include/uapi/linux/personality.h.
+var personalityDomains = map[string]uint32{
+	"LINUX":   0x0000,
+	"LINUX32": 0x0008,
+}
+
+var personalityFlags = map[string]uint32{
+	"UNAME26":            0x0020000,
+	"ADDR_NO_RANDOMIZE":  0x0040000,
+	"FDPIC_FUNCPTRS":     0x0080000,
+	"MMAP_PAGE_ZERO":     0x0100000,
+	"ADDR_COMPAT_LAYOUT": 0x0200000,
+	"READ_IMPLIES_EXEC":  0x0400000,
+	"ADDR_LIMIT_32BIT":   0x0800000,
+	"SHORT_INODE":        0x1000000,
+	"WHOLE_SECONDS":      0x2000000,
+	"STICKY_TIMEOUTS":    0x4000000,
+	"ADDR_LIMIT_3GB":     0x8000000,
+}
+
+// parsePersonality converts the OCI personality into the personality(2)
+// argument.
+func parsePersonality(personality *pb.LinuxPersonality) (uint32, error) {
+	persona, ok := personalityDomains[personality.Domain]
+	if !ok {
+		return 0, grpcStatus.Errorf(codes.InvalidArgument, "Unknown personality domain %q", personality.Domain)
+	}
+
+	for _, flag := range personality.Flags {
+		value, ok := personalityFlags[flag]
+		if !ok {
+			return 0, grpcStatus.Errorf(codes.InvalidArgument, "Unknown personality flag %q", flag)
+		}
+		persona |= value
+	}
+
+	return persona, nil
+}
+
+// setPersonality sets the personality of the calling thread and returns
+// the previous one.
+func setPersonality(persona uint32) (uint32, error) {
+	old, _, errno := unix.RawSyscall(unix.SYS_PERSONALITY, uintptr(persona), 0, 0)
+	if errno != 0 {
+		return 0, errno
+	}
+
+	return uint32(old), nil
+}
+
+// withPersonality calls fn with the personality of the current thread
+// set to persona, so that the processes it forks inherit it. The
+// personality being a thread attribute, the goroutine is locked to the
+// thread meanwhile.
+func withPersonality(persona uint32, fn func() error) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	old, err := setPersonality(persona)
+	if err != nil {
+		return err
+	}
+	defer setPersonality(old)
+
+	return fn()
+}
+
+// setContainerPersonality records the personality requested by the spec,
+// validating it.
+func (c *container) setContainerPersonality(linux *pb.Linux) error {
+	if linux == nil || linux.Personality == nil {
+		return nil
+	}
+
+	persona, err := parsePersonality(linux.Personality)
+	if err != nil {
+		return err
+	}
+
+	c.personality = &persona
+
+	return nil
+}
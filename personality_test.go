@@ -0,0 +1,112 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"runtime"
+	"strings"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+)
+
+// Queries the personality without changing it.
+const queryPersonality = 0xffffffff
+
+func TestParsePersonality(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		personality     pb.LinuxPersonality
+		expectedPersona uint32
+		expectError     bool
+	}
+
+	data := []testData{
+		{pb.LinuxPersonality{}, 0, true},
+		{pb.LinuxPersonality{Domain: "LINUX64"}, 0, true},
+		{pb.LinuxPersonality{Domain: "LINUX32", Flags: []string{"FOO"}}, 0, true},
+		{pb.LinuxPersonality{Domain: "LINUX"}, 0, false},
+		{pb.LinuxPersonality{Domain: "LINUX32"}, 0x0008, false},
+		{pb.LinuxPersonality{Domain: "LINUX32", Flags: []string{"ADDR_LIMIT_3GB"}}, 0x8000008, false},
+		{pb.LinuxPersonality{Domain: "LINUX", Flags: []string{"ADDR_NO_RANDOMIZE", "READ_IMPLIES_EXEC"}}, 0x0440000, false},
+	}
+
+	for i, d := range data {
+		persona, err := parsePersonality(&d.personality)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.Equal(d.expectedPersona, persona, "test %d (%+v)", i, d)
+		}
+	}
+}
+
+func TestSetContainerPersonality(t *testing.T) {
+	assert := assert.New(t)
+
+	ctr := &container{}
+
+	assert.NoError(ctr.setContainerPersonality(nil))
+	assert.NoError(ctr.setContainerPersonality(&pb.Linux{}))
+	assert.Nil(ctr.personality)
+
+	assert.Error(ctr.setContainerPersonality(&pb.Linux{Personality: &pb.LinuxPersonality{Domain: "foo"}}))
+	assert.Nil(ctr.personality)
+
+	assert.NoError(ctr.setContainerPersonality(&pb.Linux{Personality: &pb.LinuxPersonality{Domain: "LINUX32"}}))
+	if assert.NotNil(ctr.personality) {
+		assert.Equal(uint32(0x0008), *ctr.personality)
+	}
+}
+
+func TestWithPersonality(t *testing.T) {
+	assert := assert.New(t)
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	saved, err := setPersonality(queryPersonality)
+	assert.NoError(err)
+
+	persona, err := parsePersonality(&pb.LinuxPersonality{
+		Domain: "LINUX32",
+		Flags:  []string{"ADDR_NO_RANDOMIZE"},
+	})
+	assert.NoError(err)
+
+	cmd := exec.Command("sleep", "10")
+	err = withPersonality(persona, cmd.Start)
+	assert.NoError(err)
+	defer func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	}()
+
+	current, err := setPersonality(queryPersonality)
+	assert.NoError(err)
+	assert.Equal(saved, current)
+
+	content, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/personality", cmd.Process.Pid))
+	assert.NoError(err)
+	assert.Equal("00040008", strings.TrimSpace(string(content)))
+
+	if runtime.GOARCH == "amd64" {
+		var out []byte
+		err = withPersonality(persona, func() (err error) {
+			out, err = exec.Command("uname", "-m").Output()
+			return err
+		})
+		assert.NoError(err)
+		assert.Equal("i686", strings.TrimSpace(string(out)))
+	}
+}
@@ -0,0 +1,68 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// set function in variable to overwrite for testing.
+var procEnvironPath = func(pid int) string {
+	return fmt.Sprintf("/proc/%d/environ", pid)
+}
+
+// readProcessEnv returns the environment of the process, as it was when
+// the process was executed. The variables are NUL separated.
+func readProcessEnv(pid int) ([]string, error) {
+	content, err := ioutil.ReadFile(procEnvironPath(pid))
+	if err != nil {
+		return nil, err
+	}
+
+	var env []string
+	for _, e := range bytes.Split(content, []byte{0}) {
+		if len(e) > 0 {
+			env = append(env, string(e))
+		}
+	}
+
+	return env, nil
+}
+
+// redactEnv replaces the value of the variables whose name matches one of
+// the expressions.
+func redactEnv(env []string, redact []string) ([]string, error) {
+	var exprs []*regexp.Regexp
+	for _, r := range redact {
+		expr, err := regexp.Compile(r)
+		if err != nil {
+			return nil, grpcStatus.Errorf(codes.InvalidArgument, "Invalid redaction expression %q: %v", r, err)
+		}
+		exprs = append(exprs, expr)
+	}
+
+	redacted := make([]string, 0, len(env))
+	for _, e := range env {
+		name := strings.SplitN(e, "=", 2)[0]
+		for _, expr := range exprs {
+			if expr.MatchString(name) {
+				e = name + "=" + envMaskedValue
+				break
+			}
+		}
+		redacted = append(redacted, e)
+	}
+
+	return redacted, nil
+}
@@ -0,0 +1,94 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactEnv(t *testing.T) {
+	assert := assert.New(t)
+
+	env := []string{"PATH=/bin", "API_TOKEN=secret", "TOKEN_FILE=/run/token", "EMPTY"}
+
+	redacted, err := redactEnv(env, nil)
+	assert.NoError(err)
+	assert.Equal(env, redacted)
+
+	redacted, err = redactEnv(env, []string{"^API_", "^EMPTY$"})
+	assert.NoError(err)
+	assert.Equal([]string{"PATH=/bin", "API_TOKEN=***", "TOKEN_FILE=/run/token", "EMPTY=***"}, redacted)
+
+	_, err = redactEnv(env, []string{"("})
+	assert.Error(err)
+}
+
+func TestGetProcessEnv(t *testing.T) {
+	assert := assert.New(t)
+
+	cmd := exec.Command("sleep", "10")
+	cmd.Env = []string{"FOO=bar", "API_TOKEN=secret", "PATH=/usr/bin:/bin"}
+	assert.NoError(cmd.Start())
+	defer func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	}()
+
+	mockCtr := &mockContainer{status: libcontainer.Running}
+	initProc := &process{id: "init", restoredPid: cmd.Process.Pid}
+	execProc := &process{id: "exec", restoredPid: cmd.Process.Pid}
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			running: true,
+			containers: map[string]*container{
+				"foo": {
+					id:          "foo",
+					container:   mockCtr,
+					initProcess: initProc,
+					processes: map[string]*process{
+						"init": initProc,
+						"exec": execProc,
+					},
+				},
+			},
+		},
+	}
+
+	req := &pb.GetProcessEnvRequest{ContainerId: "foo"}
+	resp, err := a.GetProcessEnv(context.Background(), req)
+	assert.NoError(err)
+	assert.Equal(cmd.Env, resp.Env)
+
+	req.ExecId = "exec"
+	req.Redact = []string{"TOKEN"}
+	resp, err = a.GetProcessEnv(context.Background(), req)
+	assert.NoError(err)
+	assert.Equal([]string{"FOO=bar", "API_TOKEN=***", "PATH=/usr/bin:/bin"}, resp.Env)
+
+	// Only the processes managed by the agent can be inspected.
+	req.ExecId = "bar"
+	_, err = a.GetProcessEnv(context.Background(), req)
+	assert.Error(err)
+
+	req.ContainerId = "bar"
+	req.ExecId = ""
+	_, err = a.GetProcessEnv(context.Background(), req)
+	assert.Error(err)
+
+	// The init process is not the container one until it is started.
+	mockCtr.status = libcontainer.Created
+	req.ContainerId = "foo"
+	_, err = a.GetProcessEnv(context.Background(), req)
+	assert.Error(err)
+}
@@ -0,0 +1,183 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+)
+
+// Types of the open files of a process.
+const (
+	openFileRegular   = "regular"
+	openFileDirectory = "directory"
+	openFileSocket    = "socket"
+	openFilePipe      = "pipe"
+	openFileDevice    = "device"
+	openFileOther     = "other"
+)
+
+// Socket tables of /proc/<pid>/net, with the column holding the socket
+// inode.
+var procNetSocketTables = []struct {
+	protocol string
+	column   int
+}{
+	{"tcp", 9},
+	{"tcp6", 9},
+	{"udp", 9},
+	{"udp6", 9},
+	{"unix", 6},
+}
+
+// openFileType returns the type of the file a descriptor refers to.
+func openFileType(mode os.FileMode) string {
+	switch {
+	case mode.IsRegular():
+		return openFileRegular
+	case mode.IsDir():
+		return openFileDirectory
+	case mode&os.ModeSocket != 0:
+		return openFileSocket
+	case mode&os.ModeNamedPipe != 0:
+		return openFilePipe
+	case mode&os.ModeDevice != 0:
+		return openFileDevice
+	default:
+		return openFileOther
+	}
+}
+
+// readSocketProtocols returns the protocol of the sockets of the network
+// namespace of the process, by inode. Missing tables, such as the IPv6
+// ones without IPv6 support, are skipped.
+func readSocketProtocols(pid int) (map[string]string, error) {
+	protocols := make(map[string]string)
+
+	for _, table := range procNetSocketTables {
+		f, err := os.Open(fmt.Sprintf("/proc/%d/net/%s", pid, table.protocol))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		scanner := bufio.NewScanner(f)
+		// Skip the header
+		scanner.Scan()
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+			if len(fields) > table.column {
+				protocols[fields[table.column]] = table.protocol
+			}
+		}
+
+		err = scanner.Err()
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return protocols, nil
+}
+
+// readProcessFiles counts the open files of the process by type, listing
+// them when details are requested. Descriptors closed while being read are
+// skipped.
+func readProcessFiles(pid int, details bool) (*pb.ProcessFiles, error) {
+	fdDir := fmt.Sprintf("/proc/%d/fd", pid)
+
+	dir, err := os.Open(fdDir)
+	if err != nil {
+		return nil, err
+	}
+	names, err := dir.Readdirnames(-1)
+	dir.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	var fds []int
+	for _, name := range names {
+		if fd, err := strconv.Atoi(name); err == nil {
+			fds = append(fds, fd)
+		}
+	}
+	sort.Ints(fds)
+
+	var protocols map[string]string
+
+	files := &pb.ProcessFiles{}
+	for _, fd := range fds {
+		path := filepath.Join(fdDir, strconv.Itoa(fd))
+
+		// The descriptor links are followed by stat, even for the
+		// pipes and sockets without a path.
+		st, err := os.Stat(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		fileType := openFileType(st.Mode())
+		switch fileType {
+		case openFileRegular:
+			files.Regular++
+		case openFileSocket:
+			files.Socket++
+		case openFilePipe:
+			files.Pipe++
+		default:
+			files.Other++
+		}
+
+		if !details {
+			continue
+		}
+
+		target, err := os.Readlink(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		file := &pb.OpenFile{
+			Fd:     int32(fd),
+			Type:   fileType,
+			Target: target,
+		}
+
+		if fileType == openFileSocket {
+			if protocols == nil {
+				if protocols, err = readSocketProtocols(pid); err != nil {
+					return nil, err
+				}
+			}
+
+			// Socket targets are "socket:[<inode>]".
+			inode := strings.TrimSuffix(strings.TrimPrefix(target, "socket:["), "]")
+			file.Protocol = protocols[inode]
+		}
+
+		files.Files = append(files.Files, file)
+	}
+
+	return files, nil
+}
@@ -0,0 +1,145 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestOpenFileType(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		mode     os.FileMode
+		expected string
+	}
+
+	data := []testData{
+		{0644, openFileRegular},
+		{os.ModeDir | 0755, openFileDirectory},
+		{os.ModeSocket | 0777, openFileSocket},
+		{os.ModeNamedPipe | 0600, openFilePipe},
+		{os.ModeDevice | os.ModeCharDevice | 0666, openFileDevice},
+		{os.ModeDevice | 0660, openFileDevice},
+		{os.ModeIrregular, openFileOther},
+	}
+
+	for i, d := range data {
+		assert.Equal(d.expected, openFileType(d.mode), "test %d (%+v)", i, d)
+	}
+}
+
+func TestGetProcessFiles(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "files")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	regular, err := os.Create(filepath.Join(dir, "regular"))
+	assert.NoError(err)
+	defer regular.Close()
+
+	pipeReader, pipeWriter, err := os.Pipe()
+	assert.NoError(err)
+	defer pipeReader.Close()
+	defer pipeWriter.Close()
+
+	unixListener, err := net.ListenUnix("unix", &net.UnixAddr{Name: filepath.Join(dir, "sock"), Net: "unix"})
+	assert.NoError(err)
+	defer unixListener.Close()
+	unixFile, err := unixListener.File()
+	assert.NoError(err)
+	defer unixFile.Close()
+
+	tcpListener, err := net.ListenTCP("tcp4", &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	assert.NoError(err)
+	defer tcpListener.Close()
+	tcpFile, err := tcpListener.File()
+	assert.NoError(err)
+	defer tcpFile.Close()
+
+	// The standard streams are /dev/null, the other files being passed
+	// as 3, 4, 5 and 6.
+	cmd := exec.Command("sleep", "10")
+	cmd.ExtraFiles = []*os.File{regular, pipeReader, unixFile, tcpFile}
+	assert.NoError(cmd.Start())
+	defer func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	}()
+
+	initProc := &process{id: "init", restoredPid: cmd.Process.Pid}
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			running: true,
+			containers: map[string]*container{
+				"foo": {
+					id:          "foo",
+					container:   &mockContainer{status: libcontainer.Running},
+					initProcess: initProc,
+					processes: map[string]*process{
+						"init": initProc,
+					},
+				},
+			},
+		},
+	}
+
+	req := &pb.GetProcessFilesRequest{ContainerId: "foo"}
+	files, err := a.GetProcessFiles(context.Background(), req)
+	assert.NoError(err)
+	assert.Equal(&pb.ProcessFiles{Regular: 1, Socket: 2, Pipe: 1, Other: 3}, files)
+
+	req.Details = true
+	files, err = a.GetProcessFiles(context.Background(), req)
+	assert.NoError(err)
+	if !assert.Len(files.Files, 7) {
+		return
+	}
+
+	for fd := 0; fd < 3; fd++ {
+		assert.Equal(&pb.OpenFile{Fd: int32(fd), Type: openFileDevice, Target: os.DevNull}, files.Files[fd])
+	}
+
+	assert.Equal(&pb.OpenFile{Fd: 3, Type: openFileRegular, Target: regular.Name()}, files.Files[3])
+
+	type testData struct {
+		fileType string
+		protocol string
+	}
+
+	for i, d := range []testData{
+		{openFilePipe, ""},
+		{openFileSocket, "unix"},
+		{openFileSocket, "tcp"},
+	} {
+		file := files.Files[4+i]
+		assert.Equal(int32(4+i), file.Fd, "test %d (%+v)", i, d)
+		assert.Equal(d.fileType, file.Type, "test %d (%+v)", i, d)
+		assert.Equal(d.protocol, file.Protocol, "test %d (%+v)", i, d)
+		assert.Regexp("^"+d.fileType+`:\[[0-9]+\]$`, file.Target, "test %d (%+v)", i, d)
+	}
+
+	// Only the processes managed by the agent can be inspected.
+	req.ExecId = "bar"
+	_, err = a.GetProcessFiles(context.Background(), req)
+	assert.Equal(codes.NotFound, grpcStatus.Code(err))
+}
@@ -0,0 +1,265 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const procSysPath = "/proc/sys"
+
+// Entries of /proc/sys which are namespaced, see the sysctl validation of
+// libcontainer. Entries ending with a slash are directories.
+var namespacedProcSysPaths = []string{
+	"/proc/sys/net/",
+	"/proc/sys/fs/mqueue/",
+	"/proc/sys/kernel/msgmax",
+	"/proc/sys/kernel/msgmnb",
+	"/proc/sys/kernel/msgmni",
+	"/proc/sys/kernel/sem",
+	"/proc/sys/kernel/shmall",
+	"/proc/sys/kernel/shmmax",
+	"/proc/sys/kernel/shmmni",
+	"/proc/sys/kernel/shm_rmid_forced",
+	"/proc/sys/kernel/hostname",
+	"/proc/sys/kernel/domainname",
+}
+
+// Guest wide sysctls which can be set when creating the sandbox.
+var sandboxSysctlAllowlist = map[string]bool{
+	"fs.aio-max-nr":                  true,
+	"fs.file-max":                    true,
+	"fs.inotify.max_user_instances":  true,
+	"fs.inotify.max_user_watches":    true,
+	"fs.nr_open":                     true,
+	"kernel.keys.maxkeys":            true,
+	"kernel.pid_max":                 true,
+	"kernel.sched_autogroup_enabled": true,
+	"kernel.threads-max":             true,
+	"vm.dirty_background_ratio":      true,
+	"vm.dirty_expire_centisecs":      true,
+	"vm.dirty_ratio":                 true,
+	"vm.dirty_writeback_centisecs":   true,
+	"vm.max_map_count":               true,
+	"vm.min_free_kbytes":             true,
+	"vm.overcommit_memory":           true,
+	"vm.overcommit_ratio":            true,
+	"vm.swappiness":                  true,
+	"vm.vfs_cache_pressure":          true,
+	"vm.watermark_scale_factor":      true,
+}
+
+// Values of the allowlisted sysctls, made of integers.
+var sandboxSysctlValueRegex = regexp.MustCompile(`^-?[0-9]+([ \t]+-?[0-9]+)*$`)
+
+// Root of the sysctls set for the sandbox, overwritten for testing.
+var sandboxSysctlRoot = procSysPath
+
+// isNamespacedProcSysPath returns true if path is a namespaced /proc/sys
+// entry, or a directory holding only namespaced entries.
+func isNamespacedProcSysPath(path string) bool {
+	for _, p := range namespacedProcSysPaths {
+		if path == strings.TrimSuffix(p, "/") {
+			return true
+		}
+		if strings.HasSuffix(p, "/") && strings.HasPrefix(path, p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateProcSysWritable checks that the paths left writable in /proc/sys
+// cannot affect the whole guest.
+func validateProcSysWritable(paths []string) error {
+	for _, path := range paths {
+		if filepath.Clean(path) != path || !isNamespacedProcSysPath(path) {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Path %q is not a namespaced /proc/sys entry", path)
+		}
+	}
+
+	return nil
+}
+
+// bindMountSelf bind mounts path onto itself, read-only or not.
+func bindMountSelf(path string, readonly bool) error {
+	if err := unix.Mount(path, path, "", unix.MS_BIND|unix.MS_REC, ""); err != nil {
+		return err
+	}
+
+	flags := uintptr(unix.MS_BIND | unix.MS_REMOUNT)
+	if readonly {
+		flags |= unix.MS_RDONLY
+	}
+
+	return unix.Mount(path, path, "", flags, "")
+}
+
+// mountProcSysWritable makes procSys read-only, except for the given
+// entries which are bind mounted read-write on top of it.
+func mountProcSysWritable(procSys string, paths []string) error {
+	if err := bindMountSelf(procSys, true); err != nil {
+		return fmt.Errorf("could not make %s read-only: %v", procSys, err)
+	}
+
+	for _, path := range paths {
+		target := filepath.Join(procSys, strings.TrimPrefix(path, procSysPath))
+		if err := bindMountSelf(target, false); err != nil {
+			return fmt.Errorf("could not make %s writable: %v", target, err)
+		}
+	}
+
+	return nil
+}
+
+// setupProcSysWritable applies the /proc/sys mounts from the mount
+// namespace of the process. The mount namespace cannot be joined by a
+// thread sharing its filesystem attributes with the others, hence a
+// dedicated thread, which switches back to the agent mount namespace once
+// done. The thread stays locked so that it is not reused by other
+// goroutines, as it does not share the agent working directory anymore.
+func setupProcSysWritable(pid int, procSys string, paths []string) error {
+	errCh := make(chan error, 1)
+
+	go func() {
+		runtime.LockOSThread()
+
+		errCh <- func() error {
+			origNs, err := os.Open(getCurrentThreadNSPath("mnt"))
+			if err != nil {
+				return err
+			}
+			defer origNs.Close()
+
+			ns, err := os.Open(fmt.Sprintf("/proc/%d/ns/mnt", pid))
+			if err != nil {
+				return err
+			}
+			defer ns.Close()
+
+			if err := unix.Unshare(unix.CLONE_FS); err != nil {
+				return err
+			}
+
+			if err := unix.Setns(int(ns.Fd()), unix.CLONE_NEWNS); err != nil {
+				return err
+			}
+			defer unix.Setns(int(origNs.Fd()), unix.CLONE_NEWNS)
+
+			return mountProcSysWritable(procSys, paths)
+		}()
+	}()
+
+	return <-errCh
+}
+
+// addProcSysWritableHook keeps the given /proc/sys entries writable in the
+// container. This is done from a prestart hook, run once the container
+// mounts are in place, since libcontainer forbids mounts under /proc.
+func addProcSysWritableHook(config *configs.Config, paths []string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	if err := validateProcSysWritable(paths); err != nil {
+		return err
+	}
+
+	// The hook takes care of making /proc/sys read-only.
+	var readonlyPaths []string
+	for _, p := range config.ReadonlyPaths {
+		if p != procSysPath {
+			readonlyPaths = append(readonlyPaths, p)
+		}
+	}
+	config.ReadonlyPaths = readonlyPaths
+
+	procSys := filepath.Join(config.Rootfs, procSysPath)
+
+	if config.Hooks == nil {
+		config.Hooks = &configs.Hooks{}
+	}
+
+	config.Hooks.Prestart = append(config.Hooks.Prestart, configs.NewFunctionHook(func(s *specs.State) error {
+		return setupProcSysWritable(s.Pid, procSys, paths)
+	}))
+
+	return nil
+}
+
+// sysctlProcSysPath returns the /proc/sys entry of the sysctl, see
+// sysctl.conf(5).
+func sysctlProcSysPath(root, key string) string {
+	return filepath.Join(root, strings.Replace(key, ".", "/", -1))
+}
+
+// validateSandboxSysctls checks that the sysctls are allowlisted guest wide
+// ones, with valid values.
+func validateSandboxSysctls(sysctls map[string]string) error {
+	for key, value := range sysctls {
+		if isNamespacedProcSysPath(sysctlProcSysPath(procSysPath, key)) {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Sysctl %q is namespaced, it must be set per container", key)
+		}
+
+		if !sandboxSysctlAllowlist[key] {
+			return grpcStatus.Errorf(codes.PermissionDenied, "Sysctl %q is not allowed", key)
+		}
+
+		if !sandboxSysctlValueRegex.MatchString(strings.TrimSpace(value)) {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid value %q of sysctl %q", value, key)
+		}
+	}
+
+	return nil
+}
+
+// applySandboxSysctls sets the guest wide sysctls, which are expected to
+// have been validated.
+func applySandboxSysctls(sysctls map[string]string) error {
+	var keys []string
+	for key := range sysctls {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		path := sysctlProcSysPath(sandboxSysctlRoot, key)
+		value := strings.TrimSpace(sysctls[key])
+
+		previous, err := ioutil.ReadFile(path)
+		if err != nil {
+			return grpcStatus.Errorf(codes.FailedPrecondition, "Could not read sysctl %q: %v", key, err)
+		}
+
+		if err := ioutil.WriteFile(path, []byte(value), 0); err != nil {
+			return grpcStatus.Errorf(codes.Internal, "Could not set sysctl %q to %q: %v", key, value, err)
+		}
+
+		agentLog.WithFields(logrus.Fields{
+			"sysctl":         key,
+			"value":          value,
+			"previous-value": strings.TrimSpace(string(previous)),
+		}).Info("Sandbox sysctl set")
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestValidateProcSysWritable(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		paths       []string
+		expectError bool
+	}
+
+	data := []testData{
+		{nil, false},
+		{[]string{"/proc/sys/net"}, false},
+		{[]string{"/proc/sys/net/ipv4/ip_forward", "/proc/sys/kernel/shmmax"}, false},
+		{[]string{"/proc/sys/fs/mqueue/msg_max"}, false},
+		{[]string{"/proc/sys/kernel/hostname"}, false},
+		{[]string{"/proc/sys"}, true},
+		{[]string{"/proc/sys/kernel"}, true},
+		{[]string{"/proc/sys/kernel/pid_max"}, true},
+		{[]string{"/proc/sys/kernel/shmmax2"}, true},
+		{[]string{"/proc/sys/netfoo"}, true},
+		{[]string{"/proc/sys/net/../kernel/pid_max"}, true},
+		{[]string{"/proc/sys/net/"}, true},
+		{[]string{"net/ipv4"}, true},
+		{[]string{"/proc/sys/net", "/proc/sys/vm/swappiness"}, true},
+	}
+
+	for i, d := range data {
+		err := validateProcSysWritable(d.paths)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+	}
+}
+
+func TestAddProcSysWritableHook(t *testing.T) {
+	assert := assert.New(t)
+
+	readonlyPaths := []string{"/proc/asound", "/proc/sys", "/proc/sysrq-trigger"}
+
+	config := &configs.Config{
+		Rootfs:        "/rootfs",
+		ReadonlyPaths: readonlyPaths,
+	}
+
+	// Nothing to do without writable entries
+	assert.NoError(addProcSysWritableHook(config, nil))
+	assert.Equal(readonlyPaths, config.ReadonlyPaths)
+	assert.Nil(config.Hooks)
+
+	assert.Error(addProcSysWritableHook(config, []string{"/proc/sys/kernel/pid_max"}))
+	assert.Nil(config.Hooks)
+
+	assert.NoError(addProcSysWritableHook(config, []string{"/proc/sys/net"}))
+	assert.Equal([]string{"/proc/asound", "/proc/sysrq-trigger"}, config.ReadonlyPaths)
+	assert.Len(config.Hooks.Prestart, 1)
+}
+
+// procSysMountOptions returns the options of the mounts of the process
+// located under /proc/sys.
+func procSysMountOptions(t *testing.T, pid int) map[string]string {
+	f, err := os.Open(fmt.Sprintf("/proc/%d/mountinfo", pid))
+	assert.NoError(t, err)
+	defer f.Close()
+
+	options := make(map[string]string)
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) > 5 && strings.HasPrefix(fields[4], procSysPath) {
+			// Most recent mount wins
+			options[fields[4]] = strings.Split(fields[5], ",")[0]
+		}
+	}
+
+	assert.NoError(t, scanner.Err())
+
+	return options
+}
+
+func TestSetupProcSysWritable(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	// Process standing for the container, with a private mount namespace
+	cmd := exec.Command("sleep", "60")
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Unshareflags: syscall.CLONE_NEWNS,
+	}
+	assert.NoError(cmd.Start())
+	defer func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	}()
+
+	err := setupProcSysWritable(cmd.Process.Pid, procSysPath, []string{"/proc/sys/net/ipv4", "/proc/sys/kernel/shmmax"})
+	assert.NoError(err)
+
+	assert.Equal(map[string]string{
+		"/proc/sys":               "ro",
+		"/proc/sys/net/ipv4":      "rw",
+		"/proc/sys/kernel/shmmax": "rw",
+	}, procSysMountOptions(t, cmd.Process.Pid))
+
+	// The agent mount namespace is left untouched
+	assert.Empty(procSysMountOptions(t, os.Getpid()))
+}
+
+func TestValidateSandboxSysctls(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		sysctls      map[string]string
+		expectedCode codes.Code
+	}
+
+	data := []testData{
+		{nil, codes.OK},
+		{map[string]string{"vm.overcommit_memory": "1"}, codes.OK},
+		{map[string]string{"vm.overcommit_memory": "1\n", "fs.nr_open": "1048576"}, codes.OK},
+		{map[string]string{"net.ipv4.ip_forward": "1"}, codes.InvalidArgument},
+		{map[string]string{"kernel.shmmax": "1"}, codes.InvalidArgument},
+		{map[string]string{"kernel.panic": "1"}, codes.PermissionDenied},
+		{map[string]string{"vm/overcommit_memory": "1"}, codes.PermissionDenied},
+		{map[string]string{"vm.overcommit_memory": ""}, codes.InvalidArgument},
+		{map[string]string{"vm.overcommit_memory": "foo"}, codes.InvalidArgument},
+		{map[string]string{"vm.overcommit_memory": "1\n2x"}, codes.InvalidArgument},
+	}
+
+	for i, d := range data {
+		err := validateSandboxSysctls(d.sysctls)
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v)", i, d)
+	}
+}
+
+func TestApplySandboxSysctls(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedSandboxSysctlRoot := sandboxSysctlRoot
+	defer func() {
+		sandboxSysctlRoot = savedSandboxSysctlRoot
+	}()
+	sandboxSysctlRoot = dir
+
+	file := filepath.Join(dir, "vm", "overcommit_memory")
+	assert.NoError(os.MkdirAll(filepath.Dir(file), testDirMode))
+	assert.NoError(ioutil.WriteFile(file, []byte("0\n"), testFileMode))
+
+	err = applySandboxSysctls(map[string]string{"vm.overcommit_memory": "1\n"})
+	assert.NoError(err)
+
+	content, err := ioutil.ReadFile(file)
+	assert.NoError(err)
+	assert.Equal("1", string(content))
+
+	err = applySandboxSysctls(map[string]string{"vm.swappiness": "10"})
+	assert.Equal(codes.FailedPrecondition, grpcStatus.Code(err))
+}
+
+func TestCreateSandboxSysctls(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: make(map[string]*container),
+		},
+	}
+
+	req := &pb.CreateSandboxRequest{
+		Sysctls: map[string]string{"kernel.panic": "1"},
+	}
+
+	_, err := a.CreateSandbox(context.Background(), req)
+	assert.Equal(codes.PermissionDenied, grpcStatus.Code(err))
+	assert.False(a.sandbox.running)
+}
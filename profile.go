@@ -0,0 +1,65 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"runtime/pprof"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	cpuProfileName = "cpu"
+
+	// Duration of the CPU profiles, when not requested, and its upper
+	// bound, the request being held meanwhile.
+	defaultCPUProfileDuration = 5 * time.Second
+	maxCPUProfileDuration     = 30 * time.Second
+)
+
+// getProfile returns a profile of the agent in the gzip-compressed protobuf
+// pprof format, either a CPU profile over the duration or one of the
+// runtime/pprof profiles.
+func getProfile(name string, duration time.Duration) ([]byte, error) {
+	if !profiling {
+		return nil, grpcStatus.Errorf(codes.PermissionDenied, "Profiling the agent requires %s", profilingFlag)
+	}
+
+	var buf bytes.Buffer
+
+	if name == cpuProfileName {
+		if duration == 0 {
+			duration = defaultCPUProfileDuration
+		}
+		if duration > maxCPUProfileDuration {
+			return nil, grpcStatus.Errorf(codes.InvalidArgument, "CPU profile duration %s longer than %s", duration, maxCPUProfileDuration)
+		}
+
+		// Only one CPU profile can be running.
+		if err := pprof.StartCPUProfile(&buf); err != nil {
+			return nil, grpcStatus.Errorf(codes.Unavailable, "Could not start CPU profile: %v", err)
+		}
+		time.Sleep(duration)
+		pprof.StopCPUProfile()
+
+		return buf.Bytes(), nil
+	}
+
+	profile := pprof.Lookup(name)
+	if profile == nil {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "Unknown profile %q", name)
+	}
+
+	if err := profile.WriteTo(&buf, 0); err != nil {
+		return nil, grpcStatus.Errorf(codes.Internal, "Could not write %s profile: %v", name, err)
+	}
+
+	return buf.Bytes(), nil
+}
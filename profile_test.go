@@ -0,0 +1,145 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// parsePprof decodes the gzip-compressed pprof payload, returning the
+// number of sample types and the string table of the profile.
+func parsePprof(data []byte) (int, []string, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// Fields of the Profile message, see profile.proto in
+	// github.com/google/pprof.
+	const (
+		sampleTypeField  = 1
+		stringTableField = 6
+	)
+
+	var sampleTypes int
+	var strings []string
+
+	buf := proto.NewBuffer(content)
+	for {
+		key, err := buf.DecodeVarint()
+		if err != nil {
+			break
+		}
+
+		switch key & 7 {
+		case proto.WireVarint:
+			_, err = buf.DecodeVarint()
+		case proto.WireFixed64:
+			_, err = buf.DecodeFixed64()
+		case proto.WireFixed32:
+			_, err = buf.DecodeFixed32()
+		case proto.WireBytes:
+			var value []byte
+			value, err = buf.DecodeRawBytes(true)
+			switch key >> 3 {
+			case sampleTypeField:
+				sampleTypes++
+			case stringTableField:
+				strings = append(strings, string(value))
+			}
+		default:
+			err = fmt.Errorf("unexpected wire type %d", key&7)
+		}
+
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	if len(strings) == 0 || strings[0] != "" {
+		return 0, nil, fmt.Errorf("invalid string table %v", strings)
+	}
+
+	return sampleTypes, strings, nil
+}
+
+func TestGetProfile(t *testing.T) {
+	assert := assert.New(t)
+
+	savedProfiling := profiling
+	defer func() {
+		profiling = savedProfiling
+	}()
+
+	a := &agentGRPC{}
+	req := &pb.GetProfileRequest{Name: "goroutine"}
+
+	profiling = false
+	_, err := a.GetProfile(context.Background(), req)
+	assert.Equal(codes.PermissionDenied, grpcStatus.Code(err))
+
+	profiling = true
+	resp, err := a.GetProfile(context.Background(), req)
+	if !assert.NoError(err) {
+		return
+	}
+
+	sampleTypes, strings, err := parsePprof(resp.Data)
+	assert.NoError(err)
+	assert.Equal(1, sampleTypes)
+	assert.Contains(strings, "goroutine")
+	assert.Contains(strings, "github.com/kata-containers/agent.TestGetProfile")
+
+	_, err = a.GetProfile(context.Background(), &pb.GetProfileRequest{Name: "foo"})
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+
+	// The CPU profile duration is bounded
+	_, err = a.GetProfile(context.Background(), &pb.GetProfileRequest{
+		Name:    cpuProfileName,
+		Seconds: uint32(maxCPUProfileDuration/time.Second) + 1,
+	})
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+}
+
+func TestGetCPUProfile(t *testing.T) {
+	assert := assert.New(t)
+
+	savedProfiling := profiling
+	defer func() {
+		profiling = savedProfiling
+	}()
+	profiling = true
+
+	start := time.Now()
+	data, err := getProfile(cpuProfileName, 100*time.Millisecond)
+	if !assert.NoError(err) {
+		return
+	}
+	assert.True(time.Since(start) >= 100*time.Millisecond)
+
+	sampleTypes, strings, err := parsePprof(data)
+	assert.NoError(err)
+	assert.Equal(2, sampleTypes)
+	assert.Contains(strings, "cpu")
+}
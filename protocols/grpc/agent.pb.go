@@ -11,16 +11,35 @@
 
 	It has these top-level messages:
 		CreateContainerRequest
+		OutputLog
+		OutputTimestamps
 		StartContainerRequest
 		RemoveContainerRequest
 		ExecProcessRequest
+		ExecProcessResponse
 		SignalProcessRequest
 		WaitProcessRequest
 		WaitProcessResponse
 		ListProcessesRequest
 		ListProcessesResponse
+		GetProcessEnvRequest
+		ProcessEnv
+		GetProcessFilesRequest
+		OpenFile
+		ProcessFiles
+		ListContainerMountsRequest
+		ContainerMount
+		ContainerMounts
+		ExposeContainerRootfsRequest
+		ExposeContainerRootfsResponse
+		UnexposeContainerRootfsRequest
+		ReadContainerRootfsRequest
+		RootfsEntry
+		ContainerRootfsFile
 		UpdateContainerRequest
 		StatsContainerRequest
+		MemoryStatContainerRequest
+		MemoryStatContainerResponse
 		PauseContainerRequest
 		ResumeContainerRequest
 		CpuUsage
@@ -34,7 +53,12 @@
 		HugetlbStats
 		CgroupStats
 		NetworkStats
+		WorkingSetStats
+		PageCacheStats
+		OOMControl
 		StatsContainerResponse
+		StatsSandboxRequest
+		StatsSandboxResponse
 		WriteStreamRequest
 		WriteStreamResponse
 		ReadStreamRequest
@@ -48,6 +72,11 @@
 		Routes
 		UpdateInterfaceRequest
 		UpdateRoutesRequest
+		SourceRoutingInterface
+		UpdateSourceRoutingRequest
+		SourceRouting
+		SetTransparentProxyRequest
+		RemoveTransparentProxyRequest
 		ListInterfacesRequest
 		ListRoutesRequest
 		OnlineCPUMemRequest
@@ -60,14 +89,30 @@
 		Storage
 		Device
 		StringUser
+		CheckKernelModuleRequest
+		KernelModuleStatus
+		RestartAgentRequest
 		CopyFileRequest
+		ExtractLayerRequest
 		StartTracingRequest
 		StopTracingRequest
+		GetProfileRequest
+		Profile
+		GetAPIVersionRequest
+		APIVersionResponse
+		GuestFilesystemsRequest
+		GuestFilesystem
+		GuestFilesystems
+		InitTimingsRequest
+		InitPhase
+		InitTimings
 		CheckRequest
 		HealthCheckResponse
 		VersionCheckResponse
 		Spec
 		Process
+		Landlock
+		LandlockPathBeneath
 		Box
 		User
 		LinuxCapabilities
@@ -77,6 +122,7 @@
 		Hooks
 		Hook
 		Linux
+		LinuxPersonality
 		Windows
 		Solaris
 		LinuxIDMapping
@@ -122,6 +168,67 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+// Delivery of the process output through ReadStdout and ReadStderr,
+// the process not using a terminal.
+type ExecProcessRequest_OutputBuffering int32
+
+const (
+	// Each read returns the output available.
+	ExecProcessRequest_NONE ExecProcessRequest_OutputBuffering = 0
+	// Reads return complete lines, a partial line being returned
+	// once not completed within the flush interval.
+	ExecProcessRequest_LINE ExecProcessRequest_OutputBuffering = 1
+	// Reads return the requested length, or less at the end of the
+	// output.
+	ExecProcessRequest_BLOCK ExecProcessRequest_OutputBuffering = 2
+)
+
+var ExecProcessRequest_OutputBuffering_name = map[int32]string{
+	0: "NONE",
+	1: "LINE",
+	2: "BLOCK",
+}
+var ExecProcessRequest_OutputBuffering_value = map[string]int32{
+	"NONE":  0,
+	"LINE":  1,
+	"BLOCK": 2,
+}
+
+func (x ExecProcessRequest_OutputBuffering) String() string {
+	return proto.EnumName(ExecProcessRequest_OutputBuffering_name, int32(x))
+}
+func (ExecProcessRequest_OutputBuffering) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorAgent, []int{5, 0}
+}
+
+// Reason describes how the process terminated. The signal
+// field is only set when the process was killed by a signal.
+type WaitProcessResponse_Reason int32
+
+const (
+	WaitProcessResponse_EXITED     WaitProcessResponse_Reason = 0
+	WaitProcessResponse_SIGNALED   WaitProcessResponse_Reason = 1
+	WaitProcessResponse_OOM_KILLED WaitProcessResponse_Reason = 2
+)
+
+var WaitProcessResponse_Reason_name = map[int32]string{
+	0: "EXITED",
+	1: "SIGNALED",
+	2: "OOM_KILLED",
+}
+var WaitProcessResponse_Reason_value = map[string]int32{
+	"EXITED":     0,
+	"SIGNALED":   1,
+	"OOM_KILLED": 2,
+}
+
+func (x WaitProcessResponse_Reason) String() string {
+	return proto.EnumName(WaitProcessResponse_Reason_name, int32(x))
+}
+func (WaitProcessResponse_Reason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorAgent, []int{9, 0}
+}
+
 type CreateContainerRequest struct {
 	ContainerId string      `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 	ExecId      string      `protobuf:"bytes,2,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
@@ -135,6 +242,35 @@ type CreateContainerRequest struct {
 	// The agent would receive an OCI spec with PID namespace cleared
 	// out altogether and not just the pid ns path.
 	SandboxPidns bool `protobuf:"varint,7,opt,name=sandbox_pidns,json=sandboxPidns,proto3" json:"sandbox_pidns,omitempty"`
+	// This field is used to request a new cgroup namespace for the
+	// container, created once its init process has been placed into
+	// its cgroup, so that the container sees its cgroup as the root.
+	CgroupNs bool `protobuf:"varint,8,opt,name=cgroup_ns,json=cgroupNs,proto3" json:"cgroup_ns,omitempty"`
+	// Search domains and resolver options written to the container
+	// resolv.conf when the sandbox has a DNS stub resolver.
+	DnsSearch  []string `protobuf:"bytes,9,rep,name=dns_search,json=dnsSearch" json:"dns_search,omitempty"`
+	DnsOptions []string `protobuf:"bytes,10,rep,name=dns_options,json=dnsOptions" json:"dns_options,omitempty"`
+	// Size limit in bytes of the container rootfs, enforced through a
+	// project quota. The rootfs filesystem must be xfs or ext4 mounted
+	// with project quotas enabled. 0 means no limit.
+	DiskQuota uint64 `protobuf:"varint,11,opt,name=disk_quota,json=diskQuota,proto3" json:"disk_quota,omitempty"`
+	// Paths under /proc/sys left writable in the container, the rest of
+	// /proc/sys being read-only. Only namespaced paths are allowed.
+	ProcSysWritable []string `protobuf:"bytes,12,rep,name=proc_sys_writable,json=procSysWritable" json:"proc_sys_writable,omitempty"`
+	// Copy of the container output kept in the guest, rotated once it
+	// reaches its maximum size.
+	OutputLog *OutputLog `protobuf:"bytes,13,opt,name=output_log,json=outputLog" json:"output_log,omitempty"`
+	// Content of an AppArmor profile loaded, or replaced, in the guest
+	// before creating the container. The profile applied to the container
+	// processes is still the one named by the OCI process apparmorProfile.
+	ApparmorProfile string `protobuf:"bytes,14,opt,name=apparmor_profile,json=apparmorProfile,proto3" json:"apparmor_profile,omitempty"`
+	// Streams of the container output prefixed with timestamps, both
+	// when read by the runtime and in the output log.
+	OutputTimestamps *OutputTimestamps `protobuf:"bytes,15,opt,name=output_timestamps,json=outputTimestamps" json:"output_timestamps,omitempty"`
+	// Default environment of the container image, in the NAME=value
+	// form, merged with the environment of the OCI process, see
+	// ExecProcessRequest.image_env.
+	ImageEnv []string `protobuf:"bytes,16,rep,name=image_env,json=imageEnv" json:"image_env,omitempty"`
 }
 
 func (m *CreateContainerRequest) Reset()                    { *m = CreateContainerRequest{} }
@@ -191,14 +327,151 @@ func (m *CreateContainerRequest) GetSandboxPidns() bool {
 	return false
 }
 
+func (m *CreateContainerRequest) GetCgroupNs() bool {
+	if m != nil {
+		return m.CgroupNs
+	}
+	return false
+}
+
+func (m *CreateContainerRequest) GetDnsSearch() []string {
+	if m != nil {
+		return m.DnsSearch
+	}
+	return nil
+}
+
+func (m *CreateContainerRequest) GetDnsOptions() []string {
+	if m != nil {
+		return m.DnsOptions
+	}
+	return nil
+}
+
+func (m *CreateContainerRequest) GetDiskQuota() uint64 {
+	if m != nil {
+		return m.DiskQuota
+	}
+	return 0
+}
+
+func (m *CreateContainerRequest) GetProcSysWritable() []string {
+	if m != nil {
+		return m.ProcSysWritable
+	}
+	return nil
+}
+
+func (m *CreateContainerRequest) GetOutputLog() *OutputLog {
+	if m != nil {
+		return m.OutputLog
+	}
+	return nil
+}
+
+func (m *CreateContainerRequest) GetApparmorProfile() string {
+	if m != nil {
+		return m.ApparmorProfile
+	}
+	return ""
+}
+
+func (m *CreateContainerRequest) GetOutputTimestamps() *OutputTimestamps {
+	if m != nil {
+		return m.OutputTimestamps
+	}
+	return nil
+}
+
+func (m *CreateContainerRequest) GetImageEnv() []string {
+	if m != nil {
+		return m.ImageEnv
+	}
+	return nil
+}
+
+// OutputLog describes where the container output read by the runtime is
+// also written in the guest, as stdout.log and stderr.log files.
+type OutputLog struct {
+	// Absolute guest directory holding the log files.
+	Directory string `protobuf:"bytes,1,opt,name=directory,proto3" json:"directory,omitempty"`
+	// Size in bytes after which a log file is rotated, defaults to 10MiB.
+	MaxSize uint64 `protobuf:"varint,2,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	// Number of rotated files kept, as stdout.log.0, stdout.log.1, ...
+	MaxBackups uint32 `protobuf:"varint,3,opt,name=max_backups,json=maxBackups,proto3" json:"max_backups,omitempty"`
+}
+
+func (m *OutputLog) Reset()                    { *m = OutputLog{} }
+func (m *OutputLog) String() string            { return proto.CompactTextString(m) }
+func (*OutputLog) ProtoMessage()               {}
+func (*OutputLog) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{1} }
+
+func (m *OutputLog) GetDirectory() string {
+	if m != nil {
+		return m.Directory
+	}
+	return ""
+}
+
+func (m *OutputLog) GetMaxSize() uint64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+func (m *OutputLog) GetMaxBackups() uint32 {
+	if m != nil {
+		return m.MaxBackups
+	}
+	return 0
+}
+
+// OutputTimestamps selects the process output streams whose lines are
+// prefixed with the guest time they were read at, in RFC 3339 format with
+// nanoseconds, followed by a space.
+type OutputTimestamps struct {
+	Stdout bool `protobuf:"varint,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr bool `protobuf:"varint,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
+}
+
+func (m *OutputTimestamps) Reset()                    { *m = OutputTimestamps{} }
+func (m *OutputTimestamps) String() string            { return proto.CompactTextString(m) }
+func (*OutputTimestamps) ProtoMessage()               {}
+func (*OutputTimestamps) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{2} }
+
+func (m *OutputTimestamps) GetStdout() bool {
+	if m != nil {
+		return m.Stdout
+	}
+	return false
+}
+
+func (m *OutputTimestamps) GetStderr() bool {
+	if m != nil {
+		return m.Stderr
+	}
+	return false
+}
+
 type StartContainerRequest struct {
 	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// If non-zero, StartContainer waits for the sandbox network to be
+	// configured, that is the interfaces set with UpdateInterface up
+	// and a default route present, before starting the container. An
+	// error is returned after timeout seconds.
+	NetworkReadyTimeout uint32 `protobuf:"varint,2,opt,name=network_ready_timeout,json=networkReadyTimeout,proto3" json:"network_ready_timeout,omitempty"`
+	// If non-zero, the container processes are killed, the container is
+	// removed and an error is returned if it is not started after
+	// start_timeout seconds, the waits for its network and storages
+	// included.
+	StartTimeout uint32 `protobuf:"varint,3,opt,name=start_timeout,json=startTimeout,proto3" json:"start_timeout,omitempty"`
 }
 
 func (m *StartContainerRequest) Reset()                    { *m = StartContainerRequest{} }
 func (m *StartContainerRequest) String() string            { return proto.CompactTextString(m) }
 func (*StartContainerRequest) ProtoMessage()               {}
-func (*StartContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{1} }
+func (*StartContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{3} }
 
 func (m *StartContainerRequest) GetContainerId() string {
 	if m != nil {
@@ -207,6 +480,20 @@ func (m *StartContainerRequest) GetContainerId() string {
 	return ""
 }
 
+func (m *StartContainerRequest) GetNetworkReadyTimeout() uint32 {
+	if m != nil {
+		return m.NetworkReadyTimeout
+	}
+	return 0
+}
+
+func (m *StartContainerRequest) GetStartTimeout() uint32 {
+	if m != nil {
+		return m.StartTimeout
+	}
+	return 0
+}
+
 type RemoveContainerRequest struct {
 	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 	// RemoveContainer will return an error if
@@ -220,7 +507,7 @@ type RemoveContainerRequest struct {
 func (m *RemoveContainerRequest) Reset()                    { *m = RemoveContainerRequest{} }
 func (m *RemoveContainerRequest) String() string            { return proto.CompactTextString(m) }
 func (*RemoveContainerRequest) ProtoMessage()               {}
-func (*RemoveContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{2} }
+func (*RemoveContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{4} }
 
 func (m *RemoveContainerRequest) GetContainerId() string {
 	if m != nil {
@@ -241,12 +528,44 @@ type ExecProcessRequest struct {
 	ExecId      string      `protobuf:"bytes,2,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
 	StringUser  *StringUser `protobuf:"bytes,3,opt,name=string_user,json=stringUser" json:"string_user,omitempty"`
 	Process     *Process    `protobuf:"bytes,4,opt,name=process" json:"process,omitempty"`
+	// This field, if non-empty, designates the absolute path of a guest
+	// regular file whose content is fed to the process stdin, the stdin
+	// being closed once the end of the file is reached.
+	StdinPath string `protobuf:"bytes,5,opt,name=stdin_path,json=stdinPath,proto3" json:"stdin_path,omitempty"`
+	// Octal file mode creation mask of the process, such as "0027".
+	// The agent umask is used when empty.
+	Umask string `protobuf:"bytes,6,opt,name=umask,proto3" json:"umask,omitempty"`
+	// Start the process with only the variables of its spec, dropping
+	// the malformed and duplicated ones, and a default PATH if none is
+	// provided. The image_env defaults are ignored. HOME is still derived
+	// from the process user when unset.
+	StrictEnv bool `protobuf:"varint,7,opt,name=strict_env,json=strictEnv,proto3" json:"strict_env,omitempty"`
+	// Make the process lead a new session, or a new process group. The
+	// libcontainer init process sets them up before executing the
+	// process, the exec failing if it cannot.
+	Setsid  bool `protobuf:"varint,8,opt,name=setsid,proto3" json:"setsid,omitempty"`
+	Setpgid bool `protobuf:"varint,9,opt,name=setpgid,proto3" json:"setpgid,omitempty"`
+	// Buffering of the process output, none by default.
+	OutputBuffering ExecProcessRequest_OutputBuffering `protobuf:"varint,10,opt,name=output_buffering,json=outputBuffering,proto3,enum=grpc.ExecProcessRequest_OutputBuffering" json:"output_buffering,omitempty"`
+	// Delay before a partial line is returned with LINE buffering, in
+	// milliseconds, 100 when unset.
+	FlushIntervalMs uint32 `protobuf:"varint,11,opt,name=flush_interval_ms,json=flushIntervalMs,proto3" json:"flush_interval_ms,omitempty"`
+	// Streams of the process output prefixed with timestamps.
+	OutputTimestamps *OutputTimestamps `protobuf:"bytes,12,opt,name=output_timestamps,json=outputTimestamps" json:"output_timestamps,omitempty"`
+	// Default environment of the container image, in the NAME=value
+	// form. The process environment overrides the variables it defines,
+	// in place, "NAME=" setting an empty value and "NAME" removing the
+	// default. Its other variables follow the image ones, in order.
+	ImageEnv []string `protobuf:"bytes,13,rep,name=image_env,json=imageEnv" json:"image_env,omitempty"`
+	// Nice value of the process, such as "-5", overriding the container
+	// one. The container nice value is used when empty.
+	Nice string `protobuf:"bytes,14,opt,name=nice,proto3" json:"nice,omitempty"`
 }
 
 func (m *ExecProcessRequest) Reset()                    { *m = ExecProcessRequest{} }
 func (m *ExecProcessRequest) String() string            { return proto.CompactTextString(m) }
 func (*ExecProcessRequest) ProtoMessage()               {}
-func (*ExecProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{3} }
+func (*ExecProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{5} }
 
 func (m *ExecProcessRequest) GetContainerId() string {
 	if m != nil {
@@ -276,6 +595,112 @@ func (m *ExecProcessRequest) GetProcess() *Process {
 	return nil
 }
 
+func (m *ExecProcessRequest) GetStdinPath() string {
+	if m != nil {
+		return m.StdinPath
+	}
+	return ""
+}
+
+func (m *ExecProcessRequest) GetUmask() string {
+	if m != nil {
+		return m.Umask
+	}
+	return ""
+}
+
+func (m *ExecProcessRequest) GetStrictEnv() bool {
+	if m != nil {
+		return m.StrictEnv
+	}
+	return false
+}
+
+func (m *ExecProcessRequest) GetSetsid() bool {
+	if m != nil {
+		return m.Setsid
+	}
+	return false
+}
+
+func (m *ExecProcessRequest) GetSetpgid() bool {
+	if m != nil {
+		return m.Setpgid
+	}
+	return false
+}
+
+func (m *ExecProcessRequest) GetOutputBuffering() ExecProcessRequest_OutputBuffering {
+	if m != nil {
+		return m.OutputBuffering
+	}
+	return ExecProcessRequest_NONE
+}
+
+func (m *ExecProcessRequest) GetFlushIntervalMs() uint32 {
+	if m != nil {
+		return m.FlushIntervalMs
+	}
+	return 0
+}
+
+func (m *ExecProcessRequest) GetOutputTimestamps() *OutputTimestamps {
+	if m != nil {
+		return m.OutputTimestamps
+	}
+	return nil
+}
+
+func (m *ExecProcessRequest) GetImageEnv() []string {
+	if m != nil {
+		return m.ImageEnv
+	}
+	return nil
+}
+
+func (m *ExecProcessRequest) GetNice() string {
+	if m != nil {
+		return m.Nice
+	}
+	return ""
+}
+
+// ExecProcessResponse only adds fields to google.protobuf.Empty, which the
+// clients expecting it still decode.
+type ExecProcessResponse struct {
+	// IDs of the process, its process group and its session, in the
+	// guest PID namespace, read once the process is started.
+	Pid  int32 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Pgid int32 `protobuf:"varint,2,opt,name=pgid,proto3" json:"pgid,omitempty"`
+	Sid  int32 `protobuf:"varint,3,opt,name=sid,proto3" json:"sid,omitempty"`
+}
+
+func (m *ExecProcessResponse) Reset()                    { *m = ExecProcessResponse{} }
+func (m *ExecProcessResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExecProcessResponse) ProtoMessage()               {}
+func (*ExecProcessResponse) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{6} }
+
+func (m *ExecProcessResponse) GetPid() int32 {
+	if m != nil {
+		return m.Pid
+	}
+	return 0
+}
+
+func (m *ExecProcessResponse) GetPgid() int32 {
+	if m != nil {
+		return m.Pgid
+	}
+	return 0
+}
+
+func (m *ExecProcessResponse) GetSid() int32 {
+	if m != nil {
+		return m.Sid
+	}
+	return 0
+}
+
 type SignalProcessRequest struct {
 	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 	// Special case for SignalProcess(): exec_id can be empty(""),
@@ -283,12 +708,15 @@ type SignalProcessRequest struct {
 	// Other APIs with exec_id should treat empty exec_id as an invalid request.
 	ExecId string `protobuf:"bytes,2,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
 	Signal uint32 `protobuf:"varint,3,opt,name=signal,proto3" json:"signal,omitempty"`
+	// Send the stop signal of the container, as set through its
+	// annotations, instead of signal. SIGTERM is sent by default.
+	Stop bool `protobuf:"varint,4,opt,name=stop,proto3" json:"stop,omitempty"`
 }
 
 func (m *SignalProcessRequest) Reset()                    { *m = SignalProcessRequest{} }
 func (m *SignalProcessRequest) String() string            { return proto.CompactTextString(m) }
 func (*SignalProcessRequest) ProtoMessage()               {}
-func (*SignalProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{4} }
+func (*SignalProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{7} }
 
 func (m *SignalProcessRequest) GetContainerId() string {
 	if m != nil {
@@ -311,6 +739,13 @@ func (m *SignalProcessRequest) GetSignal() uint32 {
 	return 0
 }
 
+func (m *SignalProcessRequest) GetStop() bool {
+	if m != nil {
+		return m.Stop
+	}
+	return false
+}
+
 type WaitProcessRequest struct {
 	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 	ExecId      string `protobuf:"bytes,2,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
@@ -319,7 +754,7 @@ type WaitProcessRequest struct {
 func (m *WaitProcessRequest) Reset()                    { *m = WaitProcessRequest{} }
 func (m *WaitProcessRequest) String() string            { return proto.CompactTextString(m) }
 func (*WaitProcessRequest) ProtoMessage()               {}
-func (*WaitProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{5} }
+func (*WaitProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{8} }
 
 func (m *WaitProcessRequest) GetContainerId() string {
 	if m != nil {
@@ -336,13 +771,15 @@ func (m *WaitProcessRequest) GetExecId() string {
 }
 
 type WaitProcessResponse struct {
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Status int32                      `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Reason WaitProcessResponse_Reason `protobuf:"varint,2,opt,name=reason,proto3,enum=grpc.WaitProcessResponse_Reason" json:"reason,omitempty"`
+	Signal int32                      `protobuf:"varint,3,opt,name=signal,proto3" json:"signal,omitempty"`
 }
 
 func (m *WaitProcessResponse) Reset()                    { *m = WaitProcessResponse{} }
 func (m *WaitProcessResponse) String() string            { return proto.CompactTextString(m) }
 func (*WaitProcessResponse) ProtoMessage()               {}
-func (*WaitProcessResponse) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{6} }
+func (*WaitProcessResponse) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{9} }
 
 func (m *WaitProcessResponse) GetStatus() int32 {
 	if m != nil {
@@ -351,6 +788,20 @@ func (m *WaitProcessResponse) GetStatus() int32 {
 	return 0
 }
 
+func (m *WaitProcessResponse) GetReason() WaitProcessResponse_Reason {
+	if m != nil {
+		return m.Reason
+	}
+	return WaitProcessResponse_EXITED
+}
+
+func (m *WaitProcessResponse) GetSignal() int32 {
+	if m != nil {
+		return m.Signal
+	}
+	return 0
+}
+
 // ListProcessesRequest contains the options used to list running processes inside the container
 type ListProcessesRequest struct {
 	ContainerId string   `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
@@ -361,7 +812,7 @@ type ListProcessesRequest struct {
 func (m *ListProcessesRequest) Reset()                    { *m = ListProcessesRequest{} }
 func (m *ListProcessesRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListProcessesRequest) ProtoMessage()               {}
-func (*ListProcessesRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{7} }
+func (*ListProcessesRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{10} }
 
 func (m *ListProcessesRequest) GetContainerId() string {
 	if m != nil {
@@ -392,7 +843,7 @@ type ListProcessesResponse struct {
 func (m *ListProcessesResponse) Reset()                    { *m = ListProcessesResponse{} }
 func (m *ListProcessesResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListProcessesResponse) ProtoMessage()               {}
-func (*ListProcessesResponse) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{8} }
+func (*ListProcessesResponse) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{11} }
 
 func (m *ListProcessesResponse) GetProcessList() []byte {
 	if m != nil {
@@ -401,5617 +852,16396 @@ func (m *ListProcessesResponse) GetProcessList() []byte {
 	return nil
 }
 
-type UpdateContainerRequest struct {
-	ContainerId string          `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	Resources   *LinuxResources `protobuf:"bytes,2,opt,name=resources" json:"resources,omitempty"`
+// GetProcessEnvRequest reads the environment of a running container process.
+type GetProcessEnvRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// Process whose environment is returned, the container init process
+	// when empty.
+	ExecId string `protobuf:"bytes,2,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
+	// Regular expressions matched against the variable names, the value
+	// of the matching variables being redacted.
+	Redact []string `protobuf:"bytes,3,rep,name=redact" json:"redact,omitempty"`
 }
 
-func (m *UpdateContainerRequest) Reset()                    { *m = UpdateContainerRequest{} }
-func (m *UpdateContainerRequest) String() string            { return proto.CompactTextString(m) }
-func (*UpdateContainerRequest) ProtoMessage()               {}
-func (*UpdateContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{9} }
+func (m *GetProcessEnvRequest) Reset()                    { *m = GetProcessEnvRequest{} }
+func (m *GetProcessEnvRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetProcessEnvRequest) ProtoMessage()               {}
+func (*GetProcessEnvRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{12} }
 
-func (m *UpdateContainerRequest) GetContainerId() string {
+func (m *GetProcessEnvRequest) GetContainerId() string {
 	if m != nil {
 		return m.ContainerId
 	}
 	return ""
 }
 
-func (m *UpdateContainerRequest) GetResources() *LinuxResources {
+func (m *GetProcessEnvRequest) GetExecId() string {
 	if m != nil {
-		return m.Resources
+		return m.ExecId
+	}
+	return ""
+}
+
+func (m *GetProcessEnvRequest) GetRedact() []string {
+	if m != nil {
+		return m.Redact
 	}
 	return nil
 }
 
-type StatsContainerRequest struct {
-	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+// ProcessEnv is the environment of a process, as read from
+// /proc/<pid>/environ.
+type ProcessEnv struct {
+	// Variables in the NAME=value form.
+	Env []string `protobuf:"bytes,1,rep,name=env" json:"env,omitempty"`
 }
 
-func (m *StatsContainerRequest) Reset()                    { *m = StatsContainerRequest{} }
-func (m *StatsContainerRequest) String() string            { return proto.CompactTextString(m) }
-func (*StatsContainerRequest) ProtoMessage()               {}
-func (*StatsContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{10} }
+func (m *ProcessEnv) Reset()                    { *m = ProcessEnv{} }
+func (m *ProcessEnv) String() string            { return proto.CompactTextString(m) }
+func (*ProcessEnv) ProtoMessage()               {}
+func (*ProcessEnv) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{13} }
 
-func (m *StatsContainerRequest) GetContainerId() string {
+func (m *ProcessEnv) GetEnv() []string {
 	if m != nil {
-		return m.ContainerId
+		return m.Env
 	}
-	return ""
+	return nil
 }
 
-type PauseContainerRequest struct {
+// GetProcessFilesRequest lists the open files of a running container
+// process.
+type GetProcessFilesRequest struct {
 	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// Process whose files are listed, the container init process when
+	// empty.
+	ExecId string `protobuf:"bytes,2,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
+	// Return each file descriptor, not only the counts.
+	Details bool `protobuf:"varint,3,opt,name=details,proto3" json:"details,omitempty"`
 }
 
-func (m *PauseContainerRequest) Reset()                    { *m = PauseContainerRequest{} }
-func (m *PauseContainerRequest) String() string            { return proto.CompactTextString(m) }
-func (*PauseContainerRequest) ProtoMessage()               {}
-func (*PauseContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{11} }
+func (m *GetProcessFilesRequest) Reset()                    { *m = GetProcessFilesRequest{} }
+func (m *GetProcessFilesRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetProcessFilesRequest) ProtoMessage()               {}
+func (*GetProcessFilesRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{14} }
 
-func (m *PauseContainerRequest) GetContainerId() string {
+func (m *GetProcessFilesRequest) GetContainerId() string {
 	if m != nil {
 		return m.ContainerId
 	}
 	return ""
 }
 
-type ResumeContainerRequest struct {
-	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+func (m *GetProcessFilesRequest) GetExecId() string {
+	if m != nil {
+		return m.ExecId
+	}
+	return ""
 }
 
-func (m *ResumeContainerRequest) Reset()                    { *m = ResumeContainerRequest{} }
-func (m *ResumeContainerRequest) String() string            { return proto.CompactTextString(m) }
-func (*ResumeContainerRequest) ProtoMessage()               {}
-func (*ResumeContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{12} }
-
-func (m *ResumeContainerRequest) GetContainerId() string {
+func (m *GetProcessFilesRequest) GetDetails() bool {
 	if m != nil {
-		return m.ContainerId
+		return m.Details
 	}
-	return ""
+	return false
 }
 
-type CpuUsage struct {
-	TotalUsage        uint64   `protobuf:"varint,1,opt,name=total_usage,json=totalUsage,proto3" json:"total_usage,omitempty"`
-	PercpuUsage       []uint64 `protobuf:"varint,2,rep,packed,name=percpu_usage,json=percpuUsage" json:"percpu_usage,omitempty"`
-	UsageInKernelmode uint64   `protobuf:"varint,3,opt,name=usage_in_kernelmode,json=usageInKernelmode,proto3" json:"usage_in_kernelmode,omitempty"`
-	UsageInUsermode   uint64   `protobuf:"varint,4,opt,name=usage_in_usermode,json=usageInUsermode,proto3" json:"usage_in_usermode,omitempty"`
+// OpenFile is a file descriptor of a process, as read from
+// /proc/<pid>/fd.
+type OpenFile struct {
+	Fd int32 `protobuf:"varint,1,opt,name=fd,proto3" json:"fd,omitempty"`
+	// "regular", "directory", "socket", "pipe", "device" or "other",
+	// such as an eventfd.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Target of the descriptor link, a path or a description such as
+	// "socket:[1234]".
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// Protocol of a socket from /proc/<pid>/net: "tcp", "tcp6", "udp",
+	// "udp6" or "unix", empty when not found.
+	Protocol string `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
 }
 
-func (m *CpuUsage) Reset()                    { *m = CpuUsage{} }
-func (m *CpuUsage) String() string            { return proto.CompactTextString(m) }
-func (*CpuUsage) ProtoMessage()               {}
-func (*CpuUsage) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{13} }
+func (m *OpenFile) Reset()                    { *m = OpenFile{} }
+func (m *OpenFile) String() string            { return proto.CompactTextString(m) }
+func (*OpenFile) ProtoMessage()               {}
+func (*OpenFile) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{15} }
 
-func (m *CpuUsage) GetTotalUsage() uint64 {
+func (m *OpenFile) GetFd() int32 {
 	if m != nil {
-		return m.TotalUsage
+		return m.Fd
 	}
 	return 0
 }
 
-func (m *CpuUsage) GetPercpuUsage() []uint64 {
+func (m *OpenFile) GetType() string {
 	if m != nil {
-		return m.PercpuUsage
+		return m.Type
 	}
-	return nil
+	return ""
 }
 
-func (m *CpuUsage) GetUsageInKernelmode() uint64 {
+func (m *OpenFile) GetTarget() string {
 	if m != nil {
-		return m.UsageInKernelmode
+		return m.Target
 	}
-	return 0
+	return ""
 }
 
-func (m *CpuUsage) GetUsageInUsermode() uint64 {
+func (m *OpenFile) GetProtocol() string {
 	if m != nil {
-		return m.UsageInUsermode
+		return m.Protocol
 	}
-	return 0
+	return ""
 }
 
-type ThrottlingData struct {
-	Periods          uint64 `protobuf:"varint,1,opt,name=periods,proto3" json:"periods,omitempty"`
-	ThrottledPeriods uint64 `protobuf:"varint,2,opt,name=throttled_periods,json=throttledPeriods,proto3" json:"throttled_periods,omitempty"`
-	ThrottledTime    uint64 `protobuf:"varint,3,opt,name=throttled_time,json=throttledTime,proto3" json:"throttled_time,omitempty"`
+// ProcessFiles counts the file descriptors of a process by type.
+type ProcessFiles struct {
+	Regular uint32 `protobuf:"varint,1,opt,name=regular,proto3" json:"regular,omitempty"`
+	Socket  uint32 `protobuf:"varint,2,opt,name=socket,proto3" json:"socket,omitempty"`
+	Pipe    uint32 `protobuf:"varint,3,opt,name=pipe,proto3" json:"pipe,omitempty"`
+	// Directories, devices and the other types.
+	Other uint32 `protobuf:"varint,4,opt,name=other,proto3" json:"other,omitempty"`
+	// File descriptors, when requested.
+	Files []*OpenFile `protobuf:"bytes,5,rep,name=files" json:"files,omitempty"`
 }
 
-func (m *ThrottlingData) Reset()                    { *m = ThrottlingData{} }
-func (m *ThrottlingData) String() string            { return proto.CompactTextString(m) }
-func (*ThrottlingData) ProtoMessage()               {}
-func (*ThrottlingData) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{14} }
+func (m *ProcessFiles) Reset()                    { *m = ProcessFiles{} }
+func (m *ProcessFiles) String() string            { return proto.CompactTextString(m) }
+func (*ProcessFiles) ProtoMessage()               {}
+func (*ProcessFiles) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{16} }
 
-func (m *ThrottlingData) GetPeriods() uint64 {
+func (m *ProcessFiles) GetRegular() uint32 {
 	if m != nil {
-		return m.Periods
+		return m.Regular
 	}
 	return 0
 }
 
-func (m *ThrottlingData) GetThrottledPeriods() uint64 {
+func (m *ProcessFiles) GetSocket() uint32 {
 	if m != nil {
-		return m.ThrottledPeriods
+		return m.Socket
 	}
 	return 0
 }
 
-func (m *ThrottlingData) GetThrottledTime() uint64 {
+func (m *ProcessFiles) GetPipe() uint32 {
 	if m != nil {
-		return m.ThrottledTime
+		return m.Pipe
 	}
 	return 0
 }
 
-type CpuStats struct {
-	CpuUsage       *CpuUsage       `protobuf:"bytes,1,opt,name=cpu_usage,json=cpuUsage" json:"cpu_usage,omitempty"`
-	ThrottlingData *ThrottlingData `protobuf:"bytes,2,opt,name=throttling_data,json=throttlingData" json:"throttling_data,omitempty"`
-}
-
-func (m *CpuStats) Reset()                    { *m = CpuStats{} }
-func (m *CpuStats) String() string            { return proto.CompactTextString(m) }
-func (*CpuStats) ProtoMessage()               {}
-func (*CpuStats) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{15} }
-
-func (m *CpuStats) GetCpuUsage() *CpuUsage {
+func (m *ProcessFiles) GetOther() uint32 {
 	if m != nil {
-		return m.CpuUsage
+		return m.Other
 	}
-	return nil
+	return 0
 }
 
-func (m *CpuStats) GetThrottlingData() *ThrottlingData {
+func (m *ProcessFiles) GetFiles() []*OpenFile {
 	if m != nil {
-		return m.ThrottlingData
+		return m.Files
 	}
 	return nil
 }
 
-type PidsStats struct {
-	Current uint64 `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`
-	Limit   uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+type ListContainerMountsRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 }
 
-func (m *PidsStats) Reset()                    { *m = PidsStats{} }
-func (m *PidsStats) String() string            { return proto.CompactTextString(m) }
-func (*PidsStats) ProtoMessage()               {}
-func (*PidsStats) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{16} }
-
-func (m *PidsStats) GetCurrent() uint64 {
-	if m != nil {
-		return m.Current
-	}
-	return 0
+func (m *ListContainerMountsRequest) Reset()         { *m = ListContainerMountsRequest{} }
+func (m *ListContainerMountsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListContainerMountsRequest) ProtoMessage()    {}
+func (*ListContainerMountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorAgent, []int{17}
 }
 
-func (m *PidsStats) GetLimit() uint64 {
+func (m *ListContainerMountsRequest) GetContainerId() string {
 	if m != nil {
-		return m.Limit
+		return m.ContainerId
 	}
-	return 0
+	return ""
 }
 
-type MemoryData struct {
-	Usage    uint64 `protobuf:"varint,1,opt,name=usage,proto3" json:"usage,omitempty"`
-	MaxUsage uint64 `protobuf:"varint,2,opt,name=max_usage,json=maxUsage,proto3" json:"max_usage,omitempty"`
-	Failcnt  uint64 `protobuf:"varint,3,opt,name=failcnt,proto3" json:"failcnt,omitempty"`
-	Limit    uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+// ContainerMount describes a mount of the container and its health.
+type ContainerMount struct {
+	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Type        string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// The destination is still listed in the mount table.
+	Mounted bool `protobuf:"varint,4,opt,name=mounted,proto3" json:"mounted,omitempty"`
+	// The source is still reachable. Always true for non path sources.
+	SourceExists bool `protobuf:"varint,5,opt,name=source_exists,json=sourceExists,proto3" json:"source_exists,omitempty"`
+	// Set when the mount is both mounted and backed by a reachable source.
+	Healthy bool `protobuf:"varint,6,opt,name=healthy,proto3" json:"healthy,omitempty"`
 }
 
-func (m *MemoryData) Reset()                    { *m = MemoryData{} }
-func (m *MemoryData) String() string            { return proto.CompactTextString(m) }
-func (*MemoryData) ProtoMessage()               {}
-func (*MemoryData) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{17} }
+func (m *ContainerMount) Reset()                    { *m = ContainerMount{} }
+func (m *ContainerMount) String() string            { return proto.CompactTextString(m) }
+func (*ContainerMount) ProtoMessage()               {}
+func (*ContainerMount) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{18} }
 
-func (m *MemoryData) GetUsage() uint64 {
+func (m *ContainerMount) GetSource() string {
 	if m != nil {
-		return m.Usage
+		return m.Source
 	}
-	return 0
+	return ""
 }
 
-func (m *MemoryData) GetMaxUsage() uint64 {
+func (m *ContainerMount) GetDestination() string {
 	if m != nil {
-		return m.MaxUsage
+		return m.Destination
 	}
-	return 0
+	return ""
 }
 
-func (m *MemoryData) GetFailcnt() uint64 {
+func (m *ContainerMount) GetType() string {
 	if m != nil {
-		return m.Failcnt
+		return m.Type
 	}
-	return 0
+	return ""
 }
 
-func (m *MemoryData) GetLimit() uint64 {
+func (m *ContainerMount) GetMounted() bool {
 	if m != nil {
-		return m.Limit
+		return m.Mounted
 	}
-	return 0
-}
-
-type MemoryStats struct {
-	Cache        uint64            `protobuf:"varint,1,opt,name=cache,proto3" json:"cache,omitempty"`
-	Usage        *MemoryData       `protobuf:"bytes,2,opt,name=usage" json:"usage,omitempty"`
-	SwapUsage    *MemoryData       `protobuf:"bytes,3,opt,name=swap_usage,json=swapUsage" json:"swap_usage,omitempty"`
-	KernelUsage  *MemoryData       `protobuf:"bytes,4,opt,name=kernel_usage,json=kernelUsage" json:"kernel_usage,omitempty"`
-	UseHierarchy bool              `protobuf:"varint,5,opt,name=use_hierarchy,json=useHierarchy,proto3" json:"use_hierarchy,omitempty"`
-	Stats        map[string]uint64 `protobuf:"bytes,6,rep,name=stats" json:"stats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	return false
 }
 
-func (m *MemoryStats) Reset()                    { *m = MemoryStats{} }
-func (m *MemoryStats) String() string            { return proto.CompactTextString(m) }
-func (*MemoryStats) ProtoMessage()               {}
-func (*MemoryStats) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{18} }
-
-func (m *MemoryStats) GetCache() uint64 {
+func (m *ContainerMount) GetSourceExists() bool {
 	if m != nil {
-		return m.Cache
+		return m.SourceExists
 	}
-	return 0
+	return false
 }
 
-func (m *MemoryStats) GetUsage() *MemoryData {
+func (m *ContainerMount) GetHealthy() bool {
 	if m != nil {
-		return m.Usage
+		return m.Healthy
 	}
-	return nil
+	return false
 }
 
-func (m *MemoryStats) GetSwapUsage() *MemoryData {
+// ContainerMounts lists both the OCI spec mounts of the container, as seen
+// from its mount namespace, and the guest storages it relies on.
+type ContainerMounts struct {
+	Mounts   []*ContainerMount `protobuf:"bytes,1,rep,name=mounts" json:"mounts,omitempty"`
+	Storages []*ContainerMount `protobuf:"bytes,2,rep,name=storages" json:"storages,omitempty"`
+}
+
+func (m *ContainerMounts) Reset()                    { *m = ContainerMounts{} }
+func (m *ContainerMounts) String() string            { return proto.CompactTextString(m) }
+func (*ContainerMounts) ProtoMessage()               {}
+func (*ContainerMounts) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{19} }
+
+func (m *ContainerMounts) GetMounts() []*ContainerMount {
 	if m != nil {
-		return m.SwapUsage
+		return m.Mounts
 	}
 	return nil
 }
 
-func (m *MemoryStats) GetKernelUsage() *MemoryData {
+func (m *ContainerMounts) GetStorages() []*ContainerMount {
 	if m != nil {
-		return m.KernelUsage
+		return m.Storages
 	}
 	return nil
 }
 
-func (m *MemoryStats) GetUseHierarchy() bool {
-	if m != nil {
-		return m.UseHierarchy
-	}
-	return false
+// ExposeContainerRootfsRequest bind mounts the container rootfs read-only
+// into the inspect subdirectory of the shared directory. The mounts made
+// by the guest are not visible to the host through the shared directory,
+// the host reads the exposed rootfs with ReadContainerRootfs. This
+// requires the agent.rootfs_inspect kernel parameter.
+type ExposeContainerRootfsRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 }
 
-func (m *MemoryStats) GetStats() map[string]uint64 {
+func (m *ExposeContainerRootfsRequest) Reset()         { *m = ExposeContainerRootfsRequest{} }
+func (m *ExposeContainerRootfsRequest) String() string { return proto.CompactTextString(m) }
+func (*ExposeContainerRootfsRequest) ProtoMessage()    {}
+func (*ExposeContainerRootfsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorAgent, []int{20}
+}
+
+func (m *ExposeContainerRootfsRequest) GetContainerId() string {
 	if m != nil {
-		return m.Stats
+		return m.ContainerId
 	}
-	return nil
+	return ""
 }
 
-type BlkioStatsEntry struct {
-	Major uint64 `protobuf:"varint,1,opt,name=major,proto3" json:"major,omitempty"`
-	Minor uint64 `protobuf:"varint,2,opt,name=minor,proto3" json:"minor,omitempty"`
-	Op    string `protobuf:"bytes,3,opt,name=op,proto3" json:"op,omitempty"`
-	Value uint64 `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+type ExposeContainerRootfsResponse struct {
+	// Path of the exposed rootfs, relative to the shared directory.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (m *BlkioStatsEntry) Reset()                    { *m = BlkioStatsEntry{} }
-func (m *BlkioStatsEntry) String() string            { return proto.CompactTextString(m) }
-func (*BlkioStatsEntry) ProtoMessage()               {}
-func (*BlkioStatsEntry) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{19} }
+func (m *ExposeContainerRootfsResponse) Reset()         { *m = ExposeContainerRootfsResponse{} }
+func (m *ExposeContainerRootfsResponse) String() string { return proto.CompactTextString(m) }
+func (*ExposeContainerRootfsResponse) ProtoMessage()    {}
+func (*ExposeContainerRootfsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorAgent, []int{21}
+}
 
-func (m *BlkioStatsEntry) GetMajor() uint64 {
+func (m *ExposeContainerRootfsResponse) GetPath() string {
 	if m != nil {
-		return m.Major
+		return m.Path
 	}
-	return 0
+	return ""
 }
 
-func (m *BlkioStatsEntry) GetMinor() uint64 {
-	if m != nil {
-		return m.Minor
-	}
-	return 0
+// UnexposeContainerRootfsRequest removes the exposed rootfs, which is also
+// removed with the container.
+type UnexposeContainerRootfsRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 }
 
-func (m *BlkioStatsEntry) GetOp() string {
-	if m != nil {
-		return m.Op
-	}
-	return ""
+func (m *UnexposeContainerRootfsRequest) Reset()         { *m = UnexposeContainerRootfsRequest{} }
+func (m *UnexposeContainerRootfsRequest) String() string { return proto.CompactTextString(m) }
+func (*UnexposeContainerRootfsRequest) ProtoMessage()    {}
+func (*UnexposeContainerRootfsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorAgent, []int{22}
 }
 
-func (m *BlkioStatsEntry) GetValue() uint64 {
+func (m *UnexposeContainerRootfsRequest) GetContainerId() string {
 	if m != nil {
-		return m.Value
+		return m.ContainerId
 	}
-	return 0
+	return ""
 }
 
-type BlkioStats struct {
-	IoServiceBytesRecursive []*BlkioStatsEntry `protobuf:"bytes,1,rep,name=io_service_bytes_recursive,json=ioServiceBytesRecursive" json:"io_service_bytes_recursive,omitempty"`
-	IoServicedRecursive     []*BlkioStatsEntry `protobuf:"bytes,2,rep,name=io_serviced_recursive,json=ioServicedRecursive" json:"io_serviced_recursive,omitempty"`
-	IoQueuedRecursive       []*BlkioStatsEntry `protobuf:"bytes,3,rep,name=io_queued_recursive,json=ioQueuedRecursive" json:"io_queued_recursive,omitempty"`
-	IoServiceTimeRecursive  []*BlkioStatsEntry `protobuf:"bytes,4,rep,name=io_service_time_recursive,json=ioServiceTimeRecursive" json:"io_service_time_recursive,omitempty"`
-	IoWaitTimeRecursive     []*BlkioStatsEntry `protobuf:"bytes,5,rep,name=io_wait_time_recursive,json=ioWaitTimeRecursive" json:"io_wait_time_recursive,omitempty"`
-	IoMergedRecursive       []*BlkioStatsEntry `protobuf:"bytes,6,rep,name=io_merged_recursive,json=ioMergedRecursive" json:"io_merged_recursive,omitempty"`
-	IoTimeRecursive         []*BlkioStatsEntry `protobuf:"bytes,7,rep,name=io_time_recursive,json=ioTimeRecursive" json:"io_time_recursive,omitempty"`
-	SectorsRecursive        []*BlkioStatsEntry `protobuf:"bytes,8,rep,name=sectors_recursive,json=sectorsRecursive" json:"sectors_recursive,omitempty"`
+// ReadContainerRootfsRequest reads a file of the container rootfs, for the
+// host to inspect it. The volumes mounted in the container are not part of
+// the rootfs. This requires the agent.rootfs_inspect kernel parameter.
+type ReadContainerRootfsRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// Path of the file, relative to the rootfs. Symbolic links are
+	// resolved within the rootfs, except for the last component.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Offset and length of the content read from a regular file, the
+	// length being capped to 1 MiB, which is also the default.
+	Offset uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length uint32 `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
 }
 
-func (m *BlkioStats) Reset()                    { *m = BlkioStats{} }
-func (m *BlkioStats) String() string            { return proto.CompactTextString(m) }
-func (*BlkioStats) ProtoMessage()               {}
-func (*BlkioStats) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{20} }
+func (m *ReadContainerRootfsRequest) Reset()         { *m = ReadContainerRootfsRequest{} }
+func (m *ReadContainerRootfsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadContainerRootfsRequest) ProtoMessage()    {}
+func (*ReadContainerRootfsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorAgent, []int{23}
+}
 
-func (m *BlkioStats) GetIoServiceBytesRecursive() []*BlkioStatsEntry {
+func (m *ReadContainerRootfsRequest) GetContainerId() string {
 	if m != nil {
-		return m.IoServiceBytesRecursive
+		return m.ContainerId
 	}
-	return nil
+	return ""
 }
 
-func (m *BlkioStats) GetIoServicedRecursive() []*BlkioStatsEntry {
+func (m *ReadContainerRootfsRequest) GetPath() string {
 	if m != nil {
-		return m.IoServicedRecursive
+		return m.Path
 	}
-	return nil
+	return ""
 }
 
-func (m *BlkioStats) GetIoQueuedRecursive() []*BlkioStatsEntry {
+func (m *ReadContainerRootfsRequest) GetOffset() uint64 {
 	if m != nil {
-		return m.IoQueuedRecursive
+		return m.Offset
 	}
-	return nil
+	return 0
 }
 
-func (m *BlkioStats) GetIoServiceTimeRecursive() []*BlkioStatsEntry {
+func (m *ReadContainerRootfsRequest) GetLength() uint32 {
 	if m != nil {
-		return m.IoServiceTimeRecursive
+		return m.Length
 	}
-	return nil
+	return 0
 }
 
-func (m *BlkioStats) GetIoWaitTimeRecursive() []*BlkioStatsEntry {
-	if m != nil {
-		return m.IoWaitTimeRecursive
-	}
-	return nil
+// RootfsEntry describes an entry of a directory of the container rootfs.
+type RootfsEntry struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// File type and permissions, as st_mode in stat(2).
+	Mode uint32 `protobuf:"varint,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Size_ uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (m *BlkioStats) GetIoMergedRecursive() []*BlkioStatsEntry {
+func (m *RootfsEntry) Reset()                    { *m = RootfsEntry{} }
+func (m *RootfsEntry) String() string            { return proto.CompactTextString(m) }
+func (*RootfsEntry) ProtoMessage()               {}
+func (*RootfsEntry) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{24} }
+
+func (m *RootfsEntry) GetName() string {
 	if m != nil {
-		return m.IoMergedRecursive
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *BlkioStats) GetIoTimeRecursive() []*BlkioStatsEntry {
+func (m *RootfsEntry) GetMode() uint32 {
 	if m != nil {
-		return m.IoTimeRecursive
+		return m.Mode
 	}
-	return nil
+	return 0
 }
 
-func (m *BlkioStats) GetSectorsRecursive() []*BlkioStatsEntry {
+func (m *RootfsEntry) GetSize_() uint64 {
 	if m != nil {
-		return m.SectorsRecursive
+		return m.Size_
 	}
-	return nil
+	return 0
 }
 
-type HugetlbStats struct {
-	Usage    uint64 `protobuf:"varint,1,opt,name=usage,proto3" json:"usage,omitempty"`
-	MaxUsage uint64 `protobuf:"varint,2,opt,name=max_usage,json=maxUsage,proto3" json:"max_usage,omitempty"`
-	Failcnt  uint64 `protobuf:"varint,3,opt,name=failcnt,proto3" json:"failcnt,omitempty"`
+type ContainerRootfsFile struct {
+	// File type and permissions, as st_mode in stat(2).
+	Mode uint32 `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Size_ uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Content read from a regular file, empty at its end.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Entries of a directory.
+	Entries []*RootfsEntry `protobuf:"bytes,4,rep,name=entries" json:"entries,omitempty"`
+	// Target of a symbolic link.
+	LinkTarget string `protobuf:"bytes,5,opt,name=link_target,json=linkTarget,proto3" json:"link_target,omitempty"`
 }
 
-func (m *HugetlbStats) Reset()                    { *m = HugetlbStats{} }
-func (m *HugetlbStats) String() string            { return proto.CompactTextString(m) }
-func (*HugetlbStats) ProtoMessage()               {}
-func (*HugetlbStats) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{21} }
+func (m *ContainerRootfsFile) Reset()                    { *m = ContainerRootfsFile{} }
+func (m *ContainerRootfsFile) String() string            { return proto.CompactTextString(m) }
+func (*ContainerRootfsFile) ProtoMessage()               {}
+func (*ContainerRootfsFile) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{25} }
 
-func (m *HugetlbStats) GetUsage() uint64 {
+func (m *ContainerRootfsFile) GetMode() uint32 {
 	if m != nil {
-		return m.Usage
+		return m.Mode
 	}
 	return 0
 }
 
-func (m *HugetlbStats) GetMaxUsage() uint64 {
+func (m *ContainerRootfsFile) GetSize_() uint64 {
 	if m != nil {
-		return m.MaxUsage
+		return m.Size_
 	}
 	return 0
 }
 
-func (m *HugetlbStats) GetFailcnt() uint64 {
+func (m *ContainerRootfsFile) GetData() []byte {
 	if m != nil {
-		return m.Failcnt
+		return m.Data
 	}
-	return 0
-}
-
-type CgroupStats struct {
-	CpuStats     *CpuStats                `protobuf:"bytes,1,opt,name=cpu_stats,json=cpuStats" json:"cpu_stats,omitempty"`
-	MemoryStats  *MemoryStats             `protobuf:"bytes,2,opt,name=memory_stats,json=memoryStats" json:"memory_stats,omitempty"`
-	PidsStats    *PidsStats               `protobuf:"bytes,3,opt,name=pids_stats,json=pidsStats" json:"pids_stats,omitempty"`
-	BlkioStats   *BlkioStats              `protobuf:"bytes,4,opt,name=blkio_stats,json=blkioStats" json:"blkio_stats,omitempty"`
-	HugetlbStats map[string]*HugetlbStats `protobuf:"bytes,5,rep,name=hugetlb_stats,json=hugetlbStats" json:"hugetlb_stats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+	return nil
 }
 
-func (m *CgroupStats) Reset()                    { *m = CgroupStats{} }
-func (m *CgroupStats) String() string            { return proto.CompactTextString(m) }
-func (*CgroupStats) ProtoMessage()               {}
-func (*CgroupStats) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{22} }
-
-func (m *CgroupStats) GetCpuStats() *CpuStats {
+func (m *ContainerRootfsFile) GetEntries() []*RootfsEntry {
 	if m != nil {
-		return m.CpuStats
+		return m.Entries
 	}
 	return nil
 }
 
-func (m *CgroupStats) GetMemoryStats() *MemoryStats {
+func (m *ContainerRootfsFile) GetLinkTarget() string {
 	if m != nil {
-		return m.MemoryStats
+		return m.LinkTarget
 	}
-	return nil
+	return ""
 }
 
-func (m *CgroupStats) GetPidsStats() *PidsStats {
-	if m != nil {
-		return m.PidsStats
-	}
-	return nil
+type UpdateContainerRequest struct {
+	ContainerId string          `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Resources   *LinuxResources `protobuf:"bytes,2,opt,name=resources" json:"resources,omitempty"`
 }
 
-func (m *CgroupStats) GetBlkioStats() *BlkioStats {
+func (m *UpdateContainerRequest) Reset()                    { *m = UpdateContainerRequest{} }
+func (m *UpdateContainerRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateContainerRequest) ProtoMessage()               {}
+func (*UpdateContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{26} }
+
+func (m *UpdateContainerRequest) GetContainerId() string {
 	if m != nil {
-		return m.BlkioStats
+		return m.ContainerId
 	}
-	return nil
+	return ""
 }
 
-func (m *CgroupStats) GetHugetlbStats() map[string]*HugetlbStats {
+func (m *UpdateContainerRequest) GetResources() *LinuxResources {
 	if m != nil {
-		return m.HugetlbStats
+		return m.Resources
 	}
 	return nil
 }
 
-type NetworkStats struct {
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	RxBytes   uint64 `protobuf:"varint,2,opt,name=rx_bytes,json=rxBytes,proto3" json:"rx_bytes,omitempty"`
-	RxPackets uint64 `protobuf:"varint,3,opt,name=rx_packets,json=rxPackets,proto3" json:"rx_packets,omitempty"`
-	RxErrors  uint64 `protobuf:"varint,4,opt,name=rx_errors,json=rxErrors,proto3" json:"rx_errors,omitempty"`
-	RxDropped uint64 `protobuf:"varint,5,opt,name=rx_dropped,json=rxDropped,proto3" json:"rx_dropped,omitempty"`
-	TxBytes   uint64 `protobuf:"varint,6,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
-	TxPackets uint64 `protobuf:"varint,7,opt,name=tx_packets,json=txPackets,proto3" json:"tx_packets,omitempty"`
-	TxErrors  uint64 `protobuf:"varint,8,opt,name=tx_errors,json=txErrors,proto3" json:"tx_errors,omitempty"`
-	TxDropped uint64 `protobuf:"varint,9,opt,name=tx_dropped,json=txDropped,proto3" json:"tx_dropped,omitempty"`
+type StatsContainerRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 }
 
-func (m *NetworkStats) Reset()                    { *m = NetworkStats{} }
-func (m *NetworkStats) String() string            { return proto.CompactTextString(m) }
-func (*NetworkStats) ProtoMessage()               {}
-func (*NetworkStats) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{23} }
+func (m *StatsContainerRequest) Reset()                    { *m = StatsContainerRequest{} }
+func (m *StatsContainerRequest) String() string            { return proto.CompactTextString(m) }
+func (*StatsContainerRequest) ProtoMessage()               {}
+func (*StatsContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{27} }
 
-func (m *NetworkStats) GetName() string {
+func (m *StatsContainerRequest) GetContainerId() string {
 	if m != nil {
-		return m.Name
+		return m.ContainerId
 	}
 	return ""
 }
 
-func (m *NetworkStats) GetRxBytes() uint64 {
-	if m != nil {
-		return m.RxBytes
-	}
-	return 0
+type MemoryStatContainerRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// Reset the peak memory usage of the container cgroup after
+	// reading it. Only supported with cgroup v1, the request failing
+	// with FailedPrecondition otherwise.
+	ResetPeak bool `protobuf:"varint,2,opt,name=reset_peak,json=resetPeak,proto3" json:"reset_peak,omitempty"`
 }
 
-func (m *NetworkStats) GetRxPackets() uint64 {
-	if m != nil {
-		return m.RxPackets
-	}
-	return 0
+func (m *MemoryStatContainerRequest) Reset()         { *m = MemoryStatContainerRequest{} }
+func (m *MemoryStatContainerRequest) String() string { return proto.CompactTextString(m) }
+func (*MemoryStatContainerRequest) ProtoMessage()    {}
+func (*MemoryStatContainerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorAgent, []int{28}
 }
 
-func (m *NetworkStats) GetRxErrors() uint64 {
+func (m *MemoryStatContainerRequest) GetContainerId() string {
 	if m != nil {
-		return m.RxErrors
+		return m.ContainerId
 	}
-	return 0
+	return ""
 }
 
-func (m *NetworkStats) GetRxDropped() uint64 {
+func (m *MemoryStatContainerRequest) GetResetPeak() bool {
 	if m != nil {
-		return m.RxDropped
+		return m.ResetPeak
 	}
-	return 0
+	return false
 }
 
-func (m *NetworkStats) GetTxBytes() uint64 {
-	if m != nil {
-		return m.TxBytes
-	}
-	return 0
+type MemoryStatContainerResponse struct {
+	// Content of the memory.stat file of the container cgroup.
+	Stat map[string]uint64 `protobuf:"bytes,1,rep,name=stat" json:"stat,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Peak memory usage, from memory.max_usage_in_bytes with cgroup v1
+	// or memory.peak with cgroup v2, read before any reset.
+	Peak     uint64 `protobuf:"varint,2,opt,name=peak,proto3" json:"peak,omitempty"`
+	CgroupV2 bool   `protobuf:"varint,3,opt,name=cgroup_v2,json=cgroupV2,proto3" json:"cgroup_v2,omitempty"`
 }
 
-func (m *NetworkStats) GetTxPackets() uint64 {
+func (m *MemoryStatContainerResponse) Reset()         { *m = MemoryStatContainerResponse{} }
+func (m *MemoryStatContainerResponse) String() string { return proto.CompactTextString(m) }
+func (*MemoryStatContainerResponse) ProtoMessage()    {}
+func (*MemoryStatContainerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorAgent, []int{29}
+}
+
+func (m *MemoryStatContainerResponse) GetStat() map[string]uint64 {
 	if m != nil {
-		return m.TxPackets
+		return m.Stat
 	}
-	return 0
+	return nil
 }
 
-func (m *NetworkStats) GetTxErrors() uint64 {
+func (m *MemoryStatContainerResponse) GetPeak() uint64 {
 	if m != nil {
-		return m.TxErrors
+		return m.Peak
 	}
 	return 0
 }
 
-func (m *NetworkStats) GetTxDropped() uint64 {
+func (m *MemoryStatContainerResponse) GetCgroupV2() bool {
 	if m != nil {
-		return m.TxDropped
+		return m.CgroupV2
 	}
-	return 0
+	return false
 }
 
-type StatsContainerResponse struct {
-	CgroupStats  *CgroupStats    `protobuf:"bytes,1,opt,name=cgroup_stats,json=cgroupStats" json:"cgroup_stats,omitempty"`
-	NetworkStats []*NetworkStats `protobuf:"bytes,2,rep,name=network_stats,json=networkStats" json:"network_stats,omitempty"`
+type PauseContainerRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 }
 
-func (m *StatsContainerResponse) Reset()                    { *m = StatsContainerResponse{} }
-func (m *StatsContainerResponse) String() string            { return proto.CompactTextString(m) }
-func (*StatsContainerResponse) ProtoMessage()               {}
-func (*StatsContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{24} }
-
-func (m *StatsContainerResponse) GetCgroupStats() *CgroupStats {
-	if m != nil {
-		return m.CgroupStats
-	}
-	return nil
-}
+func (m *PauseContainerRequest) Reset()                    { *m = PauseContainerRequest{} }
+func (m *PauseContainerRequest) String() string            { return proto.CompactTextString(m) }
+func (*PauseContainerRequest) ProtoMessage()               {}
+func (*PauseContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{30} }
 
-func (m *StatsContainerResponse) GetNetworkStats() []*NetworkStats {
+func (m *PauseContainerRequest) GetContainerId() string {
 	if m != nil {
-		return m.NetworkStats
+		return m.ContainerId
 	}
-	return nil
+	return ""
 }
 
-type WriteStreamRequest struct {
+type ResumeContainerRequest struct {
 	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	ExecId      string `protobuf:"bytes,2,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
-	Data        []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (m *WriteStreamRequest) Reset()                    { *m = WriteStreamRequest{} }
-func (m *WriteStreamRequest) String() string            { return proto.CompactTextString(m) }
-func (*WriteStreamRequest) ProtoMessage()               {}
-func (*WriteStreamRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{25} }
+func (m *ResumeContainerRequest) Reset()                    { *m = ResumeContainerRequest{} }
+func (m *ResumeContainerRequest) String() string            { return proto.CompactTextString(m) }
+func (*ResumeContainerRequest) ProtoMessage()               {}
+func (*ResumeContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{31} }
 
-func (m *WriteStreamRequest) GetContainerId() string {
+func (m *ResumeContainerRequest) GetContainerId() string {
 	if m != nil {
 		return m.ContainerId
 	}
 	return ""
 }
 
-func (m *WriteStreamRequest) GetExecId() string {
+type CpuUsage struct {
+	TotalUsage        uint64   `protobuf:"varint,1,opt,name=total_usage,json=totalUsage,proto3" json:"total_usage,omitempty"`
+	PercpuUsage       []uint64 `protobuf:"varint,2,rep,packed,name=percpu_usage,json=percpuUsage" json:"percpu_usage,omitempty"`
+	UsageInKernelmode uint64   `protobuf:"varint,3,opt,name=usage_in_kernelmode,json=usageInKernelmode,proto3" json:"usage_in_kernelmode,omitempty"`
+	UsageInUsermode   uint64   `protobuf:"varint,4,opt,name=usage_in_usermode,json=usageInUsermode,proto3" json:"usage_in_usermode,omitempty"`
+}
+
+func (m *CpuUsage) Reset()                    { *m = CpuUsage{} }
+func (m *CpuUsage) String() string            { return proto.CompactTextString(m) }
+func (*CpuUsage) ProtoMessage()               {}
+func (*CpuUsage) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{32} }
+
+func (m *CpuUsage) GetTotalUsage() uint64 {
 	if m != nil {
-		return m.ExecId
+		return m.TotalUsage
 	}
-	return ""
+	return 0
 }
 
-func (m *WriteStreamRequest) GetData() []byte {
+func (m *CpuUsage) GetPercpuUsage() []uint64 {
 	if m != nil {
-		return m.Data
+		return m.PercpuUsage
 	}
 	return nil
 }
 
-type WriteStreamResponse struct {
-	Len uint32 `protobuf:"varint,1,opt,name=len,proto3" json:"len,omitempty"`
+func (m *CpuUsage) GetUsageInKernelmode() uint64 {
+	if m != nil {
+		return m.UsageInKernelmode
+	}
+	return 0
 }
 
-func (m *WriteStreamResponse) Reset()                    { *m = WriteStreamResponse{} }
-func (m *WriteStreamResponse) String() string            { return proto.CompactTextString(m) }
-func (*WriteStreamResponse) ProtoMessage()               {}
-func (*WriteStreamResponse) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{26} }
-
-func (m *WriteStreamResponse) GetLen() uint32 {
+func (m *CpuUsage) GetUsageInUsermode() uint64 {
 	if m != nil {
-		return m.Len
+		return m.UsageInUsermode
 	}
 	return 0
 }
 
-type ReadStreamRequest struct {
-	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	ExecId      string `protobuf:"bytes,2,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
-	Len         uint32 `protobuf:"varint,3,opt,name=len,proto3" json:"len,omitempty"`
+type ThrottlingData struct {
+	Periods          uint64 `protobuf:"varint,1,opt,name=periods,proto3" json:"periods,omitempty"`
+	ThrottledPeriods uint64 `protobuf:"varint,2,opt,name=throttled_periods,json=throttledPeriods,proto3" json:"throttled_periods,omitempty"`
+	ThrottledTime    uint64 `protobuf:"varint,3,opt,name=throttled_time,json=throttledTime,proto3" json:"throttled_time,omitempty"`
 }
 
-func (m *ReadStreamRequest) Reset()                    { *m = ReadStreamRequest{} }
-func (m *ReadStreamRequest) String() string            { return proto.CompactTextString(m) }
-func (*ReadStreamRequest) ProtoMessage()               {}
-func (*ReadStreamRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{27} }
+func (m *ThrottlingData) Reset()                    { *m = ThrottlingData{} }
+func (m *ThrottlingData) String() string            { return proto.CompactTextString(m) }
+func (*ThrottlingData) ProtoMessage()               {}
+func (*ThrottlingData) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{33} }
 
-func (m *ReadStreamRequest) GetContainerId() string {
+func (m *ThrottlingData) GetPeriods() uint64 {
 	if m != nil {
-		return m.ContainerId
+		return m.Periods
 	}
-	return ""
+	return 0
 }
 
-func (m *ReadStreamRequest) GetExecId() string {
+func (m *ThrottlingData) GetThrottledPeriods() uint64 {
 	if m != nil {
-		return m.ExecId
+		return m.ThrottledPeriods
 	}
-	return ""
+	return 0
 }
 
-func (m *ReadStreamRequest) GetLen() uint32 {
+func (m *ThrottlingData) GetThrottledTime() uint64 {
 	if m != nil {
-		return m.Len
+		return m.ThrottledTime
 	}
 	return 0
 }
 
-type ReadStreamResponse struct {
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+type CpuStats struct {
+	CpuUsage       *CpuUsage       `protobuf:"bytes,1,opt,name=cpu_usage,json=cpuUsage" json:"cpu_usage,omitempty"`
+	ThrottlingData *ThrottlingData `protobuf:"bytes,2,opt,name=throttling_data,json=throttlingData" json:"throttling_data,omitempty"`
 }
 
-func (m *ReadStreamResponse) Reset()                    { *m = ReadStreamResponse{} }
-func (m *ReadStreamResponse) String() string            { return proto.CompactTextString(m) }
-func (*ReadStreamResponse) ProtoMessage()               {}
-func (*ReadStreamResponse) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{28} }
+func (m *CpuStats) Reset()                    { *m = CpuStats{} }
+func (m *CpuStats) String() string            { return proto.CompactTextString(m) }
+func (*CpuStats) ProtoMessage()               {}
+func (*CpuStats) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{34} }
 
-func (m *ReadStreamResponse) GetData() []byte {
+func (m *CpuStats) GetCpuUsage() *CpuUsage {
 	if m != nil {
-		return m.Data
+		return m.CpuUsage
 	}
 	return nil
 }
 
-type CloseStdinRequest struct {
-	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	ExecId      string `protobuf:"bytes,2,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
+func (m *CpuStats) GetThrottlingData() *ThrottlingData {
+	if m != nil {
+		return m.ThrottlingData
+	}
+	return nil
 }
 
-func (m *CloseStdinRequest) Reset()                    { *m = CloseStdinRequest{} }
-func (m *CloseStdinRequest) String() string            { return proto.CompactTextString(m) }
-func (*CloseStdinRequest) ProtoMessage()               {}
-func (*CloseStdinRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{29} }
+type PidsStats struct {
+	Current uint64 `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`
+	Limit   uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
 
-func (m *CloseStdinRequest) GetContainerId() string {
+func (m *PidsStats) Reset()                    { *m = PidsStats{} }
+func (m *PidsStats) String() string            { return proto.CompactTextString(m) }
+func (*PidsStats) ProtoMessage()               {}
+func (*PidsStats) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{35} }
+
+func (m *PidsStats) GetCurrent() uint64 {
 	if m != nil {
-		return m.ContainerId
+		return m.Current
 	}
-	return ""
+	return 0
 }
 
-func (m *CloseStdinRequest) GetExecId() string {
+func (m *PidsStats) GetLimit() uint64 {
 	if m != nil {
-		return m.ExecId
+		return m.Limit
 	}
-	return ""
+	return 0
 }
 
-type TtyWinResizeRequest struct {
-	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	ExecId      string `protobuf:"bytes,2,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
-	Row         uint32 `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
-	Column      uint32 `protobuf:"varint,4,opt,name=column,proto3" json:"column,omitempty"`
+type MemoryData struct {
+	Usage    uint64 `protobuf:"varint,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	MaxUsage uint64 `protobuf:"varint,2,opt,name=max_usage,json=maxUsage,proto3" json:"max_usage,omitempty"`
+	Failcnt  uint64 `protobuf:"varint,3,opt,name=failcnt,proto3" json:"failcnt,omitempty"`
+	Limit    uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (m *TtyWinResizeRequest) Reset()                    { *m = TtyWinResizeRequest{} }
-func (m *TtyWinResizeRequest) String() string            { return proto.CompactTextString(m) }
-func (*TtyWinResizeRequest) ProtoMessage()               {}
-func (*TtyWinResizeRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{30} }
+func (m *MemoryData) Reset()                    { *m = MemoryData{} }
+func (m *MemoryData) String() string            { return proto.CompactTextString(m) }
+func (*MemoryData) ProtoMessage()               {}
+func (*MemoryData) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{36} }
 
-func (m *TtyWinResizeRequest) GetContainerId() string {
+func (m *MemoryData) GetUsage() uint64 {
 	if m != nil {
-		return m.ContainerId
+		return m.Usage
 	}
-	return ""
+	return 0
 }
 
-func (m *TtyWinResizeRequest) GetExecId() string {
+func (m *MemoryData) GetMaxUsage() uint64 {
 	if m != nil {
-		return m.ExecId
+		return m.MaxUsage
 	}
-	return ""
+	return 0
 }
 
-func (m *TtyWinResizeRequest) GetRow() uint32 {
+func (m *MemoryData) GetFailcnt() uint64 {
 	if m != nil {
-		return m.Row
+		return m.Failcnt
 	}
 	return 0
 }
 
-func (m *TtyWinResizeRequest) GetColumn() uint32 {
+func (m *MemoryData) GetLimit() uint64 {
 	if m != nil {
-		return m.Column
+		return m.Limit
 	}
 	return 0
 }
 
-type KernelModule struct {
-	// This field is the name of the kernel module.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// This field are the parameters for the kernel module which are
-	// whitespace-delimited key=value pairs passed to modprobe(8).
-	Parameters []string `protobuf:"bytes,2,rep,name=parameters" json:"parameters,omitempty"`
+type MemoryStats struct {
+	Cache        uint64            `protobuf:"varint,1,opt,name=cache,proto3" json:"cache,omitempty"`
+	Usage        *MemoryData       `protobuf:"bytes,2,opt,name=usage" json:"usage,omitempty"`
+	SwapUsage    *MemoryData       `protobuf:"bytes,3,opt,name=swap_usage,json=swapUsage" json:"swap_usage,omitempty"`
+	KernelUsage  *MemoryData       `protobuf:"bytes,4,opt,name=kernel_usage,json=kernelUsage" json:"kernel_usage,omitempty"`
+	UseHierarchy bool              `protobuf:"varint,5,opt,name=use_hierarchy,json=useHierarchy,proto3" json:"use_hierarchy,omitempty"`
+	Stats        map[string]uint64 `protobuf:"bytes,6,rep,name=stats" json:"stats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (m *KernelModule) Reset()                    { *m = KernelModule{} }
-func (m *KernelModule) String() string            { return proto.CompactTextString(m) }
-func (*KernelModule) ProtoMessage()               {}
-func (*KernelModule) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{31} }
+func (m *MemoryStats) Reset()                    { *m = MemoryStats{} }
+func (m *MemoryStats) String() string            { return proto.CompactTextString(m) }
+func (*MemoryStats) ProtoMessage()               {}
+func (*MemoryStats) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{37} }
 
-func (m *KernelModule) GetName() string {
+func (m *MemoryStats) GetCache() uint64 {
 	if m != nil {
-		return m.Name
+		return m.Cache
 	}
-	return ""
+	return 0
 }
 
-func (m *KernelModule) GetParameters() []string {
+func (m *MemoryStats) GetUsage() *MemoryData {
 	if m != nil {
-		return m.Parameters
+		return m.Usage
 	}
 	return nil
 }
 
-type CreateSandboxRequest struct {
-	Hostname string     `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Dns      []string   `protobuf:"bytes,2,rep,name=dns" json:"dns,omitempty"`
-	Storages []*Storage `protobuf:"bytes,3,rep,name=storages" json:"storages,omitempty"`
-	// This field means that a pause process needs to be created by the
-	// agent. This pid namespace of the pause process will be treated as
-	// a shared pid namespace. All containers created will join this shared
-	// pid namespace.
-	SandboxPidns bool `protobuf:"varint,4,opt,name=sandbox_pidns,json=sandboxPidns,proto3" json:"sandbox_pidns,omitempty"`
-	// SandboxId identifies which sandbox is using the agent. We allow only
-	// one sandbox per agent and implicitly require that CreateSandbox is
-	// called before other sandbox/network calls.
-	SandboxId string `protobuf:"bytes,5,opt,name=sandbox_id,json=sandboxId,proto3" json:"sandbox_id,omitempty"`
-	// This field, if non-empty, designates an absolute path to a directory
-	// that the agent will search for OCI hooks to run within the guest.
-	GuestHookPath string `protobuf:"bytes,6,opt,name=guest_hook_path,json=guestHookPath,proto3" json:"guest_hook_path,omitempty"`
-	// This field is the list of kernel modules to be loaded in the guest kernel.
-	KernelModules []*KernelModule `protobuf:"bytes,7,rep,name=kernel_modules,json=kernelModules" json:"kernel_modules,omitempty"`
+func (m *MemoryStats) GetSwapUsage() *MemoryData {
+	if m != nil {
+		return m.SwapUsage
+	}
+	return nil
 }
 
-func (m *CreateSandboxRequest) Reset()                    { *m = CreateSandboxRequest{} }
-func (m *CreateSandboxRequest) String() string            { return proto.CompactTextString(m) }
-func (*CreateSandboxRequest) ProtoMessage()               {}
-func (*CreateSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{32} }
-
-func (m *CreateSandboxRequest) GetHostname() string {
+func (m *MemoryStats) GetKernelUsage() *MemoryData {
 	if m != nil {
-		return m.Hostname
+		return m.KernelUsage
 	}
-	return ""
+	return nil
 }
 
-func (m *CreateSandboxRequest) GetDns() []string {
+func (m *MemoryStats) GetUseHierarchy() bool {
 	if m != nil {
-		return m.Dns
+		return m.UseHierarchy
 	}
-	return nil
+	return false
 }
 
-func (m *CreateSandboxRequest) GetStorages() []*Storage {
+func (m *MemoryStats) GetStats() map[string]uint64 {
 	if m != nil {
-		return m.Storages
+		return m.Stats
 	}
 	return nil
 }
 
-func (m *CreateSandboxRequest) GetSandboxPidns() bool {
+type BlkioStatsEntry struct {
+	Major uint64 `protobuf:"varint,1,opt,name=major,proto3" json:"major,omitempty"`
+	Minor uint64 `protobuf:"varint,2,opt,name=minor,proto3" json:"minor,omitempty"`
+	Op    string `protobuf:"bytes,3,opt,name=op,proto3" json:"op,omitempty"`
+	Value uint64 `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *BlkioStatsEntry) Reset()                    { *m = BlkioStatsEntry{} }
+func (m *BlkioStatsEntry) String() string            { return proto.CompactTextString(m) }
+func (*BlkioStatsEntry) ProtoMessage()               {}
+func (*BlkioStatsEntry) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{38} }
+
+func (m *BlkioStatsEntry) GetMajor() uint64 {
 	if m != nil {
-		return m.SandboxPidns
+		return m.Major
 	}
-	return false
+	return 0
 }
 
-func (m *CreateSandboxRequest) GetSandboxId() string {
+func (m *BlkioStatsEntry) GetMinor() uint64 {
 	if m != nil {
-		return m.SandboxId
+		return m.Minor
 	}
-	return ""
+	return 0
 }
 
-func (m *CreateSandboxRequest) GetGuestHookPath() string {
+func (m *BlkioStatsEntry) GetOp() string {
 	if m != nil {
-		return m.GuestHookPath
+		return m.Op
 	}
 	return ""
 }
 
-func (m *CreateSandboxRequest) GetKernelModules() []*KernelModule {
+func (m *BlkioStatsEntry) GetValue() uint64 {
 	if m != nil {
-		return m.KernelModules
+		return m.Value
 	}
-	return nil
+	return 0
 }
 
-type DestroySandboxRequest struct {
+type BlkioStats struct {
+	IoServiceBytesRecursive []*BlkioStatsEntry `protobuf:"bytes,1,rep,name=io_service_bytes_recursive,json=ioServiceBytesRecursive" json:"io_service_bytes_recursive,omitempty"`
+	IoServicedRecursive     []*BlkioStatsEntry `protobuf:"bytes,2,rep,name=io_serviced_recursive,json=ioServicedRecursive" json:"io_serviced_recursive,omitempty"`
+	IoQueuedRecursive       []*BlkioStatsEntry `protobuf:"bytes,3,rep,name=io_queued_recursive,json=ioQueuedRecursive" json:"io_queued_recursive,omitempty"`
+	IoServiceTimeRecursive  []*BlkioStatsEntry `protobuf:"bytes,4,rep,name=io_service_time_recursive,json=ioServiceTimeRecursive" json:"io_service_time_recursive,omitempty"`
+	IoWaitTimeRecursive     []*BlkioStatsEntry `protobuf:"bytes,5,rep,name=io_wait_time_recursive,json=ioWaitTimeRecursive" json:"io_wait_time_recursive,omitempty"`
+	IoMergedRecursive       []*BlkioStatsEntry `protobuf:"bytes,6,rep,name=io_merged_recursive,json=ioMergedRecursive" json:"io_merged_recursive,omitempty"`
+	IoTimeRecursive         []*BlkioStatsEntry `protobuf:"bytes,7,rep,name=io_time_recursive,json=ioTimeRecursive" json:"io_time_recursive,omitempty"`
+	SectorsRecursive        []*BlkioStatsEntry `protobuf:"bytes,8,rep,name=sectors_recursive,json=sectorsRecursive" json:"sectors_recursive,omitempty"`
 }
 
-func (m *DestroySandboxRequest) Reset()                    { *m = DestroySandboxRequest{} }
-func (m *DestroySandboxRequest) String() string            { return proto.CompactTextString(m) }
-func (*DestroySandboxRequest) ProtoMessage()               {}
-func (*DestroySandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{33} }
+func (m *BlkioStats) Reset()                    { *m = BlkioStats{} }
+func (m *BlkioStats) String() string            { return proto.CompactTextString(m) }
+func (*BlkioStats) ProtoMessage()               {}
+func (*BlkioStats) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{39} }
 
-type Interfaces struct {
-	Interfaces []*types.Interface `protobuf:"bytes,1,rep,name=Interfaces" json:"Interfaces,omitempty"`
+func (m *BlkioStats) GetIoServiceBytesRecursive() []*BlkioStatsEntry {
+	if m != nil {
+		return m.IoServiceBytesRecursive
+	}
+	return nil
 }
 
-func (m *Interfaces) Reset()                    { *m = Interfaces{} }
-func (m *Interfaces) String() string            { return proto.CompactTextString(m) }
-func (*Interfaces) ProtoMessage()               {}
-func (*Interfaces) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{34} }
-
-func (m *Interfaces) GetInterfaces() []*types.Interface {
+func (m *BlkioStats) GetIoServicedRecursive() []*BlkioStatsEntry {
 	if m != nil {
-		return m.Interfaces
+		return m.IoServicedRecursive
 	}
 	return nil
 }
 
-type Routes struct {
-	Routes []*types.Route `protobuf:"bytes,1,rep,name=Routes" json:"Routes,omitempty"`
+func (m *BlkioStats) GetIoQueuedRecursive() []*BlkioStatsEntry {
+	if m != nil {
+		return m.IoQueuedRecursive
+	}
+	return nil
 }
 
-func (m *Routes) Reset()                    { *m = Routes{} }
-func (m *Routes) String() string            { return proto.CompactTextString(m) }
-func (*Routes) ProtoMessage()               {}
-func (*Routes) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{35} }
-
-func (m *Routes) GetRoutes() []*types.Route {
+func (m *BlkioStats) GetIoServiceTimeRecursive() []*BlkioStatsEntry {
 	if m != nil {
-		return m.Routes
+		return m.IoServiceTimeRecursive
 	}
 	return nil
 }
 
-type UpdateInterfaceRequest struct {
-	Interface *types.Interface `protobuf:"bytes,1,opt,name=interface" json:"interface,omitempty"`
+func (m *BlkioStats) GetIoWaitTimeRecursive() []*BlkioStatsEntry {
+	if m != nil {
+		return m.IoWaitTimeRecursive
+	}
+	return nil
 }
 
-func (m *UpdateInterfaceRequest) Reset()                    { *m = UpdateInterfaceRequest{} }
-func (m *UpdateInterfaceRequest) String() string            { return proto.CompactTextString(m) }
-func (*UpdateInterfaceRequest) ProtoMessage()               {}
-func (*UpdateInterfaceRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{36} }
-
-func (m *UpdateInterfaceRequest) GetInterface() *types.Interface {
+func (m *BlkioStats) GetIoMergedRecursive() []*BlkioStatsEntry {
 	if m != nil {
-		return m.Interface
+		return m.IoMergedRecursive
 	}
 	return nil
 }
 
-type UpdateRoutesRequest struct {
-	Routes *Routes `protobuf:"bytes,1,opt,name=routes" json:"routes,omitempty"`
+func (m *BlkioStats) GetIoTimeRecursive() []*BlkioStatsEntry {
+	if m != nil {
+		return m.IoTimeRecursive
+	}
+	return nil
 }
 
-func (m *UpdateRoutesRequest) Reset()                    { *m = UpdateRoutesRequest{} }
-func (m *UpdateRoutesRequest) String() string            { return proto.CompactTextString(m) }
-func (*UpdateRoutesRequest) ProtoMessage()               {}
-func (*UpdateRoutesRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{37} }
-
-func (m *UpdateRoutesRequest) GetRoutes() *Routes {
+func (m *BlkioStats) GetSectorsRecursive() []*BlkioStatsEntry {
 	if m != nil {
-		return m.Routes
+		return m.SectorsRecursive
 	}
 	return nil
 }
 
-type ListInterfacesRequest struct {
+type HugetlbStats struct {
+	Usage    uint64 `protobuf:"varint,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	MaxUsage uint64 `protobuf:"varint,2,opt,name=max_usage,json=maxUsage,proto3" json:"max_usage,omitempty"`
+	Failcnt  uint64 `protobuf:"varint,3,opt,name=failcnt,proto3" json:"failcnt,omitempty"`
 }
 
-func (m *ListInterfacesRequest) Reset()                    { *m = ListInterfacesRequest{} }
-func (m *ListInterfacesRequest) String() string            { return proto.CompactTextString(m) }
-func (*ListInterfacesRequest) ProtoMessage()               {}
-func (*ListInterfacesRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{38} }
+func (m *HugetlbStats) Reset()                    { *m = HugetlbStats{} }
+func (m *HugetlbStats) String() string            { return proto.CompactTextString(m) }
+func (*HugetlbStats) ProtoMessage()               {}
+func (*HugetlbStats) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{40} }
 
-type ListRoutesRequest struct {
+func (m *HugetlbStats) GetUsage() uint64 {
+	if m != nil {
+		return m.Usage
+	}
+	return 0
 }
 
-func (m *ListRoutesRequest) Reset()                    { *m = ListRoutesRequest{} }
-func (m *ListRoutesRequest) String() string            { return proto.CompactTextString(m) }
-func (*ListRoutesRequest) ProtoMessage()               {}
-func (*ListRoutesRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{39} }
+func (m *HugetlbStats) GetMaxUsage() uint64 {
+	if m != nil {
+		return m.MaxUsage
+	}
+	return 0
+}
 
-type OnlineCPUMemRequest struct {
-	// Wait specifies if the caller waits for the agent to online all resources.
-	// If true the agent returns once all resources have been connected, otherwise all
-	// resources are connected asynchronously and the agent returns immediately.
-	Wait bool `protobuf:"varint,1,opt,name=wait,proto3" json:"wait,omitempty"`
-	// NbCpus specifies the number of CPUs that were added and the agent has to online.
-	NbCpus uint32 `protobuf:"varint,2,opt,name=nb_cpus,json=nbCpus,proto3" json:"nb_cpus,omitempty"`
-	// CpuOnly specifies whether only online CPU or not.
-	CpuOnly bool `protobuf:"varint,3,opt,name=cpu_only,json=cpuOnly,proto3" json:"cpu_only,omitempty"`
+func (m *HugetlbStats) GetFailcnt() uint64 {
+	if m != nil {
+		return m.Failcnt
+	}
+	return 0
 }
 
-func (m *OnlineCPUMemRequest) Reset()                    { *m = OnlineCPUMemRequest{} }
-func (m *OnlineCPUMemRequest) String() string            { return proto.CompactTextString(m) }
-func (*OnlineCPUMemRequest) ProtoMessage()               {}
-func (*OnlineCPUMemRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{40} }
+type CgroupStats struct {
+	CpuStats     *CpuStats                `protobuf:"bytes,1,opt,name=cpu_stats,json=cpuStats" json:"cpu_stats,omitempty"`
+	MemoryStats  *MemoryStats             `protobuf:"bytes,2,opt,name=memory_stats,json=memoryStats" json:"memory_stats,omitempty"`
+	PidsStats    *PidsStats               `protobuf:"bytes,3,opt,name=pids_stats,json=pidsStats" json:"pids_stats,omitempty"`
+	BlkioStats   *BlkioStats              `protobuf:"bytes,4,opt,name=blkio_stats,json=blkioStats" json:"blkio_stats,omitempty"`
+	HugetlbStats map[string]*HugetlbStats `protobuf:"bytes,5,rep,name=hugetlb_stats,json=hugetlbStats" json:"hugetlb_stats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+}
 
-func (m *OnlineCPUMemRequest) GetWait() bool {
+func (m *CgroupStats) Reset()                    { *m = CgroupStats{} }
+func (m *CgroupStats) String() string            { return proto.CompactTextString(m) }
+func (*CgroupStats) ProtoMessage()               {}
+func (*CgroupStats) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{41} }
+
+func (m *CgroupStats) GetCpuStats() *CpuStats {
 	if m != nil {
-		return m.Wait
+		return m.CpuStats
 	}
-	return false
+	return nil
 }
 
-func (m *OnlineCPUMemRequest) GetNbCpus() uint32 {
+func (m *CgroupStats) GetMemoryStats() *MemoryStats {
 	if m != nil {
-		return m.NbCpus
+		return m.MemoryStats
 	}
-	return 0
+	return nil
 }
 
-func (m *OnlineCPUMemRequest) GetCpuOnly() bool {
+func (m *CgroupStats) GetPidsStats() *PidsStats {
 	if m != nil {
-		return m.CpuOnly
+		return m.PidsStats
 	}
-	return false
+	return nil
 }
 
-type ReseedRandomDevRequest struct {
-	// Data specifies the random data used to reseed the guest crng.
-	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+func (m *CgroupStats) GetBlkioStats() *BlkioStats {
+	if m != nil {
+		return m.BlkioStats
+	}
+	return nil
 }
 
-func (m *ReseedRandomDevRequest) Reset()                    { *m = ReseedRandomDevRequest{} }
-func (m *ReseedRandomDevRequest) String() string            { return proto.CompactTextString(m) }
-func (*ReseedRandomDevRequest) ProtoMessage()               {}
-func (*ReseedRandomDevRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{41} }
-
-func (m *ReseedRandomDevRequest) GetData() []byte {
+func (m *CgroupStats) GetHugetlbStats() map[string]*HugetlbStats {
 	if m != nil {
-		return m.Data
+		return m.HugetlbStats
 	}
 	return nil
 }
 
-// AgentDetails provides information to the client about the running agent.
-type AgentDetails struct {
-	// Semantic version of agent (see https://semver.org).
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	// Set if the agent is running as PID 1.
-	InitDaemon bool `protobuf:"varint,2,opt,name=init_daemon,json=initDaemon,proto3" json:"init_daemon,omitempty"`
-	// List of available device handlers.
-	DeviceHandlers []string `protobuf:"bytes,3,rep,name=device_handlers,json=deviceHandlers" json:"device_handlers,omitempty"`
-	// List of available storage handlers.
-	StorageHandlers []string `protobuf:"bytes,4,rep,name=storage_handlers,json=storageHandlers" json:"storage_handlers,omitempty"`
-	// Set only if the agent is built with seccomp support and the guest
-	// environment supports seccomp.
-	SupportsSeccomp bool `protobuf:"varint,5,opt,name=supports_seccomp,json=supportsSeccomp,proto3" json:"supports_seccomp,omitempty"`
+type NetworkStats struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RxBytes   uint64 `protobuf:"varint,2,opt,name=rx_bytes,json=rxBytes,proto3" json:"rx_bytes,omitempty"`
+	RxPackets uint64 `protobuf:"varint,3,opt,name=rx_packets,json=rxPackets,proto3" json:"rx_packets,omitempty"`
+	RxErrors  uint64 `protobuf:"varint,4,opt,name=rx_errors,json=rxErrors,proto3" json:"rx_errors,omitempty"`
+	RxDropped uint64 `protobuf:"varint,5,opt,name=rx_dropped,json=rxDropped,proto3" json:"rx_dropped,omitempty"`
+	TxBytes   uint64 `protobuf:"varint,6,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	TxPackets uint64 `protobuf:"varint,7,opt,name=tx_packets,json=txPackets,proto3" json:"tx_packets,omitempty"`
+	TxErrors  uint64 `protobuf:"varint,8,opt,name=tx_errors,json=txErrors,proto3" json:"tx_errors,omitempty"`
+	TxDropped uint64 `protobuf:"varint,9,opt,name=tx_dropped,json=txDropped,proto3" json:"tx_dropped,omitempty"`
 }
 
-func (m *AgentDetails) Reset()                    { *m = AgentDetails{} }
-func (m *AgentDetails) String() string            { return proto.CompactTextString(m) }
-func (*AgentDetails) ProtoMessage()               {}
-func (*AgentDetails) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{42} }
+func (m *NetworkStats) Reset()                    { *m = NetworkStats{} }
+func (m *NetworkStats) String() string            { return proto.CompactTextString(m) }
+func (*NetworkStats) ProtoMessage()               {}
+func (*NetworkStats) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{42} }
 
-func (m *AgentDetails) GetVersion() string {
+func (m *NetworkStats) GetName() string {
 	if m != nil {
-		return m.Version
+		return m.Name
 	}
 	return ""
 }
 
-func (m *AgentDetails) GetInitDaemon() bool {
+func (m *NetworkStats) GetRxBytes() uint64 {
 	if m != nil {
-		return m.InitDaemon
+		return m.RxBytes
 	}
-	return false
+	return 0
 }
 
-func (m *AgentDetails) GetDeviceHandlers() []string {
+func (m *NetworkStats) GetRxPackets() uint64 {
 	if m != nil {
-		return m.DeviceHandlers
+		return m.RxPackets
 	}
-	return nil
+	return 0
 }
 
-func (m *AgentDetails) GetStorageHandlers() []string {
+func (m *NetworkStats) GetRxErrors() uint64 {
 	if m != nil {
-		return m.StorageHandlers
+		return m.RxErrors
 	}
-	return nil
+	return 0
 }
 
-func (m *AgentDetails) GetSupportsSeccomp() bool {
+func (m *NetworkStats) GetRxDropped() uint64 {
 	if m != nil {
-		return m.SupportsSeccomp
+		return m.RxDropped
 	}
-	return false
+	return 0
 }
 
-type GuestDetailsRequest struct {
-	// MemBlockSize asks server to return the system memory block size that can be used
-	// for memory hotplug alignment. Typically the server returns what's in
-	// /sys/devices/system/memory/block_size_bytes.
-	MemBlockSize bool `protobuf:"varint,1,opt,name=mem_block_size,json=memBlockSize,proto3" json:"mem_block_size,omitempty"`
-	// MemoryHotplugProbe asks server to return whether guest kernel supports memory hotplug
-	// via probeinterface. Typically the server will check if the path
-	// /sys/devices/system/memory/probe exists.
-	MemHotplugProbe bool `protobuf:"varint,2,opt,name=mem_hotplug_probe,json=memHotplugProbe,proto3" json:"mem_hotplug_probe,omitempty"`
+func (m *NetworkStats) GetTxBytes() uint64 {
+	if m != nil {
+		return m.TxBytes
+	}
+	return 0
 }
 
-func (m *GuestDetailsRequest) Reset()                    { *m = GuestDetailsRequest{} }
-func (m *GuestDetailsRequest) String() string            { return proto.CompactTextString(m) }
-func (*GuestDetailsRequest) ProtoMessage()               {}
-func (*GuestDetailsRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{43} }
+func (m *NetworkStats) GetTxPackets() uint64 {
+	if m != nil {
+		return m.TxPackets
+	}
+	return 0
+}
 
-func (m *GuestDetailsRequest) GetMemBlockSize() bool {
+func (m *NetworkStats) GetTxErrors() uint64 {
 	if m != nil {
-		return m.MemBlockSize
+		return m.TxErrors
 	}
-	return false
+	return 0
 }
 
-func (m *GuestDetailsRequest) GetMemHotplugProbe() bool {
+func (m *NetworkStats) GetTxDropped() uint64 {
 	if m != nil {
-		return m.MemHotplugProbe
+		return m.TxDropped
 	}
-	return false
+	return 0
 }
 
-type GuestDetailsResponse struct {
-	// MemBlockSizeBytes returns the system memory block size in bytes.
-	MemBlockSizeBytes      uint64        `protobuf:"varint,1,opt,name=mem_block_size_bytes,json=memBlockSizeBytes,proto3" json:"mem_block_size_bytes,omitempty"`
-	AgentDetails           *AgentDetails `protobuf:"bytes,2,opt,name=agent_details,json=agentDetails" json:"agent_details,omitempty"`
-	SupportMemHotplugProbe bool          `protobuf:"varint,3,opt,name=support_mem_hotplug_probe,json=supportMemHotplugProbe,proto3" json:"support_mem_hotplug_probe,omitempty"`
+// WorkingSetStats estimates the memory actively used by a container, as
+// its memory usage minus its inactive file cache.
+type WorkingSetStats struct {
+	// Last sample, in bytes.
+	Current uint64 `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`
+	// Average of the recent samples, in bytes.
+	Average uint64 `protobuf:"varint,2,opt,name=average,proto3" json:"average,omitempty"`
+	// Number of samples averaged.
+	Samples uint32 `protobuf:"varint,3,opt,name=samples,proto3" json:"samples,omitempty"`
 }
 
-func (m *GuestDetailsResponse) Reset()                    { *m = GuestDetailsResponse{} }
-func (m *GuestDetailsResponse) String() string            { return proto.CompactTextString(m) }
-func (*GuestDetailsResponse) ProtoMessage()               {}
-func (*GuestDetailsResponse) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{44} }
+func (m *WorkingSetStats) Reset()                    { *m = WorkingSetStats{} }
+func (m *WorkingSetStats) String() string            { return proto.CompactTextString(m) }
+func (*WorkingSetStats) ProtoMessage()               {}
+func (*WorkingSetStats) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{43} }
 
-func (m *GuestDetailsResponse) GetMemBlockSizeBytes() uint64 {
+func (m *WorkingSetStats) GetCurrent() uint64 {
 	if m != nil {
-		return m.MemBlockSizeBytes
+		return m.Current
 	}
 	return 0
 }
 
-func (m *GuestDetailsResponse) GetAgentDetails() *AgentDetails {
+func (m *WorkingSetStats) GetAverage() uint64 {
 	if m != nil {
-		return m.AgentDetails
+		return m.Average
 	}
-	return nil
+	return 0
 }
 
-func (m *GuestDetailsResponse) GetSupportMemHotplugProbe() bool {
+func (m *WorkingSetStats) GetSamples() uint32 {
 	if m != nil {
-		return m.SupportMemHotplugProbe
+		return m.Samples
 	}
-	return false
+	return 0
 }
 
-type MemHotplugByProbeRequest struct {
-	// server needs to send the value of memHotplugProbeAddr into file /sys/devices/system/memory/probe,
-	// in order to notify the guest kernel about hot-add memory event
-	MemHotplugProbeAddr []uint64 `protobuf:"varint,1,rep,packed,name=memHotplugProbeAddr" json:"memHotplugProbeAddr,omitempty"`
+// PageCacheStats reports the page cache and its pages not yet written to
+// the storage.
+type PageCacheStats struct {
+	// Page cache, in bytes.
+	Cache uint64 `protobuf:"varint,1,opt,name=cache,proto3" json:"cache,omitempty"`
+	// Dirty pages waiting to be written back, in bytes.
+	Dirty uint64 `protobuf:"varint,2,opt,name=dirty,proto3" json:"dirty,omitempty"`
+	// Pages being written back, in bytes.
+	Writeback uint64 `protobuf:"varint,3,opt,name=writeback,proto3" json:"writeback,omitempty"`
 }
 
-func (m *MemHotplugByProbeRequest) Reset()                    { *m = MemHotplugByProbeRequest{} }
-func (m *MemHotplugByProbeRequest) String() string            { return proto.CompactTextString(m) }
-func (*MemHotplugByProbeRequest) ProtoMessage()               {}
-func (*MemHotplugByProbeRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{45} }
+func (m *PageCacheStats) Reset()                    { *m = PageCacheStats{} }
+func (m *PageCacheStats) String() string            { return proto.CompactTextString(m) }
+func (*PageCacheStats) ProtoMessage()               {}
+func (*PageCacheStats) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{44} }
 
-func (m *MemHotplugByProbeRequest) GetMemHotplugProbeAddr() []uint64 {
+func (m *PageCacheStats) GetCache() uint64 {
 	if m != nil {
-		return m.MemHotplugProbeAddr
+		return m.Cache
 	}
-	return nil
-}
-
-type SetGuestDateTimeRequest struct {
-	// Sec the second since the Epoch.
-	Sec int64 `protobuf:"varint,1,opt,name=Sec,proto3" json:"Sec,omitempty"`
-	// Usec the microseconds portion of time since the Epoch.
-	Usec int64 `protobuf:"varint,2,opt,name=Usec,proto3" json:"Usec,omitempty"`
+	return 0
 }
 
-func (m *SetGuestDateTimeRequest) Reset()                    { *m = SetGuestDateTimeRequest{} }
-func (m *SetGuestDateTimeRequest) String() string            { return proto.CompactTextString(m) }
-func (*SetGuestDateTimeRequest) ProtoMessage()               {}
-func (*SetGuestDateTimeRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{46} }
-
-func (m *SetGuestDateTimeRequest) GetSec() int64 {
+func (m *PageCacheStats) GetDirty() uint64 {
 	if m != nil {
-		return m.Sec
+		return m.Dirty
 	}
 	return 0
 }
 
-func (m *SetGuestDateTimeRequest) GetUsec() int64 {
+func (m *PageCacheStats) GetWriteback() uint64 {
 	if m != nil {
-		return m.Usec
+		return m.Writeback
 	}
 	return 0
 }
 
-// Storage represents both the rootfs of the container, and any volume that
-// could have been defined through the Mount list of the OCI specification.
-type Storage struct {
-	// Driver is used to define the way the storage is passed through the
-	// virtual machine. It can be "9p", "blk", or something else, but for
-	// all cases, this will define if some extra steps are required before
-	// this storage gets mounted into the container.
-	Driver string `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
-	// DriverOptions allows the caller to define a list of options such
-	// as block sizes, numbers of luns, ... which are very specific to
-	// every device and cannot be generalized through extra fields.
-	DriverOptions []string `protobuf:"bytes,2,rep,name=driver_options,json=driverOptions" json:"driver_options,omitempty"`
-	// Source can be anything representing the source of the storage. This
-	// will be handled by the proper handler based on the Driver used.
-	// For instance, it can be a very simple path if the caller knows the
-	// name of device inside the VM, or it can be some sort of identifier
-	// to let the agent find the device inside the VM.
-	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
-	// Fstype represents the filesystem that needs to be used to mount the
-	// storage inside the VM. For instance, it could be "xfs" for block
-	// device, "9p" for shared filesystem, or "tmpfs" for shared /dev/shm.
-	Fstype string `protobuf:"bytes,4,opt,name=fstype,proto3" json:"fstype,omitempty"`
-	// Options describes the additional options that might be needed to
-	// mount properly the storage filesytem.
-	Options []string `protobuf:"bytes,5,rep,name=options" json:"options,omitempty"`
-	// MountPoint refers to the path where the storage should be mounted
-	// inside the VM.
-	MountPoint string `protobuf:"bytes,6,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
+// OOMControl reports how the OOM killer applies to a container.
+type OOMControl struct {
+	// The OOM killer is disabled, the processes waiting for memory instead,
+	// cgroup v1 only.
+	KillDisabled bool `protobuf:"varint,1,opt,name=kill_disabled,json=killDisabled,proto3" json:"kill_disabled,omitempty"`
+	// Processes are waiting for memory, cgroup v1 only.
+	UnderOom bool `protobuf:"varint,2,opt,name=under_oom,json=underOom,proto3" json:"under_oom,omitempty"`
+	// Memory usage throttled and reclaimed above, in bytes, zero when
+	// unlimited, cgroup v2 only.
+	MemoryHigh uint64 `protobuf:"varint,3,opt,name=memory_high,json=memoryHigh,proto3" json:"memory_high,omitempty"`
+	// Number of times the usage went above memory_high, cgroup v2 only.
+	HighEvents uint64 `protobuf:"varint,4,opt,name=high_events,json=highEvents,proto3" json:"high_events,omitempty"`
 }
 
-func (m *Storage) Reset()                    { *m = Storage{} }
-func (m *Storage) String() string            { return proto.CompactTextString(m) }
-func (*Storage) ProtoMessage()               {}
-func (*Storage) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{47} }
+func (m *OOMControl) Reset()                    { *m = OOMControl{} }
+func (m *OOMControl) String() string            { return proto.CompactTextString(m) }
+func (*OOMControl) ProtoMessage()               {}
+func (*OOMControl) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{45} }
 
-func (m *Storage) GetDriver() string {
+func (m *OOMControl) GetKillDisabled() bool {
 	if m != nil {
-		return m.Driver
+		return m.KillDisabled
 	}
-	return ""
+	return false
 }
 
-func (m *Storage) GetDriverOptions() []string {
+func (m *OOMControl) GetUnderOom() bool {
 	if m != nil {
-		return m.DriverOptions
+		return m.UnderOom
 	}
-	return nil
+	return false
 }
 
-func (m *Storage) GetSource() string {
+func (m *OOMControl) GetMemoryHigh() uint64 {
 	if m != nil {
-		return m.Source
+		return m.MemoryHigh
 	}
-	return ""
+	return 0
 }
 
-func (m *Storage) GetFstype() string {
+func (m *OOMControl) GetHighEvents() uint64 {
 	if m != nil {
-		return m.Fstype
+		return m.HighEvents
 	}
-	return ""
+	return 0
 }
 
-func (m *Storage) GetOptions() []string {
+type StatsContainerResponse struct {
+	CgroupStats  *CgroupStats    `protobuf:"bytes,1,opt,name=cgroup_stats,json=cgroupStats" json:"cgroup_stats,omitempty"`
+	NetworkStats []*NetworkStats `protobuf:"bytes,2,rep,name=network_stats,json=networkStats" json:"network_stats,omitempty"`
+	// Unset when the working set is not sampled.
+	WorkingSet *WorkingSetStats `protobuf:"bytes,3,opt,name=working_set,json=workingSet" json:"working_set,omitempty"`
+	// Page cache of the container, from its memory cgroup.
+	PageCache *PageCacheStats `protobuf:"bytes,4,opt,name=page_cache,json=pageCache" json:"page_cache,omitempty"`
+	// Page cache of the whole sandbox, from /proc/meminfo.
+	SandboxPageCache *PageCacheStats `protobuf:"bytes,5,opt,name=sandbox_page_cache,json=sandboxPageCache" json:"sandbox_page_cache,omitempty"`
+	// OOM killer control of the container, from its memory cgroup.
+	OomControl *OOMControl `protobuf:"bytes,6,opt,name=oom_control,json=oomControl" json:"oom_control,omitempty"`
+}
+
+func (m *StatsContainerResponse) Reset()                    { *m = StatsContainerResponse{} }
+func (m *StatsContainerResponse) String() string            { return proto.CompactTextString(m) }
+func (*StatsContainerResponse) ProtoMessage()               {}
+func (*StatsContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{46} }
+
+func (m *StatsContainerResponse) GetCgroupStats() *CgroupStats {
 	if m != nil {
-		return m.Options
+		return m.CgroupStats
 	}
 	return nil
 }
 
-func (m *Storage) GetMountPoint() string {
+func (m *StatsContainerResponse) GetNetworkStats() []*NetworkStats {
 	if m != nil {
-		return m.MountPoint
+		return m.NetworkStats
 	}
-	return ""
-}
-
-// Device represents only the devices that could have been defined through the
-// Linux Device list of the OCI specification.
-type Device struct {
-	// Id can be used to identify the device inside the VM. Some devices
-	// might not need it to be identified on the VM, and will rely on the
-	// provided VmPath instead.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Type defines the type of device described. This can be "blk",
-	// "scsi", "vfio", ...
-	// Particularly, this should be used to trigger the use of the
-	// appropriate device handler.
-	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	// VmPath can be used by the caller to provide directly the path of
-	// the device as it will appear inside the VM. For some devices, the
-	// device id or the list of options passed might not be enough to find
-	// the device. In those cases, the caller should predict and provide
-	// this vm_path.
-	VmPath string `protobuf:"bytes,3,opt,name=vm_path,json=vmPath,proto3" json:"vm_path,omitempty"`
-	// ContainerPath defines the path where the device should be found inside
-	// the container. This path should match the path of the device from
-	// the device list listed inside the OCI spec. This is used in order
-	// to identify the right device in the spec and update it with the
-	// right options such as major/minor numbers as they appear inside
-	// the VM for instance. Note that an empty ctr_path should be used
-	// to make sure the device handler inside the agent is called, but
-	// no spec update needs to be performed. This has to happen for the
-	// case of rootfs, when a device has to be waited for after it has
-	// been hotplugged. An equivalent Storage entry should be defined if
-	// any mount needs to be performed afterwards.
-	ContainerPath string `protobuf:"bytes,4,opt,name=container_path,json=containerPath,proto3" json:"container_path,omitempty"`
-	// Options allows the caller to define a list of options such as block
-	// sizes, numbers of luns, ... which are very specific to every device
-	// and cannot be generalized through extra fields.
-	Options []string `protobuf:"bytes,5,rep,name=options" json:"options,omitempty"`
+	return nil
 }
 
-func (m *Device) Reset()                    { *m = Device{} }
-func (m *Device) String() string            { return proto.CompactTextString(m) }
-func (*Device) ProtoMessage()               {}
-func (*Device) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{48} }
-
-func (m *Device) GetId() string {
+func (m *StatsContainerResponse) GetWorkingSet() *WorkingSetStats {
 	if m != nil {
-		return m.Id
+		return m.WorkingSet
 	}
-	return ""
+	return nil
 }
 
-func (m *Device) GetType() string {
+func (m *StatsContainerResponse) GetPageCache() *PageCacheStats {
 	if m != nil {
-		return m.Type
+		return m.PageCache
 	}
-	return ""
+	return nil
 }
 
-func (m *Device) GetVmPath() string {
+func (m *StatsContainerResponse) GetSandboxPageCache() *PageCacheStats {
 	if m != nil {
-		return m.VmPath
+		return m.SandboxPageCache
 	}
-	return ""
+	return nil
 }
 
-func (m *Device) GetContainerPath() string {
+func (m *StatsContainerResponse) GetOomControl() *OOMControl {
 	if m != nil {
-		return m.ContainerPath
+		return m.OomControl
 	}
-	return ""
+	return nil
 }
 
-func (m *Device) GetOptions() []string {
+type StatsSandboxRequest struct {
+}
+
+func (m *StatsSandboxRequest) Reset()                    { *m = StatsSandboxRequest{} }
+func (m *StatsSandboxRequest) String() string            { return proto.CompactTextString(m) }
+func (*StatsSandboxRequest) ProtoMessage()               {}
+func (*StatsSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{47} }
+
+type StatsSandboxResponse struct {
+	// Counters summed over the sandbox interfaces, the loopback and the
+	// interfaces stacked on another one excluded. The name is unset.
+	NetworkStats *NetworkStats `protobuf:"bytes,1,opt,name=network_stats,json=networkStats" json:"network_stats,omitempty"`
+}
+
+func (m *StatsSandboxResponse) Reset()                    { *m = StatsSandboxResponse{} }
+func (m *StatsSandboxResponse) String() string            { return proto.CompactTextString(m) }
+func (*StatsSandboxResponse) ProtoMessage()               {}
+func (*StatsSandboxResponse) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{48} }
+
+func (m *StatsSandboxResponse) GetNetworkStats() *NetworkStats {
 	if m != nil {
-		return m.Options
+		return m.NetworkStats
 	}
 	return nil
 }
 
-type StringUser struct {
-	Uid            string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Gid            string   `protobuf:"bytes,2,opt,name=gid,proto3" json:"gid,omitempty"`
-	AdditionalGids []string `protobuf:"bytes,3,rep,name=additionalGids" json:"additionalGids,omitempty"`
+type WriteStreamRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ExecId      string `protobuf:"bytes,2,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
+	Data        []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Close the process stdin once the data is written, as CloseStdin
+	// does, for the process to read EOF. Ignored for processes using a
+	// terminal, their stdin being left open.
+	Eof bool `protobuf:"varint,4,opt,name=eof,proto3" json:"eof,omitempty"`
 }
 
-func (m *StringUser) Reset()                    { *m = StringUser{} }
-func (m *StringUser) String() string            { return proto.CompactTextString(m) }
-func (*StringUser) ProtoMessage()               {}
-func (*StringUser) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{49} }
+func (m *WriteStreamRequest) Reset()                    { *m = WriteStreamRequest{} }
+func (m *WriteStreamRequest) String() string            { return proto.CompactTextString(m) }
+func (*WriteStreamRequest) ProtoMessage()               {}
+func (*WriteStreamRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{49} }
 
-func (m *StringUser) GetUid() string {
+func (m *WriteStreamRequest) GetContainerId() string {
 	if m != nil {
-		return m.Uid
+		return m.ContainerId
 	}
 	return ""
 }
 
-func (m *StringUser) GetGid() string {
+func (m *WriteStreamRequest) GetExecId() string {
 	if m != nil {
-		return m.Gid
+		return m.ExecId
 	}
 	return ""
 }
 
-func (m *StringUser) GetAdditionalGids() []string {
+func (m *WriteStreamRequest) GetData() []byte {
 	if m != nil {
-		return m.AdditionalGids
+		return m.Data
 	}
 	return nil
 }
 
-type CopyFileRequest struct {
-	// Path is the destination file in the guest. It must be absolute,
-	// canonical and below /run.
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	// FileSize is the expected file size, for security reasons write operations
-	// are made in a temporary file, once it has the expected size, it's moved
-	// to the destination path.
-	FileSize int64 `protobuf:"varint,2,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
-	// FileMode is the file mode.
-	FileMode uint32 `protobuf:"varint,3,opt,name=file_mode,json=fileMode,proto3" json:"file_mode,omitempty"`
-	// DirMode is the mode for the parent directories of destination path.
-	DirMode uint32 `protobuf:"varint,4,opt,name=dir_mode,json=dirMode,proto3" json:"dir_mode,omitempty"`
-	// Uid is the numeric user id.
-	Uid int32 `protobuf:"varint,5,opt,name=uid,proto3" json:"uid,omitempty"`
-	// Gid is the numeric group id.
-	Gid int32 `protobuf:"varint,6,opt,name=gid,proto3" json:"gid,omitempty"`
-	// Offset for the next write operation.
-	Offset int64 `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
-	// Data to write in the destination file.
-	Data []byte `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+func (m *WriteStreamRequest) GetEof() bool {
+	if m != nil {
+		return m.Eof
+	}
+	return false
 }
 
-func (m *CopyFileRequest) Reset()                    { *m = CopyFileRequest{} }
-func (m *CopyFileRequest) String() string            { return proto.CompactTextString(m) }
-func (*CopyFileRequest) ProtoMessage()               {}
-func (*CopyFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{50} }
+type WriteStreamResponse struct {
+	Len uint32 `protobuf:"varint,1,opt,name=len,proto3" json:"len,omitempty"`
+}
 
-func (m *CopyFileRequest) GetPath() string {
+func (m *WriteStreamResponse) Reset()                    { *m = WriteStreamResponse{} }
+func (m *WriteStreamResponse) String() string            { return proto.CompactTextString(m) }
+func (*WriteStreamResponse) ProtoMessage()               {}
+func (*WriteStreamResponse) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{50} }
+
+func (m *WriteStreamResponse) GetLen() uint32 {
 	if m != nil {
-		return m.Path
+		return m.Len
+	}
+	return 0
+}
+
+type ReadStreamRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ExecId      string `protobuf:"bytes,2,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
+	Len         uint32 `protobuf:"varint,3,opt,name=len,proto3" json:"len,omitempty"`
+}
+
+func (m *ReadStreamRequest) Reset()                    { *m = ReadStreamRequest{} }
+func (m *ReadStreamRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReadStreamRequest) ProtoMessage()               {}
+func (*ReadStreamRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{51} }
+
+func (m *ReadStreamRequest) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
 	}
 	return ""
 }
 
-func (m *CopyFileRequest) GetFileSize() int64 {
+func (m *ReadStreamRequest) GetExecId() string {
 	if m != nil {
-		return m.FileSize
+		return m.ExecId
 	}
-	return 0
+	return ""
 }
 
-func (m *CopyFileRequest) GetFileMode() uint32 {
+func (m *ReadStreamRequest) GetLen() uint32 {
 	if m != nil {
-		return m.FileMode
+		return m.Len
 	}
 	return 0
 }
 
-func (m *CopyFileRequest) GetDirMode() uint32 {
+type ReadStreamResponse struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ReadStreamResponse) Reset()                    { *m = ReadStreamResponse{} }
+func (m *ReadStreamResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReadStreamResponse) ProtoMessage()               {}
+func (*ReadStreamResponse) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{52} }
+
+func (m *ReadStreamResponse) GetData() []byte {
 	if m != nil {
-		return m.DirMode
+		return m.Data
 	}
-	return 0
+	return nil
 }
 
-func (m *CopyFileRequest) GetUid() int32 {
+type CloseStdinRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ExecId      string `protobuf:"bytes,2,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
+}
+
+func (m *CloseStdinRequest) Reset()                    { *m = CloseStdinRequest{} }
+func (m *CloseStdinRequest) String() string            { return proto.CompactTextString(m) }
+func (*CloseStdinRequest) ProtoMessage()               {}
+func (*CloseStdinRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{53} }
+
+func (m *CloseStdinRequest) GetContainerId() string {
 	if m != nil {
-		return m.Uid
+		return m.ContainerId
 	}
-	return 0
+	return ""
 }
 
-func (m *CopyFileRequest) GetGid() int32 {
+func (m *CloseStdinRequest) GetExecId() string {
 	if m != nil {
-		return m.Gid
+		return m.ExecId
 	}
-	return 0
+	return ""
 }
 
-func (m *CopyFileRequest) GetOffset() int64 {
+type TtyWinResizeRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ExecId      string `protobuf:"bytes,2,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
+	Row         uint32 `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
+	Column      uint32 `protobuf:"varint,4,opt,name=column,proto3" json:"column,omitempty"`
+}
+
+func (m *TtyWinResizeRequest) Reset()                    { *m = TtyWinResizeRequest{} }
+func (m *TtyWinResizeRequest) String() string            { return proto.CompactTextString(m) }
+func (*TtyWinResizeRequest) ProtoMessage()               {}
+func (*TtyWinResizeRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{54} }
+
+func (m *TtyWinResizeRequest) GetContainerId() string {
 	if m != nil {
-		return m.Offset
+		return m.ContainerId
 	}
-	return 0
+	return ""
 }
 
-func (m *CopyFileRequest) GetData() []byte {
+func (m *TtyWinResizeRequest) GetExecId() string {
 	if m != nil {
-		return m.Data
+		return m.ExecId
 	}
-	return nil
+	return ""
 }
 
-type StartTracingRequest struct {
+func (m *TtyWinResizeRequest) GetRow() uint32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
 }
 
-func (m *StartTracingRequest) Reset()                    { *m = StartTracingRequest{} }
-func (m *StartTracingRequest) String() string            { return proto.CompactTextString(m) }
-func (*StartTracingRequest) ProtoMessage()               {}
-func (*StartTracingRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{51} }
+func (m *TtyWinResizeRequest) GetColumn() uint32 {
+	if m != nil {
+		return m.Column
+	}
+	return 0
+}
 
-type StopTracingRequest struct {
+type KernelModule struct {
+	// This field is the name of the kernel module.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// This field are the parameters for the kernel module which are
+	// whitespace-delimited key=value pairs passed to modprobe(8).
+	Parameters []string `protobuf:"bytes,2,rep,name=parameters" json:"parameters,omitempty"`
 }
 
-func (m *StopTracingRequest) Reset()                    { *m = StopTracingRequest{} }
-func (m *StopTracingRequest) String() string            { return proto.CompactTextString(m) }
-func (*StopTracingRequest) ProtoMessage()               {}
-func (*StopTracingRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{52} }
+func (m *KernelModule) Reset()                    { *m = KernelModule{} }
+func (m *KernelModule) String() string            { return proto.CompactTextString(m) }
+func (*KernelModule) ProtoMessage()               {}
+func (*KernelModule) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{55} }
 
-func init() {
-	proto.RegisterType((*CreateContainerRequest)(nil), "grpc.CreateContainerRequest")
-	proto.RegisterType((*StartContainerRequest)(nil), "grpc.StartContainerRequest")
-	proto.RegisterType((*RemoveContainerRequest)(nil), "grpc.RemoveContainerRequest")
-	proto.RegisterType((*ExecProcessRequest)(nil), "grpc.ExecProcessRequest")
-	proto.RegisterType((*SignalProcessRequest)(nil), "grpc.SignalProcessRequest")
-	proto.RegisterType((*WaitProcessRequest)(nil), "grpc.WaitProcessRequest")
-	proto.RegisterType((*WaitProcessResponse)(nil), "grpc.WaitProcessResponse")
-	proto.RegisterType((*ListProcessesRequest)(nil), "grpc.ListProcessesRequest")
-	proto.RegisterType((*ListProcessesResponse)(nil), "grpc.ListProcessesResponse")
-	proto.RegisterType((*UpdateContainerRequest)(nil), "grpc.UpdateContainerRequest")
-	proto.RegisterType((*StatsContainerRequest)(nil), "grpc.StatsContainerRequest")
-	proto.RegisterType((*PauseContainerRequest)(nil), "grpc.PauseContainerRequest")
-	proto.RegisterType((*ResumeContainerRequest)(nil), "grpc.ResumeContainerRequest")
-	proto.RegisterType((*CpuUsage)(nil), "grpc.CpuUsage")
-	proto.RegisterType((*ThrottlingData)(nil), "grpc.ThrottlingData")
-	proto.RegisterType((*CpuStats)(nil), "grpc.CpuStats")
-	proto.RegisterType((*PidsStats)(nil), "grpc.PidsStats")
-	proto.RegisterType((*MemoryData)(nil), "grpc.MemoryData")
-	proto.RegisterType((*MemoryStats)(nil), "grpc.MemoryStats")
-	proto.RegisterType((*BlkioStatsEntry)(nil), "grpc.BlkioStatsEntry")
-	proto.RegisterType((*BlkioStats)(nil), "grpc.BlkioStats")
-	proto.RegisterType((*HugetlbStats)(nil), "grpc.HugetlbStats")
-	proto.RegisterType((*CgroupStats)(nil), "grpc.CgroupStats")
-	proto.RegisterType((*NetworkStats)(nil), "grpc.NetworkStats")
-	proto.RegisterType((*StatsContainerResponse)(nil), "grpc.StatsContainerResponse")
-	proto.RegisterType((*WriteStreamRequest)(nil), "grpc.WriteStreamRequest")
-	proto.RegisterType((*WriteStreamResponse)(nil), "grpc.WriteStreamResponse")
-	proto.RegisterType((*ReadStreamRequest)(nil), "grpc.ReadStreamRequest")
-	proto.RegisterType((*ReadStreamResponse)(nil), "grpc.ReadStreamResponse")
-	proto.RegisterType((*CloseStdinRequest)(nil), "grpc.CloseStdinRequest")
-	proto.RegisterType((*TtyWinResizeRequest)(nil), "grpc.TtyWinResizeRequest")
-	proto.RegisterType((*KernelModule)(nil), "grpc.KernelModule")
-	proto.RegisterType((*CreateSandboxRequest)(nil), "grpc.CreateSandboxRequest")
-	proto.RegisterType((*DestroySandboxRequest)(nil), "grpc.DestroySandboxRequest")
-	proto.RegisterType((*Interfaces)(nil), "grpc.Interfaces")
-	proto.RegisterType((*Routes)(nil), "grpc.Routes")
-	proto.RegisterType((*UpdateInterfaceRequest)(nil), "grpc.UpdateInterfaceRequest")
-	proto.RegisterType((*UpdateRoutesRequest)(nil), "grpc.UpdateRoutesRequest")
-	proto.RegisterType((*ListInterfacesRequest)(nil), "grpc.ListInterfacesRequest")
-	proto.RegisterType((*ListRoutesRequest)(nil), "grpc.ListRoutesRequest")
-	proto.RegisterType((*OnlineCPUMemRequest)(nil), "grpc.OnlineCPUMemRequest")
-	proto.RegisterType((*ReseedRandomDevRequest)(nil), "grpc.ReseedRandomDevRequest")
-	proto.RegisterType((*AgentDetails)(nil), "grpc.AgentDetails")
-	proto.RegisterType((*GuestDetailsRequest)(nil), "grpc.GuestDetailsRequest")
-	proto.RegisterType((*GuestDetailsResponse)(nil), "grpc.GuestDetailsResponse")
-	proto.RegisterType((*MemHotplugByProbeRequest)(nil), "grpc.MemHotplugByProbeRequest")
-	proto.RegisterType((*SetGuestDateTimeRequest)(nil), "grpc.SetGuestDateTimeRequest")
-	proto.RegisterType((*Storage)(nil), "grpc.Storage")
-	proto.RegisterType((*Device)(nil), "grpc.Device")
-	proto.RegisterType((*StringUser)(nil), "grpc.StringUser")
-	proto.RegisterType((*CopyFileRequest)(nil), "grpc.CopyFileRequest")
-	proto.RegisterType((*StartTracingRequest)(nil), "grpc.StartTracingRequest")
-	proto.RegisterType((*StopTracingRequest)(nil), "grpc.StopTracingRequest")
+func (m *KernelModule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc1.ClientConn
+func (m *KernelModule) GetParameters() []string {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc1.SupportPackageIsVersion4
-
-// Client API for AgentService service
-
-type AgentServiceClient interface {
-	// execution
-	CreateContainer(ctx context.Context, in *CreateContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
-	StartContainer(ctx context.Context, in *StartContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
-	// RemoveContainer will tear down an existing container by forcibly terminating
-	// all processes running inside that container and releasing all internal
-	// resources associated with it.
-	// RemoveContainer will wait for all processes termination before returning.
-	// If any process can not be killed or if it can not be killed after
-	// the RemoveContainerRequest timeout, RemoveContainer will return an error.
-	RemoveContainer(ctx context.Context, in *RemoveContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
-	ExecProcess(ctx context.Context, in *ExecProcessRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
-	SignalProcess(ctx context.Context, in *SignalProcessRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
-	WaitProcess(ctx context.Context, in *WaitProcessRequest, opts ...grpc1.CallOption) (*WaitProcessResponse, error)
-	ListProcesses(ctx context.Context, in *ListProcessesRequest, opts ...grpc1.CallOption) (*ListProcessesResponse, error)
-	UpdateContainer(ctx context.Context, in *UpdateContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
-	StatsContainer(ctx context.Context, in *StatsContainerRequest, opts ...grpc1.CallOption) (*StatsContainerResponse, error)
-	PauseContainer(ctx context.Context, in *PauseContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
-	ResumeContainer(ctx context.Context, in *ResumeContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
-	// stdio
-	WriteStdin(ctx context.Context, in *WriteStreamRequest, opts ...grpc1.CallOption) (*WriteStreamResponse, error)
-	ReadStdout(ctx context.Context, in *ReadStreamRequest, opts ...grpc1.CallOption) (*ReadStreamResponse, error)
-	ReadStderr(ctx context.Context, in *ReadStreamRequest, opts ...grpc1.CallOption) (*ReadStreamResponse, error)
-	CloseStdin(ctx context.Context, in *CloseStdinRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
-	TtyWinResize(ctx context.Context, in *TtyWinResizeRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
-	// networking
-	UpdateInterface(ctx context.Context, in *UpdateInterfaceRequest, opts ...grpc1.CallOption) (*types.Interface, error)
-	UpdateRoutes(ctx context.Context, in *UpdateRoutesRequest, opts ...grpc1.CallOption) (*Routes, error)
-	ListInterfaces(ctx context.Context, in *ListInterfacesRequest, opts ...grpc1.CallOption) (*Interfaces, error)
-	ListRoutes(ctx context.Context, in *ListRoutesRequest, opts ...grpc1.CallOption) (*Routes, error)
-	// tracing
-	StartTracing(ctx context.Context, in *StartTracingRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
-	StopTracing(ctx context.Context, in *StopTracingRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
-	// misc (TODO: some rpcs can be replaced by hyperstart-exec)
-	CreateSandbox(ctx context.Context, in *CreateSandboxRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
-	DestroySandbox(ctx context.Context, in *DestroySandboxRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
-	OnlineCPUMem(ctx context.Context, in *OnlineCPUMemRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
-	ReseedRandomDev(ctx context.Context, in *ReseedRandomDevRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
-	GetGuestDetails(ctx context.Context, in *GuestDetailsRequest, opts ...grpc1.CallOption) (*GuestDetailsResponse, error)
-	MemHotplugByProbe(ctx context.Context, in *MemHotplugByProbeRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
-	SetGuestDateTime(ctx context.Context, in *SetGuestDateTimeRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
-	CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+type CreateSandboxRequest struct {
+	Hostname string     `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Dns      []string   `protobuf:"bytes,2,rep,name=dns" json:"dns,omitempty"`
+	Storages []*Storage `protobuf:"bytes,3,rep,name=storages" json:"storages,omitempty"`
+	// This field means that a pause process needs to be created by the
+	// agent. This pid namespace of the pause process will be treated as
+	// a shared pid namespace. All containers created will join this shared
+	// pid namespace.
+	SandboxPidns bool `protobuf:"varint,4,opt,name=sandbox_pidns,json=sandboxPidns,proto3" json:"sandbox_pidns,omitempty"`
+	// SandboxId identifies which sandbox is using the agent. We allow only
+	// one sandbox per agent and implicitly require that CreateSandbox is
+	// called before other sandbox/network calls.
+	SandboxId string `protobuf:"bytes,5,opt,name=sandbox_id,json=sandboxId,proto3" json:"sandbox_id,omitempty"`
+	// This field, if non-empty, designates an absolute path to a directory
+	// that the agent will search for OCI hooks to run within the guest.
+	GuestHookPath string `protobuf:"bytes,6,opt,name=guest_hook_path,json=guestHookPath,proto3" json:"guest_hook_path,omitempty"`
+	// This field is the list of kernel modules to be loaded in the guest kernel.
+	KernelModules []*KernelModule `protobuf:"bytes,7,rep,name=kernel_modules,json=kernelModules" json:"kernel_modules,omitempty"`
+	// This field, if non-empty, is the address of a DNS stub resolver
+	// running in the guest. Each container then gets its own resolv.conf
+	// pointing at it, with its own search domains and options.
+	DnsStub string `protobuf:"bytes,8,opt,name=dns_stub,json=dnsStub,proto3" json:"dns_stub,omitempty"`
+	// Guest wide sysctls, such as "vm.overcommit_memory", applied when
+	// the sandbox is created. Only an allowlist of non-namespaced keys is
+	// accepted, namespaced ones being set per container.
+	Sysctls map[string]string `protobuf:"bytes,9,rep,name=sysctls" json:"sysctls,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-type agentServiceClient struct {
-	cc *grpc1.ClientConn
-}
+func (m *CreateSandboxRequest) Reset()                    { *m = CreateSandboxRequest{} }
+func (m *CreateSandboxRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateSandboxRequest) ProtoMessage()               {}
+func (*CreateSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{56} }
 
-func NewAgentServiceClient(cc *grpc1.ClientConn) AgentServiceClient {
-	return &agentServiceClient{cc}
+func (m *CreateSandboxRequest) GetHostname() string {
+	if m != nil {
+		return m.Hostname
+	}
+	return ""
 }
 
-func (c *agentServiceClient) CreateContainer(ctx context.Context, in *CreateContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/CreateContainer", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateSandboxRequest) GetDns() []string {
+	if m != nil {
+		return m.Dns
 	}
-	return out, nil
+	return nil
 }
 
-func (c *agentServiceClient) StartContainer(ctx context.Context, in *StartContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/StartContainer", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateSandboxRequest) GetStorages() []*Storage {
+	if m != nil {
+		return m.Storages
 	}
-	return out, nil
+	return nil
 }
 
-func (c *agentServiceClient) RemoveContainer(ctx context.Context, in *RemoveContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/RemoveContainer", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateSandboxRequest) GetSandboxPidns() bool {
+	if m != nil {
+		return m.SandboxPidns
 	}
-	return out, nil
+	return false
 }
 
-func (c *agentServiceClient) ExecProcess(ctx context.Context, in *ExecProcessRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/ExecProcess", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateSandboxRequest) GetSandboxId() string {
+	if m != nil {
+		return m.SandboxId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *agentServiceClient) SignalProcess(ctx context.Context, in *SignalProcessRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/SignalProcess", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateSandboxRequest) GetGuestHookPath() string {
+	if m != nil {
+		return m.GuestHookPath
 	}
-	return out, nil
+	return ""
 }
 
-func (c *agentServiceClient) WaitProcess(ctx context.Context, in *WaitProcessRequest, opts ...grpc1.CallOption) (*WaitProcessResponse, error) {
-	out := new(WaitProcessResponse)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/WaitProcess", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateSandboxRequest) GetKernelModules() []*KernelModule {
+	if m != nil {
+		return m.KernelModules
 	}
-	return out, nil
+	return nil
 }
 
-func (c *agentServiceClient) ListProcesses(ctx context.Context, in *ListProcessesRequest, opts ...grpc1.CallOption) (*ListProcessesResponse, error) {
-	out := new(ListProcessesResponse)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/ListProcesses", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateSandboxRequest) GetDnsStub() string {
+	if m != nil {
+		return m.DnsStub
 	}
-	return out, nil
+	return ""
 }
 
-func (c *agentServiceClient) UpdateContainer(ctx context.Context, in *UpdateContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/UpdateContainer", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateSandboxRequest) GetSysctls() map[string]string {
+	if m != nil {
+		return m.Sysctls
 	}
-	return out, nil
+	return nil
 }
 
-func (c *agentServiceClient) StatsContainer(ctx context.Context, in *StatsContainerRequest, opts ...grpc1.CallOption) (*StatsContainerResponse, error) {
-	out := new(StatsContainerResponse)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/StatsContainer", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DestroySandboxRequest struct {
 }
 
-func (c *agentServiceClient) PauseContainer(ctx context.Context, in *PauseContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/PauseContainer", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DestroySandboxRequest) Reset()                    { *m = DestroySandboxRequest{} }
+func (m *DestroySandboxRequest) String() string            { return proto.CompactTextString(m) }
+func (*DestroySandboxRequest) ProtoMessage()               {}
+func (*DestroySandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{57} }
+
+type Interfaces struct {
+	Interfaces []*types.Interface `protobuf:"bytes,1,rep,name=Interfaces" json:"Interfaces,omitempty"`
 }
 
-func (c *agentServiceClient) ResumeContainer(ctx context.Context, in *ResumeContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/ResumeContainer", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Interfaces) Reset()                    { *m = Interfaces{} }
+func (m *Interfaces) String() string            { return proto.CompactTextString(m) }
+func (*Interfaces) ProtoMessage()               {}
+func (*Interfaces) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{58} }
+
+func (m *Interfaces) GetInterfaces() []*types.Interface {
+	if m != nil {
+		return m.Interfaces
 	}
-	return out, nil
+	return nil
 }
 
-func (c *agentServiceClient) WriteStdin(ctx context.Context, in *WriteStreamRequest, opts ...grpc1.CallOption) (*WriteStreamResponse, error) {
-	out := new(WriteStreamResponse)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/WriteStdin", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type Routes struct {
+	Routes []*types.Route `protobuf:"bytes,1,rep,name=Routes" json:"Routes,omitempty"`
 }
 
-func (c *agentServiceClient) ReadStdout(ctx context.Context, in *ReadStreamRequest, opts ...grpc1.CallOption) (*ReadStreamResponse, error) {
-	out := new(ReadStreamResponse)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/ReadStdout", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Routes) Reset()                    { *m = Routes{} }
+func (m *Routes) String() string            { return proto.CompactTextString(m) }
+func (*Routes) ProtoMessage()               {}
+func (*Routes) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{59} }
+
+func (m *Routes) GetRoutes() []*types.Route {
+	if m != nil {
+		return m.Routes
 	}
-	return out, nil
+	return nil
 }
 
-func (c *agentServiceClient) ReadStderr(ctx context.Context, in *ReadStreamRequest, opts ...grpc1.CallOption) (*ReadStreamResponse, error) {
-	out := new(ReadStreamResponse)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/ReadStderr", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type UpdateInterfaceRequest struct {
+	Interface *types.Interface `protobuf:"bytes,1,opt,name=interface" json:"interface,omitempty"`
 }
 
-func (c *agentServiceClient) CloseStdin(ctx context.Context, in *CloseStdinRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/CloseStdin", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateInterfaceRequest) Reset()                    { *m = UpdateInterfaceRequest{} }
+func (m *UpdateInterfaceRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateInterfaceRequest) ProtoMessage()               {}
+func (*UpdateInterfaceRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{60} }
+
+func (m *UpdateInterfaceRequest) GetInterface() *types.Interface {
+	if m != nil {
+		return m.Interface
 	}
-	return out, nil
+	return nil
 }
 
-func (c *agentServiceClient) TtyWinResize(ctx context.Context, in *TtyWinResizeRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/TtyWinResize", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type UpdateRoutesRequest struct {
+	Routes *Routes `protobuf:"bytes,1,opt,name=routes" json:"routes,omitempty"`
 }
 
-func (c *agentServiceClient) UpdateInterface(ctx context.Context, in *UpdateInterfaceRequest, opts ...grpc1.CallOption) (*types.Interface, error) {
-	out := new(types.Interface)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/UpdateInterface", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateRoutesRequest) Reset()                    { *m = UpdateRoutesRequest{} }
+func (m *UpdateRoutesRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateRoutesRequest) ProtoMessage()               {}
+func (*UpdateRoutesRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{61} }
+
+func (m *UpdateRoutesRequest) GetRoutes() *Routes {
+	if m != nil {
+		return m.Routes
 	}
-	return out, nil
+	return nil
 }
 
-func (c *agentServiceClient) UpdateRoutes(ctx context.Context, in *UpdateRoutesRequest, opts ...grpc1.CallOption) (*Routes, error) {
-	out := new(Routes)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/UpdateRoutes", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// SourceRoutingInterface describes an interface whose return traffic must
+// egress through the interface it arrived on. The agent installs a routing
+// table holding the subnet and default routes of the interface, and a rule
+// looking up this table for the traffic coming from the subnet.
+type SourceRoutingInterface struct {
+	Device string `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	// Subnet of the interface, in CIDR notation.
+	Subnet  string `protobuf:"bytes,2,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	Gateway string `protobuf:"bytes,3,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	// Routing table and rule priority, assigned by the agent if not set.
+	Table    uint32 `protobuf:"varint,4,opt,name=table,proto3" json:"table,omitempty"`
+	Priority uint32 `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (c *agentServiceClient) ListInterfaces(ctx context.Context, in *ListInterfacesRequest, opts ...grpc1.CallOption) (*Interfaces, error) {
-	out := new(Interfaces)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/ListInterfaces", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *SourceRoutingInterface) Reset()                    { *m = SourceRoutingInterface{} }
+func (m *SourceRoutingInterface) String() string            { return proto.CompactTextString(m) }
+func (*SourceRoutingInterface) ProtoMessage()               {}
+func (*SourceRoutingInterface) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{62} }
+
+func (m *SourceRoutingInterface) GetDevice() string {
+	if m != nil {
+		return m.Device
 	}
-	return out, nil
+	return ""
 }
 
-func (c *agentServiceClient) ListRoutes(ctx context.Context, in *ListRoutesRequest, opts ...grpc1.CallOption) (*Routes, error) {
-	out := new(Routes)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/ListRoutes", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *SourceRoutingInterface) GetSubnet() string {
+	if m != nil {
+		return m.Subnet
 	}
-	return out, nil
+	return ""
 }
 
-func (c *agentServiceClient) StartTracing(ctx context.Context, in *StartTracingRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/StartTracing", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *SourceRoutingInterface) GetGateway() string {
+	if m != nil {
+		return m.Gateway
 	}
-	return out, nil
+	return ""
 }
 
-func (c *agentServiceClient) StopTracing(ctx context.Context, in *StopTracingRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/StopTracing", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *SourceRoutingInterface) GetTable() uint32 {
+	if m != nil {
+		return m.Table
 	}
-	return out, nil
+	return 0
 }
 
-func (c *agentServiceClient) CreateSandbox(ctx context.Context, in *CreateSandboxRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/CreateSandbox", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *SourceRoutingInterface) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
 	}
-	return out, nil
+	return 0
 }
 
-func (c *agentServiceClient) DestroySandbox(ctx context.Context, in *DestroySandboxRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/DestroySandbox", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type UpdateSourceRoutingRequest struct {
+	Interfaces []*SourceRoutingInterface `protobuf:"bytes,1,rep,name=interfaces" json:"interfaces,omitempty"`
 }
 
-func (c *agentServiceClient) OnlineCPUMem(ctx context.Context, in *OnlineCPUMemRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/OnlineCPUMem", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UpdateSourceRoutingRequest) Reset()         { *m = UpdateSourceRoutingRequest{} }
+func (m *UpdateSourceRoutingRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateSourceRoutingRequest) ProtoMessage()    {}
+func (*UpdateSourceRoutingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorAgent, []int{63}
 }
 
-func (c *agentServiceClient) ReseedRandomDev(ctx context.Context, in *ReseedRandomDevRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/ReseedRandomDev", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateSourceRoutingRequest) GetInterfaces() []*SourceRoutingInterface {
+	if m != nil {
+		return m.Interfaces
 	}
-	return out, nil
+	return nil
 }
 
-func (c *agentServiceClient) GetGuestDetails(ctx context.Context, in *GuestDetailsRequest, opts ...grpc1.CallOption) (*GuestDetailsResponse, error) {
-	out := new(GuestDetailsResponse)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/GetGuestDetails", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+type SourceRouting struct {
+	Interfaces []*SourceRoutingInterface `protobuf:"bytes,1,rep,name=interfaces" json:"interfaces,omitempty"`
+}
+
+func (m *SourceRouting) Reset()                    { *m = SourceRouting{} }
+func (m *SourceRouting) String() string            { return proto.CompactTextString(m) }
+func (*SourceRouting) ProtoMessage()               {}
+func (*SourceRouting) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{64} }
+
+func (m *SourceRouting) GetInterfaces() []*SourceRoutingInterface {
+	if m != nil {
+		return m.Interfaces
 	}
-	return out, nil
+	return nil
 }
 
-func (c *agentServiceClient) MemHotplugByProbe(ctx context.Context, in *MemHotplugByProbeRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/MemHotplugByProbe", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+// SetTransparentProxyRequest installs the guest iptables rules redirecting
+// the outbound IPv4 TCP traffic to a local proxy, such as a service mesh
+// sidecar. The rules are replaced if already installed.
+type SetTransparentProxyRequest struct {
+	// Port the proxy listens on.
+	ProxyPort uint32 `protobuf:"varint,1,opt,name=proxy_port,json=proxyPort,proto3" json:"proxy_port,omitempty"`
+	// UID of the proxy, whose own traffic is not redirected. It cannot be
+	// root.
+	ProxyUid uint32 `protobuf:"varint,2,opt,name=proxy_uid,json=proxyUid,proto3" json:"proxy_uid,omitempty"`
+	// IPv4 destinations, in CIDR notation, whose traffic is not
+	// redirected. The loopback traffic never is.
+	ExcludeCidrs []string `protobuf:"bytes,3,rep,name=exclude_cidrs,json=excludeCidrs" json:"exclude_cidrs,omitempty"`
+}
+
+func (m *SetTransparentProxyRequest) Reset()         { *m = SetTransparentProxyRequest{} }
+func (m *SetTransparentProxyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetTransparentProxyRequest) ProtoMessage()    {}
+func (*SetTransparentProxyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorAgent, []int{65}
+}
+
+func (m *SetTransparentProxyRequest) GetProxyPort() uint32 {
+	if m != nil {
+		return m.ProxyPort
 	}
-	return out, nil
+	return 0
 }
 
-func (c *agentServiceClient) SetGuestDateTime(ctx context.Context, in *SetGuestDateTimeRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/SetGuestDateTime", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *SetTransparentProxyRequest) GetProxyUid() uint32 {
+	if m != nil {
+		return m.ProxyUid
 	}
-	return out, nil
+	return 0
 }
 
-func (c *agentServiceClient) CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
-	err := grpc1.Invoke(ctx, "/grpc.AgentService/CopyFile", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *SetTransparentProxyRequest) GetExcludeCidrs() []string {
+	if m != nil {
+		return m.ExcludeCidrs
 	}
-	return out, nil
+	return nil
 }
 
-// Server API for AgentService service
+type RemoveTransparentProxyRequest struct {
+}
 
-type AgentServiceServer interface {
-	// execution
-	CreateContainer(context.Context, *CreateContainerRequest) (*google_protobuf2.Empty, error)
-	StartContainer(context.Context, *StartContainerRequest) (*google_protobuf2.Empty, error)
-	// RemoveContainer will tear down an existing container by forcibly terminating
-	// all processes running inside that container and releasing all internal
-	// resources associated with it.
-	// RemoveContainer will wait for all processes termination before returning.
-	// If any process can not be killed or if it can not be killed after
-	// the RemoveContainerRequest timeout, RemoveContainer will return an error.
-	RemoveContainer(context.Context, *RemoveContainerRequest) (*google_protobuf2.Empty, error)
-	ExecProcess(context.Context, *ExecProcessRequest) (*google_protobuf2.Empty, error)
-	SignalProcess(context.Context, *SignalProcessRequest) (*google_protobuf2.Empty, error)
-	WaitProcess(context.Context, *WaitProcessRequest) (*WaitProcessResponse, error)
-	ListProcesses(context.Context, *ListProcessesRequest) (*ListProcessesResponse, error)
-	UpdateContainer(context.Context, *UpdateContainerRequest) (*google_protobuf2.Empty, error)
-	StatsContainer(context.Context, *StatsContainerRequest) (*StatsContainerResponse, error)
-	PauseContainer(context.Context, *PauseContainerRequest) (*google_protobuf2.Empty, error)
-	ResumeContainer(context.Context, *ResumeContainerRequest) (*google_protobuf2.Empty, error)
-	// stdio
-	WriteStdin(context.Context, *WriteStreamRequest) (*WriteStreamResponse, error)
-	ReadStdout(context.Context, *ReadStreamRequest) (*ReadStreamResponse, error)
-	ReadStderr(context.Context, *ReadStreamRequest) (*ReadStreamResponse, error)
-	CloseStdin(context.Context, *CloseStdinRequest) (*google_protobuf2.Empty, error)
-	TtyWinResize(context.Context, *TtyWinResizeRequest) (*google_protobuf2.Empty, error)
-	// networking
-	UpdateInterface(context.Context, *UpdateInterfaceRequest) (*types.Interface, error)
-	UpdateRoutes(context.Context, *UpdateRoutesRequest) (*Routes, error)
-	ListInterfaces(context.Context, *ListInterfacesRequest) (*Interfaces, error)
-	ListRoutes(context.Context, *ListRoutesRequest) (*Routes, error)
-	// tracing
-	StartTracing(context.Context, *StartTracingRequest) (*google_protobuf2.Empty, error)
-	StopTracing(context.Context, *StopTracingRequest) (*google_protobuf2.Empty, error)
-	// misc (TODO: some rpcs can be replaced by hyperstart-exec)
-	CreateSandbox(context.Context, *CreateSandboxRequest) (*google_protobuf2.Empty, error)
-	DestroySandbox(context.Context, *DestroySandboxRequest) (*google_protobuf2.Empty, error)
-	OnlineCPUMem(context.Context, *OnlineCPUMemRequest) (*google_protobuf2.Empty, error)
-	ReseedRandomDev(context.Context, *ReseedRandomDevRequest) (*google_protobuf2.Empty, error)
-	GetGuestDetails(context.Context, *GuestDetailsRequest) (*GuestDetailsResponse, error)
-	MemHotplugByProbe(context.Context, *MemHotplugByProbeRequest) (*google_protobuf2.Empty, error)
-	SetGuestDateTime(context.Context, *SetGuestDateTimeRequest) (*google_protobuf2.Empty, error)
-	CopyFile(context.Context, *CopyFileRequest) (*google_protobuf2.Empty, error)
+func (m *RemoveTransparentProxyRequest) Reset()         { *m = RemoveTransparentProxyRequest{} }
+func (m *RemoveTransparentProxyRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveTransparentProxyRequest) ProtoMessage()    {}
+func (*RemoveTransparentProxyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorAgent, []int{66}
 }
 
-func RegisterAgentServiceServer(s *grpc1.Server, srv AgentServiceServer) {
-	s.RegisterService(&_AgentService_serviceDesc, srv)
+type ListInterfacesRequest struct {
 }
 
-func _AgentService_CreateContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateContainerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).CreateContainer(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/CreateContainer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).CreateContainer(ctx, req.(*CreateContainerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *ListInterfacesRequest) Reset()                    { *m = ListInterfacesRequest{} }
+func (m *ListInterfacesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListInterfacesRequest) ProtoMessage()               {}
+func (*ListInterfacesRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{67} }
+
+type ListRoutesRequest struct {
 }
 
-func _AgentService_StartContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartContainerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).StartContainer(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/StartContainer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).StartContainer(ctx, req.(*StartContainerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *ListRoutesRequest) Reset()                    { *m = ListRoutesRequest{} }
+func (m *ListRoutesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListRoutesRequest) ProtoMessage()               {}
+func (*ListRoutesRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{68} }
+
+type OnlineCPUMemRequest struct {
+	// Wait specifies if the caller waits for the agent to online all resources.
+	// If true the agent returns once all resources have been connected, otherwise all
+	// resources are connected asynchronously and the agent returns immediately.
+	Wait bool `protobuf:"varint,1,opt,name=wait,proto3" json:"wait,omitempty"`
+	// NbCpus specifies the number of CPUs that were added and the agent has to online.
+	NbCpus uint32 `protobuf:"varint,2,opt,name=nb_cpus,json=nbCpus,proto3" json:"nb_cpus,omitempty"`
+	// CpuOnly specifies whether only online CPU or not.
+	CpuOnly bool `protobuf:"varint,3,opt,name=cpu_only,json=cpuOnly,proto3" json:"cpu_only,omitempty"`
 }
 
-func _AgentService_RemoveContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveContainerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).RemoveContainer(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/RemoveContainer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).RemoveContainer(ctx, req.(*RemoveContainerRequest))
+func (m *OnlineCPUMemRequest) Reset()                    { *m = OnlineCPUMemRequest{} }
+func (m *OnlineCPUMemRequest) String() string            { return proto.CompactTextString(m) }
+func (*OnlineCPUMemRequest) ProtoMessage()               {}
+func (*OnlineCPUMemRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{69} }
+
+func (m *OnlineCPUMemRequest) GetWait() bool {
+	if m != nil {
+		return m.Wait
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _AgentService_ExecProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExecProcessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).ExecProcess(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/ExecProcess",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).ExecProcess(ctx, req.(*ExecProcessRequest))
+func (m *OnlineCPUMemRequest) GetNbCpus() uint32 {
+	if m != nil {
+		return m.NbCpus
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AgentService_SignalProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignalProcessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *OnlineCPUMemRequest) GetCpuOnly() bool {
+	if m != nil {
+		return m.CpuOnly
 	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).SignalProcess(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/SignalProcess",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).SignalProcess(ctx, req.(*SignalProcessRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _AgentService_WaitProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WaitProcessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).WaitProcess(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/WaitProcess",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).WaitProcess(ctx, req.(*WaitProcessRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ReseedRandomDevRequest struct {
+	// Data specifies the random data used to reseed the guest crng.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func _AgentService_ListProcesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProcessesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).ListProcesses(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/ListProcesses",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).ListProcesses(ctx, req.(*ListProcessesRequest))
+func (m *ReseedRandomDevRequest) Reset()                    { *m = ReseedRandomDevRequest{} }
+func (m *ReseedRandomDevRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReseedRandomDevRequest) ProtoMessage()               {}
+func (*ReseedRandomDevRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{70} }
+
+func (m *ReseedRandomDevRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _AgentService_UpdateContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateContainerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).UpdateContainer(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/UpdateContainer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).UpdateContainer(ctx, req.(*UpdateContainerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// AgentDetails provides information to the client about the running agent.
+type AgentDetails struct {
+	// Semantic version of agent (see https://semver.org).
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Set if the agent is running as PID 1.
+	InitDaemon bool `protobuf:"varint,2,opt,name=init_daemon,json=initDaemon,proto3" json:"init_daemon,omitempty"`
+	// List of available device handlers.
+	DeviceHandlers []string `protobuf:"bytes,3,rep,name=device_handlers,json=deviceHandlers" json:"device_handlers,omitempty"`
+	// List of available storage handlers.
+	StorageHandlers []string `protobuf:"bytes,4,rep,name=storage_handlers,json=storageHandlers" json:"storage_handlers,omitempty"`
+	// Set only if the agent is built with seccomp support and the guest
+	// environment supports seccomp.
+	SupportsSeccomp bool `protobuf:"varint,5,opt,name=supports_seccomp,json=supportsSeccomp,proto3" json:"supports_seccomp,omitempty"`
 }
 
-func _AgentService_StatsContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StatsContainerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).StatsContainer(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/StatsContainer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).StatsContainer(ctx, req.(*StatsContainerRequest))
+func (m *AgentDetails) Reset()                    { *m = AgentDetails{} }
+func (m *AgentDetails) String() string            { return proto.CompactTextString(m) }
+func (*AgentDetails) ProtoMessage()               {}
+func (*AgentDetails) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{71} }
+
+func (m *AgentDetails) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AgentService_PauseContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PauseContainerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).PauseContainer(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/PauseContainer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).PauseContainer(ctx, req.(*PauseContainerRequest))
+func (m *AgentDetails) GetInitDaemon() bool {
+	if m != nil {
+		return m.InitDaemon
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _AgentService_ResumeContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResumeContainerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).ResumeContainer(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/ResumeContainer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).ResumeContainer(ctx, req.(*ResumeContainerRequest))
+func (m *AgentDetails) GetDeviceHandlers() []string {
+	if m != nil {
+		return m.DeviceHandlers
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _AgentService_WriteStdin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WriteStreamRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).WriteStdin(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/WriteStdin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).WriteStdin(ctx, req.(*WriteStreamRequest))
+func (m *AgentDetails) GetStorageHandlers() []string {
+	if m != nil {
+		return m.StorageHandlers
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _AgentService_ReadStdout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadStreamRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).ReadStdout(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/ReadStdout",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).ReadStdout(ctx, req.(*ReadStreamRequest))
+func (m *AgentDetails) GetSupportsSeccomp() bool {
+	if m != nil {
+		return m.SupportsSeccomp
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _AgentService_ReadStderr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadStreamRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).ReadStderr(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/ReadStderr",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).ReadStderr(ctx, req.(*ReadStreamRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type GuestDetailsRequest struct {
+	// MemBlockSize asks server to return the system memory block size that can be used
+	// for memory hotplug alignment. Typically the server returns what's in
+	// /sys/devices/system/memory/block_size_bytes.
+	MemBlockSize bool `protobuf:"varint,1,opt,name=mem_block_size,json=memBlockSize,proto3" json:"mem_block_size,omitempty"`
+	// MemoryHotplugProbe asks server to return whether guest kernel supports memory hotplug
+	// via probeinterface. Typically the server will check if the path
+	// /sys/devices/system/memory/probe exists.
+	MemHotplugProbe bool `protobuf:"varint,2,opt,name=mem_hotplug_probe,json=memHotplugProbe,proto3" json:"mem_hotplug_probe,omitempty"`
 }
 
-func _AgentService_CloseStdin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CloseStdinRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).CloseStdin(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/CloseStdin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).CloseStdin(ctx, req.(*CloseStdinRequest))
+func (m *GuestDetailsRequest) Reset()                    { *m = GuestDetailsRequest{} }
+func (m *GuestDetailsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestDetailsRequest) ProtoMessage()               {}
+func (*GuestDetailsRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{72} }
+
+func (m *GuestDetailsRequest) GetMemBlockSize() bool {
+	if m != nil {
+		return m.MemBlockSize
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _AgentService_TtyWinResize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TtyWinResizeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).TtyWinResize(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/TtyWinResize",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).TtyWinResize(ctx, req.(*TtyWinResizeRequest))
+func (m *GuestDetailsRequest) GetMemHotplugProbe() bool {
+	if m != nil {
+		return m.MemHotplugProbe
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _AgentService_UpdateInterface_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateInterfaceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).UpdateInterface(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/UpdateInterface",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).UpdateInterface(ctx, req.(*UpdateInterfaceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type GuestDetailsResponse struct {
+	// MemBlockSizeBytes returns the system memory block size in bytes.
+	MemBlockSizeBytes      uint64        `protobuf:"varint,1,opt,name=mem_block_size_bytes,json=memBlockSizeBytes,proto3" json:"mem_block_size_bytes,omitempty"`
+	AgentDetails           *AgentDetails `protobuf:"bytes,2,opt,name=agent_details,json=agentDetails" json:"agent_details,omitempty"`
+	SupportMemHotplugProbe bool          `protobuf:"varint,3,opt,name=support_mem_hotplug_probe,json=supportMemHotplugProbe,proto3" json:"support_mem_hotplug_probe,omitempty"`
 }
 
-func _AgentService_UpdateRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRoutesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).UpdateRoutes(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/UpdateRoutes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).UpdateRoutes(ctx, req.(*UpdateRoutesRequest))
+func (m *GuestDetailsResponse) Reset()                    { *m = GuestDetailsResponse{} }
+func (m *GuestDetailsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GuestDetailsResponse) ProtoMessage()               {}
+func (*GuestDetailsResponse) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{73} }
+
+func (m *GuestDetailsResponse) GetMemBlockSizeBytes() uint64 {
+	if m != nil {
+		return m.MemBlockSizeBytes
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AgentService_ListInterfaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListInterfacesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).ListInterfaces(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/ListInterfaces",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).ListInterfaces(ctx, req.(*ListInterfacesRequest))
+func (m *GuestDetailsResponse) GetAgentDetails() *AgentDetails {
+	if m != nil {
+		return m.AgentDetails
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _AgentService_ListRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRoutesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).ListRoutes(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/ListRoutes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).ListRoutes(ctx, req.(*ListRoutesRequest))
+func (m *GuestDetailsResponse) GetSupportMemHotplugProbe() bool {
+	if m != nil {
+		return m.SupportMemHotplugProbe
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _AgentService_StartTracing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartTracingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).StartTracing(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/StartTracing",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).StartTracing(ctx, req.(*StartTracingRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type MemHotplugByProbeRequest struct {
+	// server needs to send the value of memHotplugProbeAddr into file /sys/devices/system/memory/probe,
+	// in order to notify the guest kernel about hot-add memory event
+	MemHotplugProbeAddr []uint64 `protobuf:"varint,1,rep,packed,name=memHotplugProbeAddr" json:"memHotplugProbeAddr,omitempty"`
 }
 
-func _AgentService_StopTracing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopTracingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).StopTracing(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/StopTracing",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).StopTracing(ctx, req.(*StopTracingRequest))
+func (m *MemHotplugByProbeRequest) Reset()                    { *m = MemHotplugByProbeRequest{} }
+func (m *MemHotplugByProbeRequest) String() string            { return proto.CompactTextString(m) }
+func (*MemHotplugByProbeRequest) ProtoMessage()               {}
+func (*MemHotplugByProbeRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{74} }
+
+func (m *MemHotplugByProbeRequest) GetMemHotplugProbeAddr() []uint64 {
+	if m != nil {
+		return m.MemHotplugProbeAddr
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _AgentService_CreateSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSandboxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).CreateSandbox(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/CreateSandbox",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).CreateSandbox(ctx, req.(*CreateSandboxRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type SetGuestDateTimeRequest struct {
+	// Sec the second since the Epoch.
+	Sec int64 `protobuf:"varint,1,opt,name=Sec,proto3" json:"Sec,omitempty"`
+	// Usec the microseconds portion of time since the Epoch.
+	Usec int64 `protobuf:"varint,2,opt,name=Usec,proto3" json:"Usec,omitempty"`
 }
 
-func _AgentService_DestroySandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DestroySandboxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).DestroySandbox(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/DestroySandbox",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).DestroySandbox(ctx, req.(*DestroySandboxRequest))
+func (m *SetGuestDateTimeRequest) Reset()                    { *m = SetGuestDateTimeRequest{} }
+func (m *SetGuestDateTimeRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetGuestDateTimeRequest) ProtoMessage()               {}
+func (*SetGuestDateTimeRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{75} }
+
+func (m *SetGuestDateTimeRequest) GetSec() int64 {
+	if m != nil {
+		return m.Sec
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AgentService_OnlineCPUMem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OnlineCPUMemRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).OnlineCPUMem(ctx, in)
-	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/OnlineCPUMem",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).OnlineCPUMem(ctx, req.(*OnlineCPUMemRequest))
+func (m *SetGuestDateTimeRequest) GetUsec() int64 {
+	if m != nil {
+		return m.Usec
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AgentService_ReseedRandomDev_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReseedRandomDevRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// Storage represents both the rootfs of the container, and any volume that
+// could have been defined through the Mount list of the OCI specification.
+type Storage struct {
+	// Driver is used to define the way the storage is passed through the
+	// virtual machine. It can be "9p", "blk", or something else, but for
+	// all cases, this will define if some extra steps are required before
+	// this storage gets mounted into the container.
+	Driver string `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	// DriverOptions allows the caller to define a list of options such
+	// as block sizes, numbers of luns, ... which are very specific to
+	// every device and cannot be generalized through extra fields.
+	DriverOptions []string `protobuf:"bytes,2,rep,name=driver_options,json=driverOptions" json:"driver_options,omitempty"`
+	// Source can be anything representing the source of the storage. This
+	// will be handled by the proper handler based on the Driver used.
+	// For instance, it can be a very simple path if the caller knows the
+	// name of device inside the VM, or it can be some sort of identifier
+	// to let the agent find the device inside the VM.
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// Fstype represents the filesystem that needs to be used to mount the
+	// storage inside the VM. For instance, it could be "xfs" for block
+	// device, "9p" for shared filesystem, or "tmpfs" for shared /dev/shm.
+	Fstype string `protobuf:"bytes,4,opt,name=fstype,proto3" json:"fstype,omitempty"`
+	// Options describes the additional options that might be needed to
+	// mount properly the storage filesytem.
+	Options []string `protobuf:"bytes,5,rep,name=options" json:"options,omitempty"`
+	// MountPoint refers to the path where the storage should be mounted
+	// inside the VM.
+	MountPoint string `protobuf:"bytes,6,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
+}
+
+func (m *Storage) Reset()                    { *m = Storage{} }
+func (m *Storage) String() string            { return proto.CompactTextString(m) }
+func (*Storage) ProtoMessage()               {}
+func (*Storage) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{76} }
+
+func (m *Storage) GetDriver() string {
+	if m != nil {
+		return m.Driver
 	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).ReseedRandomDev(ctx, in)
+	return ""
+}
+
+func (m *Storage) GetDriverOptions() []string {
+	if m != nil {
+		return m.DriverOptions
 	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/ReseedRandomDev",
+	return nil
+}
+
+func (m *Storage) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).ReseedRandomDev(ctx, req.(*ReseedRandomDevRequest))
+	return ""
+}
+
+func (m *Storage) GetFstype() string {
+	if m != nil {
+		return m.Fstype
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AgentService_GetGuestDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GuestDetailsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Storage) GetOptions() []string {
+	if m != nil {
+		return m.Options
 	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).GetGuestDetails(ctx, in)
+	return nil
+}
+
+func (m *Storage) GetMountPoint() string {
+	if m != nil {
+		return m.MountPoint
 	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/GetGuestDetails",
+	return ""
+}
+
+// Device represents only the devices that could have been defined through the
+// Linux Device list of the OCI specification.
+type Device struct {
+	// Id can be used to identify the device inside the VM. Some devices
+	// might not need it to be identified on the VM, and will rely on the
+	// provided VmPath instead.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Type defines the type of device described. This can be "blk",
+	// "scsi", "vfio", ...
+	// Particularly, this should be used to trigger the use of the
+	// appropriate device handler.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// VmPath can be used by the caller to provide directly the path of
+	// the device as it will appear inside the VM. For some devices, the
+	// device id or the list of options passed might not be enough to find
+	// the device. In those cases, the caller should predict and provide
+	// this vm_path.
+	VmPath string `protobuf:"bytes,3,opt,name=vm_path,json=vmPath,proto3" json:"vm_path,omitempty"`
+	// ContainerPath defines the path where the device should be found inside
+	// the container. This path should match the path of the device from
+	// the device list listed inside the OCI spec. This is used in order
+	// to identify the right device in the spec and update it with the
+	// right options such as major/minor numbers as they appear inside
+	// the VM for instance. Note that an empty ctr_path should be used
+	// to make sure the device handler inside the agent is called, but
+	// no spec update needs to be performed. This has to happen for the
+	// case of rootfs, when a device has to be waited for after it has
+	// been hotplugged. An equivalent Storage entry should be defined if
+	// any mount needs to be performed afterwards.
+	ContainerPath string `protobuf:"bytes,4,opt,name=container_path,json=containerPath,proto3" json:"container_path,omitempty"`
+	// Options allows the caller to define a list of options such as block
+	// sizes, numbers of luns, ... which are very specific to every device
+	// and cannot be generalized through extra fields.
+	Options []string `protobuf:"bytes,5,rep,name=options" json:"options,omitempty"`
+}
+
+func (m *Device) Reset()                    { *m = Device{} }
+func (m *Device) String() string            { return proto.CompactTextString(m) }
+func (*Device) ProtoMessage()               {}
+func (*Device) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{77} }
+
+func (m *Device) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).GetGuestDetails(ctx, req.(*GuestDetailsRequest))
+	return ""
+}
+
+func (m *Device) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AgentService_MemHotplugByProbe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MemHotplugByProbeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Device) GetVmPath() string {
+	if m != nil {
+		return m.VmPath
 	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).MemHotplugByProbe(ctx, in)
+	return ""
+}
+
+func (m *Device) GetContainerPath() string {
+	if m != nil {
+		return m.ContainerPath
 	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/MemHotplugByProbe",
+	return ""
+}
+
+func (m *Device) GetOptions() []string {
+	if m != nil {
+		return m.Options
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).MemHotplugByProbe(ctx, req.(*MemHotplugByProbeRequest))
+	return nil
+}
+
+type StringUser struct {
+	Uid            string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Gid            string   `protobuf:"bytes,2,opt,name=gid,proto3" json:"gid,omitempty"`
+	AdditionalGids []string `protobuf:"bytes,3,rep,name=additionalGids" json:"additionalGids,omitempty"`
+}
+
+func (m *StringUser) Reset()                    { *m = StringUser{} }
+func (m *StringUser) String() string            { return proto.CompactTextString(m) }
+func (*StringUser) ProtoMessage()               {}
+func (*StringUser) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{78} }
+
+func (m *StringUser) GetUid() string {
+	if m != nil {
+		return m.Uid
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AgentService_SetGuestDateTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetGuestDateTimeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *StringUser) GetGid() string {
+	if m != nil {
+		return m.Gid
 	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).SetGuestDateTime(ctx, in)
+	return ""
+}
+
+func (m *StringUser) GetAdditionalGids() []string {
+	if m != nil {
+		return m.AdditionalGids
 	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/SetGuestDateTime",
+	return nil
+}
+
+type CheckKernelModuleRequest struct {
+	Module *KernelModule `protobuf:"bytes,1,opt,name=module" json:"module,omitempty"`
+	// Load the module, with its parameters, when it is available but
+	// not loaded yet.
+	Load bool `protobuf:"varint,2,opt,name=load,proto3" json:"load,omitempty"`
+}
+
+func (m *CheckKernelModuleRequest) Reset()                    { *m = CheckKernelModuleRequest{} }
+func (m *CheckKernelModuleRequest) String() string            { return proto.CompactTextString(m) }
+func (*CheckKernelModuleRequest) ProtoMessage()               {}
+func (*CheckKernelModuleRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{79} }
+
+func (m *CheckKernelModuleRequest) GetModule() *KernelModule {
+	if m != nil {
+		return m.Module
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).SetGuestDateTime(ctx, req.(*SetGuestDateTimeRequest))
+	return nil
+}
+
+func (m *CheckKernelModuleRequest) GetLoad() bool {
+	if m != nil {
+		return m.Load
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _AgentService_CopyFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CopyFileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// KernelModuleStatus describes the state of a kernel module in the guest.
+type KernelModuleStatus struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The module is built into the kernel.
+	Builtin bool `protobuf:"varint,2,opt,name=builtin,proto3" json:"builtin,omitempty"`
+	// The module is shipped with the guest kernel and can be loaded.
+	Available bool `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	// The module is currently loaded.
+	Loaded bool `protobuf:"varint,4,opt,name=loaded,proto3" json:"loaded,omitempty"`
+}
+
+func (m *KernelModuleStatus) Reset()                    { *m = KernelModuleStatus{} }
+func (m *KernelModuleStatus) String() string            { return proto.CompactTextString(m) }
+func (*KernelModuleStatus) ProtoMessage()               {}
+func (*KernelModuleStatus) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{80} }
+
+func (m *KernelModuleStatus) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).CopyFile(ctx, in)
+	return ""
+}
+
+func (m *KernelModuleStatus) GetBuiltin() bool {
+	if m != nil {
+		return m.Builtin
 	}
-	info := &grpc1.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpc.AgentService/CopyFile",
+	return false
+}
+
+func (m *KernelModuleStatus) GetAvailable() bool {
+	if m != nil {
+		return m.Available
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).CopyFile(ctx, req.(*CopyFileRequest))
+	return false
+}
+
+func (m *KernelModuleStatus) GetLoaded() bool {
+	if m != nil {
+		return m.Loaded
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _AgentService_serviceDesc = grpc1.ServiceDesc{
-	ServiceName: "grpc.AgentService",
-	HandlerType: (*AgentServiceServer)(nil),
-	Methods: []grpc1.MethodDesc{
-		{
-			MethodName: "CreateContainer",
-			Handler:    _AgentService_CreateContainer_Handler,
-		},
-		{
-			MethodName: "StartContainer",
-			Handler:    _AgentService_StartContainer_Handler,
-		},
-		{
-			MethodName: "RemoveContainer",
-			Handler:    _AgentService_RemoveContainer_Handler,
-		},
-		{
-			MethodName: "ExecProcess",
-			Handler:    _AgentService_ExecProcess_Handler,
-		},
-		{
-			MethodName: "SignalProcess",
-			Handler:    _AgentService_SignalProcess_Handler,
-		},
-		{
-			MethodName: "WaitProcess",
-			Handler:    _AgentService_WaitProcess_Handler,
-		},
-		{
-			MethodName: "ListProcesses",
-			Handler:    _AgentService_ListProcesses_Handler,
-		},
-		{
-			MethodName: "UpdateContainer",
-			Handler:    _AgentService_UpdateContainer_Handler,
-		},
-		{
-			MethodName: "StatsContainer",
-			Handler:    _AgentService_StatsContainer_Handler,
-		},
-		{
-			MethodName: "PauseContainer",
-			Handler:    _AgentService_PauseContainer_Handler,
-		},
-		{
-			MethodName: "ResumeContainer",
-			Handler:    _AgentService_ResumeContainer_Handler,
-		},
-		{
-			MethodName: "WriteStdin",
-			Handler:    _AgentService_WriteStdin_Handler,
-		},
-		{
-			MethodName: "ReadStdout",
-			Handler:    _AgentService_ReadStdout_Handler,
-		},
-		{
-			MethodName: "ReadStderr",
-			Handler:    _AgentService_ReadStderr_Handler,
-		},
-		{
-			MethodName: "CloseStdin",
-			Handler:    _AgentService_CloseStdin_Handler,
-		},
-		{
-			MethodName: "TtyWinResize",
-			Handler:    _AgentService_TtyWinResize_Handler,
-		},
-		{
-			MethodName: "UpdateInterface",
-			Handler:    _AgentService_UpdateInterface_Handler,
-		},
-		{
-			MethodName: "UpdateRoutes",
-			Handler:    _AgentService_UpdateRoutes_Handler,
-		},
-		{
-			MethodName: "ListInterfaces",
-			Handler:    _AgentService_ListInterfaces_Handler,
-		},
-		{
-			MethodName: "ListRoutes",
-			Handler:    _AgentService_ListRoutes_Handler,
-		},
-		{
-			MethodName: "StartTracing",
-			Handler:    _AgentService_StartTracing_Handler,
-		},
-		{
-			MethodName: "StopTracing",
-			Handler:    _AgentService_StopTracing_Handler,
-		},
-		{
-			MethodName: "CreateSandbox",
-			Handler:    _AgentService_CreateSandbox_Handler,
-		},
-		{
-			MethodName: "DestroySandbox",
-			Handler:    _AgentService_DestroySandbox_Handler,
-		},
-		{
-			MethodName: "OnlineCPUMem",
-			Handler:    _AgentService_OnlineCPUMem_Handler,
-		},
-		{
-			MethodName: "ReseedRandomDev",
-			Handler:    _AgentService_ReseedRandomDev_Handler,
-		},
-		{
-			MethodName: "GetGuestDetails",
-			Handler:    _AgentService_GetGuestDetails_Handler,
-		},
-		{
-			MethodName: "MemHotplugByProbe",
-			Handler:    _AgentService_MemHotplugByProbe_Handler,
-		},
-		{
-			MethodName: "SetGuestDateTime",
-			Handler:    _AgentService_SetGuestDateTime_Handler,
-		},
-		{
-			MethodName: "CopyFile",
-			Handler:    _AgentService_CopyFile_Handler,
-		},
-	},
-	Streams:  []grpc1.StreamDesc{},
-	Metadata: "agent.proto",
+// RestartAgentRequest asks the agent to re-execute itself, keeping the
+// containers and their processes running.
+type RestartAgentRequest struct {
+	// Unsupported, must be empty: only the running agent binary is
+	// re-executed.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (m *CreateContainerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *RestartAgentRequest) Reset()                    { *m = RestartAgentRequest{} }
+func (m *RestartAgentRequest) String() string            { return proto.CompactTextString(m) }
+func (*RestartAgentRequest) ProtoMessage()               {}
+func (*RestartAgentRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{81} }
+
+func (m *RestartAgentRequest) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CreateContainerRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContainerId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i += copy(dAtA[i:], m.ContainerId)
-	}
-	if len(m.ExecId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
-		i += copy(dAtA[i:], m.ExecId)
+type CopyFileRequest struct {
+	// Path is the destination file in the guest. It must be absolute,
+	// canonical and below /run.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// FileSize is the expected file size, for security reasons write operations
+	// are made in a temporary file, once it has the expected size, it's moved
+	// to the destination path.
+	FileSize int64 `protobuf:"varint,2,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	// FileMode is the file mode.
+	FileMode uint32 `protobuf:"varint,3,opt,name=file_mode,json=fileMode,proto3" json:"file_mode,omitempty"`
+	// DirMode is the mode for the parent directories of destination path.
+	// The missing ones are created with it, owned by Uid and Gid.
+	DirMode uint32 `protobuf:"varint,4,opt,name=dir_mode,json=dirMode,proto3" json:"dir_mode,omitempty"`
+	// Uid is the numeric user id.
+	Uid int32 `protobuf:"varint,5,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Gid is the numeric group id.
+	Gid int32 `protobuf:"varint,6,opt,name=gid,proto3" json:"gid,omitempty"`
+	// Offset for the next write operation.
+	Offset int64 `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Data to write in the destination file.
+	Data []byte `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *CopyFileRequest) Reset()                    { *m = CopyFileRequest{} }
+func (m *CopyFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*CopyFileRequest) ProtoMessage()               {}
+func (*CopyFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{82} }
+
+func (m *CopyFileRequest) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	if m.StringUser != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.StringUser.Size()))
-		n1, err := m.StringUser.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
+	return ""
+}
+
+func (m *CopyFileRequest) GetFileSize() int64 {
+	if m != nil {
+		return m.FileSize
 	}
-	if len(m.Devices) > 0 {
-		for _, msg := range m.Devices {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return 0
+}
+
+func (m *CopyFileRequest) GetFileMode() uint32 {
+	if m != nil {
+		return m.FileMode
 	}
-	if len(m.Storages) > 0 {
-		for _, msg := range m.Storages {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return 0
+}
+
+func (m *CopyFileRequest) GetDirMode() uint32 {
+	if m != nil {
+		return m.DirMode
 	}
-	if m.OCI != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.OCI.Size()))
-		n2, err := m.OCI.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+	return 0
+}
+
+func (m *CopyFileRequest) GetUid() int32 {
+	if m != nil {
+		return m.Uid
 	}
-	if m.SandboxPidns {
-		dAtA[i] = 0x38
-		i++
-		if m.SandboxPidns {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return 0
+}
+
+func (m *CopyFileRequest) GetGid() int32 {
+	if m != nil {
+		return m.Gid
 	}
-	return i, nil
+	return 0
 }
 
-func (m *StartContainerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CopyFileRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *StartContainerRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContainerId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i += copy(dAtA[i:], m.ContainerId)
+func (m *CopyFileRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return i, nil
+	return nil
 }
 
-func (m *RemoveContainerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+// ExtractLayerRequest sends a chunk of a container image layer, extracted
+// into a directory once all the chunks are received.
+type ExtractLayerRequest struct {
+	// Path is the directory the layer is extracted into. It must be absolute,
+	// canonical and below /run.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// LayerSize is the size of the layer, a tar archive, possibly gzip or
+	// zstd compressed.
+	LayerSize int64 `protobuf:"varint,2,opt,name=layer_size,json=layerSize,proto3" json:"layer_size,omitempty"`
+	// Offset of the chunk in the layer. The chunk at offset 0 starts the
+	// transfer, and must be sent first.
+	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Data of the chunk.
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ExtractLayerRequest) Reset()                    { *m = ExtractLayerRequest{} }
+func (m *ExtractLayerRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExtractLayerRequest) ProtoMessage()               {}
+func (*ExtractLayerRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{83} }
+
+func (m *ExtractLayerRequest) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RemoveContainerRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContainerId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i += copy(dAtA[i:], m.ContainerId)
+func (m *ExtractLayerRequest) GetLayerSize() int64 {
+	if m != nil {
+		return m.LayerSize
 	}
-	if m.Timeout != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Timeout))
+	return 0
+}
+
+func (m *ExtractLayerRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
 	}
-	return i, nil
+	return 0
 }
 
-func (m *ExecProcessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ExtractLayerRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ExecProcessRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContainerId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i += copy(dAtA[i:], m.ContainerId)
-	}
-	if len(m.ExecId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
-		i += copy(dAtA[i:], m.ExecId)
-	}
-	if m.StringUser != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.StringUser.Size()))
-		n3, err := m.StringUser.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
-	}
-	if m.Process != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Process.Size()))
-		n4, err := m.Process.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+type StartTracingRequest struct {
+}
+
+func (m *StartTracingRequest) Reset()                    { *m = StartTracingRequest{} }
+func (m *StartTracingRequest) String() string            { return proto.CompactTextString(m) }
+func (*StartTracingRequest) ProtoMessage()               {}
+func (*StartTracingRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{84} }
+
+type StopTracingRequest struct {
+}
+
+func (m *StopTracingRequest) Reset()                    { *m = StopTracingRequest{} }
+func (m *StopTracingRequest) String() string            { return proto.CompactTextString(m) }
+func (*StopTracingRequest) ProtoMessage()               {}
+func (*StopTracingRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{85} }
+
+// GetProfileRequest requests a pprof profile of the agent.
+type GetProfileRequest struct {
+	// Name of the profile: "cpu", or a runtime/pprof one such as
+	// "goroutine" or "heap".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Duration of the CPU profile, in seconds, bounded by the agent.
+	Seconds uint32 `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+}
+
+func (m *GetProfileRequest) Reset()                    { *m = GetProfileRequest{} }
+func (m *GetProfileRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetProfileRequest) ProtoMessage()               {}
+func (*GetProfileRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{86} }
+
+func (m *GetProfileRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return i, nil
+	return ""
 }
 
-func (m *SignalProcessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *GetProfileRequest) GetSeconds() uint32 {
+	if m != nil {
+		return m.Seconds
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SignalProcessRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContainerId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i += copy(dAtA[i:], m.ContainerId)
+// Profile is a profile in the gzip-compressed protobuf pprof format.
+type Profile struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *Profile) Reset()                    { *m = Profile{} }
+func (m *Profile) String() string            { return proto.CompactTextString(m) }
+func (*Profile) ProtoMessage()               {}
+func (*Profile) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{87} }
+
+func (m *Profile) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	if len(m.ExecId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
-		i += copy(dAtA[i:], m.ExecId)
+	return nil
+}
+
+type GetAPIVersionRequest struct {
+}
+
+func (m *GetAPIVersionRequest) Reset()                    { *m = GetAPIVersionRequest{} }
+func (m *GetAPIVersionRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetAPIVersionRequest) ProtoMessage()               {}
+func (*GetAPIVersionRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{88} }
+
+// APIVersionResponse describes the protocol spoken by the agent.
+type APIVersionResponse struct {
+	// Version of the gRPC protocol, as reported by the health service.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Methods served by the agent.
+	Methods []string `protobuf:"bytes,2,rep,name=methods" json:"methods,omitempty"`
+	// Methods still served but due to be removed.
+	DeprecatedMethods []string `protobuf:"bytes,3,rep,name=deprecated_methods,json=deprecatedMethods" json:"deprecated_methods,omitempty"`
+	// Methods disabled in this build of the agent, which fail with
+	// an Unimplemented error.
+	DisabledMethods []string `protobuf:"bytes,4,rep,name=disabled_methods,json=disabledMethods" json:"disabled_methods,omitempty"`
+}
+
+func (m *APIVersionResponse) Reset()                    { *m = APIVersionResponse{} }
+func (m *APIVersionResponse) String() string            { return proto.CompactTextString(m) }
+func (*APIVersionResponse) ProtoMessage()               {}
+func (*APIVersionResponse) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{89} }
+
+func (m *APIVersionResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	if m.Signal != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Signal))
+	return ""
+}
+
+func (m *APIVersionResponse) GetMethods() []string {
+	if m != nil {
+		return m.Methods
 	}
-	return i, nil
+	return nil
 }
 
-func (m *WaitProcessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *APIVersionResponse) GetDeprecatedMethods() []string {
+	if m != nil {
+		return m.DeprecatedMethods
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *WaitProcessRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContainerId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i += copy(dAtA[i:], m.ContainerId)
+func (m *APIVersionResponse) GetDisabledMethods() []string {
+	if m != nil {
+		return m.DisabledMethods
 	}
-	if len(m.ExecId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
-		i += copy(dAtA[i:], m.ExecId)
+	return nil
+}
+
+type GuestFilesystemsRequest struct {
+	// Probe the guest again instead of returning the result of the
+	// previous probe.
+	Refresh bool `protobuf:"varint,1,opt,name=refresh,proto3" json:"refresh,omitempty"`
+}
+
+func (m *GuestFilesystemsRequest) Reset()                    { *m = GuestFilesystemsRequest{} }
+func (m *GuestFilesystemsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestFilesystemsRequest) ProtoMessage()               {}
+func (*GuestFilesystemsRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{90} }
+
+func (m *GuestFilesystemsRequest) GetRefresh() bool {
+	if m != nil {
+		return m.Refresh
 	}
-	return i, nil
+	return false
 }
 
-func (m *WaitProcessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+// GuestFilesystem describes a filesystem type known to the guest.
+type GuestFilesystem struct {
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// The filesystem does not need a block device.
+	Nodev bool `protobuf:"varint,2,opt,name=nodev,proto3" json:"nodev,omitempty"`
+	// The filesystem is registered with the kernel, being either built
+	// in or provided by a loaded module.
+	Registered bool `protobuf:"varint,3,opt,name=registered,proto3" json:"registered,omitempty"`
+	// The filesystem is provided by a module shipped with the guest and
+	// not loaded yet, its properties being unknown until then.
+	Loadable bool `protobuf:"varint,4,opt,name=loadable,proto3" json:"loadable,omitempty"`
+}
+
+func (m *GuestFilesystem) Reset()                    { *m = GuestFilesystem{} }
+func (m *GuestFilesystem) String() string            { return proto.CompactTextString(m) }
+func (*GuestFilesystem) ProtoMessage()               {}
+func (*GuestFilesystem) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{91} }
+
+func (m *GuestFilesystem) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *WaitProcessResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Status))
+func (m *GuestFilesystem) GetNodev() bool {
+	if m != nil {
+		return m.Nodev
 	}
-	return i, nil
+	return false
 }
 
-func (m *ListProcessesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *GuestFilesystem) GetRegistered() bool {
+	if m != nil {
+		return m.Registered
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ListProcessesRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContainerId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i += copy(dAtA[i:], m.ContainerId)
+func (m *GuestFilesystem) GetLoadable() bool {
+	if m != nil {
+		return m.Loadable
 	}
-	if len(m.Format) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Format)))
-		i += copy(dAtA[i:], m.Format)
+	return false
+}
+
+// GuestFilesystems describes the filesystems and mount features of the
+// guest kernel.
+type GuestFilesystems struct {
+	Filesystems []*GuestFilesystem `protobuf:"bytes,1,rep,name=filesystems" json:"filesystems,omitempty"`
+	// The kernel supports ID-mapped mounts, through mount_setattr(2).
+	IdmappedMounts bool `protobuf:"varint,2,opt,name=idmapped_mounts,json=idmappedMounts,proto3" json:"idmapped_mounts,omitempty"`
+	// Overlay filesystems can be mounted.
+	Overlayfs bool `protobuf:"varint,3,opt,name=overlayfs,proto3" json:"overlayfs,omitempty"`
+	// virtio-fs shares can be mounted with DAX, per the kernel
+	// configuration.
+	VirtiofsDax bool `protobuf:"varint,4,opt,name=virtiofs_dax,json=virtiofsDax,proto3" json:"virtiofs_dax,omitempty"`
+}
+
+func (m *GuestFilesystems) Reset()                    { *m = GuestFilesystems{} }
+func (m *GuestFilesystems) String() string            { return proto.CompactTextString(m) }
+func (*GuestFilesystems) ProtoMessage()               {}
+func (*GuestFilesystems) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{92} }
+
+func (m *GuestFilesystems) GetFilesystems() []*GuestFilesystem {
+	if m != nil {
+		return m.Filesystems
 	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	return nil
+}
+
+func (m *GuestFilesystems) GetIdmappedMounts() bool {
+	if m != nil {
+		return m.IdmappedMounts
 	}
-	return i, nil
+	return false
 }
 
-func (m *ListProcessesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *GuestFilesystems) GetOverlayfs() bool {
+	if m != nil {
+		return m.Overlayfs
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ListProcessesResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProcessList) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ProcessList)))
-		i += copy(dAtA[i:], m.ProcessList)
+func (m *GuestFilesystems) GetVirtiofsDax() bool {
+	if m != nil {
+		return m.VirtiofsDax
 	}
-	return i, nil
+	return false
 }
 
-func (m *UpdateContainerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+type InitTimingsRequest struct {
+}
+
+func (m *InitTimingsRequest) Reset()                    { *m = InitTimingsRequest{} }
+func (m *InitTimingsRequest) String() string            { return proto.CompactTextString(m) }
+func (*InitTimingsRequest) ProtoMessage()               {}
+func (*InitTimingsRequest) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{93} }
+
+// InitPhase is a completed phase of the agent initialization.
+type InitPhase struct {
+	// "init", "tracing", "logger", "restore", "setup", "channels" and
+	// "grpc" for the agent startup, "sandbox" for CreateSandbox.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Start of the phase since the agent start, and its duration, in
+	// microseconds.
+	StartUs    uint64 `protobuf:"varint,2,opt,name=start_us,json=startUs,proto3" json:"start_us,omitempty"`
+	DurationUs uint64 `protobuf:"varint,3,opt,name=duration_us,json=durationUs,proto3" json:"duration_us,omitempty"`
+}
+
+func (m *InitPhase) Reset()                    { *m = InitPhase{} }
+func (m *InitPhase) String() string            { return proto.CompactTextString(m) }
+func (*InitPhase) ProtoMessage()               {}
+func (*InitPhase) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{94} }
+
+func (m *InitPhase) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UpdateContainerRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContainerId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i += copy(dAtA[i:], m.ContainerId)
+func (m *InitPhase) GetStartUs() uint64 {
+	if m != nil {
+		return m.StartUs
 	}
-	if m.Resources != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Resources.Size()))
-		n5, err := m.Resources.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
+	return 0
+}
+
+func (m *InitPhase) GetDurationUs() uint64 {
+	if m != nil {
+		return m.DurationUs
 	}
-	return i, nil
+	return 0
 }
 
-func (m *StatsContainerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+// InitTimings breaks down the agent initialization into its phases, in the
+// order they completed.
+type InitTimings struct {
+	Phases []*InitPhase `protobuf:"bytes,1,rep,name=phases" json:"phases,omitempty"`
+	// Duration of the agent startup, until the gRPC server is started,
+	// in microseconds. The startup phases follow each other, their
+	// durations summing up to it.
+	StartupUs uint64 `protobuf:"varint,2,opt,name=startup_us,json=startupUs,proto3" json:"startup_us,omitempty"`
+}
+
+func (m *InitTimings) Reset()                    { *m = InitTimings{} }
+func (m *InitTimings) String() string            { return proto.CompactTextString(m) }
+func (*InitTimings) ProtoMessage()               {}
+func (*InitTimings) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{95} }
+
+func (m *InitTimings) GetPhases() []*InitPhase {
+	if m != nil {
+		return m.Phases
+	}
+	return nil
+}
+
+func (m *InitTimings) GetStartupUs() uint64 {
+	if m != nil {
+		return m.StartupUs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CreateContainerRequest)(nil), "grpc.CreateContainerRequest")
+	proto.RegisterType((*OutputLog)(nil), "grpc.OutputLog")
+	proto.RegisterType((*OutputTimestamps)(nil), "grpc.OutputTimestamps")
+	proto.RegisterType((*StartContainerRequest)(nil), "grpc.StartContainerRequest")
+	proto.RegisterType((*RemoveContainerRequest)(nil), "grpc.RemoveContainerRequest")
+	proto.RegisterType((*ExecProcessRequest)(nil), "grpc.ExecProcessRequest")
+	proto.RegisterType((*ExecProcessResponse)(nil), "grpc.ExecProcessResponse")
+	proto.RegisterType((*SignalProcessRequest)(nil), "grpc.SignalProcessRequest")
+	proto.RegisterType((*WaitProcessRequest)(nil), "grpc.WaitProcessRequest")
+	proto.RegisterType((*WaitProcessResponse)(nil), "grpc.WaitProcessResponse")
+	proto.RegisterType((*ListProcessesRequest)(nil), "grpc.ListProcessesRequest")
+	proto.RegisterType((*ListProcessesResponse)(nil), "grpc.ListProcessesResponse")
+	proto.RegisterType((*GetProcessEnvRequest)(nil), "grpc.GetProcessEnvRequest")
+	proto.RegisterType((*ProcessEnv)(nil), "grpc.ProcessEnv")
+	proto.RegisterType((*GetProcessFilesRequest)(nil), "grpc.GetProcessFilesRequest")
+	proto.RegisterType((*OpenFile)(nil), "grpc.OpenFile")
+	proto.RegisterType((*ProcessFiles)(nil), "grpc.ProcessFiles")
+	proto.RegisterType((*ListContainerMountsRequest)(nil), "grpc.ListContainerMountsRequest")
+	proto.RegisterType((*ContainerMount)(nil), "grpc.ContainerMount")
+	proto.RegisterType((*ContainerMounts)(nil), "grpc.ContainerMounts")
+	proto.RegisterType((*ExposeContainerRootfsRequest)(nil), "grpc.ExposeContainerRootfsRequest")
+	proto.RegisterType((*ExposeContainerRootfsResponse)(nil), "grpc.ExposeContainerRootfsResponse")
+	proto.RegisterType((*UnexposeContainerRootfsRequest)(nil), "grpc.UnexposeContainerRootfsRequest")
+	proto.RegisterType((*ReadContainerRootfsRequest)(nil), "grpc.ReadContainerRootfsRequest")
+	proto.RegisterType((*RootfsEntry)(nil), "grpc.RootfsEntry")
+	proto.RegisterType((*ContainerRootfsFile)(nil), "grpc.ContainerRootfsFile")
+	proto.RegisterType((*UpdateContainerRequest)(nil), "grpc.UpdateContainerRequest")
+	proto.RegisterType((*StatsContainerRequest)(nil), "grpc.StatsContainerRequest")
+	proto.RegisterType((*MemoryStatContainerRequest)(nil), "grpc.MemoryStatContainerRequest")
+	proto.RegisterType((*MemoryStatContainerResponse)(nil), "grpc.MemoryStatContainerResponse")
+	proto.RegisterType((*PauseContainerRequest)(nil), "grpc.PauseContainerRequest")
+	proto.RegisterType((*ResumeContainerRequest)(nil), "grpc.ResumeContainerRequest")
+	proto.RegisterType((*CpuUsage)(nil), "grpc.CpuUsage")
+	proto.RegisterType((*ThrottlingData)(nil), "grpc.ThrottlingData")
+	proto.RegisterType((*CpuStats)(nil), "grpc.CpuStats")
+	proto.RegisterType((*PidsStats)(nil), "grpc.PidsStats")
+	proto.RegisterType((*MemoryData)(nil), "grpc.MemoryData")
+	proto.RegisterType((*MemoryStats)(nil), "grpc.MemoryStats")
+	proto.RegisterType((*BlkioStatsEntry)(nil), "grpc.BlkioStatsEntry")
+	proto.RegisterType((*BlkioStats)(nil), "grpc.BlkioStats")
+	proto.RegisterType((*HugetlbStats)(nil), "grpc.HugetlbStats")
+	proto.RegisterType((*CgroupStats)(nil), "grpc.CgroupStats")
+	proto.RegisterType((*NetworkStats)(nil), "grpc.NetworkStats")
+	proto.RegisterType((*WorkingSetStats)(nil), "grpc.WorkingSetStats")
+	proto.RegisterType((*PageCacheStats)(nil), "grpc.PageCacheStats")
+	proto.RegisterType((*OOMControl)(nil), "grpc.OOMControl")
+	proto.RegisterType((*StatsContainerResponse)(nil), "grpc.StatsContainerResponse")
+	proto.RegisterType((*StatsSandboxRequest)(nil), "grpc.StatsSandboxRequest")
+	proto.RegisterType((*StatsSandboxResponse)(nil), "grpc.StatsSandboxResponse")
+	proto.RegisterType((*WriteStreamRequest)(nil), "grpc.WriteStreamRequest")
+	proto.RegisterType((*WriteStreamResponse)(nil), "grpc.WriteStreamResponse")
+	proto.RegisterType((*ReadStreamRequest)(nil), "grpc.ReadStreamRequest")
+	proto.RegisterType((*ReadStreamResponse)(nil), "grpc.ReadStreamResponse")
+	proto.RegisterType((*CloseStdinRequest)(nil), "grpc.CloseStdinRequest")
+	proto.RegisterType((*TtyWinResizeRequest)(nil), "grpc.TtyWinResizeRequest")
+	proto.RegisterType((*KernelModule)(nil), "grpc.KernelModule")
+	proto.RegisterType((*CreateSandboxRequest)(nil), "grpc.CreateSandboxRequest")
+	proto.RegisterType((*DestroySandboxRequest)(nil), "grpc.DestroySandboxRequest")
+	proto.RegisterType((*Interfaces)(nil), "grpc.Interfaces")
+	proto.RegisterType((*Routes)(nil), "grpc.Routes")
+	proto.RegisterType((*UpdateInterfaceRequest)(nil), "grpc.UpdateInterfaceRequest")
+	proto.RegisterType((*UpdateRoutesRequest)(nil), "grpc.UpdateRoutesRequest")
+	proto.RegisterType((*SourceRoutingInterface)(nil), "grpc.SourceRoutingInterface")
+	proto.RegisterType((*UpdateSourceRoutingRequest)(nil), "grpc.UpdateSourceRoutingRequest")
+	proto.RegisterType((*SourceRouting)(nil), "grpc.SourceRouting")
+	proto.RegisterType((*SetTransparentProxyRequest)(nil), "grpc.SetTransparentProxyRequest")
+	proto.RegisterType((*RemoveTransparentProxyRequest)(nil), "grpc.RemoveTransparentProxyRequest")
+	proto.RegisterType((*ListInterfacesRequest)(nil), "grpc.ListInterfacesRequest")
+	proto.RegisterType((*ListRoutesRequest)(nil), "grpc.ListRoutesRequest")
+	proto.RegisterType((*OnlineCPUMemRequest)(nil), "grpc.OnlineCPUMemRequest")
+	proto.RegisterType((*ReseedRandomDevRequest)(nil), "grpc.ReseedRandomDevRequest")
+	proto.RegisterType((*AgentDetails)(nil), "grpc.AgentDetails")
+	proto.RegisterType((*GuestDetailsRequest)(nil), "grpc.GuestDetailsRequest")
+	proto.RegisterType((*GuestDetailsResponse)(nil), "grpc.GuestDetailsResponse")
+	proto.RegisterType((*MemHotplugByProbeRequest)(nil), "grpc.MemHotplugByProbeRequest")
+	proto.RegisterType((*SetGuestDateTimeRequest)(nil), "grpc.SetGuestDateTimeRequest")
+	proto.RegisterType((*Storage)(nil), "grpc.Storage")
+	proto.RegisterType((*Device)(nil), "grpc.Device")
+	proto.RegisterType((*StringUser)(nil), "grpc.StringUser")
+	proto.RegisterType((*CheckKernelModuleRequest)(nil), "grpc.CheckKernelModuleRequest")
+	proto.RegisterType((*KernelModuleStatus)(nil), "grpc.KernelModuleStatus")
+	proto.RegisterType((*RestartAgentRequest)(nil), "grpc.RestartAgentRequest")
+	proto.RegisterType((*CopyFileRequest)(nil), "grpc.CopyFileRequest")
+	proto.RegisterType((*ExtractLayerRequest)(nil), "grpc.ExtractLayerRequest")
+	proto.RegisterType((*StartTracingRequest)(nil), "grpc.StartTracingRequest")
+	proto.RegisterType((*StopTracingRequest)(nil), "grpc.StopTracingRequest")
+	proto.RegisterType((*GetProfileRequest)(nil), "grpc.GetProfileRequest")
+	proto.RegisterType((*Profile)(nil), "grpc.Profile")
+	proto.RegisterType((*GetAPIVersionRequest)(nil), "grpc.GetAPIVersionRequest")
+	proto.RegisterType((*APIVersionResponse)(nil), "grpc.APIVersionResponse")
+	proto.RegisterType((*GuestFilesystemsRequest)(nil), "grpc.GuestFilesystemsRequest")
+	proto.RegisterType((*GuestFilesystem)(nil), "grpc.GuestFilesystem")
+	proto.RegisterType((*GuestFilesystems)(nil), "grpc.GuestFilesystems")
+	proto.RegisterType((*InitTimingsRequest)(nil), "grpc.InitTimingsRequest")
+	proto.RegisterType((*InitPhase)(nil), "grpc.InitPhase")
+	proto.RegisterType((*InitTimings)(nil), "grpc.InitTimings")
+	proto.RegisterEnum("grpc.ExecProcessRequest_OutputBuffering", ExecProcessRequest_OutputBuffering_name, ExecProcessRequest_OutputBuffering_value)
+	proto.RegisterEnum("grpc.WaitProcessResponse_Reason", WaitProcessResponse_Reason_name, WaitProcessResponse_Reason_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc1.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc1.SupportPackageIsVersion4
+
+// Client API for AgentService service
+
+type AgentServiceClient interface {
+	// execution
+	CreateContainer(ctx context.Context, in *CreateContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	StartContainer(ctx context.Context, in *StartContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	// RemoveContainer will tear down an existing container by forcibly terminating
+	// all processes running inside that container and releasing all internal
+	// resources associated with it.
+	// RemoveContainer will wait for all processes termination before returning.
+	// If any process can not be killed or if it can not be killed after
+	// the RemoveContainerRequest timeout, RemoveContainer will return an error.
+	RemoveContainer(ctx context.Context, in *RemoveContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	ExecProcess(ctx context.Context, in *ExecProcessRequest, opts ...grpc1.CallOption) (*ExecProcessResponse, error)
+	SignalProcess(ctx context.Context, in *SignalProcessRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	WaitProcess(ctx context.Context, in *WaitProcessRequest, opts ...grpc1.CallOption) (*WaitProcessResponse, error)
+	ListProcesses(ctx context.Context, in *ListProcessesRequest, opts ...grpc1.CallOption) (*ListProcessesResponse, error)
+	GetProcessEnv(ctx context.Context, in *GetProcessEnvRequest, opts ...grpc1.CallOption) (*ProcessEnv, error)
+	GetProcessFiles(ctx context.Context, in *GetProcessFilesRequest, opts ...grpc1.CallOption) (*ProcessFiles, error)
+	ListContainerMounts(ctx context.Context, in *ListContainerMountsRequest, opts ...grpc1.CallOption) (*ContainerMounts, error)
+	ExposeContainerRootfs(ctx context.Context, in *ExposeContainerRootfsRequest, opts ...grpc1.CallOption) (*ExposeContainerRootfsResponse, error)
+	UnexposeContainerRootfs(ctx context.Context, in *UnexposeContainerRootfsRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	ReadContainerRootfs(ctx context.Context, in *ReadContainerRootfsRequest, opts ...grpc1.CallOption) (*ContainerRootfsFile, error)
+	UpdateContainer(ctx context.Context, in *UpdateContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	StatsContainer(ctx context.Context, in *StatsContainerRequest, opts ...grpc1.CallOption) (*StatsContainerResponse, error)
+	StatsSandbox(ctx context.Context, in *StatsSandboxRequest, opts ...grpc1.CallOption) (*StatsSandboxResponse, error)
+	MemoryStatContainer(ctx context.Context, in *MemoryStatContainerRequest, opts ...grpc1.CallOption) (*MemoryStatContainerResponse, error)
+	PauseContainer(ctx context.Context, in *PauseContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	ResumeContainer(ctx context.Context, in *ResumeContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	// stdio
+	WriteStdin(ctx context.Context, in *WriteStreamRequest, opts ...grpc1.CallOption) (*WriteStreamResponse, error)
+	ReadStdout(ctx context.Context, in *ReadStreamRequest, opts ...grpc1.CallOption) (*ReadStreamResponse, error)
+	ReadStderr(ctx context.Context, in *ReadStreamRequest, opts ...grpc1.CallOption) (*ReadStreamResponse, error)
+	CloseStdin(ctx context.Context, in *CloseStdinRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	TtyWinResize(ctx context.Context, in *TtyWinResizeRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	// networking
+	UpdateInterface(ctx context.Context, in *UpdateInterfaceRequest, opts ...grpc1.CallOption) (*types.Interface, error)
+	UpdateRoutes(ctx context.Context, in *UpdateRoutesRequest, opts ...grpc1.CallOption) (*Routes, error)
+	ListInterfaces(ctx context.Context, in *ListInterfacesRequest, opts ...grpc1.CallOption) (*Interfaces, error)
+	ListRoutes(ctx context.Context, in *ListRoutesRequest, opts ...grpc1.CallOption) (*Routes, error)
+	UpdateSourceRouting(ctx context.Context, in *UpdateSourceRoutingRequest, opts ...grpc1.CallOption) (*SourceRouting, error)
+	SetTransparentProxy(ctx context.Context, in *SetTransparentProxyRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	RemoveTransparentProxy(ctx context.Context, in *RemoveTransparentProxyRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	// tracing
+	StartTracing(ctx context.Context, in *StartTracingRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	StopTracing(ctx context.Context, in *StopTracingRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc1.CallOption) (*Profile, error)
+	// misc (TODO: some rpcs can be replaced by hyperstart-exec)
+	CreateSandbox(ctx context.Context, in *CreateSandboxRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	DestroySandbox(ctx context.Context, in *DestroySandboxRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	OnlineCPUMem(ctx context.Context, in *OnlineCPUMemRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	ReseedRandomDev(ctx context.Context, in *ReseedRandomDevRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	GetGuestDetails(ctx context.Context, in *GuestDetailsRequest, opts ...grpc1.CallOption) (*GuestDetailsResponse, error)
+	MemHotplugByProbe(ctx context.Context, in *MemHotplugByProbeRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	SetGuestDateTime(ctx context.Context, in *SetGuestDateTimeRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	ExtractLayer(ctx context.Context, in *ExtractLayerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	CheckKernelModule(ctx context.Context, in *CheckKernelModuleRequest, opts ...grpc1.CallOption) (*KernelModuleStatus, error)
+	RestartAgent(ctx context.Context, in *RestartAgentRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error)
+	GetAPIVersion(ctx context.Context, in *GetAPIVersionRequest, opts ...grpc1.CallOption) (*APIVersionResponse, error)
+	GetGuestFilesystems(ctx context.Context, in *GuestFilesystemsRequest, opts ...grpc1.CallOption) (*GuestFilesystems, error)
+	GetInitTimings(ctx context.Context, in *InitTimingsRequest, opts ...grpc1.CallOption) (*InitTimings, error)
+}
+
+type agentServiceClient struct {
+	cc *grpc1.ClientConn
+}
+
+func NewAgentServiceClient(cc *grpc1.ClientConn) AgentServiceClient {
+	return &agentServiceClient{cc}
+}
+
+func (c *agentServiceClient) CreateContainer(ctx context.Context, in *CreateContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/CreateContainer", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *StatsContainerRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContainerId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i += copy(dAtA[i:], m.ContainerId)
+func (c *agentServiceClient) StartContainer(ctx context.Context, in *StartContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/StartContainer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *PauseContainerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *agentServiceClient) RemoveContainer(ctx context.Context, in *RemoveContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/RemoveContainer", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PauseContainerRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContainerId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i += copy(dAtA[i:], m.ContainerId)
+func (c *agentServiceClient) ExecProcess(ctx context.Context, in *ExecProcessRequest, opts ...grpc1.CallOption) (*ExecProcessResponse, error) {
+	out := new(ExecProcessResponse)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/ExecProcess", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ResumeContainerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *agentServiceClient) SignalProcess(ctx context.Context, in *SignalProcessRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/SignalProcess", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ResumeContainerRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContainerId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i += copy(dAtA[i:], m.ContainerId)
+func (c *agentServiceClient) WaitProcess(ctx context.Context, in *WaitProcessRequest, opts ...grpc1.CallOption) (*WaitProcessResponse, error) {
+	out := new(WaitProcessResponse)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/WaitProcess", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *CpuUsage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *agentServiceClient) ListProcesses(ctx context.Context, in *ListProcessesRequest, opts ...grpc1.CallOption) (*ListProcessesResponse, error) {
+	out := new(ListProcessesResponse)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/ListProcesses", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *CpuUsage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.TotalUsage != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.TotalUsage))
+func (c *agentServiceClient) GetProcessEnv(ctx context.Context, in *GetProcessEnvRequest, opts ...grpc1.CallOption) (*ProcessEnv, error) {
+	out := new(ProcessEnv)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/GetProcessEnv", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PercpuUsage) > 0 {
-		dAtA7 := make([]byte, len(m.PercpuUsage)*10)
-		var j6 int
-		for _, num := range m.PercpuUsage {
-			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j6++
-			}
-			dAtA7[j6] = uint8(num)
-			j6++
-		}
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(j6))
-		i += copy(dAtA[i:], dAtA7[:j6])
-	}
-	if m.UsageInKernelmode != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.UsageInKernelmode))
-	}
-	if m.UsageInUsermode != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.UsageInUsermode))
-	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ThrottlingData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *agentServiceClient) GetProcessFiles(ctx context.Context, in *GetProcessFilesRequest, opts ...grpc1.CallOption) (*ProcessFiles, error) {
+	out := new(ProcessFiles)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/GetProcessFiles", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ThrottlingData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Periods != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Periods))
-	}
-	if m.ThrottledPeriods != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.ThrottledPeriods))
-	}
-	if m.ThrottledTime != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.ThrottledTime))
+func (c *agentServiceClient) ListContainerMounts(ctx context.Context, in *ListContainerMountsRequest, opts ...grpc1.CallOption) (*ContainerMounts, error) {
+	out := new(ContainerMounts)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/ListContainerMounts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *CpuStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *agentServiceClient) ExposeContainerRootfs(ctx context.Context, in *ExposeContainerRootfsRequest, opts ...grpc1.CallOption) (*ExposeContainerRootfsResponse, error) {
+	out := new(ExposeContainerRootfsResponse)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/ExposeContainerRootfs", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *CpuStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.CpuUsage != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.CpuUsage.Size()))
-		n8, err := m.CpuUsage.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
-	}
-	if m.ThrottlingData != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.ThrottlingData.Size()))
-		n9, err := m.ThrottlingData.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
+func (c *agentServiceClient) UnexposeContainerRootfs(ctx context.Context, in *UnexposeContainerRootfsRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/UnexposeContainerRootfs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *PidsStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *agentServiceClient) ReadContainerRootfs(ctx context.Context, in *ReadContainerRootfsRequest, opts ...grpc1.CallOption) (*ContainerRootfsFile, error) {
+	out := new(ContainerRootfsFile)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/ReadContainerRootfs", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PidsStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Current != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Current))
-	}
-	if m.Limit != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Limit))
+func (c *agentServiceClient) UpdateContainer(ctx context.Context, in *UpdateContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/UpdateContainer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *MemoryData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *agentServiceClient) StatsContainer(ctx context.Context, in *StatsContainerRequest, opts ...grpc1.CallOption) (*StatsContainerResponse, error) {
+	out := new(StatsContainerResponse)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/StatsContainer", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MemoryData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Usage != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Usage))
-	}
-	if m.MaxUsage != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.MaxUsage))
-	}
-	if m.Failcnt != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Failcnt))
-	}
-	if m.Limit != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Limit))
+func (c *agentServiceClient) StatsSandbox(ctx context.Context, in *StatsSandboxRequest, opts ...grpc1.CallOption) (*StatsSandboxResponse, error) {
+	out := new(StatsSandboxResponse)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/StatsSandbox", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *MemoryStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *agentServiceClient) MemoryStatContainer(ctx context.Context, in *MemoryStatContainerRequest, opts ...grpc1.CallOption) (*MemoryStatContainerResponse, error) {
+	out := new(MemoryStatContainerResponse)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/MemoryStatContainer", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MemoryStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Cache != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Cache))
-	}
-	if m.Usage != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Usage.Size()))
-		n10, err := m.Usage.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
+func (c *agentServiceClient) PauseContainer(ctx context.Context, in *PauseContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/PauseContainer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.SwapUsage != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.SwapUsage.Size()))
-		n11, err := m.SwapUsage.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
+	return out, nil
+}
+
+func (c *agentServiceClient) ResumeContainer(ctx context.Context, in *ResumeContainerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/ResumeContainer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.KernelUsage != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.KernelUsage.Size()))
-		n12, err := m.KernelUsage.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n12
+	return out, nil
+}
+
+func (c *agentServiceClient) WriteStdin(ctx context.Context, in *WriteStreamRequest, opts ...grpc1.CallOption) (*WriteStreamResponse, error) {
+	out := new(WriteStreamResponse)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/WriteStdin", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.UseHierarchy {
-		dAtA[i] = 0x28
-		i++
-		if m.UseHierarchy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return out, nil
+}
+
+func (c *agentServiceClient) ReadStdout(ctx context.Context, in *ReadStreamRequest, opts ...grpc1.CallOption) (*ReadStreamResponse, error) {
+	out := new(ReadStreamResponse)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/ReadStdout", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Stats) > 0 {
-		for k, _ := range m.Stats {
-			dAtA[i] = 0x32
-			i++
-			v := m.Stats[k]
-			mapSize := 1 + len(k) + sovAgent(uint64(len(k))) + 1 + sovAgent(uint64(v))
-			i = encodeVarintAgent(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintAgent(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x10
-			i++
-			i = encodeVarintAgent(dAtA, i, uint64(v))
-		}
+	return out, nil
+}
+
+func (c *agentServiceClient) ReadStderr(ctx context.Context, in *ReadStreamRequest, opts ...grpc1.CallOption) (*ReadStreamResponse, error) {
+	out := new(ReadStreamResponse)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/ReadStderr", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *BlkioStatsEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *agentServiceClient) CloseStdin(ctx context.Context, in *CloseStdinRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/CloseStdin", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *BlkioStatsEntry) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Major != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Major))
-	}
-	if m.Minor != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Minor))
+func (c *agentServiceClient) TtyWinResize(ctx context.Context, in *TtyWinResizeRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/TtyWinResize", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Op) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Op)))
-		i += copy(dAtA[i:], m.Op)
+	return out, nil
+}
+
+func (c *agentServiceClient) UpdateInterface(ctx context.Context, in *UpdateInterfaceRequest, opts ...grpc1.CallOption) (*types.Interface, error) {
+	out := new(types.Interface)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/UpdateInterface", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Value != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Value))
+	return out, nil
+}
+
+func (c *agentServiceClient) UpdateRoutes(ctx context.Context, in *UpdateRoutesRequest, opts ...grpc1.CallOption) (*Routes, error) {
+	out := new(Routes)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/UpdateRoutes", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *BlkioStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *agentServiceClient) ListInterfaces(ctx context.Context, in *ListInterfacesRequest, opts ...grpc1.CallOption) (*Interfaces, error) {
+	out := new(Interfaces)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/ListInterfaces", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *BlkioStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.IoServiceBytesRecursive) > 0 {
-		for _, msg := range m.IoServiceBytesRecursive {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (c *agentServiceClient) ListRoutes(ctx context.Context, in *ListRoutesRequest, opts ...grpc1.CallOption) (*Routes, error) {
+	out := new(Routes)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/ListRoutes", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.IoServicedRecursive) > 0 {
-		for _, msg := range m.IoServicedRecursive {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return out, nil
+}
+
+func (c *agentServiceClient) UpdateSourceRouting(ctx context.Context, in *UpdateSourceRoutingRequest, opts ...grpc1.CallOption) (*SourceRouting, error) {
+	out := new(SourceRouting)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/UpdateSourceRouting", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.IoQueuedRecursive) > 0 {
-		for _, msg := range m.IoQueuedRecursive {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return out, nil
+}
+
+func (c *agentServiceClient) SetTransparentProxy(ctx context.Context, in *SetTransparentProxyRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/SetTransparentProxy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.IoServiceTimeRecursive) > 0 {
-		for _, msg := range m.IoServiceTimeRecursive {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return out, nil
+}
+
+func (c *agentServiceClient) RemoveTransparentProxy(ctx context.Context, in *RemoveTransparentProxyRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/RemoveTransparentProxy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.IoWaitTimeRecursive) > 0 {
-		for _, msg := range m.IoWaitTimeRecursive {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return out, nil
+}
+
+func (c *agentServiceClient) StartTracing(ctx context.Context, in *StartTracingRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/StartTracing", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.IoMergedRecursive) > 0 {
-		for _, msg := range m.IoMergedRecursive {
-			dAtA[i] = 0x32
-			i++
-			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return out, nil
+}
+
+func (c *agentServiceClient) StopTracing(ctx context.Context, in *StopTracingRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/StopTracing", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.IoTimeRecursive) > 0 {
-		for _, msg := range m.IoTimeRecursive {
-			dAtA[i] = 0x3a
-			i++
-			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return out, nil
+}
+
+func (c *agentServiceClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc1.CallOption) (*Profile, error) {
+	out := new(Profile)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/GetProfile", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.SectorsRecursive) > 0 {
-		for _, msg := range m.SectorsRecursive {
-			dAtA[i] = 0x42
-			i++
-			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return out, nil
+}
+
+func (c *agentServiceClient) CreateSandbox(ctx context.Context, in *CreateSandboxRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/CreateSandbox", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *HugetlbStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *agentServiceClient) DestroySandbox(ctx context.Context, in *DestroySandboxRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/DestroySandbox", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *HugetlbStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Usage != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Usage))
+func (c *agentServiceClient) OnlineCPUMem(ctx context.Context, in *OnlineCPUMemRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/OnlineCPUMem", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.MaxUsage != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.MaxUsage))
+	return out, nil
+}
+
+func (c *agentServiceClient) ReseedRandomDev(ctx context.Context, in *ReseedRandomDevRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/ReseedRandomDev", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Failcnt != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Failcnt))
+	return out, nil
+}
+
+func (c *agentServiceClient) GetGuestDetails(ctx context.Context, in *GuestDetailsRequest, opts ...grpc1.CallOption) (*GuestDetailsResponse, error) {
+	out := new(GuestDetailsResponse)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/GetGuestDetails", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *CgroupStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *agentServiceClient) MemHotplugByProbe(ctx context.Context, in *MemHotplugByProbeRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/MemHotplugByProbe", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *CgroupStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.CpuStats != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.CpuStats.Size()))
-		n13, err := m.CpuStats.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n13
-	}
-	if m.MemoryStats != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.MemoryStats.Size()))
-		n14, err := m.MemoryStats.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n14
-	}
-	if m.PidsStats != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.PidsStats.Size()))
-		n15, err := m.PidsStats.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n15
-	}
-	if m.BlkioStats != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.BlkioStats.Size()))
-		n16, err := m.BlkioStats.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n16
-	}
-	if len(m.HugetlbStats) > 0 {
-		for k, _ := range m.HugetlbStats {
-			dAtA[i] = 0x2a
-			i++
-			v := m.HugetlbStats[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovAgent(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovAgent(uint64(len(k))) + msgSize
-			i = encodeVarintAgent(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintAgent(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintAgent(dAtA, i, uint64(v.Size()))
-				n17, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n17
-			}
-		}
-	}
-	return i, nil
-}
-
-func (m *NetworkStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *agentServiceClient) SetGuestDateTime(ctx context.Context, in *SetGuestDateTimeRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/SetGuestDateTime", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *NetworkStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if m.RxBytes != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.RxBytes))
-	}
-	if m.RxPackets != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.RxPackets))
-	}
-	if m.RxErrors != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.RxErrors))
-	}
-	if m.RxDropped != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.RxDropped))
-	}
-	if m.TxBytes != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.TxBytes))
-	}
-	if m.TxPackets != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.TxPackets))
-	}
-	if m.TxErrors != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.TxErrors))
-	}
-	if m.TxDropped != 0 {
-		dAtA[i] = 0x48
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.TxDropped))
-	}
-	return i, nil
+	return out, nil
 }
 
-func (m *StatsContainerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *agentServiceClient) CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/CopyFile", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *StatsContainerResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.CgroupStats != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.CgroupStats.Size()))
-		n18, err := m.CgroupStats.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n18
-	}
-	if len(m.NetworkStats) > 0 {
-		for _, msg := range m.NetworkStats {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (c *agentServiceClient) ExtractLayer(ctx context.Context, in *ExtractLayerRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/ExtractLayer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *WriteStreamRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *agentServiceClient) CheckKernelModule(ctx context.Context, in *CheckKernelModuleRequest, opts ...grpc1.CallOption) (*KernelModuleStatus, error) {
+	out := new(KernelModuleStatus)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/CheckKernelModule", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *WriteStreamRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContainerId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i += copy(dAtA[i:], m.ContainerId)
-	}
-	if len(m.ExecId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
-		i += copy(dAtA[i:], m.ExecId)
-	}
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+func (c *agentServiceClient) RestartAgent(ctx context.Context, in *RestartAgentRequest, opts ...grpc1.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/RestartAgent", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *WriteStreamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *agentServiceClient) GetAPIVersion(ctx context.Context, in *GetAPIVersionRequest, opts ...grpc1.CallOption) (*APIVersionResponse, error) {
+	out := new(APIVersionResponse)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/GetAPIVersion", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *WriteStreamResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Len != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Len))
+func (c *agentServiceClient) GetGuestFilesystems(ctx context.Context, in *GuestFilesystemsRequest, opts ...grpc1.CallOption) (*GuestFilesystems, error) {
+	out := new(GuestFilesystems)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/GetGuestFilesystems", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ReadStreamRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *agentServiceClient) GetInitTimings(ctx context.Context, in *InitTimingsRequest, opts ...grpc1.CallOption) (*InitTimings, error) {
+	out := new(InitTimings)
+	err := grpc1.Invoke(ctx, "/grpc.AgentService/GetInitTimings", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ReadStreamRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContainerId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i += copy(dAtA[i:], m.ContainerId)
-	}
-	if len(m.ExecId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
-		i += copy(dAtA[i:], m.ExecId)
-	}
-	if m.Len != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Len))
-	}
-	return i, nil
-}
+// Server API for AgentService service
 
-func (m *ReadStreamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type AgentServiceServer interface {
+	// execution
+	CreateContainer(context.Context, *CreateContainerRequest) (*google_protobuf2.Empty, error)
+	StartContainer(context.Context, *StartContainerRequest) (*google_protobuf2.Empty, error)
+	// RemoveContainer will tear down an existing container by forcibly terminating
+	// all processes running inside that container and releasing all internal
+	// resources associated with it.
+	// RemoveContainer will wait for all processes termination before returning.
+	// If any process can not be killed or if it can not be killed after
+	// the RemoveContainerRequest timeout, RemoveContainer will return an error.
+	RemoveContainer(context.Context, *RemoveContainerRequest) (*google_protobuf2.Empty, error)
+	ExecProcess(context.Context, *ExecProcessRequest) (*ExecProcessResponse, error)
+	SignalProcess(context.Context, *SignalProcessRequest) (*google_protobuf2.Empty, error)
+	WaitProcess(context.Context, *WaitProcessRequest) (*WaitProcessResponse, error)
+	ListProcesses(context.Context, *ListProcessesRequest) (*ListProcessesResponse, error)
+	GetProcessEnv(context.Context, *GetProcessEnvRequest) (*ProcessEnv, error)
+	GetProcessFiles(context.Context, *GetProcessFilesRequest) (*ProcessFiles, error)
+	ListContainerMounts(context.Context, *ListContainerMountsRequest) (*ContainerMounts, error)
+	ExposeContainerRootfs(context.Context, *ExposeContainerRootfsRequest) (*ExposeContainerRootfsResponse, error)
+	UnexposeContainerRootfs(context.Context, *UnexposeContainerRootfsRequest) (*google_protobuf2.Empty, error)
+	ReadContainerRootfs(context.Context, *ReadContainerRootfsRequest) (*ContainerRootfsFile, error)
+	UpdateContainer(context.Context, *UpdateContainerRequest) (*google_protobuf2.Empty, error)
+	StatsContainer(context.Context, *StatsContainerRequest) (*StatsContainerResponse, error)
+	StatsSandbox(context.Context, *StatsSandboxRequest) (*StatsSandboxResponse, error)
+	MemoryStatContainer(context.Context, *MemoryStatContainerRequest) (*MemoryStatContainerResponse, error)
+	PauseContainer(context.Context, *PauseContainerRequest) (*google_protobuf2.Empty, error)
+	ResumeContainer(context.Context, *ResumeContainerRequest) (*google_protobuf2.Empty, error)
+	// stdio
+	WriteStdin(context.Context, *WriteStreamRequest) (*WriteStreamResponse, error)
+	ReadStdout(context.Context, *ReadStreamRequest) (*ReadStreamResponse, error)
+	ReadStderr(context.Context, *ReadStreamRequest) (*ReadStreamResponse, error)
+	CloseStdin(context.Context, *CloseStdinRequest) (*google_protobuf2.Empty, error)
+	TtyWinResize(context.Context, *TtyWinResizeRequest) (*google_protobuf2.Empty, error)
+	// networking
+	UpdateInterface(context.Context, *UpdateInterfaceRequest) (*types.Interface, error)
+	UpdateRoutes(context.Context, *UpdateRoutesRequest) (*Routes, error)
+	ListInterfaces(context.Context, *ListInterfacesRequest) (*Interfaces, error)
+	ListRoutes(context.Context, *ListRoutesRequest) (*Routes, error)
+	UpdateSourceRouting(context.Context, *UpdateSourceRoutingRequest) (*SourceRouting, error)
+	SetTransparentProxy(context.Context, *SetTransparentProxyRequest) (*google_protobuf2.Empty, error)
+	RemoveTransparentProxy(context.Context, *RemoveTransparentProxyRequest) (*google_protobuf2.Empty, error)
+	// tracing
+	StartTracing(context.Context, *StartTracingRequest) (*google_protobuf2.Empty, error)
+	StopTracing(context.Context, *StopTracingRequest) (*google_protobuf2.Empty, error)
+	GetProfile(context.Context, *GetProfileRequest) (*Profile, error)
+	// misc (TODO: some rpcs can be replaced by hyperstart-exec)
+	CreateSandbox(context.Context, *CreateSandboxRequest) (*google_protobuf2.Empty, error)
+	DestroySandbox(context.Context, *DestroySandboxRequest) (*google_protobuf2.Empty, error)
+	OnlineCPUMem(context.Context, *OnlineCPUMemRequest) (*google_protobuf2.Empty, error)
+	ReseedRandomDev(context.Context, *ReseedRandomDevRequest) (*google_protobuf2.Empty, error)
+	GetGuestDetails(context.Context, *GuestDetailsRequest) (*GuestDetailsResponse, error)
+	MemHotplugByProbe(context.Context, *MemHotplugByProbeRequest) (*google_protobuf2.Empty, error)
+	SetGuestDateTime(context.Context, *SetGuestDateTimeRequest) (*google_protobuf2.Empty, error)
+	CopyFile(context.Context, *CopyFileRequest) (*google_protobuf2.Empty, error)
+	ExtractLayer(context.Context, *ExtractLayerRequest) (*google_protobuf2.Empty, error)
+	CheckKernelModule(context.Context, *CheckKernelModuleRequest) (*KernelModuleStatus, error)
+	RestartAgent(context.Context, *RestartAgentRequest) (*google_protobuf2.Empty, error)
+	GetAPIVersion(context.Context, *GetAPIVersionRequest) (*APIVersionResponse, error)
+	GetGuestFilesystems(context.Context, *GuestFilesystemsRequest) (*GuestFilesystems, error)
+	GetInitTimings(context.Context, *InitTimingsRequest) (*InitTimings, error)
 }
 
-func (m *ReadStreamResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
-	return i, nil
+func RegisterAgentServiceServer(s *grpc1.Server, srv AgentServiceServer) {
+	s.RegisterService(&_AgentService_serviceDesc, srv)
 }
 
-func (m *CloseStdinRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_CreateContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateContainerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AgentServiceServer).CreateContainer(ctx, in)
+	}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/CreateContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).CreateContainer(ctx, req.(*CreateContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CloseStdinRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContainerId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i += copy(dAtA[i:], m.ContainerId)
+func _AgentService_StartContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ExecId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
-		i += copy(dAtA[i:], m.ExecId)
+	if interceptor == nil {
+		return srv.(AgentServiceServer).StartContainer(ctx, in)
 	}
-	return i, nil
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/StartContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).StartContainer(ctx, req.(*StartContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TtyWinResizeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_RemoveContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveContainerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AgentServiceServer).RemoveContainer(ctx, in)
+	}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/RemoveContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).RemoveContainer(ctx, req.(*RemoveContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TtyWinResizeRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContainerId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i += copy(dAtA[i:], m.ContainerId)
+func _AgentService_ExecProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecProcessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ExecId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
-		i += copy(dAtA[i:], m.ExecId)
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ExecProcess(ctx, in)
 	}
-	if m.Row != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Row))
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/ExecProcess",
 	}
-	if m.Column != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Column))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ExecProcess(ctx, req.(*ExecProcessRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *KernelModule) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_SignalProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalProcessRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AgentServiceServer).SignalProcess(ctx, in)
+	}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/SignalProcess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).SignalProcess(ctx, req.(*SignalProcessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *KernelModule) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+func _AgentService_WaitProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitProcessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Parameters) > 0 {
-		for _, s := range m.Parameters {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).WaitProcess(ctx, in)
 	}
-	return i, nil
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/WaitProcess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).WaitProcess(ctx, req.(*WaitProcessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CreateSandboxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_ListProcesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProcessesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ListProcesses(ctx, in)
+	}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/ListProcesses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ListProcesses(ctx, req.(*ListProcessesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CreateSandboxRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hostname) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Hostname)))
-		i += copy(dAtA[i:], m.Hostname)
+func _AgentService_GetProcessEnv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProcessEnvRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Dns) > 0 {
-		for _, s := range m.Dns {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).GetProcessEnv(ctx, in)
 	}
-	if len(m.Storages) > 0 {
-		for _, msg := range m.Storages {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/GetProcessEnv",
 	}
-	if m.SandboxPidns {
-		dAtA[i] = 0x20
-		i++
-		if m.SandboxPidns {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).GetProcessEnv(ctx, req.(*GetProcessEnvRequest))
 	}
-	if len(m.SandboxId) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.SandboxId)))
-		i += copy(dAtA[i:], m.SandboxId)
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_GetProcessFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProcessFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.GuestHookPath) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.GuestHookPath)))
-		i += copy(dAtA[i:], m.GuestHookPath)
+	if interceptor == nil {
+		return srv.(AgentServiceServer).GetProcessFiles(ctx, in)
 	}
-	if len(m.KernelModules) > 0 {
-		for _, msg := range m.KernelModules {
-			dAtA[i] = 0x3a
-			i++
-			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/GetProcessFiles",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).GetProcessFiles(ctx, req.(*GetProcessFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DestroySandboxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_ListContainerMounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContainerMountsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DestroySandboxRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ListContainerMounts(ctx, in)
+	}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/ListContainerMounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ListContainerMounts(ctx, req.(*ListContainerMountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Interfaces) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_ExposeContainerRootfs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExposeContainerRootfsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Interfaces) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Interfaces) > 0 {
-		for _, msg := range m.Interfaces {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ExposeContainerRootfs(ctx, in)
 	}
-	return i, nil
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/ExposeContainerRootfs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ExposeContainerRootfs(ctx, req.(*ExposeContainerRootfsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Routes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_UnexposeContainerRootfs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnexposeContainerRootfsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Routes) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Routes) > 0 {
-		for _, msg := range m.Routes {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).UnexposeContainerRootfs(ctx, in)
 	}
-	return i, nil
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/UnexposeContainerRootfs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).UnexposeContainerRootfs(ctx, req.(*UnexposeContainerRootfsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateInterfaceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_ReadContainerRootfs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadContainerRootfsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateInterfaceRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Interface != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Interface.Size()))
-		n19, err := m.Interface.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n19
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ReadContainerRootfs(ctx, in)
 	}
-	return i, nil
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/ReadContainerRootfs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ReadContainerRootfs(ctx, req.(*ReadContainerRootfsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateRoutesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_UpdateContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContainerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AgentServiceServer).UpdateContainer(ctx, in)
+	}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/UpdateContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).UpdateContainer(ctx, req.(*UpdateContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Routes != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Routes.Size()))
-		n20, err := m.Routes.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n20
+func _AgentService_StatsContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return i, nil
+	if interceptor == nil {
+		return srv.(AgentServiceServer).StatsContainer(ctx, in)
+	}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/StatsContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).StatsContainer(ctx, req.(*StatsContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListInterfacesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_StatsSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsSandboxRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AgentServiceServer).StatsSandbox(ctx, in)
+	}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/StatsSandbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).StatsSandbox(ctx, req.(*StatsSandboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListInterfacesRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
-}
-
-func (m *ListRoutesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_MemoryStatContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemoryStatContainerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+	if interceptor == nil {
+		return srv.(AgentServiceServer).MemoryStatContainer(ctx, in)
+	}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/MemoryStatContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).MemoryStatContainer(ctx, req.(*MemoryStatContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OnlineCPUMemRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_PauseContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseContainerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OnlineCPUMemRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Wait {
-		dAtA[i] = 0x8
-		i++
-		if m.Wait {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if interceptor == nil {
+		return srv.(AgentServiceServer).PauseContainer(ctx, in)
 	}
-	if m.NbCpus != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.NbCpus))
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/PauseContainer",
 	}
-	if m.CpuOnly {
-		dAtA[i] = 0x18
-		i++
-		if m.CpuOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).PauseContainer(ctx, req.(*PauseContainerRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ReseedRandomDevRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_ResumeContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeContainerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ReseedRandomDevRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ResumeContainer(ctx, in)
 	}
-	return i, nil
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/ResumeContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ResumeContainer(ctx, req.(*ResumeContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AgentDetails) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_WriteStdin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteStreamRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AgentServiceServer).WriteStdin(ctx, in)
+	}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/WriteStdin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).WriteStdin(ctx, req.(*WriteStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AgentDetails) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Version) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Version)))
-		i += copy(dAtA[i:], m.Version)
-	}
-	if m.InitDaemon {
-		dAtA[i] = 0x10
-		i++
-		if m.InitDaemon {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func _AgentService_ReadStdout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.DeviceHandlers) > 0 {
-		for _, s := range m.DeviceHandlers {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ReadStdout(ctx, in)
 	}
-	if len(m.StorageHandlers) > 0 {
-		for _, s := range m.StorageHandlers {
-			dAtA[i] = 0x22
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/ReadStdout",
 	}
-	if m.SupportsSeccomp {
-		dAtA[i] = 0x28
-		i++
-		if m.SupportsSeccomp {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ReadStdout(ctx, req.(*ReadStreamRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GuestDetailsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_ReadStderr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadStreamRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GuestDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.MemBlockSize {
-		dAtA[i] = 0x8
-		i++
-		if m.MemBlockSize {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ReadStderr(ctx, in)
 	}
-	if m.MemHotplugProbe {
-		dAtA[i] = 0x10
-		i++
-		if m.MemHotplugProbe {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/ReadStderr",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ReadStderr(ctx, req.(*ReadStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GuestDetailsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_CloseStdin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseStdinRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GuestDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.MemBlockSizeBytes != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.MemBlockSizeBytes))
+	if interceptor == nil {
+		return srv.(AgentServiceServer).CloseStdin(ctx, in)
 	}
-	if m.AgentDetails != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.AgentDetails.Size()))
-		n21, err := m.AgentDetails.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n21
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/CloseStdin",
 	}
-	if m.SupportMemHotplugProbe {
-		dAtA[i] = 0x18
-		i++
-		if m.SupportMemHotplugProbe {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).CloseStdin(ctx, req.(*CloseStdinRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MemHotplugByProbeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_TtyWinResize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TtyWinResizeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MemHotplugByProbeRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.MemHotplugProbeAddr) > 0 {
-		dAtA23 := make([]byte, len(m.MemHotplugProbeAddr)*10)
-		var j22 int
-		for _, num := range m.MemHotplugProbeAddr {
-			for num >= 1<<7 {
-				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j22++
-			}
-			dAtA23[j22] = uint8(num)
-			j22++
-		}
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(j22))
-		i += copy(dAtA[i:], dAtA23[:j22])
+	if interceptor == nil {
+		return srv.(AgentServiceServer).TtyWinResize(ctx, in)
 	}
-	return i, nil
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/TtyWinResize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).TtyWinResize(ctx, req.(*TtyWinResizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SetGuestDateTimeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_UpdateInterface_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInterfaceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AgentServiceServer).UpdateInterface(ctx, in)
+	}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/UpdateInterface",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).UpdateInterface(ctx, req.(*UpdateInterfaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SetGuestDateTimeRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Sec != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Sec))
+func _AgentService_UpdateRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Usec != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Usec))
+	if interceptor == nil {
+		return srv.(AgentServiceServer).UpdateRoutes(ctx, in)
 	}
-	return i, nil
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/UpdateRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).UpdateRoutes(ctx, req.(*UpdateRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Storage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_ListInterfaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInterfacesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ListInterfaces(ctx, in)
+	}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/ListInterfaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ListInterfaces(ctx, req.(*ListInterfacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Storage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Driver) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Driver)))
-		i += copy(dAtA[i:], m.Driver)
+func _AgentService_ListRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.DriverOptions) > 0 {
-		for _, s := range m.DriverOptions {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ListRoutes(ctx, in)
 	}
-	if len(m.Source) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Source)))
-		i += copy(dAtA[i:], m.Source)
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/ListRoutes",
 	}
-	if len(m.Fstype) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Fstype)))
-		i += copy(dAtA[i:], m.Fstype)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ListRoutes(ctx, req.(*ListRoutesRequest))
 	}
-	if len(m.Options) > 0 {
-		for _, s := range m.Options {
-			dAtA[i] = 0x2a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_UpdateSourceRouting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSourceRoutingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.MountPoint) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.MountPoint)))
-		i += copy(dAtA[i:], m.MountPoint)
+	if interceptor == nil {
+		return srv.(AgentServiceServer).UpdateSourceRouting(ctx, in)
 	}
-	return i, nil
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/UpdateSourceRouting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).UpdateSourceRouting(ctx, req.(*UpdateSourceRoutingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Device) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_SetTransparentProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransparentProxyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AgentServiceServer).SetTransparentProxy(ctx, in)
+	}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/SetTransparentProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).SetTransparentProxy(ctx, req.(*SetTransparentProxyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Device) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+func _AgentService_RemoveTransparentProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTransparentProxyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Type) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Type)))
-		i += copy(dAtA[i:], m.Type)
+	if interceptor == nil {
+		return srv.(AgentServiceServer).RemoveTransparentProxy(ctx, in)
 	}
-	if len(m.VmPath) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.VmPath)))
-		i += copy(dAtA[i:], m.VmPath)
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/RemoveTransparentProxy",
 	}
-	if len(m.ContainerPath) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerPath)))
-		i += copy(dAtA[i:], m.ContainerPath)
-	}
-	if len(m.Options) > 0 {
-		for _, s := range m.Options {
-			dAtA[i] = 0x2a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).RemoveTransparentProxy(ctx, req.(*RemoveTransparentProxyRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StringUser) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_StartTracing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTracingRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *StringUser) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Uid) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Uid)))
-		i += copy(dAtA[i:], m.Uid)
+	if interceptor == nil {
+		return srv.(AgentServiceServer).StartTracing(ctx, in)
 	}
-	if len(m.Gid) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Gid)))
-		i += copy(dAtA[i:], m.Gid)
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/StartTracing",
 	}
-	if len(m.AdditionalGids) > 0 {
-		for _, s := range m.AdditionalGids {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).StartTracing(ctx, req.(*StartTracingRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CopyFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_StopTracing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopTracingRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AgentServiceServer).StopTracing(ctx, in)
+	}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/StopTracing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).StopTracing(ctx, req.(*StopTracingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CopyFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Path) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Path)))
-		i += copy(dAtA[i:], m.Path)
+func _AgentService_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.FileSize != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.FileSize))
+	if interceptor == nil {
+		return srv.(AgentServiceServer).GetProfile(ctx, in)
 	}
-	if m.FileMode != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.FileMode))
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/GetProfile",
 	}
-	if m.DirMode != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.DirMode))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).GetProfile(ctx, req.(*GetProfileRequest))
 	}
-	if m.Uid != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Uid))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_CreateSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSandboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Gid != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Gid))
+	if interceptor == nil {
+		return srv.(AgentServiceServer).CreateSandbox(ctx, in)
 	}
-	if m.Offset != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(m.Offset))
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/CreateSandbox",
 	}
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).CreateSandbox(ctx, req.(*CreateSandboxRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StartTracingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_DestroySandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DestroySandboxRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *StartTracingRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+	if interceptor == nil {
+		return srv.(AgentServiceServer).DestroySandbox(ctx, in)
+	}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/DestroySandbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).DestroySandbox(ctx, req.(*DestroySandboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StopTracingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AgentService_OnlineCPUMem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnlineCPUMemRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AgentServiceServer).OnlineCPUMem(ctx, in)
+	}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/OnlineCPUMem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).OnlineCPUMem(ctx, req.(*OnlineCPUMemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StopTracingRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+func _AgentService_ReseedRandomDev_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReseedRandomDevRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ReseedRandomDev(ctx, in)
+	}
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/ReseedRandomDev",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ReseedRandomDev(ctx, req.(*ReseedRandomDevRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintAgent(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _AgentService_GetGuestDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *CreateContainerRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if interceptor == nil {
+		return srv.(AgentServiceServer).GetGuestDetails(ctx, in)
 	}
-	l = len(m.ExecId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/GetGuestDetails",
 	}
-	if m.StringUser != nil {
-		l = m.StringUser.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).GetGuestDetails(ctx, req.(*GuestDetailsRequest))
 	}
-	if len(m.Devices) > 0 {
-		for _, e := range m.Devices {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_MemHotplugByProbe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemHotplugByProbeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Storages) > 0 {
-		for _, e := range m.Storages {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).MemHotplugByProbe(ctx, in)
 	}
-	if m.OCI != nil {
-		l = m.OCI.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/MemHotplugByProbe",
 	}
-	if m.SandboxPidns {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).MemHotplugByProbe(ctx, req.(*MemHotplugByProbeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StartContainerRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+func _AgentService_SetGuestDateTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGuestDateTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *RemoveContainerRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if interceptor == nil {
+		return srv.(AgentServiceServer).SetGuestDateTime(ctx, in)
 	}
-	if m.Timeout != 0 {
-		n += 1 + sovAgent(uint64(m.Timeout))
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/SetGuestDateTime",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).SetGuestDateTime(ctx, req.(*SetGuestDateTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ExecProcessRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+func _AgentService_CopyFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ExecId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if interceptor == nil {
+		return srv.(AgentServiceServer).CopyFile(ctx, in)
 	}
-	if m.StringUser != nil {
-		l = m.StringUser.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/CopyFile",
 	}
-	if m.Process != nil {
-		l = m.Process.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).CopyFile(ctx, req.(*CopyFileRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SignalProcessRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.ExecId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+func _AgentService_ExtractLayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtractLayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Signal != 0 {
-		n += 1 + sovAgent(uint64(m.Signal))
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ExtractLayer(ctx, in)
 	}
-	return n
-}
-
-func (m *WaitProcessRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/ExtractLayer",
 	}
-	l = len(m.ExecId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ExtractLayer(ctx, req.(*ExtractLayerRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WaitProcessResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovAgent(uint64(m.Status))
+func _AgentService_CheckKernelModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckKernelModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *ListProcessesRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if interceptor == nil {
+		return srv.(AgentServiceServer).CheckKernelModule(ctx, in)
 	}
-	l = len(m.Format)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/CheckKernelModule",
 	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).CheckKernelModule(ctx, req.(*CheckKernelModuleRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListProcessesResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ProcessList)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+func _AgentService_RestartAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *UpdateContainerRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if interceptor == nil {
+		return srv.(AgentServiceServer).RestartAgent(ctx, in)
 	}
-	if m.Resources != nil {
-		l = m.Resources.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/RestartAgent",
 	}
-	return n
-}
-
-func (m *StatsContainerRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).RestartAgent(ctx, req.(*RestartAgentRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PauseContainerRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+func _AgentService_GetAPIVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAPIVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *ResumeContainerRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if interceptor == nil {
+		return srv.(AgentServiceServer).GetAPIVersion(ctx, in)
 	}
-	return n
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/GetAPIVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).GetAPIVersion(ctx, req.(*GetAPIVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CpuUsage) Size() (n int) {
-	var l int
-	_ = l
-	if m.TotalUsage != 0 {
-		n += 1 + sovAgent(uint64(m.TotalUsage))
+func _AgentService_GetGuestFilesystems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFilesystemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.PercpuUsage) > 0 {
-		l = 0
-		for _, e := range m.PercpuUsage {
-			l += sovAgent(uint64(e))
-		}
-		n += 1 + sovAgent(uint64(l)) + l
+	if interceptor == nil {
+		return srv.(AgentServiceServer).GetGuestFilesystems(ctx, in)
 	}
-	if m.UsageInKernelmode != 0 {
-		n += 1 + sovAgent(uint64(m.UsageInKernelmode))
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/GetGuestFilesystems",
 	}
-	if m.UsageInUsermode != 0 {
-		n += 1 + sovAgent(uint64(m.UsageInUsermode))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).GetGuestFilesystems(ctx, req.(*GuestFilesystemsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ThrottlingData) Size() (n int) {
-	var l int
-	_ = l
-	if m.Periods != 0 {
-		n += 1 + sovAgent(uint64(m.Periods))
+func _AgentService_GetInitTimings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc1.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitTimingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ThrottledPeriods != 0 {
-		n += 1 + sovAgent(uint64(m.ThrottledPeriods))
+	if interceptor == nil {
+		return srv.(AgentServiceServer).GetInitTimings(ctx, in)
 	}
-	if m.ThrottledTime != 0 {
-		n += 1 + sovAgent(uint64(m.ThrottledTime))
+	info := &grpc1.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.AgentService/GetInitTimings",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).GetInitTimings(ctx, req.(*InitTimingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CpuStats) Size() (n int) {
-	var l int
-	_ = l
-	if m.CpuUsage != nil {
-		l = m.CpuUsage.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.ThrottlingData != nil {
-		l = m.ThrottlingData.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	return n
-}
-
-func (m *PidsStats) Size() (n int) {
-	var l int
-	_ = l
-	if m.Current != 0 {
-		n += 1 + sovAgent(uint64(m.Current))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovAgent(uint64(m.Limit))
-	}
-	return n
+var _AgentService_serviceDesc = grpc1.ServiceDesc{
+	ServiceName: "grpc.AgentService",
+	HandlerType: (*AgentServiceServer)(nil),
+	Methods: []grpc1.MethodDesc{
+		{
+			MethodName: "CreateContainer",
+			Handler:    _AgentService_CreateContainer_Handler,
+		},
+		{
+			MethodName: "StartContainer",
+			Handler:    _AgentService_StartContainer_Handler,
+		},
+		{
+			MethodName: "RemoveContainer",
+			Handler:    _AgentService_RemoveContainer_Handler,
+		},
+		{
+			MethodName: "ExecProcess",
+			Handler:    _AgentService_ExecProcess_Handler,
+		},
+		{
+			MethodName: "SignalProcess",
+			Handler:    _AgentService_SignalProcess_Handler,
+		},
+		{
+			MethodName: "WaitProcess",
+			Handler:    _AgentService_WaitProcess_Handler,
+		},
+		{
+			MethodName: "ListProcesses",
+			Handler:    _AgentService_ListProcesses_Handler,
+		},
+		{
+			MethodName: "GetProcessEnv",
+			Handler:    _AgentService_GetProcessEnv_Handler,
+		},
+		{
+			MethodName: "GetProcessFiles",
+			Handler:    _AgentService_GetProcessFiles_Handler,
+		},
+		{
+			MethodName: "ListContainerMounts",
+			Handler:    _AgentService_ListContainerMounts_Handler,
+		},
+		{
+			MethodName: "ExposeContainerRootfs",
+			Handler:    _AgentService_ExposeContainerRootfs_Handler,
+		},
+		{
+			MethodName: "UnexposeContainerRootfs",
+			Handler:    _AgentService_UnexposeContainerRootfs_Handler,
+		},
+		{
+			MethodName: "ReadContainerRootfs",
+			Handler:    _AgentService_ReadContainerRootfs_Handler,
+		},
+		{
+			MethodName: "UpdateContainer",
+			Handler:    _AgentService_UpdateContainer_Handler,
+		},
+		{
+			MethodName: "StatsContainer",
+			Handler:    _AgentService_StatsContainer_Handler,
+		},
+		{
+			MethodName: "StatsSandbox",
+			Handler:    _AgentService_StatsSandbox_Handler,
+		},
+		{
+			MethodName: "MemoryStatContainer",
+			Handler:    _AgentService_MemoryStatContainer_Handler,
+		},
+		{
+			MethodName: "PauseContainer",
+			Handler:    _AgentService_PauseContainer_Handler,
+		},
+		{
+			MethodName: "ResumeContainer",
+			Handler:    _AgentService_ResumeContainer_Handler,
+		},
+		{
+			MethodName: "WriteStdin",
+			Handler:    _AgentService_WriteStdin_Handler,
+		},
+		{
+			MethodName: "ReadStdout",
+			Handler:    _AgentService_ReadStdout_Handler,
+		},
+		{
+			MethodName: "ReadStderr",
+			Handler:    _AgentService_ReadStderr_Handler,
+		},
+		{
+			MethodName: "CloseStdin",
+			Handler:    _AgentService_CloseStdin_Handler,
+		},
+		{
+			MethodName: "TtyWinResize",
+			Handler:    _AgentService_TtyWinResize_Handler,
+		},
+		{
+			MethodName: "UpdateInterface",
+			Handler:    _AgentService_UpdateInterface_Handler,
+		},
+		{
+			MethodName: "UpdateRoutes",
+			Handler:    _AgentService_UpdateRoutes_Handler,
+		},
+		{
+			MethodName: "ListInterfaces",
+			Handler:    _AgentService_ListInterfaces_Handler,
+		},
+		{
+			MethodName: "ListRoutes",
+			Handler:    _AgentService_ListRoutes_Handler,
+		},
+		{
+			MethodName: "UpdateSourceRouting",
+			Handler:    _AgentService_UpdateSourceRouting_Handler,
+		},
+		{
+			MethodName: "SetTransparentProxy",
+			Handler:    _AgentService_SetTransparentProxy_Handler,
+		},
+		{
+			MethodName: "RemoveTransparentProxy",
+			Handler:    _AgentService_RemoveTransparentProxy_Handler,
+		},
+		{
+			MethodName: "StartTracing",
+			Handler:    _AgentService_StartTracing_Handler,
+		},
+		{
+			MethodName: "StopTracing",
+			Handler:    _AgentService_StopTracing_Handler,
+		},
+		{
+			MethodName: "GetProfile",
+			Handler:    _AgentService_GetProfile_Handler,
+		},
+		{
+			MethodName: "CreateSandbox",
+			Handler:    _AgentService_CreateSandbox_Handler,
+		},
+		{
+			MethodName: "DestroySandbox",
+			Handler:    _AgentService_DestroySandbox_Handler,
+		},
+		{
+			MethodName: "OnlineCPUMem",
+			Handler:    _AgentService_OnlineCPUMem_Handler,
+		},
+		{
+			MethodName: "ReseedRandomDev",
+			Handler:    _AgentService_ReseedRandomDev_Handler,
+		},
+		{
+			MethodName: "GetGuestDetails",
+			Handler:    _AgentService_GetGuestDetails_Handler,
+		},
+		{
+			MethodName: "MemHotplugByProbe",
+			Handler:    _AgentService_MemHotplugByProbe_Handler,
+		},
+		{
+			MethodName: "SetGuestDateTime",
+			Handler:    _AgentService_SetGuestDateTime_Handler,
+		},
+		{
+			MethodName: "CopyFile",
+			Handler:    _AgentService_CopyFile_Handler,
+		},
+		{
+			MethodName: "ExtractLayer",
+			Handler:    _AgentService_ExtractLayer_Handler,
+		},
+		{
+			MethodName: "CheckKernelModule",
+			Handler:    _AgentService_CheckKernelModule_Handler,
+		},
+		{
+			MethodName: "RestartAgent",
+			Handler:    _AgentService_RestartAgent_Handler,
+		},
+		{
+			MethodName: "GetAPIVersion",
+			Handler:    _AgentService_GetAPIVersion_Handler,
+		},
+		{
+			MethodName: "GetGuestFilesystems",
+			Handler:    _AgentService_GetGuestFilesystems_Handler,
+		},
+		{
+			MethodName: "GetInitTimings",
+			Handler:    _AgentService_GetInitTimings_Handler,
+		},
+	},
+	Streams:  []grpc1.StreamDesc{},
+	Metadata: "agent.proto",
 }
 
-func (m *MemoryData) Size() (n int) {
-	var l int
-	_ = l
-	if m.Usage != 0 {
-		n += 1 + sovAgent(uint64(m.Usage))
-	}
-	if m.MaxUsage != 0 {
-		n += 1 + sovAgent(uint64(m.MaxUsage))
-	}
-	if m.Failcnt != 0 {
-		n += 1 + sovAgent(uint64(m.Failcnt))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovAgent(uint64(m.Limit))
+func (m *CreateContainerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MemoryStats) Size() (n int) {
+func (m *CreateContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Cache != 0 {
-		n += 1 + sovAgent(uint64(m.Cache))
-	}
-	if m.Usage != nil {
-		l = m.Usage.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.SwapUsage != nil {
-		l = m.SwapUsage.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
 	}
-	if m.KernelUsage != nil {
-		l = m.KernelUsage.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.ExecId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
+		i += copy(dAtA[i:], m.ExecId)
 	}
-	if m.UseHierarchy {
-		n += 2
+	if m.StringUser != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.StringUser.Size()))
+		n1, err := m.StringUser.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
 	}
-	if len(m.Stats) > 0 {
-		for k, v := range m.Stats {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovAgent(uint64(len(k))) + 1 + sovAgent(uint64(v))
-			n += mapEntrySize + 1 + sovAgent(uint64(mapEntrySize))
+	if len(m.Devices) > 0 {
+		for _, msg := range m.Devices {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
-}
-
-func (m *BlkioStatsEntry) Size() (n int) {
-	var l int
-	_ = l
-	if m.Major != 0 {
-		n += 1 + sovAgent(uint64(m.Major))
-	}
-	if m.Minor != 0 {
-		n += 1 + sovAgent(uint64(m.Minor))
-	}
-	l = len(m.Op)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.Storages) > 0 {
+		for _, msg := range m.Storages {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.Value != 0 {
-		n += 1 + sovAgent(uint64(m.Value))
+	if m.OCI != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.OCI.Size()))
+		n2, err := m.OCI.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
 	}
-	return n
-}
-
-func (m *BlkioStats) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.IoServiceBytesRecursive) > 0 {
-		for _, e := range m.IoServiceBytesRecursive {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+	if m.SandboxPidns {
+		dAtA[i] = 0x38
+		i++
+		if m.SandboxPidns {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if len(m.IoServicedRecursive) > 0 {
-		for _, e := range m.IoServicedRecursive {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+	if m.CgroupNs {
+		dAtA[i] = 0x40
+		i++
+		if m.CgroupNs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if len(m.IoQueuedRecursive) > 0 {
-		for _, e := range m.IoQueuedRecursive {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+	if len(m.DnsSearch) > 0 {
+		for _, s := range m.DnsSearch {
+			dAtA[i] = 0x4a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.IoServiceTimeRecursive) > 0 {
-		for _, e := range m.IoServiceTimeRecursive {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+	if len(m.DnsOptions) > 0 {
+		for _, s := range m.DnsOptions {
+			dAtA[i] = 0x52
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.IoWaitTimeRecursive) > 0 {
-		for _, e := range m.IoWaitTimeRecursive {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+	if m.DiskQuota != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.DiskQuota))
+	}
+	if len(m.ProcSysWritable) > 0 {
+		for _, s := range m.ProcSysWritable {
+			dAtA[i] = 0x62
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.IoMergedRecursive) > 0 {
-		for _, e := range m.IoMergedRecursive {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+	if m.OutputLog != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.OutputLog.Size()))
+		n3, err := m.OutputLog.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n3
 	}
-	if len(m.IoTimeRecursive) > 0 {
-		for _, e := range m.IoTimeRecursive {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+	if len(m.ApparmorProfile) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ApparmorProfile)))
+		i += copy(dAtA[i:], m.ApparmorProfile)
+	}
+	if m.OutputTimestamps != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.OutputTimestamps.Size()))
+		n31, err := m.OutputTimestamps.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n31
 	}
-	if len(m.SectorsRecursive) > 0 {
-		for _, e := range m.SectorsRecursive {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+	if len(m.ImageEnv) > 0 {
+		for _, s := range m.ImageEnv {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *HugetlbStats) Size() (n int) {
+func (m *OutputLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutputLog) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Usage != 0 {
-		n += 1 + sovAgent(uint64(m.Usage))
+	if len(m.Directory) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Directory)))
+		i += copy(dAtA[i:], m.Directory)
 	}
-	if m.MaxUsage != 0 {
-		n += 1 + sovAgent(uint64(m.MaxUsage))
+	if m.MaxSize != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.MaxSize))
 	}
-	if m.Failcnt != 0 {
-		n += 1 + sovAgent(uint64(m.Failcnt))
+	if m.MaxBackups != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.MaxBackups))
 	}
-	return n
+	return i, nil
 }
 
-func (m *CgroupStats) Size() (n int) {
+func (m *OutputTimestamps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutputTimestamps) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.CpuStats != nil {
-		l = m.CpuStats.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.MemoryStats != nil {
-		l = m.MemoryStats.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.PidsStats != nil {
-		l = m.PidsStats.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.BlkioStats != nil {
-		l = m.BlkioStats.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if m.Stdout {
+		dAtA[i] = 0x8
+		i++
+		if m.Stdout {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if len(m.HugetlbStats) > 0 {
-		for k, v := range m.HugetlbStats {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovAgent(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovAgent(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovAgent(uint64(mapEntrySize))
+	if m.Stderr {
+		dAtA[i] = 0x10
+		i++
+		if m.Stderr {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	return n
+	return i, nil
 }
 
-func (m *NetworkStats) Size() (n int) {
+func (m *StartContainerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.RxBytes != 0 {
-		n += 1 + sovAgent(uint64(m.RxBytes))
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
 	}
-	if m.RxPackets != 0 {
-		n += 1 + sovAgent(uint64(m.RxPackets))
+	if m.NetworkReadyTimeout != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.NetworkReadyTimeout))
 	}
-	if m.RxErrors != 0 {
-		n += 1 + sovAgent(uint64(m.RxErrors))
+	if m.StartTimeout != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.StartTimeout))
 	}
-	if m.RxDropped != 0 {
-		n += 1 + sovAgent(uint64(m.RxDropped))
-	}
-	if m.TxBytes != 0 {
-		n += 1 + sovAgent(uint64(m.TxBytes))
-	}
-	if m.TxPackets != 0 {
-		n += 1 + sovAgent(uint64(m.TxPackets))
-	}
-	if m.TxErrors != 0 {
-		n += 1 + sovAgent(uint64(m.TxErrors))
-	}
-	if m.TxDropped != 0 {
-		n += 1 + sovAgent(uint64(m.TxDropped))
-	}
-	return n
-}
-
-func (m *StatsContainerResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.CgroupStats != nil {
-		l = m.CgroupStats.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if len(m.NetworkStats) > 0 {
-		for _, e := range m.NetworkStats {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
-	}
-	return n
-}
-
-func (m *WriteStreamRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.ExecId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	return n
+	return i, nil
 }
 
-func (m *WriteStreamResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Len != 0 {
-		n += 1 + sovAgent(uint64(m.Len))
+func (m *RemoveContainerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ReadStreamRequest) Size() (n int) {
+func (m *RemoveContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.ExecId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
 	}
-	if m.Len != 0 {
-		n += 1 + sovAgent(uint64(m.Len))
+	if m.Timeout != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Timeout))
 	}
-	return n
+	return i, nil
 }
 
-func (m *ReadStreamResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+func (m *ExecProcessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CloseStdinRequest) Size() (n int) {
+func (m *ExecProcessRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.ExecId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
 	}
-	return n
-}
-
-func (m *TtyWinResizeRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.ExecId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
+		i += copy(dAtA[i:], m.ExecId)
 	}
-	l = len(m.ExecId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.StringUser != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.StringUser.Size()))
+		n4, err := m.StringUser.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
 	}
-	if m.Row != 0 {
-		n += 1 + sovAgent(uint64(m.Row))
+	if m.Process != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Process.Size()))
+		n5, err := m.Process.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
 	}
-	if m.Column != 0 {
-		n += 1 + sovAgent(uint64(m.Column))
+	if len(m.StdinPath) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.StdinPath)))
+		i += copy(dAtA[i:], m.StdinPath)
 	}
-	return n
-}
-
-func (m *KernelModule) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.Umask) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Umask)))
+		i += copy(dAtA[i:], m.Umask)
 	}
-	if len(m.Parameters) > 0 {
-		for _, s := range m.Parameters {
-			l = len(s)
-			n += 1 + l + sovAgent(uint64(l))
+	if m.StrictEnv {
+		dAtA[i] = 0x38
+		i++
+		if m.StrictEnv {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	return n
-}
-
-func (m *CreateSandboxRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Hostname)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if len(m.Dns) > 0 {
-		for _, s := range m.Dns {
-			l = len(s)
-			n += 1 + l + sovAgent(uint64(l))
+	if m.Setsid {
+		dAtA[i] = 0x40
+		i++
+		if m.Setsid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if len(m.Storages) > 0 {
-		for _, e := range m.Storages {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+	if m.Setpgid {
+		dAtA[i] = 0x48
+		i++
+		if m.Setpgid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if m.SandboxPidns {
-		n += 2
+	if m.OutputBuffering != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.OutputBuffering))
 	}
-	l = len(m.SandboxId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.FlushIntervalMs != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.FlushIntervalMs))
 	}
-	l = len(m.GuestHookPath)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.OutputTimestamps != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.OutputTimestamps.Size()))
+		n32, err := m.OutputTimestamps.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
 	}
-	if len(m.KernelModules) > 0 {
-		for _, e := range m.KernelModules {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+	if len(m.ImageEnv) > 0 {
+		for _, s := range m.ImageEnv {
+			dAtA[i] = 0x6a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	return n
+	if len(m.Nice) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Nice)))
+		i += copy(dAtA[i:], m.Nice)
+	}
+	return i, nil
 }
 
-func (m *DestroySandboxRequest) Size() (n int) {
-	var l int
-	_ = l
-	return n
+func (m *ExecProcessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *Interfaces) Size() (n int) {
+func (m *ExecProcessResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Interfaces) > 0 {
-		for _, e := range m.Interfaces {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if m.Pid != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Pid))
 	}
-	return n
+	if m.Pgid != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Pgid))
+	}
+	if m.Sid != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Sid))
+	}
+	return i, nil
 }
 
-func (m *Routes) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
+func (m *SignalProcessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UpdateInterfaceRequest) Size() (n int) {
+func (m *SignalProcessRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Interface != nil {
-		l = m.Interface.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
 	}
-	return n
+	if len(m.ExecId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
+		i += copy(dAtA[i:], m.ExecId)
+	}
+	if m.Signal != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Signal))
+	}
+	if m.Stop {
+		dAtA[i] = 0x20
+		i++
+		if m.Stop {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
 }
 
-func (m *UpdateRoutesRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Routes != nil {
-		l = m.Routes.Size()
-		n += 1 + l + sovAgent(uint64(l))
+func (m *WaitProcessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListInterfacesRequest) Size() (n int) {
+func (m *WaitProcessRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
+	}
+	if len(m.ExecId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
+		i += copy(dAtA[i:], m.ExecId)
+	}
+	return i, nil
 }
 
-func (m *ListRoutesRequest) Size() (n int) {
-	var l int
-	_ = l
-	return n
+func (m *WaitProcessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *OnlineCPUMemRequest) Size() (n int) {
+func (m *WaitProcessResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Wait {
-		n += 2
+	if m.Status != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Status))
 	}
-	if m.NbCpus != 0 {
-		n += 1 + sovAgent(uint64(m.NbCpus))
+	if m.Reason != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Reason))
 	}
-	if m.CpuOnly {
-		n += 2
+	if m.Signal != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Signal))
 	}
-	return n
+	return i, nil
 }
 
-func (m *ReseedRandomDevRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+func (m *ListProcessesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AgentDetails) Size() (n int) {
+func (m *ListProcessesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.InitDaemon {
-		n += 2
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
 	}
-	if len(m.DeviceHandlers) > 0 {
-		for _, s := range m.DeviceHandlers {
-			l = len(s)
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if len(m.Format) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Format)))
+		i += copy(dAtA[i:], m.Format)
 	}
-	if len(m.StorageHandlers) > 0 {
-		for _, s := range m.StorageHandlers {
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			dAtA[i] = 0x1a
+			i++
 			l = len(s)
-			n += 1 + l + sovAgent(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	if m.SupportsSeccomp {
-		n += 2
-	}
-	return n
+	return i, nil
 }
 
-func (m *GuestDetailsRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.MemBlockSize {
-		n += 2
-	}
-	if m.MemHotplugProbe {
-		n += 2
+func (m *ListProcessesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GuestDetailsResponse) Size() (n int) {
+func (m *ListProcessesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.MemBlockSizeBytes != 0 {
-		n += 1 + sovAgent(uint64(m.MemBlockSizeBytes))
-	}
-	if m.AgentDetails != nil {
-		l = m.AgentDetails.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.SupportMemHotplugProbe {
-		n += 2
+	if len(m.ProcessList) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ProcessList)))
+		i += copy(dAtA[i:], m.ProcessList)
 	}
-	return n
+	return i, nil
 }
 
-func (m *MemHotplugByProbeRequest) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.MemHotplugProbeAddr) > 0 {
-		l = 0
-		for _, e := range m.MemHotplugProbeAddr {
-			l += sovAgent(uint64(e))
-		}
-		n += 1 + sovAgent(uint64(l)) + l
+func (m *GetProcessEnvRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SetGuestDateTimeRequest) Size() (n int) {
+func (m *GetProcessEnvRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Sec != 0 {
-		n += 1 + sovAgent(uint64(m.Sec))
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
 	}
-	if m.Usec != 0 {
-		n += 1 + sovAgent(uint64(m.Usec))
+	if len(m.ExecId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
+		i += copy(dAtA[i:], m.ExecId)
 	}
-	return n
+	if len(m.Redact) > 0 {
+		for _, s := range m.Redact {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
 }
 
-func (m *Storage) Size() (n int) {
+func (m *ProcessEnv) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProcessEnv) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Driver)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if len(m.DriverOptions) > 0 {
-		for _, s := range m.DriverOptions {
+	if len(m.Env) > 0 {
+		for _, s := range m.Env {
+			dAtA[i] = 0xa
+			i++
 			l = len(s)
-			n += 1 + l + sovAgent(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	return i, nil
+}
+
+func (m *GetProcessFilesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Fstype)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *GetProcessFilesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
 	}
-	if len(m.Options) > 0 {
-		for _, s := range m.Options {
-			l = len(s)
-			n += 1 + l + sovAgent(uint64(l))
+	if len(m.ExecId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
+		i += copy(dAtA[i:], m.ExecId)
+	}
+	if m.Details {
+		dAtA[i] = 0x18
+		i++
+		if m.Details {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	l = len(m.MountPoint)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	return i, nil
+}
+
+func (m *OpenFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Device) Size() (n int) {
+func (m *OpenFile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.Fd != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Fd))
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
 	}
-	l = len(m.VmPath)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.Target) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Target)))
+		i += copy(dAtA[i:], m.Target)
 	}
-	l = len(m.ContainerPath)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.Protocol) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Protocol)))
+		i += copy(dAtA[i:], m.Protocol)
 	}
-	if len(m.Options) > 0 {
-		for _, s := range m.Options {
-			l = len(s)
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	return i, nil
+}
+
+func (m *ProcessFiles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *StringUser) Size() (n int) {
+func (m *ProcessFiles) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Uid)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.Regular != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Regular))
 	}
-	l = len(m.Gid)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.Socket != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Socket))
 	}
-	if len(m.AdditionalGids) > 0 {
-		for _, s := range m.AdditionalGids {
-			l = len(s)
-			n += 1 + l + sovAgent(uint64(l))
+	if m.Pipe != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Pipe))
+	}
+	if m.Other != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Other))
+	}
+	if len(m.Files) > 0 {
+		for _, msg := range m.Files {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *CopyFileRequest) Size() (n int) {
+func (m *ListContainerMountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListContainerMountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
 	}
-	if m.FileSize != 0 {
-		n += 1 + sovAgent(uint64(m.FileSize))
+	return i, nil
+}
+
+func (m *ContainerMount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.FileMode != 0 {
-		n += 1 + sovAgent(uint64(m.FileMode))
+	return dAtA[:n], nil
+}
+
+func (m *ContainerMount) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
 	}
-	if m.DirMode != 0 {
-		n += 1 + sovAgent(uint64(m.DirMode))
+	if len(m.Destination) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Destination)))
+		i += copy(dAtA[i:], m.Destination)
 	}
-	if m.Uid != 0 {
-		n += 1 + sovAgent(uint64(m.Uid))
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
 	}
-	if m.Gid != 0 {
-		n += 1 + sovAgent(uint64(m.Gid))
+	if m.Mounted {
+		dAtA[i] = 0x20
+		i++
+		if m.Mounted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.Offset != 0 {
-		n += 1 + sovAgent(uint64(m.Offset))
+	if m.SourceExists {
+		dAtA[i] = 0x28
+		i++
+		if m.SourceExists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.Healthy {
+		dAtA[i] = 0x30
+		i++
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ContainerMounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerMounts) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mounts) > 0 {
+		for _, msg := range m.Mounts {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Storages) > 0 {
+		for _, msg := range m.Storages {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ExposeContainerRootfsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExposeContainerRootfsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
+	}
+	return i, nil
+}
+
+func (m *ExposeContainerRootfsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExposeContainerRootfsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	return i, nil
+}
+
+func (m *UnexposeContainerRootfsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnexposeContainerRootfsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
+	}
+	return i, nil
+}
+
+func (m *ReadContainerRootfsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadContainerRootfsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
+	}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Offset))
+	}
+	if m.Length != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Length))
+	}
+	return i, nil
+}
+
+func (m *RootfsEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RootfsEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Mode != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Mode))
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Size_))
+	}
+	return i, nil
+}
+
+func (m *ContainerRootfsFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerRootfsFile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Mode))
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Size_))
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if len(m.Entries) > 0 {
+		for _, msg := range m.Entries {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.LinkTarget) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.LinkTarget)))
+		i += copy(dAtA[i:], m.LinkTarget)
+	}
+	return i, nil
+}
+
+func (m *UpdateContainerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
+	}
+	if m.Resources != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Resources.Size()))
+		n6, err := m.Resources.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	return i, nil
+}
+
+func (m *StatsContainerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatsContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
+	}
+	return i, nil
+}
+
+func (m *MemoryStatContainerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemoryStatContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
+	}
+	if m.ResetPeak {
+		dAtA[i] = 0x10
+		i++
+		if m.ResetPeak {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *MemoryStatContainerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemoryStatContainerResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stat) > 0 {
+		for k, _ := range m.Stat {
+			dAtA[i] = 0xa
+			i++
+			v := m.Stat[k]
+			mapSize := 1 + len(k) + sovAgent(uint64(len(k))) + 1 + sovAgent(uint64(v))
+			i = encodeVarintAgent(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(v))
+		}
+	}
+	if m.Peak != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Peak))
+	}
+	if m.CgroupV2 {
+		dAtA[i] = 0x18
+		i++
+		if m.CgroupV2 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *PauseContainerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
+	}
+	return i, nil
+}
+
+func (m *ResumeContainerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
+	}
+	return i, nil
+}
+
+func (m *CpuUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CpuUsage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TotalUsage != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.TotalUsage))
+	}
+	if len(m.PercpuUsage) > 0 {
+		dAtA8 := make([]byte, len(m.PercpuUsage)*10)
+		var j7 int
+		for _, num := range m.PercpuUsage {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(j7))
+		i += copy(dAtA[i:], dAtA8[:j7])
+	}
+	if m.UsageInKernelmode != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.UsageInKernelmode))
+	}
+	if m.UsageInUsermode != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.UsageInUsermode))
+	}
+	return i, nil
+}
+
+func (m *ThrottlingData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThrottlingData) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Periods != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Periods))
+	}
+	if m.ThrottledPeriods != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.ThrottledPeriods))
+	}
+	if m.ThrottledTime != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.ThrottledTime))
+	}
+	return i, nil
+}
+
+func (m *CpuStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CpuStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.CpuUsage != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.CpuUsage.Size()))
+		n9, err := m.CpuUsage.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.ThrottlingData != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.ThrottlingData.Size()))
+		n10, err := m.ThrottlingData.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+
+func (m *PidsStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PidsStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Current != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Current))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *MemoryData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemoryData) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Usage != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Usage))
+	}
+	if m.MaxUsage != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.MaxUsage))
+	}
+	if m.Failcnt != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Failcnt))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *MemoryStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemoryStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Cache != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Cache))
+	}
+	if m.Usage != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Usage.Size()))
+		n11, err := m.Usage.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.SwapUsage != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.SwapUsage.Size()))
+		n12, err := m.SwapUsage.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.KernelUsage != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.KernelUsage.Size()))
+		n13, err := m.KernelUsage.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if m.UseHierarchy {
+		dAtA[i] = 0x28
+		i++
+		if m.UseHierarchy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Stats) > 0 {
+		for k, _ := range m.Stats {
+			dAtA[i] = 0x32
+			i++
+			v := m.Stats[k]
+			mapSize := 1 + len(k) + sovAgent(uint64(len(k))) + 1 + sovAgent(uint64(v))
+			i = encodeVarintAgent(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(v))
+		}
+	}
+	return i, nil
+}
+
+func (m *BlkioStatsEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlkioStatsEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Major != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Major))
+	}
+	if m.Minor != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Minor))
+	}
+	if len(m.Op) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Op)))
+		i += copy(dAtA[i:], m.Op)
+	}
+	if m.Value != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Value))
+	}
+	return i, nil
+}
+
+func (m *BlkioStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlkioStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.IoServiceBytesRecursive) > 0 {
+		for _, msg := range m.IoServiceBytesRecursive {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.IoServicedRecursive) > 0 {
+		for _, msg := range m.IoServicedRecursive {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.IoQueuedRecursive) > 0 {
+		for _, msg := range m.IoQueuedRecursive {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.IoServiceTimeRecursive) > 0 {
+		for _, msg := range m.IoServiceTimeRecursive {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.IoWaitTimeRecursive) > 0 {
+		for _, msg := range m.IoWaitTimeRecursive {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.IoMergedRecursive) > 0 {
+		for _, msg := range m.IoMergedRecursive {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.IoTimeRecursive) > 0 {
+		for _, msg := range m.IoTimeRecursive {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.SectorsRecursive) > 0 {
+		for _, msg := range m.SectorsRecursive {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *HugetlbStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HugetlbStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Usage != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Usage))
+	}
+	if m.MaxUsage != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.MaxUsage))
+	}
+	if m.Failcnt != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Failcnt))
+	}
+	return i, nil
+}
+
+func (m *CgroupStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CgroupStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.CpuStats != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.CpuStats.Size()))
+		n14, err := m.CpuStats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.MemoryStats != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.MemoryStats.Size()))
+		n15, err := m.MemoryStats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.PidsStats != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.PidsStats.Size()))
+		n16, err := m.PidsStats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.BlkioStats != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.BlkioStats.Size()))
+		n17, err := m.BlkioStats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if len(m.HugetlbStats) > 0 {
+		for k, _ := range m.HugetlbStats {
+			dAtA[i] = 0x2a
+			i++
+			v := m.HugetlbStats[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovAgent(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovAgent(uint64(len(k))) + msgSize
+			i = encodeVarintAgent(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintAgent(dAtA, i, uint64(v.Size()))
+				n18, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n18
+			}
+		}
+	}
+	return i, nil
+}
+
+func (m *NetworkStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.RxBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.RxBytes))
+	}
+	if m.RxPackets != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.RxPackets))
+	}
+	if m.RxErrors != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.RxErrors))
+	}
+	if m.RxDropped != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.RxDropped))
+	}
+	if m.TxBytes != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.TxBytes))
+	}
+	if m.TxPackets != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.TxPackets))
+	}
+	if m.TxErrors != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.TxErrors))
+	}
+	if m.TxDropped != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.TxDropped))
+	}
+	return i, nil
+}
+
+func (m *WorkingSetStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkingSetStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Current != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Current))
+	}
+	if m.Average != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Average))
+	}
+	if m.Samples != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Samples))
+	}
+	return i, nil
+}
+
+func (m *PageCacheStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PageCacheStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Cache != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Cache))
+	}
+	if m.Dirty != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Dirty))
+	}
+	if m.Writeback != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Writeback))
+	}
+	return i, nil
+}
+
+func (m *OOMControl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OOMControl) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.KillDisabled {
+		dAtA[i] = 0x8
+		i++
+		if m.KillDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.UnderOom {
+		dAtA[i] = 0x10
+		i++
+		if m.UnderOom {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MemoryHigh != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.MemoryHigh))
+	}
+	if m.HighEvents != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.HighEvents))
+	}
+	return i, nil
+}
+
+func (m *StatsContainerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatsContainerResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.CgroupStats != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.CgroupStats.Size()))
+		n19, err := m.CgroupStats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	if len(m.NetworkStats) > 0 {
+		for _, msg := range m.NetworkStats {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.WorkingSet != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.WorkingSet.Size()))
+		n20, err := m.WorkingSet.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if m.PageCache != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.PageCache.Size()))
+		n21, err := m.PageCache.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if m.SandboxPageCache != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.SandboxPageCache.Size()))
+		n22, err := m.SandboxPageCache.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if m.OomControl != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.OomControl.Size()))
+		n30, err := m.OomControl.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	return i, nil
+}
+
+func (m *StatsSandboxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatsSandboxRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *StatsSandboxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatsSandboxResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NetworkStats != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.NetworkStats.Size()))
+		n29, err := m.NetworkStats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	return i, nil
+}
+
+func (m *WriteStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
+	}
+	if len(m.ExecId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
+		i += copy(dAtA[i:], m.ExecId)
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.Eof {
+		dAtA[i] = 0x20
+		i++
+		if m.Eof {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *WriteStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Len != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Len))
+	}
+	return i, nil
+}
+
+func (m *ReadStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
+	}
+	if len(m.ExecId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
+		i += copy(dAtA[i:], m.ExecId)
+	}
+	if m.Len != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Len))
+	}
+	return i, nil
+}
+
+func (m *ReadStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func (m *CloseStdinRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CloseStdinRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
+	}
+	if len(m.ExecId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
+		i += copy(dAtA[i:], m.ExecId)
+	}
+	return i, nil
+}
+
+func (m *TtyWinResizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TtyWinResizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i += copy(dAtA[i:], m.ContainerId)
+	}
+	if len(m.ExecId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
+		i += copy(dAtA[i:], m.ExecId)
+	}
+	if m.Row != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Row))
+	}
+	if m.Column != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Column))
+	}
+	return i, nil
+}
+
+func (m *KernelModule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KernelModule) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Parameters) > 0 {
+		for _, s := range m.Parameters {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *CreateSandboxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateSandboxRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
+	}
+	if len(m.Dns) > 0 {
+		for _, s := range m.Dns {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Storages) > 0 {
+		for _, msg := range m.Storages {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.SandboxPidns {
+		dAtA[i] = 0x20
+		i++
+		if m.SandboxPidns {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.SandboxId) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.SandboxId)))
+		i += copy(dAtA[i:], m.SandboxId)
+	}
+	if len(m.GuestHookPath) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.GuestHookPath)))
+		i += copy(dAtA[i:], m.GuestHookPath)
+	}
+	if len(m.KernelModules) > 0 {
+		for _, msg := range m.KernelModules {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.DnsStub) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.DnsStub)))
+		i += copy(dAtA[i:], m.DnsStub)
+	}
+	if len(m.Sysctls) > 0 {
+		for k, _ := range m.Sysctls {
+			dAtA[i] = 0x4a
+			i++
+			v := m.Sysctls[k]
+			mapSize := 1 + len(k) + sovAgent(uint64(len(k))) + 1 + len(v) + sovAgent(uint64(len(v)))
+			i = encodeVarintAgent(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *DestroySandboxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DestroySandboxRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *Interfaces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Interfaces) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Interfaces) > 0 {
+		for _, msg := range m.Interfaces {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Routes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Routes) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, msg := range m.Routes {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *UpdateInterfaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateInterfaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Interface != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Interface.Size()))
+		n23, err := m.Interface.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	return i, nil
+}
+
+func (m *UpdateRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Routes != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Routes.Size()))
+		n24, err := m.Routes.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	return i, nil
+}
+
+func (m *SourceRoutingInterface) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceRoutingInterface) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Device) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Device)))
+		i += copy(dAtA[i:], m.Device)
+	}
+	if len(m.Subnet) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Subnet)))
+		i += copy(dAtA[i:], m.Subnet)
+	}
+	if len(m.Gateway) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Gateway)))
+		i += copy(dAtA[i:], m.Gateway)
+	}
+	if m.Table != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Table))
+	}
+	if m.Priority != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Priority))
+	}
+	return i, nil
+}
+
+func (m *UpdateSourceRoutingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateSourceRoutingRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Interfaces) > 0 {
+		for _, msg := range m.Interfaces {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *SourceRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceRouting) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Interfaces) > 0 {
+		for _, msg := range m.Interfaces {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *SetTransparentProxyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetTransparentProxyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ProxyPort != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.ProxyPort))
+	}
+	if m.ProxyUid != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.ProxyUid))
+	}
+	if len(m.ExcludeCidrs) > 0 {
+		for _, s := range m.ExcludeCidrs {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *RemoveTransparentProxyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveTransparentProxyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ListInterfacesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListInterfacesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ListRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *OnlineCPUMemRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OnlineCPUMemRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Wait {
+		dAtA[i] = 0x8
+		i++
+		if m.Wait {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.NbCpus != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.NbCpus))
+	}
+	if m.CpuOnly {
+		dAtA[i] = 0x18
+		i++
+		if m.CpuOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ReseedRandomDevRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReseedRandomDevRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func (m *AgentDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AgentDetails) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if m.InitDaemon {
+		dAtA[i] = 0x10
+		i++
+		if m.InitDaemon {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.DeviceHandlers) > 0 {
+		for _, s := range m.DeviceHandlers {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.StorageHandlers) > 0 {
+		for _, s := range m.StorageHandlers {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.SupportsSeccomp {
+		dAtA[i] = 0x28
+		i++
+		if m.SupportsSeccomp {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *GuestDetailsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GuestDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MemBlockSize {
+		dAtA[i] = 0x8
+		i++
+		if m.MemBlockSize {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MemHotplugProbe {
+		dAtA[i] = 0x10
+		i++
+		if m.MemHotplugProbe {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *GuestDetailsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GuestDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MemBlockSizeBytes != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.MemBlockSizeBytes))
+	}
+	if m.AgentDetails != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.AgentDetails.Size()))
+		n25, err := m.AgentDetails.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	if m.SupportMemHotplugProbe {
+		dAtA[i] = 0x18
+		i++
+		if m.SupportMemHotplugProbe {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *MemHotplugByProbeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemHotplugByProbeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.MemHotplugProbeAddr) > 0 {
+		dAtA27 := make([]byte, len(m.MemHotplugProbeAddr)*10)
+		var j26 int
+		for _, num := range m.MemHotplugProbeAddr {
+			for num >= 1<<7 {
+				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j26++
+			}
+			dAtA27[j26] = uint8(num)
+			j26++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(j26))
+		i += copy(dAtA[i:], dAtA27[:j26])
+	}
+	return i, nil
+}
+
+func (m *SetGuestDateTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetGuestDateTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sec != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Sec))
+	}
+	if m.Usec != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Usec))
+	}
+	return i, nil
+}
+
+func (m *Storage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Storage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Driver) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Driver)))
+		i += copy(dAtA[i:], m.Driver)
+	}
+	if len(m.DriverOptions) > 0 {
+		for _, s := range m.DriverOptions {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Source) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
+	}
+	if len(m.Fstype) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Fstype)))
+		i += copy(dAtA[i:], m.Fstype)
+	}
+	if len(m.Options) > 0 {
+		for _, s := range m.Options {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.MountPoint) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.MountPoint)))
+		i += copy(dAtA[i:], m.MountPoint)
+	}
+	return i, nil
+}
+
+func (m *Device) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Device) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.VmPath) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.VmPath)))
+		i += copy(dAtA[i:], m.VmPath)
+	}
+	if len(m.ContainerPath) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerPath)))
+		i += copy(dAtA[i:], m.ContainerPath)
+	}
+	if len(m.Options) > 0 {
+		for _, s := range m.Options {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *StringUser) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StringUser) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Uid) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Uid)))
+		i += copy(dAtA[i:], m.Uid)
+	}
+	if len(m.Gid) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Gid)))
+		i += copy(dAtA[i:], m.Gid)
+	}
+	if len(m.AdditionalGids) > 0 {
+		for _, s := range m.AdditionalGids {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *CheckKernelModuleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckKernelModuleRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Module != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Module.Size()))
+		n28, err := m.Module.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if m.Load {
+		dAtA[i] = 0x10
+		i++
+		if m.Load {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *KernelModuleStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KernelModuleStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Builtin {
+		dAtA[i] = 0x10
+		i++
+		if m.Builtin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Available {
+		dAtA[i] = 0x18
+		i++
+		if m.Available {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Loaded {
+		dAtA[i] = 0x20
+		i++
+		if m.Loaded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *RestartAgentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestartAgentRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	return i, nil
+}
+
+func (m *CopyFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CopyFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	if m.FileSize != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.FileSize))
+	}
+	if m.FileMode != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.FileMode))
+	}
+	if m.DirMode != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.DirMode))
+	}
+	if m.Uid != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Uid))
+	}
+	if m.Gid != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Gid))
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Offset))
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func (m *ExtractLayerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtractLayerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	if m.LayerSize != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.LayerSize))
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Offset))
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func (m *StartTracingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartTracingRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *StopTracingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopTracingRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *GetProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Seconds != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.Seconds))
+	}
+	return i, nil
+}
+
+func (m *Profile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Profile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func (m *GetAPIVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAPIVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *APIVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *APIVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if len(m.Methods) > 0 {
+		for _, s := range m.Methods {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.DeprecatedMethods) > 0 {
+		for _, s := range m.DeprecatedMethods {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.DisabledMethods) > 0 {
+		for _, s := range m.DisabledMethods {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *GuestFilesystemsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GuestFilesystemsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Refresh {
+		dAtA[i] = 0x8
+		i++
+		if m.Refresh {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *GuestFilesystem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GuestFilesystem) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if m.Nodev {
+		dAtA[i] = 0x10
+		i++
+		if m.Nodev {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Registered {
+		dAtA[i] = 0x18
+		i++
+		if m.Registered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Loadable {
+		dAtA[i] = 0x20
+		i++
+		if m.Loadable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *GuestFilesystems) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GuestFilesystems) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Filesystems) > 0 {
+		for _, msg := range m.Filesystems {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.IdmappedMounts {
+		dAtA[i] = 0x10
+		i++
+		if m.IdmappedMounts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Overlayfs {
+		dAtA[i] = 0x18
+		i++
+		if m.Overlayfs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.VirtiofsDax {
+		dAtA[i] = 0x20
+		i++
+		if m.VirtiofsDax {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *InitTimingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InitTimingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *InitPhase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InitPhase) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.StartUs != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.StartUs))
+	}
+	if m.DurationUs != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.DurationUs))
+	}
+	return i, nil
+}
+
+func (m *InitTimings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InitTimings) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Phases) > 0 {
+		for _, msg := range m.Phases {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAgent(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.StartupUs != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAgent(dAtA, i, uint64(m.StartupUs))
+	}
+	return i, nil
+}
+
+func encodeVarintAgent(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *CreateContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ExecId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.StringUser != nil {
+		l = m.StringUser.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Devices) > 0 {
+		for _, e := range m.Devices {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.Storages) > 0 {
+		for _, e := range m.Storages {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.OCI != nil {
+		l = m.OCI.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.SandboxPidns {
+		n += 2
+	}
+	if m.CgroupNs {
+		n += 2
+	}
+	if len(m.DnsSearch) > 0 {
+		for _, s := range m.DnsSearch {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.DnsOptions) > 0 {
+		for _, s := range m.DnsOptions {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.DiskQuota != 0 {
+		n += 1 + sovAgent(uint64(m.DiskQuota))
+	}
+	if len(m.ProcSysWritable) > 0 {
+		for _, s := range m.ProcSysWritable {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.OutputLog != nil {
+		l = m.OutputLog.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ApparmorProfile)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.OutputTimestamps != nil {
+		l = m.OutputTimestamps.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.ImageEnv) > 0 {
+		for _, s := range m.ImageEnv {
+			l = len(s)
+			n += 2 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OutputLog) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Directory)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.MaxSize != 0 {
+		n += 1 + sovAgent(uint64(m.MaxSize))
+	}
+	if m.MaxBackups != 0 {
+		n += 1 + sovAgent(uint64(m.MaxBackups))
+	}
+	return n
+}
+
+func (m *OutputTimestamps) Size() (n int) {
+	var l int
+	_ = l
+	if m.Stdout {
+		n += 2
+	}
+	if m.Stderr {
+		n += 2
+	}
+	return n
+}
+
+func (m *StartContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.NetworkReadyTimeout != 0 {
+		n += 1 + sovAgent(uint64(m.NetworkReadyTimeout))
+	}
+	if m.StartTimeout != 0 {
+		n += 1 + sovAgent(uint64(m.StartTimeout))
+	}
+	return n
+}
+
+func (m *RemoveContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovAgent(uint64(m.Timeout))
+	}
+	return n
+}
+
+func (m *ExecProcessRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ExecId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.StringUser != nil {
+		l = m.StringUser.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Process != nil {
+		l = m.Process.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.StdinPath)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Umask)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.StrictEnv {
+		n += 2
+	}
+	if m.Setsid {
+		n += 2
+	}
+	if m.Setpgid {
+		n += 2
+	}
+	if m.OutputBuffering != 0 {
+		n += 1 + sovAgent(uint64(m.OutputBuffering))
+	}
+	if m.FlushIntervalMs != 0 {
+		n += 1 + sovAgent(uint64(m.FlushIntervalMs))
+	}
+	if m.OutputTimestamps != nil {
+		l = m.OutputTimestamps.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.ImageEnv) > 0 {
+		for _, s := range m.ImageEnv {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	l = len(m.Nice)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecProcessResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pid != 0 {
+		n += 1 + sovAgent(uint64(m.Pid))
+	}
+	if m.Pgid != 0 {
+		n += 1 + sovAgent(uint64(m.Pgid))
+	}
+	if m.Sid != 0 {
+		n += 1 + sovAgent(uint64(m.Sid))
+	}
+	return n
+}
+
+func (m *SignalProcessRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ExecId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Signal != 0 {
+		n += 1 + sovAgent(uint64(m.Signal))
+	}
+	if m.Stop {
+		n += 2
+	}
+	return n
+}
+
+func (m *WaitProcessRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ExecId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *WaitProcessResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovAgent(uint64(m.Status))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovAgent(uint64(m.Reason))
+	}
+	if m.Signal != 0 {
+		n += 1 + sovAgent(uint64(m.Signal))
+	}
+	return n
+}
+
+func (m *ListProcessesRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListProcessesResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ProcessList)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *GetProcessEnvRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ExecId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Redact) > 0 {
+		for _, s := range m.Redact {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProcessEnv) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Env) > 0 {
+		for _, s := range m.Env {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetProcessFilesRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ExecId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Details {
+		n += 2
+	}
+	return n
+}
+
+func (m *OpenFile) Size() (n int) {
+	var l int
+	_ = l
+	if m.Fd != 0 {
+		n += 1 + sovAgent(uint64(m.Fd))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *ProcessFiles) Size() (n int) {
+	var l int
+	_ = l
+	if m.Regular != 0 {
+		n += 1 + sovAgent(uint64(m.Regular))
+	}
+	if m.Socket != 0 {
+		n += 1 + sovAgent(uint64(m.Socket))
+	}
+	if m.Pipe != 0 {
+		n += 1 + sovAgent(uint64(m.Pipe))
+	}
+	if m.Other != 0 {
+		n += 1 + sovAgent(uint64(m.Other))
+	}
+	if len(m.Files) > 0 {
+		for _, e := range m.Files {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListContainerMountsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *ContainerMount) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Mounted {
+		n += 2
+	}
+	if m.SourceExists {
+		n += 2
+	}
+	if m.Healthy {
+		n += 2
+	}
+	return n
+}
+
+func (m *ContainerMounts) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Mounts) > 0 {
+		for _, e := range m.Mounts {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.Storages) > 0 {
+		for _, e := range m.Storages {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExposeContainerRootfsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *ExposeContainerRootfsResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *UnexposeContainerRootfsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *ReadContainerRootfsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAgent(uint64(m.Offset))
+	}
+	if m.Length != 0 {
+		n += 1 + sovAgent(uint64(m.Length))
+	}
+	return n
+}
+
+func (m *RootfsEntry) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovAgent(uint64(m.Mode))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovAgent(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *ContainerRootfsFile) Size() (n int) {
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovAgent(uint64(m.Mode))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovAgent(uint64(m.Size_))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	l = len(m.LinkTarget)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Resources != nil {
+		l = m.Resources.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *StatsContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *MemoryStatContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.ResetPeak {
+		n += 2
+	}
+	return n
+}
+
+func (m *MemoryStatContainerResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Stat) > 0 {
+		for k, v := range m.Stat {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAgent(uint64(len(k))) + 1 + sovAgent(uint64(v))
+			n += mapEntrySize + 1 + sovAgent(uint64(mapEntrySize))
+		}
+	}
+	if m.Peak != 0 {
+		n += 1 + sovAgent(uint64(m.Peak))
+	}
+	if m.CgroupV2 {
+		n += 2
+	}
+	return n
+}
+
+func (m *PauseContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *ResumeContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *CpuUsage) Size() (n int) {
+	var l int
+	_ = l
+	if m.TotalUsage != 0 {
+		n += 1 + sovAgent(uint64(m.TotalUsage))
+	}
+	if len(m.PercpuUsage) > 0 {
+		l = 0
+		for _, e := range m.PercpuUsage {
+			l += sovAgent(uint64(e))
+		}
+		n += 1 + sovAgent(uint64(l)) + l
+	}
+	if m.UsageInKernelmode != 0 {
+		n += 1 + sovAgent(uint64(m.UsageInKernelmode))
+	}
+	if m.UsageInUsermode != 0 {
+		n += 1 + sovAgent(uint64(m.UsageInUsermode))
+	}
+	return n
+}
+
+func (m *ThrottlingData) Size() (n int) {
+	var l int
+	_ = l
+	if m.Periods != 0 {
+		n += 1 + sovAgent(uint64(m.Periods))
+	}
+	if m.ThrottledPeriods != 0 {
+		n += 1 + sovAgent(uint64(m.ThrottledPeriods))
+	}
+	if m.ThrottledTime != 0 {
+		n += 1 + sovAgent(uint64(m.ThrottledTime))
+	}
+	return n
+}
+
+func (m *CpuStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.CpuUsage != nil {
+		l = m.CpuUsage.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.ThrottlingData != nil {
+		l = m.ThrottlingData.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *PidsStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.Current != 0 {
+		n += 1 + sovAgent(uint64(m.Current))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovAgent(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *MemoryData) Size() (n int) {
+	var l int
+	_ = l
+	if m.Usage != 0 {
+		n += 1 + sovAgent(uint64(m.Usage))
+	}
+	if m.MaxUsage != 0 {
+		n += 1 + sovAgent(uint64(m.MaxUsage))
+	}
+	if m.Failcnt != 0 {
+		n += 1 + sovAgent(uint64(m.Failcnt))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovAgent(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *MemoryStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.Cache != 0 {
+		n += 1 + sovAgent(uint64(m.Cache))
+	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.SwapUsage != nil {
+		l = m.SwapUsage.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.KernelUsage != nil {
+		l = m.KernelUsage.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.UseHierarchy {
+		n += 2
+	}
+	if len(m.Stats) > 0 {
+		for k, v := range m.Stats {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAgent(uint64(len(k))) + 1 + sovAgent(uint64(v))
+			n += mapEntrySize + 1 + sovAgent(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *BlkioStatsEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.Major != 0 {
+		n += 1 + sovAgent(uint64(m.Major))
+	}
+	if m.Minor != 0 {
+		n += 1 + sovAgent(uint64(m.Minor))
+	}
+	l = len(m.Op)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovAgent(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *BlkioStats) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.IoServiceBytesRecursive) > 0 {
+		for _, e := range m.IoServiceBytesRecursive {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.IoServicedRecursive) > 0 {
+		for _, e := range m.IoServicedRecursive {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.IoQueuedRecursive) > 0 {
+		for _, e := range m.IoQueuedRecursive {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.IoServiceTimeRecursive) > 0 {
+		for _, e := range m.IoServiceTimeRecursive {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.IoWaitTimeRecursive) > 0 {
+		for _, e := range m.IoWaitTimeRecursive {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.IoMergedRecursive) > 0 {
+		for _, e := range m.IoMergedRecursive {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.IoTimeRecursive) > 0 {
+		for _, e := range m.IoTimeRecursive {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.SectorsRecursive) > 0 {
+		for _, e := range m.SectorsRecursive {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HugetlbStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.Usage != 0 {
+		n += 1 + sovAgent(uint64(m.Usage))
+	}
+	if m.MaxUsage != 0 {
+		n += 1 + sovAgent(uint64(m.MaxUsage))
+	}
+	if m.Failcnt != 0 {
+		n += 1 + sovAgent(uint64(m.Failcnt))
+	}
+	return n
+}
+
+func (m *CgroupStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.CpuStats != nil {
+		l = m.CpuStats.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.MemoryStats != nil {
+		l = m.MemoryStats.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.PidsStats != nil {
+		l = m.PidsStats.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.BlkioStats != nil {
+		l = m.BlkioStats.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.HugetlbStats) > 0 {
+		for k, v := range m.HugetlbStats {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovAgent(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovAgent(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovAgent(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *NetworkStats) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.RxBytes != 0 {
+		n += 1 + sovAgent(uint64(m.RxBytes))
+	}
+	if m.RxPackets != 0 {
+		n += 1 + sovAgent(uint64(m.RxPackets))
+	}
+	if m.RxErrors != 0 {
+		n += 1 + sovAgent(uint64(m.RxErrors))
+	}
+	if m.RxDropped != 0 {
+		n += 1 + sovAgent(uint64(m.RxDropped))
+	}
+	if m.TxBytes != 0 {
+		n += 1 + sovAgent(uint64(m.TxBytes))
+	}
+	if m.TxPackets != 0 {
+		n += 1 + sovAgent(uint64(m.TxPackets))
+	}
+	if m.TxErrors != 0 {
+		n += 1 + sovAgent(uint64(m.TxErrors))
+	}
+	if m.TxDropped != 0 {
+		n += 1 + sovAgent(uint64(m.TxDropped))
+	}
+	return n
+}
+
+func (m *WorkingSetStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.Current != 0 {
+		n += 1 + sovAgent(uint64(m.Current))
+	}
+	if m.Average != 0 {
+		n += 1 + sovAgent(uint64(m.Average))
+	}
+	if m.Samples != 0 {
+		n += 1 + sovAgent(uint64(m.Samples))
+	}
+	return n
+}
+
+func (m *PageCacheStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.Cache != 0 {
+		n += 1 + sovAgent(uint64(m.Cache))
+	}
+	if m.Dirty != 0 {
+		n += 1 + sovAgent(uint64(m.Dirty))
+	}
+	if m.Writeback != 0 {
+		n += 1 + sovAgent(uint64(m.Writeback))
+	}
+	return n
+}
+
+func (m *OOMControl) Size() (n int) {
+	var l int
+	_ = l
+	if m.KillDisabled {
+		n += 2
+	}
+	if m.UnderOom {
+		n += 2
+	}
+	if m.MemoryHigh != 0 {
+		n += 1 + sovAgent(uint64(m.MemoryHigh))
+	}
+	if m.HighEvents != 0 {
+		n += 1 + sovAgent(uint64(m.HighEvents))
+	}
+	return n
+}
+
+func (m *StatsContainerResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.CgroupStats != nil {
+		l = m.CgroupStats.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.NetworkStats) > 0 {
+		for _, e := range m.NetworkStats {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.WorkingSet != nil {
+		l = m.WorkingSet.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.PageCache != nil {
+		l = m.PageCache.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.SandboxPageCache != nil {
+		l = m.SandboxPageCache.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.OomControl != nil {
+		l = m.OomControl.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *StatsSandboxRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StatsSandboxResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.NetworkStats != nil {
+		l = m.NetworkStats.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *WriteStreamRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ExecId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Eof {
+		n += 2
+	}
+	return n
+}
+
+func (m *WriteStreamResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Len != 0 {
+		n += 1 + sovAgent(uint64(m.Len))
+	}
+	return n
+}
+
+func (m *ReadStreamRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ExecId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Len != 0 {
+		n += 1 + sovAgent(uint64(m.Len))
+	}
+	return n
+}
+
+func (m *ReadStreamResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *CloseStdinRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ExecId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *TtyWinResizeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ExecId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Row != 0 {
+		n += 1 + sovAgent(uint64(m.Row))
+	}
+	if m.Column != 0 {
+		n += 1 + sovAgent(uint64(m.Column))
+	}
+	return n
+}
+
+func (m *KernelModule) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Parameters) > 0 {
+		for _, s := range m.Parameters {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreateSandboxRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Dns) > 0 {
+		for _, s := range m.Dns {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.Storages) > 0 {
+		for _, e := range m.Storages {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.SandboxPidns {
+		n += 2
+	}
+	l = len(m.SandboxId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.GuestHookPath)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.KernelModules) > 0 {
+		for _, e := range m.KernelModules {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	l = len(m.DnsStub)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Sysctls) > 0 {
+		for k, v := range m.Sysctls {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAgent(uint64(len(k))) + 1 + len(v) + sovAgent(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAgent(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *DestroySandboxRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Interfaces) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Interfaces) > 0 {
+		for _, e := range m.Interfaces {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Routes) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateInterfaceRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Interface != nil {
+		l = m.Interface.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateRoutesRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Routes != nil {
+		l = m.Routes.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *SourceRoutingInterface) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Device)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Subnet)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Gateway)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Table != 0 {
+		n += 1 + sovAgent(uint64(m.Table))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovAgent(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *UpdateSourceRoutingRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Interfaces) > 0 {
+		for _, e := range m.Interfaces {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SourceRouting) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Interfaces) > 0 {
+		for _, e := range m.Interfaces {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetTransparentProxyRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.ProxyPort != 0 {
+		n += 1 + sovAgent(uint64(m.ProxyPort))
+	}
+	if m.ProxyUid != 0 {
+		n += 1 + sovAgent(uint64(m.ProxyUid))
+	}
+	if len(m.ExcludeCidrs) > 0 {
+		for _, s := range m.ExcludeCidrs {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoveTransparentProxyRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListInterfacesRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListRoutesRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *OnlineCPUMemRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Wait {
+		n += 2
+	}
+	if m.NbCpus != 0 {
+		n += 1 + sovAgent(uint64(m.NbCpus))
+	}
+	if m.CpuOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *ReseedRandomDevRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *AgentDetails) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.InitDaemon {
+		n += 2
+	}
+	if len(m.DeviceHandlers) > 0 {
+		for _, s := range m.DeviceHandlers {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.StorageHandlers) > 0 {
+		for _, s := range m.StorageHandlers {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.SupportsSeccomp {
+		n += 2
+	}
+	return n
+}
+
+func (m *GuestDetailsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.MemBlockSize {
+		n += 2
+	}
+	if m.MemHotplugProbe {
+		n += 2
+	}
+	return n
+}
+
+func (m *GuestDetailsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.MemBlockSizeBytes != 0 {
+		n += 1 + sovAgent(uint64(m.MemBlockSizeBytes))
+	}
+	if m.AgentDetails != nil {
+		l = m.AgentDetails.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.SupportMemHotplugProbe {
+		n += 2
+	}
+	return n
+}
+
+func (m *MemHotplugByProbeRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.MemHotplugProbeAddr) > 0 {
+		l = 0
+		for _, e := range m.MemHotplugProbeAddr {
+			l += sovAgent(uint64(e))
+		}
+		n += 1 + sovAgent(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *SetGuestDateTimeRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Sec != 0 {
+		n += 1 + sovAgent(uint64(m.Sec))
+	}
+	if m.Usec != 0 {
+		n += 1 + sovAgent(uint64(m.Usec))
+	}
+	return n
+}
+
+func (m *Storage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Driver)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.DriverOptions) > 0 {
+		for _, s := range m.DriverOptions {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Fstype)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, s := range m.Options {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	l = len(m.MountPoint)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *Device) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.VmPath)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ContainerPath)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, s := range m.Options {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StringUser) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Gid)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.AdditionalGids) > 0 {
+		for _, s := range m.AdditionalGids {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckKernelModuleRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Module != nil {
+		l = m.Module.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Load {
+		n += 2
+	}
+	return n
+}
+
+func (m *KernelModuleStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Builtin {
+		n += 2
+	}
+	if m.Available {
+		n += 2
+	}
+	if m.Loaded {
+		n += 2
+	}
+	return n
+}
+
+func (m *RestartAgentRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *CopyFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.FileSize != 0 {
+		n += 1 + sovAgent(uint64(m.FileSize))
+	}
+	if m.FileMode != 0 {
+		n += 1 + sovAgent(uint64(m.FileMode))
+	}
+	if m.DirMode != 0 {
+		n += 1 + sovAgent(uint64(m.DirMode))
+	}
+	if m.Uid != 0 {
+		n += 1 + sovAgent(uint64(m.Uid))
+	}
+	if m.Gid != 0 {
+		n += 1 + sovAgent(uint64(m.Gid))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAgent(uint64(m.Offset))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtractLayerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.LayerSize != 0 {
+		n += 1 + sovAgent(uint64(m.LayerSize))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAgent(uint64(m.Offset))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *StartTracingRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StopTracingRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetProfileRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Seconds != 0 {
+		n += 1 + sovAgent(uint64(m.Seconds))
+	}
+	return n
+}
+
+func (m *Profile) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAPIVersionRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *APIVersionResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Methods) > 0 {
+		for _, s := range m.Methods {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.DeprecatedMethods) > 0 {
+		for _, s := range m.DeprecatedMethods {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.DisabledMethods) > 0 {
+		for _, s := range m.DisabledMethods {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GuestFilesystemsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Refresh {
+		n += 2
+	}
+	return n
+}
+
+func (m *GuestFilesystem) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Nodev {
+		n += 2
+	}
+	if m.Registered {
+		n += 2
+	}
+	if m.Loadable {
+		n += 2
+	}
+	return n
+}
+
+func (m *GuestFilesystems) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Filesystems) > 0 {
+		for _, e := range m.Filesystems {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.IdmappedMounts {
+		n += 2
+	}
+	if m.Overlayfs {
+		n += 2
+	}
+	if m.VirtiofsDax {
+		n += 2
+	}
+	return n
+}
+
+func (m *InitTimingsRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *InitPhase) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.StartUs != 0 {
+		n += 1 + sovAgent(uint64(m.StartUs))
+	}
+	if m.DurationUs != 0 {
+		n += 1 + sovAgent(uint64(m.DurationUs))
+	}
+	return n
+}
+
+func (m *InitTimings) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Phases) > 0 {
+		for _, e := range m.Phases {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.StartupUs != 0 {
+		n += 1 + sovAgent(uint64(m.StartupUs))
+	}
+	return n
+}
+
+func sovAgent(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozAgent(x uint64) (n int) {
+	return sovAgent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringUser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StringUser == nil {
+				m.StringUser = &StringUser{}
+			}
+			if err := m.StringUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Devices = append(m.Devices, &Device{})
+			if err := m.Devices[len(m.Devices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Storages = append(m.Storages, &Storage{})
+			if err := m.Storages[len(m.Storages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OCI", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OCI == nil {
+				m.OCI = &Spec{}
+			}
+			if err := m.OCI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SandboxPidns", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SandboxPidns = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CgroupNs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CgroupNs = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DnsSearch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DnsSearch = append(m.DnsSearch, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DnsOptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DnsOptions = append(m.DnsOptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskQuota", wireType)
+			}
+			m.DiskQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskQuota |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcSysWritable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcSysWritable = append(m.ProcSysWritable, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutputLog == nil {
+				m.OutputLog = &OutputLog{}
+			}
+			if err := m.OutputLog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApparmorProfile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApparmorProfile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputTimestamps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutputTimestamps == nil {
+				m.OutputTimestamps = &OutputTimestamps{}
+			}
+			if err := m.OutputTimestamps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageEnv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageEnv = append(m.ImageEnv, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutputLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutputLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutputLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Directory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
+			}
+			m.MaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSize |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackups", wireType)
+			}
+			m.MaxBackups = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBackups |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutputTimestamps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutputTimestamps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutputTimestamps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stdout = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stderr = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkReadyTimeout", wireType)
+			}
+			m.NetworkReadyTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkReadyTimeout |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimeout", wireType)
+			}
+			m.StartTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTimeout |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecProcessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecProcessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringUser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StringUser == nil {
+				m.StringUser = &StringUser{}
+			}
+			if err := m.StringUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Process", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Process == nil {
+				m.Process = &Process{}
+			}
+			if err := m.Process.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdinPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StdinPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Umask", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Umask = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictEnv", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictEnv = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Setsid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Setsid = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Setpgid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Setpgid = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBuffering", wireType)
+			}
+			m.OutputBuffering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputBuffering |= (ExecProcessRequest_OutputBuffering(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlushIntervalMs", wireType)
+			}
+			m.FlushIntervalMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlushIntervalMs |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputTimestamps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutputTimestamps == nil {
+				m.OutputTimestamps = &OutputTimestamps{}
+			}
+			if err := m.OutputTimestamps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageEnv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageEnv = append(m.ImageEnv, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecProcessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecProcessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecProcessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			m.Pid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pgid", wireType)
+			}
+			m.Pgid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pgid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sid", wireType)
+			}
+			m.Sid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalProcessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalProcessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
+			}
+			m.Signal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Signal |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stop", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stop = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WaitProcessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WaitProcessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WaitProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WaitProcessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WaitProcessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WaitProcessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= (WaitProcessResponse_Reason(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
+			}
+			m.Signal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Signal |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListProcessesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListProcessesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListProcessesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListProcessesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListProcessesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListProcessesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessList", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessList = append(m.ProcessList[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProcessList == nil {
+				m.ProcessList = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetProcessEnvRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetProcessEnvRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetProcessEnvRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redact = append(m.Redact, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessEnv) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessEnv: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessEnv: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetProcessFilesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetProcessFilesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetProcessFilesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Details = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpenFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpenFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpenFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fd", wireType)
+			}
+			m.Fd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fd |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessFiles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessFiles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessFiles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regular", wireType)
+			}
+			m.Regular = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Regular |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Socket", wireType)
+			}
+			m.Socket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Socket |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipe", wireType)
+			}
+			m.Pipe = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pipe |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Other", wireType)
+			}
+			m.Other = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Other |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Files = append(m.Files, &OpenFile{})
+			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListContainerMountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListContainerMountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListContainerMountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerMount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerMount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerMount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mounted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mounted = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceExists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SourceExists = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Healthy = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerMounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerMounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerMounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mounts = append(m.Mounts, &ContainerMount{})
+			if err := m.Mounts[len(m.Mounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Storages = append(m.Storages, &ContainerMount{})
+			if err := m.Storages[len(m.Storages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExposeContainerRootfsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExposeContainerRootfsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExposeContainerRootfsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExposeContainerRootfsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExposeContainerRootfsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExposeContainerRootfsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnexposeContainerRootfsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnexposeContainerRootfsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnexposeContainerRootfsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadContainerRootfsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadContainerRootfsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadContainerRootfsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RootfsEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RootfsEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RootfsEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerRootfsFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerRootfsFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerRootfsFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &RootfsEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkTarget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resources == nil {
+				m.Resources = &LinuxResources{}
+			}
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatsContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatsContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatsContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemoryStatContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoryStatContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoryStatContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetPeak", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResetPeak = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemoryStatContainerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoryStatContainerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoryStatContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stat == nil {
+				m.Stat = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAgent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAgent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Stat[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peak", wireType)
+			}
+			m.Peak = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Peak |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CgroupV2", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CgroupV2 = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResumeContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CpuUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CpuUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CpuUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUsage", wireType)
+			}
+			m.TotalUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalUsage |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PercpuUsage = append(m.PercpuUsage, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAgent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PercpuUsage = append(m.PercpuUsage, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PercpuUsage", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageInKernelmode", wireType)
+			}
+			m.UsageInKernelmode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsageInKernelmode |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageInUsermode", wireType)
+			}
+			m.UsageInUsermode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsageInUsermode |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThrottlingData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThrottlingData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThrottlingData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrottledPeriods", wireType)
+			}
+			m.ThrottledPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThrottledPeriods |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrottledTime", wireType)
+			}
+			m.ThrottledTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThrottledTime |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CpuStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CpuStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CpuStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CpuUsage == nil {
+				m.CpuUsage = &CpuUsage{}
+			}
+			if err := m.CpuUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrottlingData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ThrottlingData == nil {
+				m.ThrottlingData = &ThrottlingData{}
+			}
+			if err := m.ThrottlingData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PidsStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PidsStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PidsStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			m.Current = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Current |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemoryData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoryData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoryData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			m.Usage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Usage |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUsage", wireType)
+			}
+			m.MaxUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUsage |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failcnt", wireType)
+			}
+			m.Failcnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failcnt |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemoryStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoryStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoryStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+			}
+			m.Cache = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cache |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = &MemoryData{}
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SwapUsage == nil {
+				m.SwapUsage = &MemoryData{}
+			}
+			if err := m.SwapUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KernelUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KernelUsage == nil {
+				m.KernelUsage = &MemoryData{}
+			}
+			if err := m.KernelUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseHierarchy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseHierarchy = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAgent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAgent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Stats[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlkioStatsEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlkioStatsEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlkioStatsEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Major", wireType)
+			}
+			m.Major = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Major |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minor", wireType)
+			}
+			m.Minor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Minor |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Op = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlkioStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlkioStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlkioStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IoServiceBytesRecursive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IoServiceBytesRecursive = append(m.IoServiceBytesRecursive, &BlkioStatsEntry{})
+			if err := m.IoServiceBytesRecursive[len(m.IoServiceBytesRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IoServicedRecursive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IoServicedRecursive = append(m.IoServicedRecursive, &BlkioStatsEntry{})
+			if err := m.IoServicedRecursive[len(m.IoServicedRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IoQueuedRecursive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IoQueuedRecursive = append(m.IoQueuedRecursive, &BlkioStatsEntry{})
+			if err := m.IoQueuedRecursive[len(m.IoQueuedRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IoServiceTimeRecursive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IoServiceTimeRecursive = append(m.IoServiceTimeRecursive, &BlkioStatsEntry{})
+			if err := m.IoServiceTimeRecursive[len(m.IoServiceTimeRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IoWaitTimeRecursive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IoWaitTimeRecursive = append(m.IoWaitTimeRecursive, &BlkioStatsEntry{})
+			if err := m.IoWaitTimeRecursive[len(m.IoWaitTimeRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IoMergedRecursive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IoMergedRecursive = append(m.IoMergedRecursive, &BlkioStatsEntry{})
+			if err := m.IoMergedRecursive[len(m.IoMergedRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IoTimeRecursive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IoTimeRecursive = append(m.IoTimeRecursive, &BlkioStatsEntry{})
+			if err := m.IoTimeRecursive[len(m.IoTimeRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SectorsRecursive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SectorsRecursive = append(m.SectorsRecursive, &BlkioStatsEntry{})
+			if err := m.SectorsRecursive[len(m.SectorsRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HugetlbStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HugetlbStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HugetlbStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			m.Usage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Usage |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUsage", wireType)
+			}
+			m.MaxUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUsage |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failcnt", wireType)
+			}
+			m.Failcnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failcnt |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CgroupStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CgroupStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CgroupStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CpuStats == nil {
+				m.CpuStats = &CpuStats{}
+			}
+			if err := m.CpuStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MemoryStats == nil {
+				m.MemoryStats = &MemoryStats{}
+			}
+			if err := m.MemoryStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidsStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PidsStats == nil {
+				m.PidsStats = &PidsStats{}
+			}
+			if err := m.PidsStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlkioStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlkioStats == nil {
+				m.BlkioStats = &BlkioStats{}
+			}
+			if err := m.BlkioStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HugetlbStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HugetlbStats == nil {
+				m.HugetlbStats = make(map[string]*HugetlbStats)
+			}
+			var mapkey string
+			var mapvalue *HugetlbStats
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAgent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthAgent
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &HugetlbStats{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAgent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.HugetlbStats[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
-
-func (m *StartTracingRequest) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
-
-func (m *StopTracingRequest) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
 
-func sovAgent(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozAgent(x uint64) (n int) {
-	return sovAgent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
+func (m *NetworkStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6034,15 +17264,15 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateContainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetworkStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetworkStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6067,13 +17297,13 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxBytes", wireType)
 			}
-			var stringLen uint64
+			m.RxBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6083,26 +17313,16 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.RxBytes |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExecId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StringUser", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxPackets", wireType)
 			}
-			var msglen int
+			m.RxPackets = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6112,30 +17332,16 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.RxPackets |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StringUser == nil {
-				m.StringUser = &StringUser{}
-			}
-			if err := m.StringUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxErrors", wireType)
 			}
-			var msglen int
+			m.RxErrors = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6145,28 +17351,16 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.RxErrors |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Devices = append(m.Devices, &Device{})
-			if err := m.Devices[len(m.Devices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Storages", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxDropped", wireType)
 			}
-			var msglen int
+			m.RxDropped = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6176,28 +17370,16 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.RxDropped |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Storages = append(m.Storages, &Storage{})
-			if err := m.Storages[len(m.Storages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OCI", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
 			}
-			var msglen int
+			m.TxBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6207,30 +17389,16 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.TxBytes |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.OCI == nil {
-				m.OCI = &Spec{}
-			}
-			if err := m.OCI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SandboxPidns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPackets", wireType)
 			}
-			var v int
+			m.TxPackets = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6240,67 +17408,16 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.TxPackets |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SandboxPidns = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StartContainerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StartContainerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxErrors", wireType)
 			}
-			var stringLen uint64
+			m.TxErrors = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6310,21 +17427,30 @@ func (m *StartContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.TxErrors |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxDropped", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.TxDropped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxDropped |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -6346,7 +17472,7 @@ func (m *StartContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveContainerRequest) Unmarshal(dAtA []byte) error {
+func (m *WorkingSetStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6369,17 +17495,36 @@ func (m *RemoveContainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveContainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkingSetStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkingSetStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			m.Current = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Current |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Average", wireType)
+			}
+			m.Average = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6389,26 +17534,16 @@ func (m *RemoveContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Average |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
 			}
-			m.Timeout = 0
+			m.Samples = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6418,7 +17553,7 @@ func (m *RemoveContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= (uint32(b) & 0x7F) << shift
+				m.Samples |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6444,7 +17579,7 @@ func (m *RemoveContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecProcessRequest) Unmarshal(dAtA []byte) error {
+func (m *PageCacheStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6467,17 +17602,17 @@ func (m *ExecProcessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecProcessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PageCacheStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PageCacheStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
 			}
-			var stringLen uint64
+			m.Cache = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6487,26 +17622,16 @@ func (m *ExecProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Cache |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dirty", wireType)
 			}
-			var stringLen uint64
+			m.Dirty = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6516,59 +17641,16 @@ func (m *ExecProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Dirty |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExecId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StringUser", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StringUser == nil {
-				m.StringUser = &StringUser{}
-			}
-			if err := m.StringUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Process", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Writeback", wireType)
 			}
-			var msglen int
+			m.Writeback = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6578,25 +17660,11 @@ func (m *ExecProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Writeback |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Process == nil {
-				m.Process = &Process{}
-			}
-			if err := m.Process.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -6618,7 +17686,7 @@ func (m *ExecProcessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalProcessRequest) Unmarshal(dAtA []byte) error {
+func (m *OOMControl) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6641,17 +17709,17 @@ func (m *SignalProcessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalProcessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OOMControl: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OOMControl: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KillDisabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6661,26 +17729,17 @@ func (m *SignalProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.KillDisabled = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnderOom", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6690,26 +17749,36 @@ func (m *SignalProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
+			m.UnderOom = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryHigh", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MemoryHigh = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryHigh |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ExecId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HighEvents", wireType)
 			}
-			m.Signal = 0
+			m.HighEvents = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6719,7 +17788,7 @@ func (m *SignalProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Signal |= (uint32(b) & 0x7F) << shift
+				m.HighEvents |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6745,7 +17814,7 @@ func (m *SignalProcessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WaitProcessRequest) Unmarshal(dAtA []byte) error {
+func (m *StatsContainerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6768,17 +17837,17 @@ func (m *WaitProcessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WaitProcessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatsContainerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WaitProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatsContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CgroupStats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6788,26 +17857,30 @@ func (m *WaitProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			if m.CgroupStats == nil {
+				m.CgroupStats = &CgroupStats{}
+			}
+			if err := m.CgroupStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkStats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6817,76 +17890,28 @@ func (m *WaitProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
+			m.NetworkStats = append(m.NetworkStats, &NetworkStats{})
+			if err := m.NetworkStats[len(m.NetworkStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WaitProcessResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WaitProcessResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WaitProcessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkingSet", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6896,66 +17921,30 @@ func (m *WaitProcessResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= (int32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListProcessesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.WorkingSet == nil {
+				m.WorkingSet = &WorkingSetStats{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.WorkingSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListProcessesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListProcessesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageCache", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6965,26 +17954,30 @@ func (m *ListProcessesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			if m.PageCache == nil {
+				m.PageCache = &PageCacheStats{}
+			}
+			if err := m.PageCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SandboxPageCache", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6994,26 +17987,30 @@ func (m *ListProcessesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Format = string(dAtA[iNdEx:postIndex])
+			if m.SandboxPageCache == nil {
+				m.SandboxPageCache = &PageCacheStats{}
+			}
+			if err := m.SandboxPageCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OomControl", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7023,20 +18020,24 @@ func (m *ListProcessesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			if m.OomControl == nil {
+				m.OomControl = &OOMControl{}
+			}
+			if err := m.OomControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7059,7 +18060,7 @@ func (m *ListProcessesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListProcessesResponse) Unmarshal(dAtA []byte) error {
+func (m *StatsSandboxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7082,43 +18083,12 @@ func (m *ListProcessesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListProcessesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatsSandboxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListProcessesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatsSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessList", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProcessList = append(m.ProcessList[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProcessList == nil {
-				m.ProcessList = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -7140,7 +18110,7 @@ func (m *ListProcessesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateContainerRequest) Unmarshal(dAtA []byte) error {
+func (m *StatsSandboxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7163,44 +18133,15 @@ func (m *UpdateContainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateContainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatsSandboxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatsSandboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7224,10 +18165,10 @@ func (m *UpdateContainerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resources == nil {
-				m.Resources = &LinuxResources{}
+			if m.NetworkStats == nil {
+				m.NetworkStats = &NetworkStats{}
 			}
-			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetworkStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7252,7 +18193,7 @@ func (m *UpdateContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatsContainerRequest) Unmarshal(dAtA []byte) error {
+func (m *WriteStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7275,10 +18216,10 @@ func (m *StatsContainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatsContainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WriteStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatsContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WriteStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7310,6 +18251,86 @@ func (m *StatsContainerRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eof", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Eof = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -7331,7 +18352,7 @@ func (m *StatsContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
+func (m *WriteStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7354,17 +18375,17 @@ func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PauseContainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WriteStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PauseContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WriteStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Len", wireType)
 			}
-			var stringLen uint64
+			m.Len = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7374,21 +18395,11 @@ func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Len |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -7410,7 +18421,7 @@ func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResumeContainerRequest) Unmarshal(dAtA []byte) error {
+func (m *ReadStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7433,10 +18444,10 @@ func (m *ResumeContainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResumeContainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResumeContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7468,6 +18479,54 @@ func (m *ResumeContainerRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Len", wireType)
+			}
+			m.Len = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Len |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -7489,7 +18548,7 @@ func (m *ResumeContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CpuUsage) Unmarshal(dAtA []byte) error {
+func (m *ReadStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7512,17 +18571,17 @@ func (m *CpuUsage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CpuUsage: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CpuUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalUsage", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.TotalUsage = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7532,111 +18591,23 @@ func (m *CpuUsage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalUsage |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAgent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.PercpuUsage = append(m.PercpuUsage, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAgent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthAgent
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAgent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.PercpuUsage = append(m.PercpuUsage, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field PercpuUsage", wireType)
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsageInKernelmode", wireType)
-			}
-			m.UsageInKernelmode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UsageInKernelmode |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsageInUsermode", wireType)
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.UsageInUsermode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UsageInUsermode |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -7658,7 +18629,7 @@ func (m *CpuUsage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ThrottlingData) Unmarshal(dAtA []byte) error {
+func (m *CloseStdinRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7681,17 +18652,17 @@ func (m *ThrottlingData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ThrottlingData: wiretype end group for non-group")
+			return fmt.Errorf("proto: CloseStdinRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ThrottlingData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CloseStdinRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
-			m.Periods = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7701,16 +18672,26 @@ func (m *ThrottlingData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Periods |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThrottledPeriods", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
 			}
-			m.ThrottledPeriods = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7720,30 +18701,21 @@ func (m *ThrottlingData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ThrottledPeriods |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThrottledTime", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			m.ThrottledTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ThrottledTime |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ExecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -7765,7 +18737,7 @@ func (m *ThrottlingData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CpuStats) Unmarshal(dAtA []byte) error {
+func (m *TtyWinResizeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7788,17 +18760,17 @@ func (m *CpuStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CpuStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: TtyWinResizeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CpuStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TtyWinResizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuUsage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7808,30 +18780,26 @@ func (m *CpuStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CpuUsage == nil {
-				m.CpuUsage = &CpuUsage{}
-			}
-			if err := m.CpuUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThrottlingData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7841,25 +18809,59 @@ func (m *CpuStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ThrottlingData == nil {
-				m.ThrottlingData = &ThrottlingData{}
+			m.ExecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
 			}
-			if err := m.ThrottlingData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Row = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Row |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
+			}
+			m.Column = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Column |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -7881,7 +18883,7 @@ func (m *CpuStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PidsStats) Unmarshal(dAtA []byte) error {
+func (m *KernelModule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7904,17 +18906,17 @@ func (m *PidsStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PidsStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: KernelModule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PidsStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KernelModule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Current = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7924,16 +18926,26 @@ func (m *PidsStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Current |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7943,11 +18955,21 @@ func (m *PidsStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -7969,7 +18991,7 @@ func (m *PidsStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemoryData) Unmarshal(dAtA []byte) error {
+func (m *CreateSandboxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7992,17 +19014,17 @@ func (m *MemoryData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemoryData: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateSandboxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemoryData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
 			}
-			m.Usage = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8012,16 +19034,26 @@ func (m *MemoryData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Usage |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUsage", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dns", wireType)
 			}
-			m.MaxUsage = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8031,35 +19063,26 @@ func (m *MemoryData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxUsage |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failcnt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			m.Failcnt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Failcnt |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			m.Dns = append(m.Dns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storages", wireType)
 			}
-			m.Limit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8069,66 +19092,28 @@ func (m *MemoryData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MemoryStats) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Storages = append(m.Storages, &Storage{})
+			if err := m.Storages[len(m.Storages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MemoryStats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemoryStats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SandboxPidns", wireType)
 			}
-			m.Cache = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8138,16 +19123,17 @@ func (m *MemoryStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Cache |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.SandboxPidns = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SandboxId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8157,30 +19143,26 @@ func (m *MemoryStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Usage == nil {
-				m.Usage = &MemoryData{}
-			}
-			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SandboxId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapUsage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GuestHookPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8190,28 +19172,24 @@ func (m *MemoryStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SwapUsage == nil {
-				m.SwapUsage = &MemoryData{}
-			}
-			if err := m.SwapUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GuestHookPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KernelUsage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KernelModules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8235,18 +19213,16 @@ func (m *MemoryStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KernelUsage == nil {
-				m.KernelUsage = &MemoryData{}
-			}
-			if err := m.KernelUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.KernelModules = append(m.KernelModules, &KernelModule{})
+			if err := m.KernelModules[len(m.KernelModules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseHierarchy", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DnsStub", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8256,15 +19232,24 @@ func (m *MemoryStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseHierarchy = bool(v != 0)
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DnsStub = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sysctls", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8288,11 +19273,11 @@ func (m *MemoryStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = make(map[string]uint64)
+			if m.Sysctls == nil {
+				m.Sysctls = make(map[string]string)
 			}
 			var mapkey string
-			var mapvalue uint64
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -8338,6 +19323,7 @@ func (m *MemoryStats) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowAgent
@@ -8347,11 +19333,21 @@ func (m *MemoryStats) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvalue |= (uint64(b) & 0x7F) << shift
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAgent
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipAgent(dAtA[iNdEx:])
@@ -8367,7 +19363,7 @@ func (m *MemoryStats) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Stats[mapkey] = mapvalue
+			m.Sysctls[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8390,7 +19386,7 @@ func (m *MemoryStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlkioStatsEntry) Unmarshal(dAtA []byte) error {
+func (m *DestroySandboxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8413,98 +19409,12 @@ func (m *BlkioStatsEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlkioStatsEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: DestroySandboxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlkioStatsEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DestroySandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Major", wireType)
-			}
-			m.Major = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Major |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minor", wireType)
-			}
-			m.Minor = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Minor |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Op = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			m.Value = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Value |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -8526,7 +19436,7 @@ func (m *BlkioStatsEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlkioStats) Unmarshal(dAtA []byte) error {
+func (m *Interfaces) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8549,15 +19459,15 @@ func (m *BlkioStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlkioStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: Interfaces: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlkioStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Interfaces: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoServiceBytesRecursive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interfaces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8581,76 +19491,64 @@ func (m *BlkioStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IoServiceBytesRecursive = append(m.IoServiceBytesRecursive, &BlkioStatsEntry{})
-			if err := m.IoServiceBytesRecursive[len(m.IoServiceBytesRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Interfaces = append(m.Interfaces, &types.Interface{})
+			if err := m.Interfaces[len(m.Interfaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoServicedRecursive", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IoServicedRecursive = append(m.IoServicedRecursive, &BlkioStatsEntry{})
-			if err := m.IoServicedRecursive[len(m.IoServicedRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoQueuedRecursive", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Routes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IoQueuedRecursive = append(m.IoQueuedRecursive, &BlkioStatsEntry{})
-			if err := m.IoQueuedRecursive[len(m.IoQueuedRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Routes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Routes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoServiceTimeRecursive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8674,45 +19572,64 @@ func (m *BlkioStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IoServiceTimeRecursive = append(m.IoServiceTimeRecursive, &BlkioStatsEntry{})
-			if err := m.IoServiceTimeRecursive[len(m.IoServiceTimeRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, &types.Route{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoWaitTimeRecursive", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IoWaitTimeRecursive = append(m.IoWaitTimeRecursive, &BlkioStatsEntry{})
-			if err := m.IoWaitTimeRecursive[len(m.IoWaitTimeRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateInterfaceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
 			}
-			iNdEx = postIndex
-		case 6:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateInterfaceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateInterfaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoMergedRecursive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interface", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8736,45 +19653,66 @@ func (m *BlkioStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IoMergedRecursive = append(m.IoMergedRecursive, &BlkioStatsEntry{})
-			if err := m.IoMergedRecursive[len(m.IoMergedRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Interface == nil {
+				m.Interface = &types.Interface{}
+			}
+			if err := m.Interface.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoTimeRecursive", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IoTimeRecursive = append(m.IoTimeRecursive, &BlkioStatsEntry{})
-			if err := m.IoTimeRecursive[len(m.IoTimeRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRoutesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
 			}
-			iNdEx = postIndex
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRoutesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SectorsRecursive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8798,8 +19736,10 @@ func (m *BlkioStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SectorsRecursive = append(m.SectorsRecursive, &BlkioStatsEntry{})
-			if err := m.SectorsRecursive[len(m.SectorsRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Routes == nil {
+				m.Routes = &Routes{}
+			}
+			if err := m.Routes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8824,7 +19764,7 @@ func (m *BlkioStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HugetlbStats) Unmarshal(dAtA []byte) error {
+func (m *SourceRoutingInterface) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8847,17 +19787,17 @@ func (m *HugetlbStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HugetlbStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: SourceRoutingInterface: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HugetlbStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SourceRoutingInterface: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
 			}
-			m.Usage = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8867,16 +19807,84 @@ func (m *HugetlbStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Usage |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Device = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subnet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subnet = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gateway", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gateway = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUsage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
-			m.MaxUsage = 0
+			m.Table = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8886,16 +19894,16 @@ func (m *HugetlbStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxUsage |= (uint64(b) & 0x7F) << shift
+				m.Table |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failcnt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			m.Failcnt = 0
+			m.Priority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8905,7 +19913,7 @@ func (m *HugetlbStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Failcnt |= (uint64(b) & 0x7F) << shift
+				m.Priority |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8931,7 +19939,7 @@ func (m *HugetlbStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CgroupStats) Unmarshal(dAtA []byte) error {
+func (m *UpdateSourceRoutingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8954,48 +19962,15 @@ func (m *CgroupStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CgroupStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateSourceRoutingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CgroupStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateSourceRoutingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuStats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CpuStats == nil {
-				m.CpuStats = &CpuStats{}
-			}
-			if err := m.CpuStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoryStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interfaces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9019,201 +19994,91 @@ func (m *CgroupStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MemoryStats == nil {
-				m.MemoryStats = &MemoryStats{}
-			}
-			if err := m.MemoryStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Interfaces = append(m.Interfaces, &SourceRoutingInterface{})
+			if err := m.Interfaces[len(m.Interfaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PidsStats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PidsStats == nil {
-				m.PidsStats = &PidsStats{}
-			}
-			if err := m.PidsStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlkioStats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlkioStats == nil {
-				m.BlkioStats = &BlkioStats{}
-			}
-			if err := m.BlkioStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HugetlbStats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HugetlbStats == nil {
-				m.HugetlbStats = make(map[string]*HugetlbStats)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var mapkey string
-			var mapvalue *HugetlbStats
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAgent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAgent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthAgent
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAgent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= (int(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthAgent
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if mapmsglen < 0 {
-						return ErrInvalidLengthAgent
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &HugetlbStats{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipAgent(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthAgent
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interfaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.HugetlbStats[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interfaces = append(m.Interfaces, &SourceRoutingInterface{})
+			if err := m.Interfaces[len(m.Interfaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9236,7 +20101,7 @@ func (m *CgroupStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetworkStats) Unmarshal(dAtA []byte) error {
+func (m *SetTransparentProxyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9259,17 +20124,17 @@ func (m *NetworkStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetTransparentProxyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetTransparentProxyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyPort", wireType)
 			}
-			var stringLen uint64
+			m.ProxyPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9279,26 +20144,16 @@ func (m *NetworkStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.ProxyPort |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyUid", wireType)
 			}
-			m.RxBytes = 0
+			m.ProxyUid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9308,16 +20163,16 @@ func (m *NetworkStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RxBytes |= (uint64(b) & 0x7F) << shift
+				m.ProxyUid |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxPackets", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeCidrs", wireType)
 			}
-			m.RxPackets = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9327,125 +20182,121 @@ func (m *NetworkStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RxPackets |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxErrors", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			m.RxErrors = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RxErrors |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxDropped", wireType)
+			m.ExcludeCidrs = append(m.ExcludeCidrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.RxDropped = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RxDropped |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TxBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxBytes |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveTransparentProxyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxPackets", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TxPackets = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxPackets |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxErrors", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveTransparentProxyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveTransparentProxyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.TxErrors = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxErrors |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxDropped", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TxDropped = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxDropped |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListInterfacesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListInterfacesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListInterfacesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -9467,7 +20318,7 @@ func (m *NetworkStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatsContainerResponse) Unmarshal(dAtA []byte) error {
+func (m *ListRoutesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9490,76 +20341,12 @@ func (m *StatsContainerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatsContainerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListRoutesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatsContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CgroupStats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CgroupStats == nil {
-				m.CgroupStats = &CgroupStats{}
-			}
-			if err := m.CgroupStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkStats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NetworkStats = append(m.NetworkStats, &NetworkStats{})
-			if err := m.NetworkStats[len(m.NetworkStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -9581,7 +20368,7 @@ func (m *StatsContainerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WriteStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *OnlineCPUMemRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9604,17 +20391,17 @@ func (m *WriteStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WriteStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OnlineCPUMemRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WriteStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OnlineCPUMemRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9624,26 +20411,17 @@ func (m *WriteStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Wait = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NbCpus", wireType)
 			}
-			var stringLen uint64
+			m.NbCpus = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9653,26 +20431,16 @@ func (m *WriteStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.NbCpus |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExecId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuOnly", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9682,23 +20450,12 @@ func (m *WriteStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
+			m.CpuOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -9720,7 +20477,7 @@ func (m *WriteStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WriteStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *ReseedRandomDevRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9743,17 +20500,17 @@ func (m *WriteStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WriteStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReseedRandomDevRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WriteStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReseedRandomDevRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Len", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.Len = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9763,11 +20520,23 @@ func (m *WriteStreamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Len |= (uint32(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -9789,7 +20558,7 @@ func (m *WriteStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *AgentDetails) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9812,15 +20581,15 @@ func (m *ReadStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AgentDetails: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AgentDetails: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9845,13 +20614,13 @@ func (m *ReadStreamRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitDaemon", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9861,26 +20630,17 @@ func (m *ReadStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExecId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.InitDaemon = bool(v != 0)
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Len", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceHandlers", wireType)
 			}
-			m.Len = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9890,66 +20650,26 @@ func (m *ReadStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Len |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReadStreamResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReadStreamResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DeviceHandlers = append(m.DeviceHandlers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageHandlers", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9959,23 +20679,41 @@ func (m *ReadStreamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.StorageHandlers = append(m.StorageHandlers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsSeccomp", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupportsSeccomp = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -9997,7 +20735,7 @@ func (m *ReadStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloseStdinRequest) Unmarshal(dAtA []byte) error {
+func (m *GuestDetailsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10020,17 +20758,17 @@ func (m *CloseStdinRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloseStdinRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GuestDetailsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseStdinRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GuestDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemBlockSize", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10040,26 +20778,17 @@ func (m *CloseStdinRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.MemBlockSize = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemHotplugProbe", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10069,21 +20798,12 @@ func (m *CloseStdinRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExecId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.MemHotplugProbe = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -10105,7 +20825,7 @@ func (m *CloseStdinRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TtyWinResizeRequest) Unmarshal(dAtA []byte) error {
+func (m *GuestDetailsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10128,17 +20848,17 @@ func (m *TtyWinResizeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TtyWinResizeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GuestDetailsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TtyWinResizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GuestDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemBlockSizeBytes", wireType)
 			}
-			var stringLen uint64
+			m.MemBlockSizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10148,26 +20868,16 @@ func (m *TtyWinResizeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MemBlockSizeBytes |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentDetails", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10177,26 +20887,30 @@ func (m *TtyWinResizeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecId = string(dAtA[iNdEx:postIndex])
+			if m.AgentDetails == nil {
+				m.AgentDetails = &AgentDetails{}
+			}
+			if err := m.AgentDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportMemHotplugProbe", wireType)
 			}
-			m.Row = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10206,29 +20920,123 @@ func (m *TtyWinResizeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Row |= (uint32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
+			m.SupportMemHotplugProbe = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Column = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemHotplugByProbeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemHotplugByProbeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemHotplugByProbeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.MemHotplugProbeAddr = append(m.MemHotplugProbeAddr, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAgent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Column |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MemHotplugProbeAddr = append(m.MemHotplugProbeAddr, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemHotplugProbeAddr", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -10251,7 +21059,7 @@ func (m *TtyWinResizeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KernelModule) Unmarshal(dAtA []byte) error {
+func (m *SetGuestDateTimeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10274,17 +21082,17 @@ func (m *KernelModule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KernelModule: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetGuestDateTimeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KernelModule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetGuestDateTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sec", wireType)
 			}
-			var stringLen uint64
+			m.Sec = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10294,26 +21102,16 @@ func (m *KernelModule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Sec |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usec", wireType)
 			}
-			var stringLen uint64
+			m.Usec = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10323,21 +21121,11 @@ func (m *KernelModule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Usec |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -10359,7 +21147,7 @@ func (m *KernelModule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateSandboxRequest) Unmarshal(dAtA []byte) error {
+func (m *Storage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10382,15 +21170,15 @@ func (m *CreateSandboxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateSandboxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Storage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Storage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10415,11 +21203,11 @@ func (m *CreateSandboxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hostname = string(dAtA[iNdEx:postIndex])
+			m.Driver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DriverOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10444,13 +21232,13 @@ func (m *CreateSandboxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dns = append(m.Dns, string(dAtA[iNdEx:postIndex]))
+			m.DriverOptions = append(m.DriverOptions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Storages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10460,46 +21248,24 @@ func (m *CreateSandboxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Storages = append(m.Storages, &Storage{})
-			if err := m.Storages[len(m.Storages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SandboxPidns", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SandboxPidns = bool(v != 0)
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SandboxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fstype", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10524,11 +21290,11 @@ func (m *CreateSandboxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SandboxId = string(dAtA[iNdEx:postIndex])
+			m.Fstype = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GuestHookPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10553,13 +21319,13 @@ func (m *CreateSandboxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GuestHookPath = string(dAtA[iNdEx:postIndex])
+			m.Options = append(m.Options, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KernelModules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MountPoint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10569,22 +21335,20 @@ func (m *CreateSandboxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KernelModules = append(m.KernelModules, &KernelModule{})
-			if err := m.KernelModules[len(m.KernelModules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MountPoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10607,7 +21371,7 @@ func (m *CreateSandboxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DestroySandboxRequest) Unmarshal(dAtA []byte) error {
+func (m *Device) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10630,67 +21394,133 @@ func (m *DestroySandboxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DestroySandboxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Device: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DestroySandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Device: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Interfaces) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmPath", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Interfaces: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Interfaces: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interfaces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10700,22 +21530,20 @@ func (m *Interfaces) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Interfaces = append(m.Interfaces, &types.Interface{})
-			if err := m.Interfaces[len(m.Interfaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Options = append(m.Options, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10738,7 +21566,7 @@ func (m *Interfaces) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Routes) Unmarshal(dAtA []byte) error {
+func (m *StringUser) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10761,17 +21589,17 @@ func (m *Routes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Routes: wiretype end group for non-group")
+			return fmt.Errorf("proto: StringUser: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Routes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StringUser: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10781,78 +21609,55 @@ func (m *Routes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, &types.Route{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAgent
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gid", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateInterfaceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateInterfaceRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateInterfaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Gid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interface", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalGids", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10862,24 +21667,20 @@ func (m *UpdateInterfaceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Interface == nil {
-				m.Interface = &types.Interface{}
-			}
-			if err := m.Interface.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AdditionalGids = append(m.AdditionalGids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10902,7 +21703,7 @@ func (m *UpdateInterfaceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRoutesRequest) Unmarshal(dAtA []byte) error {
+func (m *CheckKernelModuleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10925,15 +21726,15 @@ func (m *UpdateRoutesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRoutesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckKernelModuleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckKernelModuleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10957,113 +21758,33 @@ func (m *UpdateRoutesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Routes == nil {
-				m.Routes = &Routes{}
+			if m.Module == nil {
+				m.Module = &KernelModule{}
 			}
-			if err := m.Routes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Module.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListInterfacesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListInterfacesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListInterfacesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListRoutesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListRoutesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Load = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -11085,7 +21806,7 @@ func (m *ListRoutesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OnlineCPUMemRequest) Unmarshal(dAtA []byte) error {
+func (m *KernelModuleStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11108,15 +21829,44 @@ func (m *OnlineCPUMemRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OnlineCPUMemRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: KernelModuleStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OnlineCPUMemRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KernelModuleStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Builtin", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -11133,12 +21883,12 @@ func (m *OnlineCPUMemRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Wait = bool(v != 0)
-		case 2:
+			m.Builtin = bool(v != 0)
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NbCpus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
 			}
-			m.NbCpus = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -11148,14 +21898,15 @@ func (m *OnlineCPUMemRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NbCpus |= (uint32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.Available = bool(v != 0)
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Loaded", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -11172,7 +21923,7 @@ func (m *OnlineCPUMemRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.CpuOnly = bool(v != 0)
+			m.Loaded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -11194,7 +21945,7 @@ func (m *OnlineCPUMemRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReseedRandomDevRequest) Unmarshal(dAtA []byte) error {
+func (m *RestartAgentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11217,17 +21968,17 @@ func (m *ReseedRandomDevRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReseedRandomDevRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestartAgentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReseedRandomDevRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestartAgentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -11237,22 +21988,20 @@ func (m *ReseedRandomDevRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11275,7 +22024,7 @@ func (m *ReseedRandomDevRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AgentDetails) Unmarshal(dAtA []byte) error {
+func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11298,15 +22047,15 @@ func (m *AgentDetails) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AgentDetails: wiretype end group for non-group")
+			return fmt.Errorf("proto: CopyFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AgentDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CopyFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11331,13 +22080,13 @@ func (m *AgentDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitDaemon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
 			}
-			var v int
+			m.FileSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -11347,17 +22096,16 @@ func (m *AgentDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.FileSize |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InitDaemon = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviceHandlers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileMode", wireType)
 			}
-			var stringLen uint64
+			m.FileMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -11367,26 +22115,16 @@ func (m *AgentDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.FileMode |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeviceHandlers = append(m.DeviceHandlers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageHandlers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirMode", wireType)
 			}
-			var stringLen uint64
+			m.DirMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -11396,26 +22134,16 @@ func (m *AgentDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.DirMode |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StorageHandlers = append(m.StorageHandlers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportsSeccomp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			var v int
+			m.Uid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -11425,67 +22153,35 @@ func (m *AgentDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Uid |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SupportsSeccomp = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GuestDetailsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gid", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Gid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GuestDetailsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GuestDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemBlockSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var v int
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -11495,17 +22191,16 @@ func (m *GuestDetailsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Offset |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MemBlockSize = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemHotplugProbe", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -11515,12 +22210,23 @@ func (m *GuestDetailsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MemHotplugProbe = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -11542,7 +22248,7 @@ func (m *GuestDetailsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GuestDetailsResponse) Unmarshal(dAtA []byte) error {
+func (m *ExtractLayerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11565,17 +22271,46 @@ func (m *GuestDetailsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GuestDetailsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExtractLayerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GuestDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExtractLayerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemBlockSizeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LayerSize", wireType)
 			}
-			m.MemBlockSizeBytes = 0
+			m.LayerSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -11585,16 +22320,16 @@ func (m *GuestDetailsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MemBlockSizeBytes |= (uint64(b) & 0x7F) << shift
+				m.LayerSize |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentDetails", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var msglen int
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -11604,30 +22339,16 @@ func (m *GuestDetailsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Offset |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AgentDetails == nil {
-				m.AgentDetails = &AgentDetails{}
-			}
-			if err := m.AgentDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportMemHotplugProbe", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -11637,12 +22358,23 @@ func (m *GuestDetailsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SupportMemHotplugProbe = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -11664,7 +22396,7 @@ func (m *GuestDetailsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemHotplugByProbeRequest) Unmarshal(dAtA []byte) error {
+func (m *StartTracingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11687,74 +22419,12 @@ func (m *MemHotplugByProbeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemHotplugByProbeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartTracingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemHotplugByProbeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartTracingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAgent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MemHotplugProbeAddr = append(m.MemHotplugProbeAddr, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAgent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthAgent
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAgent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MemHotplugProbeAddr = append(m.MemHotplugProbeAddr, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemHotplugProbeAddr", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -11776,7 +22446,7 @@ func (m *MemHotplugByProbeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetGuestDateTimeRequest) Unmarshal(dAtA []byte) error {
+func (m *StopTracingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11799,50 +22469,12 @@ func (m *SetGuestDateTimeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetGuestDateTimeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopTracingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetGuestDateTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopTracingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sec", wireType)
-			}
-			m.Sec = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sec |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Usec", wireType)
-			}
-			m.Usec = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Usec |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -11864,7 +22496,7 @@ func (m *SetGuestDateTimeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Storage) Unmarshal(dAtA []byte) error {
+func (m *GetProfileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11887,102 +22519,15 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Storage: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetProfileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Storage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Driver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DriverOptions", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DriverOptions = append(m.DriverOptions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fstype", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12007,13 +22552,13 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fstype = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seconds", wireType)
 			}
-			var stringLen uint64
+			m.Seconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12023,26 +22568,66 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Seconds |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Options = append(m.Options, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Profile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Profile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Profile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MountPoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12052,20 +22637,22 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MountPoint = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12088,7 +22675,7 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Device) Unmarshal(dAtA []byte) error {
+func (m *GetAPIVersionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12111,44 +22698,65 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Device: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAPIVersionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Device: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAPIVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *APIVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: APIVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: APIVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12173,11 +22781,11 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12202,11 +22810,11 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmPath = string(dAtA[iNdEx:postIndex])
+			m.Methods = append(m.Methods, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedMethods", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12231,11 +22839,11 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerPath = string(dAtA[iNdEx:postIndex])
+			m.DeprecatedMethods = append(m.DeprecatedMethods, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledMethods", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12260,7 +22868,7 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Options = append(m.Options, string(dAtA[iNdEx:postIndex]))
+			m.DisabledMethods = append(m.DisabledMethods, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12283,7 +22891,7 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StringUser) Unmarshal(dAtA []byte) error {
+func (m *GuestFilesystemsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12306,75 +22914,17 @@ func (m *StringUser) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StringUser: wiretype end group for non-group")
+			return fmt.Errorf("proto: GuestFilesystemsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StringUser: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GuestFilesystemsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Uid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Gid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalGids", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refresh", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12384,21 +22934,12 @@ func (m *StringUser) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AdditionalGids = append(m.AdditionalGids, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Refresh = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -12420,7 +22961,7 @@ func (m *StringUser) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
+func (m *GuestFilesystem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12443,15 +22984,15 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CopyFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GuestFilesystem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CopyFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GuestFilesystem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12476,13 +23017,13 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodev", wireType)
 			}
-			m.FileSize = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12492,16 +23033,17 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FileSize |= (int64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Nodev = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileMode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Registered", wireType)
 			}
-			m.FileMode = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12511,16 +23053,17 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FileMode |= (uint32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Registered = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DirMode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Loadable", wireType)
 			}
-			m.DirMode = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12530,16 +23073,67 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DirMode |= (uint32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			m.Loadable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Uid = 0
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GuestFilesystems) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GuestFilesystems: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GuestFilesystems: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filesystems", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12549,16 +23143,28 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Uid |= (int32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filesystems = append(m.Filesystems, &GuestFilesystem{})
+			if err := m.Filesystems[len(m.Filesystems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdmappedMounts", wireType)
 			}
-			m.Gid = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12568,16 +23174,17 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gid |= (int32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			m.IdmappedMounts = bool(v != 0)
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overlayfs", wireType)
 			}
-			m.Offset = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12587,16 +23194,17 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= (int64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			m.Overlayfs = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtiofsDax", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12606,23 +23214,62 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			m.VirtiofsDax = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InitTimingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InitTimingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InitTimingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -12644,7 +23291,7 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartTracingRequest) Unmarshal(dAtA []byte) error {
+func (m *InitPhase) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12667,12 +23314,79 @@ func (m *StartTracingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartTracingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InitPhase: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartTracingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InitPhase: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartUs", wireType)
+			}
+			m.StartUs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartUs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationUs", wireType)
+			}
+			m.DurationUs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationUs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -12694,7 +23408,7 @@ func (m *StartTracingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopTracingRequest) Unmarshal(dAtA []byte) error {
+func (m *InitTimings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12717,12 +23431,62 @@ func (m *StopTracingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopTracingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InitTimings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopTracingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InitTimings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phases = append(m.Phases, &InitPhase{})
+			if err := m.Phases[len(m.Phases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartupUs", wireType)
+			}
+			m.StartupUs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartupUs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -12852,184 +23616,325 @@ var (
 func init() { proto.RegisterFile("agent.proto", fileDescriptorAgent) }
 
 var fileDescriptorAgent = []byte{
-	// 2862 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x39, 0x4b, 0x6f, 0x1c, 0xc7,
-	0xd1, 0xd8, 0x07, 0x97, 0xbb, 0xb5, 0x2f, 0x6e, 0x93, 0xa2, 0x56, 0x2b, 0x5b, 0x9f, 0x3c, 0xb6,
-	0x65, 0xfa, 0x73, 0xbc, 0xb4, 0x65, 0x23, 0x7e, 0xc1, 0x11, 0xc4, 0x47, 0x44, 0xc6, 0x56, 0xc4,
-	0x0c, 0x45, 0x38, 0x40, 0x10, 0x0c, 0x86, 0x33, 0xcd, 0x65, 0x9b, 0x3b, 0xd3, 0xe3, 0x9e, 0x1e,
-	0x8a, 0xeb, 0x00, 0x39, 0x26, 0xb7, 0x5c, 0x02, 0xe4, 0x96, 0x3f, 0x10, 0xe4, 0x96, 0x63, 0xae,
-	0x39, 0x18, 0x39, 0xe5, 0x17, 0x04, 0x81, 0x7f, 0x42, 0x7e, 0x41, 0xd0, 0xaf, 0x79, 0xec, 0x0e,
-	0x29, 0x84, 0x20, 0x90, 0xcb, 0xa2, 0xab, 0xba, 0xba, 0x5e, 0xdd, 0x55, 0x53, 0x55, 0x0b, 0x6d,
-	0x77, 0x82, 0x43, 0x3e, 0x8e, 0x18, 0xe5, 0x14, 0xd5, 0x27, 0x2c, 0xf2, 0x46, 0x2d, 0xea, 0x11,
-	0x85, 0x18, 0xfd, 0x70, 0x42, 0xf8, 0x69, 0x72, 0x3c, 0xf6, 0x68, 0xb0, 0x79, 0xe6, 0x72, 0xf7,
-	0x5d, 0x8f, 0x86, 0xdc, 0x25, 0x21, 0x66, 0xf1, 0xa6, 0x3c, 0xb8, 0x19, 0x9d, 0x4d, 0x36, 0xf9,
-	0x2c, 0xc2, 0xb1, 0xfa, 0xd5, 0xe7, 0xee, 0x4e, 0x28, 0x9d, 0x4c, 0xf1, 0xa6, 0x84, 0x8e, 0x93,
-	0x93, 0x4d, 0x1c, 0x44, 0x7c, 0xa6, 0x36, 0xad, 0x3f, 0x56, 0x61, 0x7d, 0x9b, 0x61, 0x97, 0xe3,
-	0x6d, 0xc3, 0xcd, 0xc6, 0xdf, 0x24, 0x38, 0xe6, 0xe8, 0x35, 0xe8, 0xa4, 0x12, 0x1c, 0xe2, 0x0f,
-	0x2b, 0xf7, 0x2b, 0x1b, 0x2d, 0xbb, 0x9d, 0xe2, 0xf6, 0x7d, 0x74, 0x1b, 0x96, 0xf1, 0x05, 0xf6,
-	0xc4, 0x6e, 0x55, 0xee, 0x36, 0x04, 0xb8, 0xef, 0xa3, 0xf7, 0xa1, 0x1d, 0x73, 0x46, 0xc2, 0x89,
-	0x93, 0xc4, 0x98, 0x0d, 0x6b, 0xf7, 0x2b, 0x1b, 0xed, 0x87, 0x2b, 0x63, 0x61, 0xd2, 0xf8, 0x50,
-	0x6e, 0x1c, 0xc5, 0x98, 0xd9, 0x10, 0xa7, 0x6b, 0xf4, 0x00, 0x96, 0x7d, 0x7c, 0x4e, 0x3c, 0x1c,
-	0x0f, 0xeb, 0xf7, 0x6b, 0x1b, 0xed, 0x87, 0x1d, 0x45, 0xbe, 0x23, 0x91, 0xb6, 0xd9, 0x44, 0x6f,
-	0x43, 0x33, 0xe6, 0x94, 0xb9, 0x13, 0x1c, 0x0f, 0x97, 0x24, 0x61, 0xd7, 0xf0, 0x95, 0x58, 0x3b,
-	0xdd, 0x46, 0xaf, 0x40, 0xed, 0xd9, 0xf6, 0xfe, 0xb0, 0x21, 0xa5, 0x83, 0xa6, 0x8a, 0xb0, 0x67,
-	0x0b, 0x34, 0x7a, 0x1d, 0xba, 0xb1, 0x1b, 0xfa, 0xc7, 0xf4, 0xc2, 0x89, 0x88, 0x1f, 0xc6, 0xc3,
-	0xe5, 0xfb, 0x95, 0x8d, 0xa6, 0xdd, 0xd1, 0xc8, 0x03, 0x81, 0xb3, 0x3e, 0x85, 0x5b, 0x87, 0xdc,
-	0x65, 0xfc, 0x1a, 0xde, 0xb1, 0x8e, 0x60, 0xdd, 0xc6, 0x01, 0x3d, 0xbf, 0x96, 0x6b, 0x87, 0xb0,
-	0xcc, 0x49, 0x80, 0x69, 0xc2, 0xa5, 0x6b, 0xbb, 0xb6, 0x01, 0xad, 0x3f, 0x57, 0x00, 0xed, 0x5e,
-	0x60, 0xef, 0x80, 0x51, 0x0f, 0xc7, 0xf1, 0xff, 0xe8, 0xba, 0xde, 0x82, 0xe5, 0x48, 0x29, 0x30,
-	0xac, 0x4b, 0x72, 0x7d, 0x0b, 0x46, 0x2b, 0xb3, 0x6b, 0x7d, 0x0d, 0x6b, 0x87, 0x64, 0x12, 0xba,
-	0xd3, 0x1b, 0xd4, 0x77, 0x1d, 0x1a, 0xb1, 0xe4, 0x29, 0x55, 0xed, 0xda, 0x1a, 0xb2, 0x0e, 0x00,
-	0x7d, 0xe5, 0x12, 0x7e, 0x73, 0x92, 0xac, 0x77, 0x61, 0xb5, 0xc0, 0x31, 0x8e, 0x68, 0x18, 0x63,
-	0xa9, 0x00, 0x77, 0x79, 0x12, 0x4b, 0x66, 0x4b, 0xb6, 0x86, 0x2c, 0x0c, 0x6b, 0x5f, 0x92, 0xd8,
-	0x90, 0xe3, 0xff, 0x46, 0x85, 0x75, 0x68, 0x9c, 0x50, 0x16, 0xb8, 0xdc, 0x68, 0xa0, 0x20, 0x84,
-	0xa0, 0xee, 0xb2, 0x49, 0x3c, 0xac, 0xdd, 0xaf, 0x6d, 0xb4, 0x6c, 0xb9, 0x16, 0xaf, 0x72, 0x4e,
-	0x8c, 0xd6, 0xeb, 0x35, 0xe8, 0x68, 0xbf, 0x3b, 0x53, 0x12, 0x73, 0x29, 0xa7, 0x63, 0xb7, 0x35,
-	0x4e, 0x9c, 0xb1, 0x28, 0xac, 0x1f, 0x45, 0xfe, 0x35, 0x03, 0xfe, 0x21, 0xb4, 0x18, 0x8e, 0x69,
-	0xc2, 0x44, 0x98, 0x56, 0xe5, 0xbd, 0xaf, 0xa9, 0x7b, 0xff, 0x92, 0x84, 0xc9, 0x85, 0x6d, 0xf6,
-	0xec, 0x8c, 0x4c, 0x87, 0x10, 0x8f, 0xaf, 0x13, 0x42, 0x9f, 0xc2, 0xad, 0x03, 0x37, 0x89, 0xaf,
-	0xa3, 0xab, 0xf5, 0x99, 0x08, 0xbf, 0x38, 0x09, 0xae, 0x75, 0xf8, 0x4f, 0x15, 0x68, 0x6e, 0x47,
-	0xc9, 0x51, 0xec, 0x4e, 0x30, 0xfa, 0x3f, 0x68, 0x73, 0xca, 0xdd, 0xa9, 0x93, 0x08, 0x50, 0x92,
-	0xd7, 0x6d, 0x90, 0x28, 0x45, 0x20, 0xdc, 0x8e, 0x99, 0x17, 0x25, 0x9a, 0xa2, 0x7a, 0xbf, 0xb6,
-	0x51, 0xb7, 0xdb, 0x0a, 0xa7, 0x48, 0xc6, 0xb0, 0x2a, 0xf7, 0x1c, 0x12, 0x3a, 0x67, 0x98, 0x85,
-	0x78, 0x1a, 0x50, 0x1f, 0xcb, 0xf7, 0x5b, 0xb7, 0x07, 0x72, 0x6b, 0x3f, 0xfc, 0x22, 0xdd, 0x40,
-	0xff, 0x0f, 0x83, 0x94, 0x5e, 0x04, 0xa5, 0xa4, 0xae, 0x4b, 0xea, 0xbe, 0xa6, 0x3e, 0xd2, 0x68,
-	0xeb, 0xd7, 0xd0, 0x7b, 0x7e, 0xca, 0x28, 0xe7, 0x53, 0x12, 0x4e, 0x76, 0x5c, 0xee, 0x8a, 0xec,
-	0x11, 0x61, 0x46, 0xa8, 0x1f, 0x6b, 0x6d, 0x0d, 0x88, 0xde, 0x81, 0x01, 0x57, 0xb4, 0xd8, 0x77,
-	0x0c, 0x4d, 0x55, 0xd2, 0xac, 0xa4, 0x1b, 0x07, 0x9a, 0xf8, 0x4d, 0xe8, 0x65, 0xc4, 0x22, 0xff,
-	0x68, 0x7d, 0xbb, 0x29, 0xf6, 0x39, 0x09, 0xb0, 0x75, 0x2e, 0x7d, 0x25, 0x2f, 0x19, 0xbd, 0x03,
-	0xad, 0xcc, 0x0f, 0x15, 0xf9, 0x42, 0x7a, 0xea, 0x85, 0x18, 0x77, 0xda, 0xcd, 0xd4, 0x29, 0x9f,
-	0x43, 0x9f, 0xa7, 0x8a, 0x3b, 0xbe, 0xcb, 0xdd, 0xe2, 0xa3, 0x2a, 0x5a, 0x65, 0xf7, 0x78, 0x01,
-	0xb6, 0x3e, 0x83, 0xd6, 0x01, 0xf1, 0x63, 0x25, 0x78, 0x08, 0xcb, 0x5e, 0xc2, 0x18, 0x0e, 0xb9,
-	0x31, 0x59, 0x83, 0x68, 0x0d, 0x96, 0xa6, 0x24, 0x20, 0x5c, 0x9b, 0xa9, 0x00, 0x8b, 0x02, 0x3c,
-	0xc5, 0x01, 0x65, 0x33, 0xe9, 0xb0, 0x35, 0x58, 0xca, 0x5f, 0xae, 0x02, 0xd0, 0x5d, 0x68, 0x05,
-	0xee, 0x45, 0x7a, 0xa9, 0x62, 0xa7, 0x19, 0xb8, 0x17, 0x4a, 0xf9, 0x21, 0x2c, 0x9f, 0xb8, 0x64,
-	0xea, 0x85, 0x5c, 0x7b, 0xc5, 0x80, 0x99, 0xc0, 0x7a, 0x5e, 0xe0, 0xdf, 0xaa, 0xd0, 0x56, 0x12,
-	0x95, 0xc2, 0x6b, 0xb0, 0xe4, 0xb9, 0xde, 0x69, 0x2a, 0x52, 0x02, 0xe8, 0x81, 0x51, 0xa4, 0x9a,
-	0x4f, 0xc2, 0x99, 0xa6, 0x46, 0xb5, 0x4d, 0x80, 0xf8, 0x85, 0x1b, 0x69, 0xdd, 0x6a, 0x97, 0x10,
-	0xb7, 0x04, 0x8d, 0x52, 0xf7, 0x03, 0xe8, 0xa8, 0x77, 0xa7, 0x8f, 0xd4, 0x2f, 0x39, 0xd2, 0x56,
-	0x54, 0xea, 0xd0, 0xeb, 0xd0, 0x4d, 0x62, 0xec, 0x9c, 0x12, 0xcc, 0x5c, 0xe6, 0x9d, 0xce, 0x86,
-	0x4b, 0xea, 0x1b, 0x99, 0xc4, 0x78, 0xcf, 0xe0, 0xd0, 0x43, 0x58, 0x12, 0xe9, 0x2f, 0x1e, 0x36,
-	0xe4, 0xe7, 0xf8, 0x95, 0x3c, 0x4b, 0x69, 0xea, 0x58, 0xfe, 0xee, 0x86, 0x9c, 0xcd, 0x6c, 0x45,
-	0x3a, 0xfa, 0x18, 0x20, 0x43, 0xa2, 0x15, 0xa8, 0x9d, 0xe1, 0x99, 0x8e, 0x43, 0xb1, 0x14, 0xce,
-	0x39, 0x77, 0xa7, 0x89, 0xf1, 0xba, 0x02, 0x3e, 0xad, 0x7e, 0x5c, 0xb1, 0x3c, 0xe8, 0x6f, 0x4d,
-	0xcf, 0x08, 0xcd, 0x1d, 0x5f, 0x83, 0xa5, 0xc0, 0xfd, 0x9a, 0x32, 0xe3, 0x49, 0x09, 0x48, 0x2c,
-	0x09, 0x29, 0x33, 0x2c, 0x24, 0x80, 0x7a, 0x50, 0xa5, 0x91, 0xf4, 0x57, 0xcb, 0xae, 0xd2, 0x28,
-	0x13, 0x54, 0xcf, 0x09, 0xb2, 0xfe, 0x59, 0x07, 0xc8, 0xa4, 0x20, 0x1b, 0x46, 0x84, 0x3a, 0x31,
-	0x66, 0xa2, 0x04, 0x71, 0x8e, 0x67, 0x1c, 0xc7, 0x0e, 0xc3, 0x5e, 0xc2, 0x62, 0x72, 0x2e, 0xee,
-	0x4f, 0x98, 0x7d, 0x4b, 0x99, 0x3d, 0xa7, 0x9b, 0x7d, 0x9b, 0xd0, 0x43, 0x75, 0x6e, 0x4b, 0x1c,
-	0xb3, 0xcd, 0x29, 0xb4, 0x0f, 0xb7, 0x32, 0x9e, 0x7e, 0x8e, 0x5d, 0xf5, 0x2a, 0x76, 0xab, 0x29,
-	0x3b, 0x3f, 0x63, 0xb5, 0x0b, 0xab, 0x84, 0x3a, 0xdf, 0x24, 0x38, 0x29, 0x30, 0xaa, 0x5d, 0xc5,
-	0x68, 0x40, 0xe8, 0xcf, 0xe4, 0x81, 0x8c, 0xcd, 0x01, 0xdc, 0xc9, 0x59, 0x29, 0xc2, 0x3d, 0xc7,
-	0xac, 0x7e, 0x15, 0xb3, 0xf5, 0x54, 0x2b, 0x91, 0x0f, 0x32, 0x8e, 0x3f, 0x81, 0x75, 0x42, 0x9d,
-	0x17, 0x2e, 0xe1, 0xf3, 0xec, 0x96, 0x5e, 0x62, 0xa4, 0xf8, 0xe8, 0x16, 0x79, 0x29, 0x23, 0x03,
-	0xcc, 0x26, 0x05, 0x23, 0x1b, 0x2f, 0x31, 0xf2, 0xa9, 0x3c, 0x90, 0xb1, 0x79, 0x0c, 0x03, 0x42,
-	0xe7, 0xb5, 0x59, 0xbe, 0x8a, 0x49, 0x9f, 0xd0, 0xa2, 0x26, 0x5b, 0x30, 0x88, 0xb1, 0xc7, 0x29,
-	0xcb, 0x3f, 0x82, 0xe6, 0x55, 0x2c, 0x56, 0x34, 0x7d, 0xca, 0xc3, 0xfa, 0x05, 0x74, 0xf6, 0x92,
-	0x09, 0xe6, 0xd3, 0xe3, 0x34, 0x19, 0xdc, 0x58, 0xfe, 0xb1, 0xfe, 0x5d, 0x85, 0xf6, 0xf6, 0x84,
-	0xd1, 0x24, 0x2a, 0xe4, 0x64, 0x15, 0xa4, 0xf3, 0x39, 0x59, 0x92, 0xc8, 0x9c, 0xac, 0x88, 0x3f,
-	0x84, 0x4e, 0x20, 0x43, 0x57, 0xd3, 0xab, 0x3c, 0x34, 0x58, 0x08, 0x6a, 0xbb, 0x1d, 0xe4, 0x92,
-	0xd9, 0x18, 0x20, 0x22, 0x7e, 0xac, 0xcf, 0xa8, 0x74, 0xd4, 0xd7, 0x15, 0xa1, 0x49, 0xd1, 0x76,
-	0x2b, 0x4a, 0xb3, 0xf5, 0xfb, 0xd0, 0x3e, 0x16, 0x4e, 0xd2, 0x07, 0x0a, 0xc9, 0x28, 0xf3, 0x9e,
-	0x0d, 0xc7, 0x59, 0x10, 0xee, 0x41, 0xf7, 0x54, 0xb9, 0x4c, 0x1f, 0x52, 0x6f, 0xe8, 0x75, 0x6d,
-	0x49, 0x66, 0xef, 0x38, 0xef, 0x59, 0x75, 0x01, 0x9d, 0xd3, 0x1c, 0x6a, 0x74, 0x08, 0x83, 0x05,
-	0x92, 0x92, 0x1c, 0xb4, 0x91, 0xcf, 0x41, 0xed, 0x87, 0x48, 0x09, 0xca, 0x9f, 0xcc, 0xe7, 0xa5,
-	0xdf, 0x55, 0xa1, 0xf3, 0x53, 0xcc, 0x5f, 0x50, 0x76, 0xa6, 0xf4, 0x45, 0x50, 0x0f, 0xdd, 0x00,
-	0x6b, 0x8e, 0x72, 0x8d, 0xee, 0x40, 0x93, 0x5d, 0xa8, 0x04, 0xa2, 0xef, 0x73, 0x99, 0x5d, 0xc8,
-	0xc4, 0x80, 0x5e, 0x05, 0x60, 0x17, 0x4e, 0xe4, 0x7a, 0x67, 0x58, 0x7b, 0xb0, 0x6e, 0xb7, 0xd8,
-	0xc5, 0x81, 0x42, 0x88, 0xa7, 0xc0, 0x2e, 0x1c, 0xcc, 0x18, 0x65, 0xb1, 0xce, 0x55, 0x4d, 0x76,
-	0xb1, 0x2b, 0x61, 0x7d, 0xd6, 0x67, 0x34, 0x8a, 0xb0, 0x2f, 0x73, 0xb4, 0x3c, 0xbb, 0xa3, 0x10,
-	0x42, 0x2a, 0x37, 0x52, 0x1b, 0x4a, 0x2a, 0xcf, 0xa4, 0xf2, 0x4c, 0xea, 0xb2, 0x3a, 0xc9, 0xf3,
-	0x52, 0x79, 0x2a, 0xb5, 0xa9, 0xa4, 0xf2, 0x9c, 0x54, 0x9e, 0x49, 0x6d, 0x99, 0xb3, 0x5a, 0xaa,
-	0xf5, 0xdb, 0x0a, 0xac, 0xcf, 0x17, 0x7e, 0xba, 0x4c, 0xfd, 0x10, 0x3a, 0x9e, 0xbc, 0xaf, 0xc2,
-	0x9b, 0x1c, 0x2c, 0xdc, 0xa4, 0xdd, 0xf6, 0x72, 0xcf, 0xf8, 0x23, 0xe8, 0x86, 0xca, 0xc1, 0xe9,
-	0xd3, 0xac, 0x65, 0xf7, 0x92, 0xf7, 0xbd, 0xdd, 0x09, 0x73, 0x90, 0xe5, 0x03, 0xfa, 0x8a, 0x11,
-	0x8e, 0x0f, 0x39, 0xc3, 0x6e, 0x70, 0x13, 0x0d, 0x08, 0x82, 0xba, 0xac, 0x56, 0x6a, 0xb2, 0xbe,
-	0x96, 0x6b, 0xeb, 0x2d, 0x58, 0x2d, 0x48, 0xd1, 0xb6, 0xae, 0x40, 0x6d, 0x8a, 0x43, 0xc9, 0xbd,
-	0x6b, 0x8b, 0xa5, 0xe5, 0xc2, 0xc0, 0xc6, 0xae, 0x7f, 0x73, 0xda, 0x68, 0x11, 0xb5, 0x4c, 0xc4,
-	0x06, 0xa0, 0xbc, 0x08, 0xad, 0x8a, 0xd1, 0xba, 0x92, 0xd3, 0xfa, 0x19, 0x0c, 0xb6, 0xa7, 0x34,
-	0xc6, 0x87, 0xdc, 0x27, 0xe1, 0x4d, 0x74, 0x4c, 0xbf, 0x82, 0xd5, 0xe7, 0x7c, 0xf6, 0x95, 0x60,
-	0x16, 0x93, 0x6f, 0xf1, 0x0d, 0xd9, 0xc7, 0xe8, 0x0b, 0x63, 0x1f, 0xa3, 0x2f, 0x44, 0xb3, 0xe4,
-	0xd1, 0x69, 0x12, 0x84, 0x32, 0x14, 0xba, 0xb6, 0x86, 0xac, 0x2d, 0xe8, 0xa8, 0x1a, 0xfa, 0x29,
-	0xf5, 0x93, 0x29, 0x2e, 0x8d, 0xc1, 0x7b, 0x00, 0x91, 0xcb, 0xdc, 0x00, 0x73, 0xcc, 0xd4, 0x1b,
-	0x6a, 0xd9, 0x39, 0x8c, 0xf5, 0x87, 0x2a, 0xac, 0xa9, 0x91, 0xc8, 0xa1, 0x9a, 0x04, 0x18, 0x13,
-	0x46, 0xd0, 0x3c, 0xa5, 0x31, 0xcf, 0x31, 0x4c, 0x61, 0xa1, 0xa2, 0x1f, 0x1a, 0x6e, 0x62, 0x59,
-	0x98, 0x53, 0xd4, 0xae, 0x9e, 0x53, 0x2c, 0x4c, 0x22, 0xea, 0x8b, 0x93, 0x08, 0x11, 0x6d, 0x86,
-	0x88, 0xa8, 0x18, 0x6f, 0xd9, 0x2d, 0x8d, 0xd9, 0xf7, 0xd1, 0x03, 0xe8, 0x4f, 0x84, 0x96, 0xce,
-	0x29, 0xa5, 0x67, 0x4e, 0xe4, 0xf2, 0x53, 0x19, 0xea, 0x2d, 0xbb, 0x2b, 0xd1, 0x7b, 0x94, 0x9e,
-	0x1d, 0xb8, 0xfc, 0x14, 0x7d, 0x02, 0x3d, 0x5d, 0x06, 0x06, 0xd2, 0x45, 0xb1, 0xfe, 0xf8, 0xe9,
-	0x28, 0xca, 0x7b, 0xcf, 0xee, 0x9e, 0xe5, 0xa0, 0xd8, 0xba, 0x0d, 0xb7, 0x76, 0x70, 0xcc, 0x19,
-	0x9d, 0x15, 0x1d, 0x63, 0xfd, 0x08, 0x60, 0x3f, 0xe4, 0x98, 0x9d, 0xb8, 0x1e, 0x8e, 0xd1, 0x7b,
-	0x79, 0x48, 0x17, 0x47, 0x2b, 0x63, 0x35, 0x91, 0x4a, 0x37, 0xec, 0x1c, 0x8d, 0x35, 0x86, 0x86,
-	0x4d, 0x13, 0x91, 0x8e, 0xde, 0x30, 0x2b, 0x7d, 0xae, 0xa3, 0xcf, 0x49, 0xa4, 0xad, 0xf7, 0xac,
-	0x3d, 0xd3, 0xc2, 0x66, 0xec, 0xf4, 0x15, 0x8d, 0xa1, 0x45, 0x0c, 0x4e, 0x67, 0x95, 0x45, 0xd1,
-	0x19, 0x89, 0xf5, 0x19, 0xac, 0x2a, 0x4e, 0x8a, 0xb3, 0x61, 0xf3, 0x06, 0x34, 0x98, 0x51, 0xa3,
-	0x92, 0x8d, 0xa2, 0x34, 0x91, 0xde, 0x13, 0xfe, 0x10, 0x1d, 0x75, 0x66, 0x88, 0xf1, 0xc7, 0x2a,
-	0x0c, 0xc4, 0x46, 0x81, 0xa7, 0xf5, 0x4b, 0x58, 0x7d, 0x16, 0x4e, 0x49, 0x88, 0xb7, 0x0f, 0x8e,
-	0x9e, 0xe2, 0x34, 0xee, 0x11, 0xd4, 0x45, 0x7d, 0x24, 0x05, 0x35, 0x6d, 0xb9, 0x16, 0x81, 0x10,
-	0x1e, 0x3b, 0x5e, 0x94, 0xc4, 0x7a, 0xf6, 0xd3, 0x08, 0x8f, 0xb7, 0xa3, 0x24, 0x16, 0x89, 0x5c,
-	0x7c, 0xc8, 0x69, 0x38, 0x9d, 0xc9, 0x68, 0x68, 0xda, 0xcb, 0x5e, 0x94, 0x3c, 0x0b, 0xa7, 0x33,
-	0xeb, 0x07, 0xb2, 0xdb, 0xc5, 0xd8, 0xb7, 0xdd, 0xd0, 0xa7, 0xc1, 0x0e, 0x3e, 0xcf, 0x49, 0x48,
-	0x3b, 0x2b, 0x13, 0xf5, 0xdf, 0x55, 0xa0, 0xf3, 0x78, 0x82, 0x43, 0xbe, 0x83, 0xb9, 0x4b, 0xa6,
-	0xb2, 0x7b, 0x3a, 0xc7, 0x2c, 0x26, 0x34, 0xd4, 0x4f, 0xdb, 0x80, 0xa2, 0xf9, 0x25, 0x21, 0xe1,
-	0x8e, 0xef, 0xe2, 0x80, 0x86, 0x92, 0x4b, 0xd3, 0x06, 0x81, 0xda, 0x91, 0x18, 0xf4, 0x16, 0xf4,
-	0xd5, 0x6c, 0xce, 0x39, 0x75, 0x43, 0x7f, 0x2a, 0x82, 0x4a, 0xcd, 0x2a, 0x7a, 0x0a, 0xbd, 0xa7,
-	0xb1, 0xe8, 0x6d, 0x58, 0xd1, 0x4f, 0x3e, 0xa3, 0xac, 0x4b, 0xca, 0xbe, 0xc6, 0x17, 0x48, 0x93,
-	0x28, 0xa2, 0x8c, 0xc7, 0x4e, 0x8c, 0x3d, 0x8f, 0x06, 0x91, 0x6e, 0x3d, 0xfa, 0x06, 0x7f, 0xa8,
-	0xd0, 0xd6, 0x04, 0x56, 0x9f, 0x08, 0x3b, 0xb5, 0x25, 0xd9, 0x15, 0xf6, 0x02, 0x1c, 0x38, 0xc7,
-	0x53, 0xea, 0x9d, 0x39, 0x22, 0x11, 0x69, 0x0f, 0x8b, 0xe2, 0x66, 0x4b, 0x20, 0x0f, 0xc9, 0xb7,
-	0xb2, 0xcb, 0x16, 0x54, 0xa7, 0x94, 0x47, 0xd3, 0x64, 0xe2, 0x44, 0x8c, 0x1e, 0x63, 0x6d, 0x62,
-	0x3f, 0xc0, 0xc1, 0x9e, 0xc2, 0x1f, 0x08, 0xb4, 0xf5, 0xd7, 0x0a, 0xac, 0x15, 0x25, 0xe9, 0xb4,
-	0xba, 0x09, 0x6b, 0x45, 0x51, 0xfa, 0x53, 0xab, 0x4a, 0xb9, 0x41, 0x5e, 0xa0, 0xfa, 0xe8, 0x7e,
-	0x04, 0x5d, 0x39, 0xb0, 0x75, 0x7c, 0xc5, 0xa9, 0x58, 0x60, 0xe4, 0xef, 0xc5, 0xee, 0xb8, 0xf9,
-	0x5b, 0xfa, 0x04, 0xee, 0x68, 0xf3, 0x9d, 0x45, 0xb5, 0xd5, 0x83, 0x58, 0xd7, 0x04, 0x4f, 0xe7,
-	0xb4, 0xff, 0x12, 0x86, 0x19, 0x6a, 0x6b, 0x26, 0x91, 0xc6, 0x57, 0xef, 0xc1, 0xea, 0x9c, 0xb1,
-	0x8f, 0x7d, 0x9f, 0xc9, 0x10, 0xac, 0xdb, 0x65, 0x5b, 0xd6, 0x23, 0xb8, 0x7d, 0x88, 0xb9, 0xf2,
-	0x86, 0xcb, 0x75, 0xd5, 0xaf, 0x98, 0xad, 0x40, 0xed, 0x10, 0x7b, 0xd2, 0xf8, 0x9a, 0x2d, 0x96,
-	0xe2, 0x01, 0x1e, 0xc5, 0xd8, 0x93, 0x56, 0xd6, 0x6c, 0xb9, 0xb6, 0xfe, 0x52, 0x81, 0x65, 0x9d,
-	0x08, 0x45, 0x32, 0xf7, 0x19, 0x39, 0xc7, 0x4c, 0x3f, 0x3d, 0x0d, 0xa1, 0x37, 0xa1, 0xa7, 0x56,
-	0x0e, 0x8d, 0x38, 0xa1, 0x69, 0x7a, 0xed, 0x2a, 0xec, 0x33, 0x85, 0x94, 0xb3, 0x38, 0x39, 0x6a,
-	0xd2, 0x5d, 0x9d, 0x86, 0xe4, 0x40, 0x2d, 0x16, 0xb1, 0x2f, 0xd3, 0x69, 0xcb, 0xd6, 0x90, 0x78,
-	0xea, 0x86, 0xdf, 0x92, 0xe4, 0x67, 0x40, 0xf1, 0xd4, 0x03, 0x9a, 0x84, 0xdc, 0x89, 0x28, 0x09,
-	0xb9, 0xce, 0x9f, 0x20, 0x51, 0x07, 0x02, 0x63, 0xfd, 0xa6, 0x02, 0x0d, 0x35, 0x8f, 0x16, 0x7d,
-	0x64, 0xfa, 0x15, 0xab, 0x12, 0x59, 0x11, 0x48, 0x59, 0xea, 0xcb, 0x25, 0xd7, 0x22, 0x8e, 0xcf,
-	0x03, 0x95, 0x8b, 0xb5, 0x6a, 0xe7, 0x81, 0x4c, 0xc2, 0x6f, 0x42, 0x2f, 0xfb, 0x18, 0xca, 0x7d,
-	0xa5, 0x62, 0x37, 0xc5, 0x4a, 0xb2, 0x4b, 0x35, 0xb5, 0x7e, 0x2e, 0xda, 0xe7, 0x74, 0x16, 0xbb,
-	0x02, 0xb5, 0x24, 0x55, 0x46, 0x2c, 0x05, 0x66, 0x92, 0x7e, 0x46, 0xc5, 0x12, 0x3d, 0x80, 0x9e,
-	0xeb, 0xfb, 0x44, 0x1c, 0x77, 0xa7, 0x4f, 0x88, 0x9f, 0x06, 0x69, 0x11, 0x6b, 0xfd, 0xbd, 0x02,
-	0xfd, 0x6d, 0x1a, 0xcd, 0x7e, 0x4c, 0xa6, 0x38, 0x97, 0x41, 0xa4, 0x92, 0xfa, 0x2b, 0x2a, 0xd6,
-	0xa2, 0x32, 0x3c, 0x21, 0x53, 0xac, 0x42, 0x4b, 0xdd, 0x6c, 0x53, 0x20, 0x64, 0x58, 0x99, 0xcd,
-	0x74, 0xc4, 0xd5, 0x55, 0x9b, 0x4f, 0xa9, 0x2f, 0x6b, 0x60, 0x9f, 0x30, 0x27, 0x1d, 0x68, 0x75,
-	0xed, 0x65, 0x9f, 0x30, 0xb9, 0xa5, 0x0d, 0x59, 0x92, 0x33, 0xd5, 0xbc, 0x21, 0x0d, 0x85, 0x11,
-	0x86, 0xac, 0x43, 0x83, 0x9e, 0x9c, 0xc4, 0x98, 0xcb, 0x6a, 0xb5, 0x66, 0x6b, 0x28, 0x4d, 0x73,
-	0xcd, 0x5c, 0x9a, 0xbb, 0x05, 0xab, 0x72, 0x7a, 0xff, 0x9c, 0xb9, 0x1e, 0x09, 0x27, 0x26, 0x15,
-	0xaf, 0x01, 0x3a, 0xe4, 0x34, 0x2a, 0x62, 0x1f, 0xfe, 0x7e, 0x45, 0xe7, 0x44, 0xdd, 0xca, 0xa2,
-	0x27, 0xd0, 0x9f, 0xfb, 0x6b, 0x04, 0xe9, 0xd9, 0x46, 0xf9, 0x3f, 0x26, 0xa3, 0xf5, 0xb1, 0xfa,
-	0xab, 0x65, 0x6c, 0xfe, 0x6a, 0x19, 0xef, 0x06, 0x11, 0x9f, 0xa1, 0x5d, 0xe8, 0x15, 0xff, 0x44,
-	0x40, 0x77, 0x4d, 0x29, 0x50, 0xf2, 0xd7, 0xc2, 0xa5, 0x6c, 0x9e, 0x40, 0x7f, 0xee, 0xff, 0x04,
-	0xa3, 0x4f, 0xf9, 0xdf, 0x0c, 0x97, 0x32, 0x7a, 0x04, 0xed, 0xdc, 0x1f, 0x08, 0x68, 0xa8, 0x98,
-	0x2c, 0xfe, 0xa7, 0x70, 0x29, 0x83, 0x6d, 0xe8, 0x16, 0x66, 0xfa, 0x68, 0xa4, 0xed, 0x29, 0x19,
-	0xf4, 0x5f, 0xca, 0x64, 0x0b, 0xda, 0xb9, 0xd1, 0xba, 0xd1, 0x62, 0x71, 0x7e, 0x3f, 0xba, 0x53,
-	0xb2, 0xa3, 0x53, 0xef, 0x1e, 0x74, 0x0b, 0x83, 0x70, 0xa3, 0x48, 0xd9, 0x10, 0x7e, 0x74, 0xb7,
-	0x74, 0x4f, 0x73, 0x7a, 0x02, 0xfd, 0xb9, 0xb1, 0xb8, 0x71, 0x6e, 0xf9, 0xb4, 0xfc, 0x52, 0xb3,
-	0xbe, 0x90, 0x97, 0x9d, 0xeb, 0x7a, 0x72, 0x97, 0xbd, 0x38, 0x04, 0x1f, 0xbd, 0x52, 0xbe, 0xa9,
-	0xb5, 0xda, 0x85, 0x5e, 0x71, 0xfe, 0x6d, 0x98, 0x95, 0x4e, 0xc5, 0xaf, 0x7e, 0x39, 0x85, 0x51,
-	0x78, 0xf6, 0x72, 0xca, 0x26, 0xe4, 0x97, 0x32, 0x7a, 0x0c, 0xa0, 0x7b, 0x1c, 0x9f, 0x84, 0xe9,
-	0x95, 0x2d, 0xf4, 0x56, 0xe9, 0x95, 0x95, 0xf4, 0x43, 0x8f, 0x00, 0x54, 0x6b, 0xe2, 0xd3, 0x84,
-	0xa3, 0xdb, 0x46, 0x8d, 0xb9, 0x7e, 0x68, 0x34, 0x5c, 0xdc, 0x58, 0x60, 0x80, 0x19, 0xbb, 0x0e,
-	0x83, 0xcf, 0x01, 0xb2, 0x96, 0xc7, 0x30, 0x58, 0x68, 0x82, 0xae, 0xf0, 0x41, 0x27, 0xdf, 0xe0,
-	0x20, 0x6d, 0x6b, 0x49, 0xd3, 0x73, 0x05, 0x8b, 0xfe, 0x5c, 0x01, 0x5b, 0x7c, 0x6c, 0xf3, 0x75,
-	0xed, 0x68, 0xa1, 0x88, 0x45, 0x1f, 0x41, 0x27, 0x5f, 0xb9, 0x1a, 0x2d, 0x4a, 0xaa, 0xd9, 0x51,
-	0xa1, 0x7a, 0x45, 0x8f, 0xa0, 0x57, 0xac, 0x5a, 0x51, 0x2e, 0x2e, 0x16, 0x6a, 0xd9, 0x91, 0x9e,
-	0xc9, 0xe4, 0xc8, 0x3f, 0x00, 0xc8, 0xaa, 0x5b, 0xe3, 0xbe, 0x85, 0x7a, 0x77, 0x4e, 0xea, 0x63,
-	0xe8, 0xe4, 0x33, 0xb1, 0x51, 0xb7, 0x24, 0x3b, 0x5f, 0x95, 0xb5, 0x72, 0x59, 0xdb, 0x3c, 0xbe,
-	0xc5, 0x44, 0x7e, 0x55, 0xd6, 0x2a, 0xf4, 0x75, 0x26, 0x59, 0x94, 0x35, 0x7b, 0x57, 0xe5, 0xf2,
-	0x62, 0x13, 0x64, 0xdc, 0x57, 0xda, 0x1a, 0x5d, 0xf5, 0x88, 0xf2, 0xdd, 0x80, 0xf1, 0x47, 0x49,
-	0x87, 0xf0, 0x92, 0xa0, 0xce, 0x57, 0xfc, 0xb9, 0xa0, 0x2e, 0x69, 0x04, 0x2e, 0x65, 0xb4, 0x07,
-	0xfd, 0x27, 0xa6, 0x98, 0xd3, 0x85, 0xa6, 0x56, 0xa7, 0xa4, 0xb0, 0x1e, 0x8d, 0xca, 0xb6, 0x74,
-	0x64, 0x7d, 0x01, 0x83, 0x85, 0x22, 0x13, 0xdd, 0x4b, 0x47, 0x87, 0xa5, 0xd5, 0xe7, 0xa5, 0x6a,
-	0xed, 0xc3, 0xca, 0x7c, 0x8d, 0x89, 0x5e, 0xd5, 0x97, 0x5e, 0x5e, 0x7b, 0x5e, 0xca, 0xea, 0x13,
-	0x68, 0x9a, 0x9a, 0x06, 0xe9, 0x11, 0xed, 0x5c, 0x8d, 0x73, 0xd9, 0xd1, 0xad, 0xce, 0x77, 0xdf,
-	0xdf, 0xab, 0xfc, 0xe3, 0xfb, 0x7b, 0x95, 0x7f, 0x7d, 0x7f, 0xaf, 0x72, 0xdc, 0x90, 0xbb, 0x1f,
-	0xfc, 0x27, 0x00, 0x00, 0xff, 0xff, 0xa5, 0xac, 0x85, 0x1d, 0xaa, 0x21, 0x00, 0x00,
+	// 5120 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3b, 0x4d, 0x6f, 0x23, 0x47,
+	0x76, 0x4b, 0x89, 0x92, 0xc8, 0x47, 0x52, 0x94, 0x5a, 0x1a, 0x0d, 0x87, 0x9e, 0x99, 0x95, 0xdb,
+	0x5e, 0x7b, 0x6c, 0xef, 0x6a, 0xbc, 0x1a, 0x63, 0xfd, 0x15, 0xc7, 0x18, 0x69, 0x94, 0x19, 0xc5,
+	0xd2, 0x48, 0xdb, 0x1c, 0xad, 0x17, 0x5e, 0x24, 0x9d, 0x56, 0x77, 0x89, 0xec, 0x15, 0xd9, 0xd5,
+	0xae, 0xaa, 0xe6, 0x88, 0x1b, 0x64, 0x0f, 0x09, 0x90, 0x63, 0x10, 0x20, 0xb9, 0x07, 0xc8, 0x31,
+	0xa7, 0x00, 0x41, 0x90, 0x43, 0xae, 0x39, 0x18, 0x39, 0xe5, 0x1e, 0x20, 0x08, 0x7c, 0xcd, 0x29,
+	0xf9, 0x05, 0xc1, 0xab, 0x8f, 0xfe, 0x20, 0x9b, 0x9a, 0x78, 0x3c, 0x40, 0x2e, 0x44, 0xbf, 0x57,
+	0xaf, 0xde, 0x7b, 0x55, 0xf5, 0xea, 0x55, 0xd5, 0x7b, 0x8f, 0xd0, 0xf0, 0xfa, 0x24, 0x12, 0x3b,
+	0x31, 0xa3, 0x82, 0x5a, 0xd5, 0x3e, 0x8b, 0xfd, 0x6e, 0x9d, 0xfa, 0xa1, 0x42, 0x74, 0x7f, 0xd6,
+	0x0f, 0xc5, 0x20, 0x39, 0xdf, 0xf1, 0xe9, 0xe8, 0xfe, 0xa5, 0x27, 0xbc, 0x9f, 0xf8, 0x34, 0x12,
+	0x5e, 0x18, 0x11, 0xc6, 0xef, 0xcb, 0x8e, 0xf7, 0xe3, 0xcb, 0xfe, 0x7d, 0x31, 0x89, 0x09, 0x57,
+	0xbf, 0xba, 0xdf, 0x6b, 0x7d, 0x4a, 0xfb, 0x43, 0x72, 0x5f, 0x42, 0xe7, 0xc9, 0xc5, 0x7d, 0x32,
+	0x8a, 0xc5, 0x44, 0x35, 0xda, 0x7f, 0xba, 0x04, 0x5b, 0xfb, 0x8c, 0x78, 0x82, 0xec, 0x1b, 0x6e,
+	0x0e, 0xf9, 0x3a, 0x21, 0x5c, 0x58, 0xaf, 0x43, 0x33, 0x95, 0xe0, 0x86, 0x41, 0xa7, 0xb2, 0x5d,
+	0xb9, 0x57, 0x77, 0x1a, 0x29, 0xee, 0x30, 0xb0, 0x6e, 0xc2, 0x0a, 0xb9, 0x22, 0x3e, 0xb6, 0x2e,
+	0xc8, 0xd6, 0x65, 0x04, 0x0f, 0x03, 0xeb, 0xa7, 0xd0, 0xe0, 0x82, 0x85, 0x51, 0xdf, 0x4d, 0x38,
+	0x61, 0x9d, 0xc5, 0xed, 0xca, 0xbd, 0xc6, 0xee, 0xda, 0x0e, 0x0e, 0x69, 0xa7, 0x27, 0x1b, 0xce,
+	0x38, 0x61, 0x0e, 0xf0, 0xf4, 0xdb, 0x7a, 0x0b, 0x56, 0x02, 0x32, 0x0e, 0x7d, 0xc2, 0x3b, 0xd5,
+	0xed, 0xc5, 0x7b, 0x8d, 0xdd, 0xa6, 0x22, 0x7f, 0x24, 0x91, 0x8e, 0x69, 0xb4, 0xde, 0x81, 0x1a,
+	0x17, 0x94, 0x79, 0x7d, 0xc2, 0x3b, 0x4b, 0x92, 0xb0, 0x65, 0xf8, 0x4a, 0xac, 0x93, 0x36, 0x5b,
+	0xb7, 0x61, 0xf1, 0x64, 0xff, 0xb0, 0xb3, 0x2c, 0xa5, 0x83, 0xa6, 0x8a, 0x89, 0xef, 0x20, 0xda,
+	0x7a, 0x03, 0x5a, 0xdc, 0x8b, 0x82, 0x73, 0x7a, 0xe5, 0xc6, 0x61, 0x10, 0xf1, 0xce, 0xca, 0x76,
+	0xe5, 0x5e, 0xcd, 0x69, 0x6a, 0xe4, 0x29, 0xe2, 0xac, 0xd7, 0xa0, 0xee, 0xf7, 0x19, 0x4d, 0x62,
+	0x37, 0xe2, 0x9d, 0x9a, 0x24, 0xa8, 0x29, 0xc4, 0x53, 0x6e, 0xdd, 0x01, 0x08, 0x22, 0xee, 0x72,
+	0xe2, 0x31, 0x7f, 0xd0, 0xa9, 0x6f, 0x2f, 0xde, 0xab, 0x3b, 0xf5, 0x20, 0xe2, 0x3d, 0x89, 0xb0,
+	0x7e, 0x08, 0x0d, 0x6c, 0xa6, 0xb1, 0x08, 0x69, 0xc4, 0x3b, 0x20, 0xdb, 0xb1, 0xc7, 0x89, 0xc2,
+	0xc8, 0xfe, 0x21, 0xbf, 0x74, 0xbf, 0x4e, 0xa8, 0xf0, 0x3a, 0x8d, 0xed, 0xca, 0xbd, 0xaa, 0x53,
+	0x47, 0xcc, 0xcf, 0x11, 0x61, 0xbd, 0x0b, 0xeb, 0x31, 0xa3, 0xbe, 0xcb, 0x27, 0xdc, 0x7d, 0xce,
+	0x42, 0xe1, 0x9d, 0x0f, 0x49, 0xa7, 0x29, 0xb9, 0xb4, 0xb1, 0xa1, 0x37, 0xe1, 0x5f, 0x6a, 0xb4,
+	0xb5, 0x03, 0x40, 0x13, 0x11, 0x27, 0xc2, 0x1d, 0xd2, 0x7e, 0xa7, 0x25, 0x47, 0xdc, 0x56, 0x23,
+	0x3e, 0x91, 0xf8, 0x23, 0xda, 0x77, 0xea, 0xd4, 0x7c, 0x5a, 0xef, 0xc0, 0x9a, 0x17, 0xc7, 0x1e,
+	0x1b, 0x51, 0xe6, 0xc6, 0x8c, 0x5e, 0x84, 0x43, 0xd2, 0x59, 0x95, 0x4b, 0xd8, 0x36, 0xf8, 0x53,
+	0x85, 0xb6, 0xf6, 0x61, 0x5d, 0xb3, 0x16, 0xe1, 0x88, 0x70, 0xe1, 0x8d, 0x62, 0xde, 0x69, 0x4b,
+	0x09, 0x5b, 0x79, 0x09, 0xcf, 0xd2, 0x56, 0x67, 0x8d, 0x4e, 0x61, 0x70, 0x1e, 0xc3, 0x91, 0xd7,
+	0x27, 0x2e, 0x89, 0xc6, 0x9d, 0x35, 0x39, 0x86, 0x9a, 0x44, 0x1c, 0x44, 0x63, 0x9b, 0x40, 0x3d,
+	0x55, 0xd2, 0xba, 0x0d, 0xf5, 0x20, 0x64, 0xc4, 0x17, 0x94, 0x4d, 0xb4, 0xcd, 0x65, 0x08, 0xeb,
+	0x16, 0xd4, 0x46, 0xde, 0x95, 0xcb, 0xc3, 0xdf, 0x10, 0x69, 0x72, 0x55, 0x67, 0x65, 0xe4, 0x5d,
+	0xf5, 0xc2, 0xdf, 0x10, 0x9c, 0x6e, 0x6c, 0x3a, 0xf7, 0xfc, 0xcb, 0x24, 0xe6, 0xd2, 0xe6, 0x5a,
+	0x0e, 0x8c, 0xbc, 0xab, 0x3d, 0x85, 0xb1, 0xf7, 0x60, 0x6d, 0x5a, 0x53, 0x6b, 0x0b, 0x96, 0xb9,
+	0x08, 0x68, 0x22, 0xa4, 0xa8, 0x9a, 0xa3, 0x21, 0x8d, 0x27, 0x8c, 0x75, 0x16, 0x52, 0x3c, 0x61,
+	0xcc, 0xfe, 0xab, 0x0a, 0xdc, 0xe8, 0x09, 0x8f, 0x89, 0x97, 0xd9, 0x2e, 0xbb, 0x70, 0x23, 0x22,
+	0xe2, 0x39, 0x65, 0x97, 0x2e, 0x23, 0x5e, 0x30, 0x91, 0x13, 0x8a, 0xb2, 0x17, 0xa4, 0xae, 0x1b,
+	0xba, 0xd1, 0xc1, 0xb6, 0x67, 0xaa, 0x49, 0x5a, 0x29, 0xca, 0x4b, 0x69, 0xd5, 0xb8, 0x9a, 0x12,
+	0xa9, 0x89, 0xec, 0x33, 0xd8, 0x72, 0xc8, 0x88, 0x8e, 0x5f, 0x6a, 0x13, 0x77, 0x60, 0xa5, 0xa8,
+	0x87, 0x01, 0xed, 0x7f, 0xaf, 0x82, 0x75, 0x70, 0x45, 0xfc, 0x53, 0x46, 0x7d, 0xc2, 0xf9, 0xff,
+	0x93, 0x63, 0x78, 0x1b, 0x56, 0x62, 0xa5, 0x40, 0xa7, 0xba, 0x5d, 0xc9, 0xf6, 0xbb, 0xd1, 0xca,
+	0xb4, 0xe2, 0x76, 0xe2, 0x22, 0x08, 0x23, 0x37, 0xf6, 0xc4, 0xa0, 0xb3, 0xa4, 0x4c, 0x47, 0x62,
+	0x4e, 0x3d, 0x31, 0xb0, 0x36, 0x61, 0x29, 0x19, 0x79, 0xfc, 0x52, 0xfa, 0x83, 0xba, 0xa3, 0x00,
+	0xd5, 0x89, 0x85, 0xbe, 0x90, 0x96, 0xa9, 0x5c, 0x40, 0x5d, 0x61, 0x0e, 0xa2, 0xb1, 0xb4, 0x03,
+	0x22, 0x78, 0x18, 0xe8, 0xcd, 0xaf, 0x21, 0x9c, 0x34, 0x4e, 0x44, 0xdc, 0x0f, 0x83, 0x4e, 0x5d,
+	0x36, 0x18, 0xd0, 0xea, 0x81, 0xb6, 0x7e, 0xf7, 0x3c, 0xb9, 0xb8, 0x20, 0x38, 0x8c, 0x0e, 0x6c,
+	0x57, 0xee, 0xad, 0xee, 0xde, 0x53, 0x7a, 0xcf, 0xce, 0xa8, 0xde, 0x40, 0x7b, 0x86, 0xde, 0x69,
+	0xd3, 0x22, 0x02, 0x5d, 0xc1, 0xc5, 0x30, 0xe1, 0x03, 0x37, 0x8c, 0x04, 0x61, 0x63, 0x6f, 0xe8,
+	0x8e, 0xb8, 0x74, 0x18, 0x2d, 0xa7, 0x2d, 0x1b, 0x0e, 0x35, 0xfe, 0x98, 0x97, 0xef, 0xd7, 0xe6,
+	0xf7, 0xd9, 0xaf, 0xad, 0xe2, 0x7e, 0xb5, 0x2c, 0xa8, 0x46, 0xa1, 0x6f, 0x1c, 0x86, 0xfc, 0xb6,
+	0xdf, 0x87, 0xf6, 0xd4, 0x28, 0xac, 0x1a, 0x54, 0x9f, 0x9e, 0x3c, 0x3d, 0x58, 0xfb, 0x01, 0x7e,
+	0x1d, 0x1d, 0x3e, 0x3d, 0x58, 0xab, 0x58, 0x75, 0x58, 0xda, 0x3b, 0x3a, 0xd9, 0xff, 0x62, 0x6d,
+	0xc1, 0x3e, 0x86, 0x8d, 0xc2, 0x54, 0xf0, 0x98, 0x46, 0x9c, 0x58, 0x6b, 0xb0, 0x18, 0x6b, 0xa3,
+	0x5a, 0x72, 0xf0, 0x13, 0xc5, 0xc5, 0x7d, 0x6d, 0x49, 0x4b, 0x8e, 0xfc, 0x46, 0x2a, 0x5c, 0x94,
+	0x45, 0x45, 0xc5, 0xc3, 0xc0, 0xfe, 0x2d, 0x6c, 0xf6, 0xc2, 0x7e, 0xe4, 0x0d, 0x5f, 0xa1, 0xb5,
+	0xe2, 0xea, 0x4b, 0x9e, 0x7a, 0xd7, 0x69, 0x08, 0x35, 0xe2, 0x82, 0xc6, 0xd2, 0x1e, 0x6b, 0x8e,
+	0xfc, 0xb6, 0x4f, 0xc1, 0xfa, 0xd2, 0x0b, 0xc5, 0xab, 0x93, 0x6e, 0xff, 0x43, 0x05, 0x36, 0x0a,
+	0x2c, 0xf5, 0x0c, 0x49, 0xdf, 0xe4, 0x89, 0x84, 0xeb, 0x49, 0xd2, 0x90, 0xf5, 0x11, 0x2c, 0x33,
+	0xe2, 0x71, 0x1a, 0x49, 0x3e, 0xab, 0xbb, 0xdb, 0x6a, 0xb5, 0x4b, 0x58, 0xec, 0x38, 0x92, 0xce,
+	0xd1, 0xf4, 0x53, 0xe3, 0x5c, 0x32, 0xe3, 0xb4, 0x77, 0x61, 0x59, 0x51, 0x5a, 0x00, 0xcb, 0x07,
+	0xbf, 0x3c, 0x7c, 0x76, 0xf0, 0x68, 0xed, 0x07, 0x56, 0x13, 0x6a, 0xbd, 0xc3, 0xc7, 0x4f, 0x1f,
+	0x1e, 0x1d, 0x3c, 0x5a, 0xab, 0x58, 0xab, 0x00, 0x27, 0x27, 0xc7, 0xee, 0x17, 0x87, 0x47, 0x08,
+	0x2f, 0xd8, 0x04, 0x36, 0x8f, 0x42, 0x6e, 0x24, 0x92, 0xef, 0x32, 0x13, 0x5b, 0xb0, 0x7c, 0x41,
+	0xd9, 0xc8, 0x13, 0x66, 0x22, 0x14, 0x84, 0xd3, 0xed, 0xb1, 0x3e, 0xba, 0x74, 0xb4, 0x43, 0xf9,
+	0x6d, 0x7f, 0x02, 0x37, 0xa6, 0xc4, 0xe8, 0xd9, 0x79, 0x1d, 0x9a, 0xda, 0x21, 0xb8, 0xc3, 0x90,
+	0x2b, 0xbf, 0xde, 0x74, 0x1a, 0x1a, 0x87, 0x7d, 0xec, 0x5f, 0xc3, 0xe6, 0x63, 0x62, 0xba, 0x1e,
+	0x44, 0xe3, 0x57, 0x64, 0x2a, 0x8c, 0x04, 0x9e, 0x2f, 0xb4, 0x96, 0x1a, 0xb2, 0xef, 0x02, 0x64,
+	0x82, 0xd0, 0x6c, 0x71, 0x43, 0x55, 0x24, 0x09, 0x7e, 0xda, 0x11, 0x6c, 0x65, 0xba, 0xfc, 0x5e,
+	0x38, 0x24, 0xaf, 0xc4, 0x70, 0x3b, 0x78, 0x99, 0x12, 0x5e, 0x38, 0x54, 0xe7, 0x60, 0xcd, 0x31,
+	0xa0, 0x7d, 0x0e, 0xb5, 0x93, 0x98, 0x44, 0x28, 0xc9, 0x5a, 0x85, 0x85, 0x0b, 0xb3, 0xd3, 0x16,
+	0x2e, 0xe4, 0x46, 0xc3, 0x8b, 0xa3, 0xe6, 0x25, 0xbf, 0x71, 0x5c, 0xc2, 0x63, 0x7d, 0xa2, 0x0e,
+	0x9e, 0xba, 0xa3, 0x21, 0xab, 0x0b, 0x35, 0x79, 0x83, 0xf4, 0xe9, 0x50, 0x6e, 0x83, 0xba, 0x93,
+	0xc2, 0xf6, 0x5f, 0x56, 0xa0, 0x99, 0x1f, 0x11, 0xaa, 0xc3, 0x48, 0x3f, 0x19, 0x7a, 0x4c, 0x4a,
+	0x6b, 0x39, 0x06, 0x94, 0x96, 0x47, 0xfd, 0x4b, 0x62, 0xce, 0x1e, 0x0d, 0xc9, 0x3d, 0x1f, 0xc6,
+	0x44, 0xef, 0x3b, 0xf9, 0x8d, 0x0e, 0x9c, 0x8a, 0x01, 0x61, 0x52, 0x5e, 0xcb, 0x51, 0x80, 0xf5,
+	0x26, 0x2c, 0xe1, 0x35, 0xc5, 0x5c, 0x06, 0x57, 0xb5, 0x8b, 0xd3, 0x63, 0x74, 0x54, 0xa3, 0xfd,
+	0x39, 0x74, 0x71, 0xe9, 0xd3, 0xf3, 0xf1, 0x98, 0x26, 0x91, 0xf8, 0x0e, 0x53, 0x6d, 0xff, 0x53,
+	0x05, 0x56, 0x8b, 0xbd, 0x95, 0xee, 0x09, 0xf3, 0x89, 0xa6, 0xd7, 0x90, 0xb5, 0x0d, 0x8d, 0x80,
+	0x70, 0x11, 0x46, 0x1e, 0x5e, 0xf3, 0xf4, 0x6c, 0xe6, 0x51, 0xe9, 0x44, 0x2f, 0xe6, 0x26, 0xba,
+	0x03, 0x2b, 0x23, 0x64, 0x4b, 0x02, 0xed, 0x56, 0x0c, 0x28, 0xaf, 0x00, 0x92, 0xb3, 0x4b, 0xae,
+	0x42, 0x2e, 0x78, 0x67, 0x49, 0x5f, 0x54, 0x25, 0xf2, 0x40, 0xe2, 0xb0, 0xfb, 0x80, 0x78, 0x43,
+	0x31, 0x98, 0xc8, 0xf3, 0xad, 0xe6, 0x18, 0xd0, 0xfe, 0x1a, 0xda, 0x53, 0xc3, 0xb6, 0x7e, 0x0c,
+	0xcb, 0x92, 0x39, 0x97, 0x96, 0xd8, 0xd8, 0xdd, 0x54, 0x93, 0x56, 0x24, 0x73, 0x34, 0x8d, 0xf5,
+	0x7e, 0xee, 0xc6, 0xbd, 0x70, 0x0d, 0x7d, 0x4a, 0x65, 0x3f, 0x84, 0xdb, 0x07, 0x57, 0x31, 0xe5,
+	0xb9, 0xfb, 0x08, 0xa5, 0xe2, 0xe2, 0xbb, 0xcc, 0xf7, 0x03, 0xb8, 0x33, 0x87, 0x85, 0xde, 0xe7,
+	0x68, 0x21, 0x78, 0xce, 0xab, 0xbe, 0xf2, 0xdb, 0xde, 0x87, 0xbb, 0x67, 0x11, 0xf9, 0x9e, 0x92,
+	0xff, 0xac, 0x02, 0x5d, 0xbc, 0x82, 0xbd, 0x34, 0x87, 0x54, 0xb5, 0x85, 0x4c, 0x35, 0x34, 0x16,
+	0x7a, 0x71, 0xc1, 0xf5, 0x3e, 0xaa, 0x3a, 0x1a, 0x42, 0xfc, 0x90, 0x44, 0x7d, 0x31, 0xd0, 0x56,
+	0xad, 0x21, 0xfb, 0x10, 0x1a, 0x4a, 0xee, 0x41, 0x24, 0xd8, 0x44, 0x1e, 0xb9, 0xde, 0xc8, 0x58,
+	0x9a, 0xfc, 0x46, 0xdc, 0x88, 0x06, 0x44, 0xef, 0x1c, 0xf9, 0x8d, 0x38, 0x79, 0x37, 0x56, 0x42,
+	0xe4, 0xb7, 0xfd, 0x37, 0x15, 0xd8, 0x98, 0x1a, 0x8c, 0xdc, 0xfe, 0xa6, 0x7f, 0xa5, 0xa4, 0xff,
+	0x42, 0xd6, 0x1f, 0x71, 0x81, 0x27, 0x3c, 0xc9, 0xb3, 0xe9, 0xc8, 0x6f, 0xeb, 0x3d, 0x58, 0x21,
+	0x91, 0x60, 0x61, 0xfa, 0x5a, 0x5b, 0x57, 0x26, 0x91, 0xd3, 0xd9, 0x31, 0x14, 0x78, 0x33, 0x1f,
+	0x86, 0xd1, 0xa5, 0xab, 0x1d, 0x89, 0xba, 0x99, 0x01, 0xa2, 0x9e, 0x49, 0x8c, 0x4d, 0x61, 0xeb,
+	0x2c, 0x0e, 0x5e, 0xf2, 0x11, 0xba, 0x0b, 0x75, 0x46, 0xd4, 0x5e, 0xe0, 0x52, 0xef, 0xd4, 0x3e,
+	0x8f, 0xc2, 0x28, 0xb9, 0x72, 0x4c, 0x9b, 0x93, 0x91, 0xe1, 0xe9, 0xd1, 0x13, 0x9e, 0xe0, 0x2f,
+	0x21, 0xcf, 0xfe, 0x43, 0xe8, 0x1e, 0x93, 0x11, 0x65, 0x13, 0xe4, 0xf0, 0x32, 0x0a, 0xdf, 0x01,
+	0x60, 0x84, 0x13, 0xe1, 0xc6, 0xc4, 0xbb, 0xd4, 0xef, 0x8b, 0xba, 0xc4, 0x9c, 0x12, 0xef, 0xd2,
+	0xfe, 0xa6, 0x02, 0xaf, 0x95, 0x0a, 0xd0, 0x86, 0xff, 0x39, 0x5e, 0x3e, 0x3c, 0xa1, 0xb7, 0xee,
+	0x7b, 0x6a, 0xa8, 0xd7, 0x74, 0xd8, 0x41, 0xac, 0x5a, 0x11, 0xd9, 0x51, 0x9a, 0xa7, 0x91, 0x5c,
+	0x75, 0xe4, 0x77, 0xee, 0x9d, 0x3b, 0xde, 0xed, 0x2c, 0xe6, 0xdf, 0xb9, 0xbf, 0xd8, 0xed, 0x7e,
+	0x08, 0xf5, 0x94, 0x07, 0x1e, 0x61, 0x97, 0xc4, 0xbc, 0xcc, 0xf0, 0x13, 0xfd, 0xf2, 0xd8, 0x1b,
+	0x26, 0xc6, 0x68, 0x14, 0xf0, 0xc9, 0xc2, 0x47, 0x15, 0x9c, 0xe6, 0x53, 0x2f, 0xe1, 0x2f, 0xb3,
+	0xac, 0xf6, 0xa7, 0xf8, 0xa6, 0xe1, 0xc9, 0xe8, 0xa5, 0x3a, 0xff, 0x5d, 0x05, 0x6a, 0xfb, 0x71,
+	0x72, 0xc6, 0xbd, 0xbe, 0x7c, 0x18, 0x0a, 0x2a, 0xbc, 0xa1, 0x9b, 0x20, 0x28, 0xc9, 0xab, 0x0e,
+	0x48, 0x94, 0x22, 0xc0, 0x2b, 0x03, 0x61, 0x7e, 0x9c, 0x68, 0x0a, 0x74, 0x72, 0x55, 0xa7, 0xa1,
+	0x70, 0x8a, 0x64, 0x07, 0x36, 0x64, 0x9b, 0x1b, 0x46, 0xee, 0x25, 0x61, 0x11, 0x19, 0xca, 0xad,
+	0xa3, 0xb6, 0xd9, 0xba, 0x6c, 0x3a, 0x8c, 0xbe, 0x48, 0x1b, 0xf0, 0xc2, 0x9e, 0xd2, 0x27, 0x9c,
+	0x30, 0x49, 0x5d, 0x95, 0xd4, 0x6d, 0x4d, 0x7d, 0xa6, 0xd1, 0xf6, 0x6f, 0x61, 0xf5, 0xd9, 0x80,
+	0x51, 0x21, 0x86, 0x61, 0xd4, 0x7f, 0x84, 0xbb, 0xab, 0x03, 0x2b, 0x31, 0x61, 0x21, 0x0d, 0xb8,
+	0xd6, 0xd6, 0x80, 0xd6, 0x7b, 0xb0, 0x2e, 0x14, 0x2d, 0x09, 0x5c, 0x43, 0xa3, 0xe6, 0x7d, 0x2d,
+	0x6d, 0x38, 0xd5, 0xc4, 0x3f, 0x82, 0xd5, 0x8c, 0x18, 0x1f, 0x03, 0x5a, 0xdf, 0x56, 0x8a, 0xc5,
+	0x1b, 0xbf, 0x3d, 0x96, 0x73, 0x25, 0xf7, 0x83, 0xf5, 0x1e, 0xd4, 0xb3, 0x79, 0xa8, 0x6c, 0x57,
+	0xb2, 0x13, 0xd5, 0x4c, 0xa7, 0x53, 0x4b, 0x27, 0xe5, 0x33, 0x68, 0x8b, 0x54, 0x71, 0x57, 0xfa,
+	0x88, 0xc2, 0xfe, 0x2b, 0x8e, 0xca, 0x59, 0x15, 0x05, 0xd8, 0xfe, 0x14, 0xea, 0xa7, 0x61, 0xc0,
+	0x95, 0xe0, 0x0e, 0xac, 0xf8, 0x09, 0x63, 0x24, 0x12, 0x66, 0xc8, 0x1a, 0x44, 0xf3, 0x1a, 0x86,
+	0xa3, 0x50, 0x18, 0xf3, 0x92, 0x80, 0x4d, 0x01, 0x94, 0xcd, 0xcb, 0x09, 0xc3, 0xb7, 0x5d, 0x6e,
+	0x71, 0x15, 0x80, 0x46, 0x8d, 0x11, 0x01, 0xb3, 0xa8, 0xd8, 0x82, 0xd1, 0x03, 0xa5, 0x7c, 0x07,
+	0x56, 0x2e, 0xbc, 0x70, 0xe8, 0x47, 0xc6, 0x23, 0x1b, 0x30, 0x13, 0x58, 0xcd, 0x0b, 0xfc, 0x97,
+	0x05, 0x68, 0x64, 0xbb, 0x8c, 0x23, 0x95, 0xef, 0xf9, 0x83, 0x54, 0xa4, 0x04, 0xac, 0xb7, 0x60,
+	0x29, 0x13, 0x97, 0xbe, 0x6c, 0x33, 0x4d, 0x8d, 0x6a, 0xf7, 0x01, 0xf8, 0x73, 0x2f, 0xd6, 0xba,
+	0x2d, 0xce, 0x21, 0xae, 0x23, 0x8d, 0x52, 0xf7, 0x01, 0x34, 0x95, 0xdd, 0xe9, 0x2e, 0xd5, 0x39,
+	0x5d, 0x1a, 0x8a, 0x4a, 0x75, 0x7a, 0x03, 0x5a, 0x09, 0x27, 0xee, 0x20, 0x24, 0x0c, 0x23, 0x52,
+	0x13, 0x73, 0x73, 0x48, 0x38, 0x79, 0x62, 0x70, 0xd6, 0x2e, 0x2c, 0xa1, 0x5b, 0xe0, 0x9d, 0x65,
+	0xe9, 0x50, 0x6e, 0x4f, 0x3b, 0x14, 0x2e, 0x1d, 0x88, 0xf6, 0xe9, 0x8a, 0xb4, 0xfb, 0x11, 0x40,
+	0x86, 0xfc, 0x4e, 0x2e, 0xc1, 0x87, 0xf6, 0xde, 0xf0, 0x32, 0xa4, 0xb9, 0xee, 0x9b, 0xb0, 0x34,
+	0xf2, 0x7e, 0x4d, 0x99, 0x99, 0x49, 0x09, 0x48, 0x6c, 0x18, 0x51, 0x66, 0x58, 0x48, 0x00, 0xaf,
+	0xac, 0x34, 0xd6, 0xf7, 0xa6, 0x05, 0x1a, 0x67, 0x82, 0xaa, 0x39, 0x41, 0xf6, 0x7f, 0x54, 0x01,
+	0x32, 0x29, 0x96, 0x03, 0xdd, 0x90, 0xba, 0x9c, 0x30, 0x8c, 0x20, 0xba, 0xe7, 0x13, 0x41, 0xb8,
+	0xcb, 0x88, 0x9f, 0x30, 0x1e, 0x8e, 0x89, 0xf6, 0xa3, 0x37, 0xd4, 0xb0, 0xa7, 0x74, 0x73, 0x6e,
+	0x86, 0xb4, 0xa7, 0xfa, 0xed, 0x61, 0x37, 0xc7, 0xf4, 0xb2, 0x0e, 0xe1, 0x46, 0xc6, 0x33, 0xc8,
+	0xb1, 0x5b, 0xb8, 0x8e, 0xdd, 0x46, 0xca, 0x2e, 0xc8, 0x58, 0x1d, 0xc0, 0x46, 0x48, 0xdd, 0xaf,
+	0x13, 0x92, 0x14, 0x18, 0x2d, 0x5e, 0xc7, 0x68, 0x3d, 0xa4, 0x3f, 0x97, 0x1d, 0x32, 0x36, 0xa7,
+	0x70, 0x2b, 0x37, 0x4a, 0xdc, 0xee, 0x39, 0x66, 0xd5, 0xeb, 0x98, 0x6d, 0xa5, 0x5a, 0xa1, 0x3f,
+	0xc8, 0x38, 0xfe, 0x3e, 0x6c, 0x85, 0xd4, 0x7d, 0xee, 0x85, 0x62, 0x9a, 0xdd, 0xd2, 0x0b, 0x06,
+	0x89, 0x6f, 0xce, 0x22, 0x2f, 0x35, 0xc8, 0x11, 0x61, 0xfd, 0xc2, 0x20, 0x97, 0x5f, 0x30, 0xc8,
+	0x63, 0xd9, 0x21, 0x63, 0xf3, 0x10, 0xd6, 0x43, 0x3a, 0xad, 0xcd, 0xca, 0x75, 0x4c, 0xda, 0x21,
+	0x2d, 0x6a, 0xb2, 0x07, 0xeb, 0x5c, 0x46, 0x13, 0xf3, 0x46, 0x50, 0xbb, 0x8e, 0xc5, 0x9a, 0xa6,
+	0x4f, 0x79, 0xd8, 0xbf, 0x82, 0xe6, 0x93, 0xa4, 0x4f, 0xc4, 0xf0, 0x3c, 0x75, 0x06, 0xaf, 0xcc,
+	0xff, 0xd8, 0xff, 0xb3, 0x00, 0x8d, 0x7d, 0x79, 0xf6, 0x16, 0x7c, 0xb2, 0xda, 0xa4, 0xd3, 0x3e,
+	0x59, 0x92, 0x48, 0x9f, 0xac, 0x88, 0x3f, 0x80, 0xe6, 0x48, 0x6e, 0x5d, 0x4d, 0xaf, 0xfc, 0xd0,
+	0xfa, 0xcc, 0xa6, 0x76, 0x1a, 0xa3, 0x0c, 0xc0, 0xf0, 0x71, 0x1c, 0x06, 0x5c, 0xf7, 0x59, 0xcc,
+	0x87, 0x8f, 0x53, 0x17, 0xed, 0xd4, 0x63, 0xf3, 0x89, 0x61, 0xbc, 0x73, 0x9c, 0x24, 0xdd, 0xa1,
+	0xe0, 0x8c, 0xb2, 0xd9, 0x73, 0xe0, 0x3c, 0xfd, 0xb6, 0x9e, 0x40, 0x6b, 0xa0, 0xa6, 0x4c, 0x77,
+	0x52, 0x36, 0xf4, 0x86, 0x1e, 0x49, 0x36, 0xde, 0x9d, 0xfc, 0xcc, 0xaa, 0x05, 0x68, 0x0e, 0x72,
+	0xa8, 0x6e, 0x0f, 0xd6, 0x67, 0x48, 0x4a, 0x7c, 0xd0, 0xbd, 0xbc, 0x0f, 0x6a, 0xec, 0x5a, 0x4a,
+	0x50, 0xbe, 0x67, 0xde, 0x2f, 0xfd, 0xc5, 0x02, 0x34, 0x9f, 0xaa, 0xf8, 0xab, 0xd2, 0xb7, 0xec,
+	0xc6, 0x7d, 0x0b, 0x6a, 0xec, 0x4a, 0x39, 0x10, 0x13, 0x7d, 0x66, 0x57, 0xd2, 0x31, 0xc8, 0x4b,
+	0xdd, 0x95, 0x1b, 0x7b, 0xf8, 0x7a, 0xe5, 0x7a, 0x45, 0xeb, 0xec, 0xea, 0x54, 0x21, 0xd0, 0x14,
+	0xd8, 0x95, 0x4b, 0x18, 0xa3, 0x8c, 0x6b, 0x5f, 0x55, 0x63, 0x57, 0x07, 0x12, 0xd6, 0x7d, 0x03,
+	0x46, 0xe3, 0x98, 0x04, 0x9d, 0x25, 0xd3, 0xf7, 0x91, 0x42, 0xa0, 0x54, 0x61, 0xa4, 0x2e, 0x2b,
+	0xa9, 0x22, 0x93, 0x2a, 0x32, 0xa9, 0x2b, 0xaa, 0xa7, 0xc8, 0x4b, 0x15, 0xa9, 0xd4, 0x9a, 0x92,
+	0x2a, 0x72, 0x52, 0x45, 0x26, 0xb5, 0x6e, 0xfa, 0x6a, 0xa9, 0xb6, 0x0b, 0xed, 0x2f, 0x29, 0xbb,
+	0x0c, 0xa3, 0x7e, 0x8f, 0x88, 0x17, 0x9d, 0xd1, 0x1d, 0x58, 0xf1, 0xc6, 0x84, 0x65, 0x76, 0x6e,
+	0x40, 0x6c, 0xe1, 0xde, 0x28, 0x1e, 0x12, 0x35, 0x29, 0x2d, 0xc7, 0x80, 0xf6, 0x57, 0xb0, 0x7a,
+	0xea, 0xf5, 0xc9, 0x3e, 0x9e, 0x9b, 0xd7, 0x1d, 0xa9, 0x9b, 0xb0, 0x14, 0x84, 0x4c, 0x4c, 0xcc,
+	0x41, 0x20, 0x01, 0x4c, 0x13, 0x60, 0x4e, 0x84, 0x60, 0xb8, 0xdf, 0x4c, 0x77, 0x8a, 0xc0, 0x08,
+	0x04, 0x46, 0xa5, 0xf0, 0xea, 0xc8, 0xe8, 0x10, 0xcf, 0xc1, 0xcb, 0x70, 0x38, 0x74, 0x83, 0x90,
+	0x63, 0xb6, 0x24, 0xd0, 0xc1, 0xfe, 0x26, 0x22, 0x1f, 0x69, 0x1c, 0x4e, 0x56, 0x12, 0x05, 0x84,
+	0xb9, 0x94, 0x8e, 0xf4, 0xad, 0xbc, 0x26, 0x11, 0x27, 0x74, 0x24, 0x93, 0x0b, 0x6a, 0x5b, 0x0d,
+	0xc2, 0xfe, 0x40, 0x0b, 0x04, 0x85, 0x7a, 0x12, 0xf6, 0x65, 0xb2, 0x07, 0x5b, 0x5c, 0x32, 0x26,
+	0x91, 0x30, 0x4b, 0x0c, 0x88, 0x3a, 0x90, 0x18, 0xfb, 0xbf, 0x16, 0x60, 0x6b, 0xfa, 0xcd, 0xa1,
+	0x6f, 0xf4, 0x1f, 0x40, 0x53, 0x5f, 0xbe, 0xf3, 0x7b, 0x7c, 0x7d, 0x66, 0x67, 0x38, 0x0d, 0x3f,
+	0x03, 0xac, 0x0f, 0xa1, 0x65, 0xb2, 0x09, 0x66, 0xab, 0x2f, 0x66, 0x76, 0x9e, 0xb7, 0x65, 0xa7,
+	0x19, 0xe5, 0x20, 0xeb, 0x67, 0xd0, 0x78, 0xae, 0x56, 0xd6, 0x35, 0xef, 0xd1, 0xd4, 0xf5, 0x4d,
+	0x2d, 0xb9, 0x03, 0xcf, 0x53, 0x84, 0xf5, 0x00, 0x20, 0xc6, 0x2b, 0xad, 0x5a, 0xa3, 0x6a, 0xfe,
+	0xa6, 0x57, 0x5c, 0x48, 0xa7, 0x1e, 0x1b, 0xd8, 0xda, 0x03, 0x2b, 0xcd, 0xb2, 0x65, 0x9d, 0x97,
+	0xae, 0xe9, 0xbc, 0x66, 0x12, 0x70, 0x29, 0x8f, 0x9f, 0x42, 0x83, 0xd2, 0x91, 0xeb, 0xab, 0xd5,
+	0xec, 0x2c, 0xe7, 0xbd, 0x4d, 0xb6, 0xca, 0x0e, 0x50, 0x3a, 0xd2, 0xdf, 0xf6, 0x0d, 0xd8, 0x90,
+	0xdc, 0x7a, 0x8a, 0x97, 0x7e, 0x3a, 0xd8, 0x27, 0xb0, 0x59, 0x44, 0xeb, 0x15, 0x98, 0x99, 0xcb,
+	0xca, 0x76, 0xe5, 0xff, 0x32, 0x97, 0xf6, 0x18, 0x2c, 0xcc, 0xc1, 0x91, 0x9e, 0x60, 0xc4, 0x1b,
+	0xbd, 0x8a, 0xd0, 0x5d, 0xd9, 0x6b, 0x1b, 0xc3, 0x86, 0xf4, 0x42, 0xc7, 0x85, 0xf0, 0xd3, 0x7e,
+	0x1b, 0x36, 0x0a, 0x72, 0xb3, 0xe0, 0xf9, 0x90, 0x44, 0xfa, 0x45, 0x8f, 0x9f, 0xb6, 0x07, 0xeb,
+	0x18, 0xcc, 0x78, 0x75, 0xfa, 0x69, 0x11, 0x8b, 0x99, 0x88, 0x7b, 0x60, 0xe5, 0x45, 0x64, 0xf1,
+	0x19, 0x39, 0x8e, 0x4a, 0x36, 0x0e, 0xfb, 0x04, 0xd6, 0xf7, 0x87, 0x94, 0x93, 0x1e, 0x26, 0x65,
+	0x5e, 0x45, 0x88, 0xfc, 0x8f, 0x61, 0xe3, 0x99, 0x98, 0x7c, 0x89, 0xcc, 0x30, 0x54, 0xf1, 0x8a,
+	0xc6, 0xc7, 0xe8, 0x73, 0x33, 0x3e, 0x46, 0x9f, 0x63, 0x88, 0xc6, 0xa7, 0xc3, 0x64, 0x14, 0x99,
+	0x10, 0x8d, 0x82, 0xec, 0x3d, 0x68, 0xaa, 0x17, 0xdf, 0x31, 0x0d, 0x12, 0x15, 0x4f, 0x99, 0x39,
+	0x31, 0xee, 0xe2, 0x9e, 0x61, 0xde, 0x88, 0x08, 0xc2, 0xd4, 0x0e, 0xad, 0x3b, 0x39, 0x8c, 0xfd,
+	0xf7, 0x8b, 0xb0, 0xa9, 0xf2, 0xef, 0x45, 0x4b, 0xc5, 0xf8, 0xea, 0x80, 0x72, 0x91, 0x63, 0x98,
+	0xc2, 0xa8, 0x62, 0x10, 0x19, 0x6e, 0xf8, 0x59, 0x48, 0x8a, 0x2f, 0x5e, 0x9f, 0x14, 0x9f, 0x49,
+	0x7b, 0x57, 0x4b, 0xd2, 0xde, 0x98, 0x15, 0xd3, 0x44, 0x61, 0x90, 0xa6, 0xd2, 0x14, 0xe6, 0x30,
+	0xb0, 0xde, 0x82, 0x76, 0x1f, 0xb5, 0x74, 0x07, 0x94, 0x5e, 0xaa, 0x74, 0x9b, 0x4a, 0xaa, 0xb5,
+	0x24, 0xfa, 0x09, 0xa5, 0x97, 0x32, 0xe5, 0xf6, 0x31, 0xac, 0xea, 0x47, 0xcb, 0x48, 0x4e, 0x11,
+	0xd7, 0x57, 0x35, 0xbd, 0xaf, 0xf2, 0xb3, 0xe7, 0xb4, 0x2e, 0x73, 0x10, 0xc7, 0x43, 0x4f, 0xe6,
+	0xd6, 0x45, 0x72, 0x2e, 0x4f, 0xae, 0xba, 0xb3, 0x82, 0x99, 0x75, 0x91, 0x9c, 0x5b, 0x0f, 0x61,
+	0x85, 0x4f, 0xb8, 0x2f, 0x86, 0x5c, 0xe6, 0xdc, 0x1b, 0xbb, 0x6f, 0x6b, 0x4f, 0x59, 0x32, 0x8f,
+	0x3b, 0x3d, 0x45, 0xa9, 0x23, 0x52, 0xba, 0x5f, 0xf7, 0x13, 0x68, 0xe6, 0x1b, 0x5e, 0xf4, 0x82,
+	0xa9, 0xe7, 0x6f, 0x0a, 0x37, 0xe1, 0xc6, 0x23, 0xc2, 0x05, 0xa3, 0x93, 0x29, 0xe7, 0xf2, 0xbb,
+	0x00, 0x32, 0x0d, 0x77, 0xe1, 0xf9, 0x04, 0xa3, 0xa6, 0x39, 0x48, 0x3f, 0x32, 0xd6, 0x76, 0x54,
+	0x61, 0x46, 0xda, 0xe0, 0xe4, 0x68, 0xec, 0x1d, 0x58, 0x76, 0x68, 0x82, 0xc7, 0xfa, 0x9b, 0xe6,
+	0x4b, 0xf7, 0x6b, 0xea, 0x7e, 0x12, 0xe9, 0xe8, 0x36, 0xfb, 0x89, 0x89, 0x9a, 0x65, 0xec, 0xb4,
+	0xf1, 0xec, 0x40, 0x3d, 0x34, 0x38, 0xed, 0xca, 0x66, 0x45, 0x67, 0x24, 0xf6, 0xa7, 0xb0, 0xa1,
+	0x38, 0x29, 0xce, 0x86, 0xcd, 0x9b, 0xb0, 0xcc, 0x8c, 0x1a, 0x95, 0xac, 0x22, 0x43, 0x13, 0xe9,
+	0x36, 0xfb, 0xaf, 0x2b, 0xb0, 0xd5, 0x93, 0x71, 0x35, 0x6c, 0x08, 0xa3, 0x7e, 0x2a, 0x02, 0x77,
+	0x8e, 0x2a, 0xdb, 0x30, 0x11, 0x72, 0x05, 0x21, 0x9e, 0x27, 0xe7, 0x11, 0x49, 0x13, 0x3d, 0x0a,
+	0xc2, 0xcb, 0x42, 0xdf, 0x13, 0xe4, 0xb9, 0x37, 0xd1, 0x4f, 0x3c, 0x03, 0xe2, 0x72, 0xa8, 0xfa,
+	0x07, 0x1d, 0xfb, 0x97, 0x80, 0x4a, 0x42, 0x84, 0x94, 0x85, 0x42, 0x3d, 0x6d, 0x5b, 0x4e, 0x0a,
+	0xdb, 0x5f, 0x41, 0x57, 0x8d, 0xa9, 0xa0, 0x9b, 0x19, 0xda, 0xef, 0x00, 0x84, 0xd3, 0xab, 0xa3,
+	0x5f, 0xbe, 0xe5, 0x63, 0x71, 0x72, 0xf4, 0xf6, 0x31, 0xb4, 0x0a, 0x54, 0xdf, 0x93, 0xdd, 0x9f,
+	0x40, 0xb7, 0x47, 0xc4, 0x33, 0xe6, 0x45, 0x3c, 0xf6, 0x18, 0x89, 0x30, 0x1d, 0x74, 0x35, 0x31,
+	0xaa, 0xde, 0x01, 0x88, 0x11, 0x76, 0x63, 0xca, 0x84, 0x76, 0xed, 0x75, 0x89, 0x39, 0xa5, 0x4c,
+	0xe0, 0xb5, 0x45, 0x35, 0x27, 0xda, 0x95, 0xc9, 0x49, 0xa0, 0x57, 0x93, 0xb3, 0x50, 0xa6, 0x0e,
+	0xc8, 0x95, 0x3f, 0x4c, 0x02, 0xe2, 0xfa, 0x61, 0xc0, 0x4c, 0x0a, 0xad, 0xa9, 0x91, 0xfb, 0x88,
+	0xb3, 0x7f, 0x08, 0x77, 0x54, 0xf5, 0xc0, 0x1c, 0x0d, 0xd0, 0xe2, 0x31, 0x79, 0x92, 0x99, 0xaa,
+	0x69, 0xd8, 0x80, 0x75, 0x6c, 0x28, 0x58, 0x8d, 0xfd, 0x07, 0xb0, 0x71, 0x12, 0x0d, 0xc3, 0x88,
+	0xec, 0x9f, 0x9e, 0x1d, 0x93, 0xf4, 0xcc, 0xb1, 0xa0, 0x8a, 0x2f, 0x49, 0x7d, 0xf5, 0x92, 0xdf,
+	0xe8, 0x84, 0xa3, 0x73, 0xd7, 0x8f, 0x13, 0x6e, 0xd2, 0x3f, 0xd1, 0xf9, 0x7e, 0x9c, 0xc8, 0xdd,
+	0x8f, 0x4f, 0x1e, 0x1a, 0x0d, 0x27, 0x26, 0x81, 0xe5, 0xc7, 0xc9, 0x49, 0x34, 0x9c, 0xd8, 0x3f,
+	0x96, 0x71, 0x41, 0x42, 0x02, 0xc7, 0x8b, 0x02, 0x3a, 0x7a, 0x44, 0xc6, 0x39, 0x09, 0x69, 0x0c,
+	0xca, 0x9c, 0x38, 0xdf, 0x54, 0xa0, 0xf9, 0xb0, 0x4f, 0x22, 0xf1, 0x48, 0xe5, 0xbf, 0xd0, 0xc4,
+	0xc6, 0x84, 0x71, 0x4c, 0xcc, 0x28, 0x9b, 0x34, 0x20, 0xde, 0xe0, 0xc2, 0x28, 0x14, 0x6e, 0xe0,
+	0x91, 0x91, 0x4e, 0xdb, 0xd4, 0x70, 0x99, 0x42, 0xf1, 0x48, 0x62, 0xac, 0xb7, 0xa1, 0xad, 0xec,
+	0xd7, 0x1d, 0x78, 0x51, 0x30, 0x24, 0xe9, 0x74, 0xae, 0x2a, 0xf4, 0x13, 0x8d, 0xc5, 0xe2, 0x1a,
+	0xed, 0x6e, 0x33, 0xca, 0xaa, 0xaa, 0xdb, 0xd1, 0xf8, 0x02, 0x69, 0x12, 0xe3, 0xca, 0x72, 0x97,
+	0x13, 0xdf, 0xa7, 0xa3, 0x58, 0x07, 0x69, 0xda, 0x06, 0xdf, 0x53, 0x68, 0xbb, 0x0f, 0x1b, 0x8f,
+	0x71, 0x9c, 0x7a, 0x24, 0xd9, 0x26, 0x5d, 0x1d, 0x91, 0x91, 0x7b, 0x3e, 0xa4, 0xfe, 0xa5, 0xaa,
+	0x8b, 0xd1, 0x97, 0xdb, 0x11, 0x19, 0xed, 0x21, 0x52, 0x16, 0xc7, 0xbc, 0x0b, 0xeb, 0x48, 0x35,
+	0xa0, 0x22, 0x1e, 0x26, 0x7d, 0x2c, 0xf9, 0x39, 0x27, 0x7a, 0x88, 0xed, 0x11, 0x19, 0x3d, 0x51,
+	0xf8, 0x53, 0x44, 0xdb, 0xff, 0x5c, 0x81, 0xcd, 0xa2, 0x24, 0x7d, 0xa4, 0xdf, 0x87, 0xcd, 0xa2,
+	0x28, 0xfd, 0x28, 0x51, 0xd7, 0xf5, 0xf5, 0xbc, 0x40, 0xf5, 0x3c, 0xf9, 0x10, 0x5a, 0xb2, 0x32,
+	0xcd, 0x35, 0xc9, 0xc8, 0xc2, 0x53, 0x2c, 0xbf, 0x2e, 0x4e, 0xd3, 0xcb, 0x41, 0xd6, 0xc7, 0x70,
+	0x4b, 0x0f, 0xdf, 0x9d, 0x55, 0x5b, 0x19, 0xc4, 0x96, 0x26, 0x38, 0x9e, 0xd2, 0xfe, 0x08, 0x3a,
+	0x19, 0x6a, 0x6f, 0x22, 0x91, 0x66, 0xae, 0xde, 0x87, 0x8d, 0xa9, 0xc1, 0x3e, 0x0c, 0x02, 0x26,
+	0xf7, 0x6b, 0xd5, 0x29, 0x6b, 0xb2, 0x3f, 0x87, 0x9b, 0x3d, 0x22, 0xd4, 0x6c, 0x78, 0x42, 0xc7,
+	0x47, 0x14, 0xb3, 0x35, 0x58, 0xec, 0x11, 0x5f, 0x0e, 0x7e, 0xd1, 0xc1, 0x4f, 0x34, 0xc0, 0x33,
+	0x4e, 0x7c, 0x39, 0xca, 0x45, 0x47, 0x7e, 0x63, 0x12, 0x7f, 0x45, 0x1f, 0xc2, 0xd2, 0x1d, 0xb2,
+	0x70, 0x4c, 0x58, 0xea, 0x0e, 0x25, 0x84, 0x71, 0x5a, 0xf5, 0x95, 0xd6, 0x8a, 0xa9, 0xa3, 0xbd,
+	0xa5, 0xb0, 0xa6, 0x5c, 0x2c, 0xcb, 0x37, 0x2e, 0x16, 0xf2, 0x8d, 0x98, 0x36, 0xe7, 0x32, 0x9f,
+	0xa8, 0x12, 0xb1, 0x1a, 0x42, 0x53, 0x37, 0xfc, 0x96, 0x24, 0x3f, 0x03, 0xca, 0xd7, 0x0c, 0x4d,
+	0x22, 0xe1, 0xc6, 0x34, 0x8c, 0x84, 0x3e, 0xbb, 0x41, 0xa2, 0x4e, 0x11, 0x63, 0xff, 0x79, 0x05,
+	0x96, 0x55, 0xe1, 0x1d, 0x46, 0xdc, 0xd2, 0x1b, 0xd4, 0x42, 0x58, 0x9e, 0x24, 0xbe, 0x09, 0x2b,
+	0xe3, 0x91, 0xba, 0x07, 0x68, 0xd5, 0xc6, 0x23, 0x79, 0x01, 0xf8, 0x11, 0xac, 0x66, 0x17, 0x31,
+	0xd9, 0xae, 0x54, 0x6c, 0xa5, 0x58, 0x49, 0x36, 0x57, 0x53, 0xfb, 0x97, 0x18, 0x68, 0x4c, 0x4b,
+	0x81, 0xd6, 0x60, 0x31, 0x49, 0x95, 0xc1, 0x4f, 0xc4, 0xf4, 0xd3, 0x2b, 0x1c, 0x7e, 0x5a, 0x6f,
+	0xc1, 0xaa, 0x17, 0x04, 0x21, 0x76, 0xf7, 0x86, 0x8f, 0xc3, 0x20, 0xdd, 0xa4, 0x45, 0xac, 0xfd,
+	0x15, 0x74, 0xf6, 0x07, 0xc4, 0xbf, 0x2c, 0x5c, 0x42, 0xf4, 0xd2, 0xbe, 0x8b, 0xf9, 0x51, 0x44,
+	0x14, 0xdf, 0x01, 0x05, 0x52, 0x4d, 0x81, 0xf3, 0x31, 0xa4, 0x5e, 0xa0, 0x37, 0x93, 0xfc, 0xb6,
+	0xaf, 0xc0, 0xca, 0xd3, 0xf6, 0x54, 0x7d, 0x46, 0xd9, 0xfd, 0xb0, 0x03, 0x2b, 0xe7, 0x49, 0x38,
+	0x14, 0xa1, 0x71, 0x38, 0x06, 0xc4, 0x07, 0xae, 0x37, 0xf6, 0xc2, 0xa1, 0x3c, 0xf5, 0x94, 0xc9,
+	0x67, 0x08, 0x99, 0x36, 0xa4, 0x5e, 0x90, 0x26, 0x8b, 0x35, 0x64, 0xbf, 0x03, 0x1b, 0x0e, 0x91,
+	0xb5, 0x61, 0x72, 0x77, 0xe5, 0x5c, 0xe3, 0x4c, 0xb2, 0xf4, 0x5f, 0x2b, 0x98, 0x18, 0x8e, 0x27,
+	0x32, 0x4d, 0x3e, 0x9f, 0x0e, 0x0f, 0x18, 0xcc, 0xa1, 0x67, 0x35, 0x77, 0x8b, 0x4e, 0x0d, 0x11,
+	0xd2, 0xaf, 0x98, 0xc6, 0x34, 0x1b, 0xd2, 0x52, 0x8d, 0xc7, 0x98, 0x04, 0xc1, 0x3b, 0x5c, 0xc8,
+	0xdc, 0x34, 0xf7, 0xd1, 0x72, 0x56, 0x82, 0x90, 0xc9, 0x26, 0xbd, 0x92, 0x4b, 0xaa, 0x7e, 0x27,
+	0xb7, 0x92, 0xcb, 0x0a, 0x83, 0x2b, 0x99, 0xa5, 0x4c, 0x57, 0xa4, 0x54, 0x0d, 0xa5, 0x7e, 0xbe,
+	0x96, 0xf3, 0xf3, 0x02, 0x8b, 0x89, 0x04, 0xf3, 0x7c, 0x71, 0xe4, 0x4d, 0x08, 0xbb, 0x6e, 0x3c,
+	0x77, 0x00, 0x86, 0x48, 0x93, 0x1f, 0x50, 0x5d, 0x62, 0xe4, 0x88, 0x8a, 0x89, 0xda, 0x59, 0xa9,
+	0xd5, 0x9c, 0x54, 0xf5, 0xca, 0x64, 0x78, 0x74, 0xfb, 0xd9, 0xe5, 0xc2, 0xde, 0x04, 0xab, 0x27,
+	0x68, 0x3c, 0x85, 0x7d, 0x08, 0xeb, 0xaa, 0xd2, 0xe3, 0xa2, 0x38, 0xe1, 0x65, 0x36, 0xc1, 0x89,
+	0x4f, 0xa3, 0xc0, 0x9c, 0x8a, 0x06, 0xb4, 0xef, 0xc0, 0x8a, 0xee, 0x5f, 0xfa, 0xbc, 0xda, 0x92,
+	0x75, 0x2d, 0x0f, 0x4f, 0x0f, 0x7f, 0xa1, 0x8e, 0x34, 0x23, 0xf9, 0x6f, 0x2b, 0x60, 0xe5, 0xb1,
+	0xda, 0x9d, 0xcf, 0x3f, 0x0a, 0xb1, 0x16, 0x81, 0x88, 0x81, 0xca, 0x2d, 0xc9, 0xfd, 0xa8, 0x41,
+	0xeb, 0x27, 0x60, 0x05, 0x24, 0x66, 0xc4, 0xf7, 0x04, 0x09, 0x5c, 0x43, 0xa4, 0x76, 0xd8, 0x7a,
+	0xd6, 0x72, 0xac, 0xc9, 0xdf, 0x81, 0x35, 0x13, 0x73, 0x49, 0x89, 0xf5, 0x49, 0x68, 0xf0, 0x9a,
+	0xd4, 0x7e, 0x00, 0x37, 0xa5, 0x9b, 0x45, 0x73, 0xe4, 0x13, 0x2e, 0xc8, 0x28, 0x3d, 0xe2, 0x64,
+	0xf9, 0xc8, 0x05, 0x23, 0x7c, 0xa0, 0xcf, 0x36, 0x03, 0xda, 0xcf, 0xa1, 0x3d, 0xd5, 0x29, 0xf5,
+	0x4f, 0x95, 0x9c, 0x7f, 0xda, 0x84, 0xa5, 0x88, 0x06, 0x64, 0xac, 0xf7, 0x98, 0x02, 0xf0, 0x6d,
+	0xc6, 0x48, 0x3f, 0xe4, 0x82, 0x30, 0x12, 0xe8, 0x2d, 0x96, 0xc3, 0xe0, 0xed, 0x12, 0x77, 0x55,
+	0x7a, 0xed, 0xac, 0x39, 0x29, 0x6c, 0xff, 0x63, 0x05, 0xd6, 0xa6, 0xd5, 0xb5, 0x3e, 0x84, 0xc6,
+	0x45, 0x06, 0x16, 0x13, 0x0b, 0x53, 0xc4, 0x4e, 0x9e, 0x12, 0x6f, 0x16, 0x61, 0x30, 0xf2, 0x30,
+	0xee, 0xe6, 0xea, 0xc2, 0x0c, 0xa5, 0xe9, 0xaa, 0x41, 0xeb, 0xc2, 0x8d, 0xdb, 0x50, 0xa7, 0x63,
+	0xc2, 0x86, 0xde, 0xe4, 0xc2, 0x54, 0xf6, 0x64, 0x08, 0x7c, 0xf6, 0x8e, 0x43, 0x26, 0x42, 0x7a,
+	0xc1, 0xdd, 0xc0, 0xbb, 0xd2, 0x4a, 0x37, 0x0c, 0xee, 0x91, 0x77, 0x85, 0xa6, 0x79, 0x18, 0xc9,
+	0xd8, 0x7c, 0x18, 0xf5, 0xd3, 0x2b, 0xdb, 0xaf, 0xa0, 0x8e, 0xd8, 0xd3, 0x81, 0xc7, 0xc9, 0xbc,
+	0xc0, 0xa7, 0xaa, 0x42, 0x4d, 0xd2, 0xc0, 0xa7, 0x84, 0xcf, 0xe4, 0x59, 0x12, 0x24, 0x4c, 0xd6,
+	0xb5, 0xb8, 0x89, 0x52, 0xaa, 0xea, 0x80, 0x41, 0x9d, 0x71, 0xfb, 0x0c, 0x1a, 0x39, 0x91, 0xd6,
+	0xdb, 0xb0, 0x1c, 0xa3, 0x1c, 0x33, 0x3f, 0x3a, 0xcc, 0x9c, 0xca, 0x77, 0x74, 0xb3, 0xaa, 0xcc,
+	0xf4, 0x98, 0x48, 0xe2, 0x4c, 0x6a, 0x5d, 0x63, 0xce, 0xf8, 0xee, 0x7f, 0x77, 0xf5, 0xcd, 0x4e,
+	0xa7, 0x2e, 0xac, 0xc7, 0xd0, 0x9e, 0xaa, 0x64, 0xb7, 0x6e, 0xe7, 0x1f, 0x86, 0xd3, 0x79, 0xe4,
+	0xee, 0xd6, 0x8e, 0xaa, 0x8c, 0xdf, 0x31, 0x95, 0xf1, 0x3b, 0x07, 0x58, 0x19, 0x6f, 0x1d, 0xc0,
+	0x6a, 0xb1, 0xc4, 0xd7, 0x7a, 0xcd, 0x3c, 0xa6, 0x4b, 0x0a, 0x7f, 0xe7, 0xb2, 0x79, 0x0c, 0xed,
+	0xa9, 0xa2, 0x5c, 0xa3, 0x4f, 0x79, 0xad, 0xee, 0x5c, 0x46, 0x7b, 0xd0, 0xc8, 0x15, 0x4a, 0x5a,
+	0x9d, 0x79, 0x65, 0xa4, 0xdd, 0x5b, 0x25, 0x2d, 0x7a, 0xaf, 0xef, 0x43, 0xab, 0x50, 0x1d, 0x69,
+	0x75, 0xf5, 0x90, 0x4a, 0x4a, 0x26, 0xaf, 0x53, 0x24, 0x57, 0x4c, 0x68, 0x14, 0x99, 0xad, 0x7a,
+	0xec, 0xde, 0x2a, 0x69, 0xd1, 0x8a, 0x3c, 0x81, 0x56, 0xa1, 0x6e, 0xcf, 0x28, 0x52, 0x56, 0x33,
+	0xd8, 0x7d, 0xad, 0xb4, 0x4d, 0x73, 0xfa, 0x0c, 0x5a, 0x85, 0x2a, 0x3e, 0xc3, 0xa9, 0xac, 0xb4,
+	0xaf, 0xbb, 0x56, 0xa8, 0x19, 0x46, 0xea, 0x7d, 0x68, 0x4f, 0x15, 0xde, 0x99, 0xe5, 0x29, 0xaf,
+	0xc7, 0xeb, 0x5a, 0x05, 0x16, 0xaa, 0xc7, 0x53, 0xd8, 0x28, 0x29, 0x2b, 0xb3, 0xb6, 0x33, 0xbd,
+	0xcb, 0x2b, 0xce, 0xba, 0x37, 0xca, 0x2a, 0xa8, 0xb8, 0xf5, 0x47, 0x70, 0xa3, 0xb4, 0xea, 0xc9,
+	0xb2, 0xcd, 0xd2, 0xce, 0xaf, 0x6d, 0xea, 0xbe, 0x71, 0x2d, 0x8d, 0x9e, 0xb5, 0x2f, 0xe1, 0xe6,
+	0x9c, 0x12, 0x29, 0xeb, 0x4d, 0xd5, 0xff, 0xfa, 0x0a, 0xaa, 0xb9, 0xc6, 0xe1, 0xc0, 0x46, 0x49,
+	0xd5, 0x94, 0x99, 0x8a, 0xf9, 0x05, 0x55, 0xc6, 0x58, 0xca, 0x2a, 0x94, 0x1e, 0x43, 0x7b, 0xaa,
+	0x2e, 0xc8, 0xac, 0x51, 0x79, 0xb9, 0xd0, 0x5c, 0xe5, 0xbe, 0x80, 0xd5, 0x62, 0xec, 0x3d, 0xb7,
+	0xa5, 0x67, 0xab, 0x80, 0xba, 0xb7, 0xcb, 0x1b, 0xf5, 0x14, 0x1e, 0x40, 0x33, 0x1f, 0x44, 0xb6,
+	0x6e, 0xe5, 0xa8, 0x8b, 0x21, 0xa1, 0x6e, 0xb7, 0xac, 0x49, 0xb3, 0xf9, 0x0a, 0x36, 0x4a, 0xaa,
+	0x76, 0xcc, 0x84, 0xcd, 0x2f, 0x31, 0xea, 0xbe, 0xfe, 0xc2, 0x92, 0x1f, 0x74, 0x61, 0xc5, 0xc2,
+	0x1b, 0x33, 0xde, 0xd2, 0x72, 0x9c, 0xeb, 0x5d, 0x58, 0xa1, 0x06, 0x27, 0x73, 0x61, 0x65, 0xa5,
+	0x39, 0x73, 0x19, 0x3d, 0x04, 0xd0, 0xe1, 0xea, 0x20, 0x8c, 0x52, 0xc7, 0x31, 0x13, 0x38, 0xef,
+	0xde, 0x2a, 0x69, 0x49, 0xcb, 0x9e, 0x40, 0x45, 0x99, 0xe5, 0xff, 0x33, 0x6e, 0x66, 0x66, 0x55,
+	0xe4, 0xd0, 0x99, 0x6d, 0x98, 0x61, 0x40, 0x18, 0x7b, 0x19, 0x06, 0x9f, 0x01, 0x64, 0xd1, 0x6b,
+	0xc3, 0x60, 0x26, 0x9e, 0x7d, 0xcd, 0x1c, 0x34, 0xf3, 0xb1, 0x6a, 0x63, 0x36, 0x25, 0xf1, 0xeb,
+	0x6b, 0x58, 0xb4, 0xa7, 0x22, 0x7e, 0xc5, 0xfd, 0x30, 0x1d, 0x08, 0xec, 0xce, 0x44, 0xfd, 0xac,
+	0x0f, 0xa1, 0x99, 0x0f, 0xf5, 0x19, 0x2d, 0x4a, 0xc2, 0x7f, 0xdd, 0x42, 0xb8, 0xcf, 0xfa, 0x1c,
+	0x56, 0x8b, 0x41, 0x20, 0x2b, 0xe7, 0x9d, 0x67, 0x42, 0x43, 0xc6, 0xe1, 0xe6, 0xc8, 0x1f, 0x00,
+	0x64, 0xc1, 0x22, 0x33, 0x7d, 0x33, 0xe1, 0xa3, 0x29, 0xa9, 0x47, 0x26, 0x32, 0x59, 0x8c, 0xb7,
+	0x6d, 0xe7, 0xb5, 0x2e, 0x0b, 0xf0, 0x75, 0x37, 0x4a, 0xa2, 0x6f, 0xd6, 0x09, 0x6c, 0x94, 0x04,
+	0xda, 0x0c, 0xb7, 0xf9, 0x31, 0xb8, 0xb9, 0x0b, 0x92, 0xfe, 0xf1, 0x66, 0x86, 0xe7, 0x1b, 0xf9,
+	0xa3, 0xfe, 0xbb, 0xb2, 0x7d, 0x08, 0xcd, 0xfc, 0xbb, 0x22, 0xe7, 0x61, 0xa6, 0xdf, 0x1a, 0x73,
+	0x59, 0x7c, 0x0e, 0x8d, 0xdc, 0x1b, 0xc4, 0x6c, 0xb9, 0xd9, 0x67, 0xc9, 0x5c, 0x06, 0x1f, 0x00,
+	0x64, 0xcf, 0x15, 0xb3, 0x5c, 0x33, 0x0f, 0x98, 0x6e, 0xf6, 0x67, 0x1c, 0xfd, 0x67, 0xb1, 0x56,
+	0x21, 0x0c, 0x6f, 0x0e, 0xe5, 0xb2, 0xd8, 0xfc, 0x75, 0x17, 0xb0, 0x62, 0x84, 0xdd, 0x98, 0x5a,
+	0x69, 0xdc, 0xfd, 0xba, 0x59, 0xcc, 0x07, 0x22, 0xcd, 0x2c, 0x96, 0x04, 0x27, 0x5f, 0xe0, 0x00,
+	0xf3, 0xc1, 0xc6, 0x9c, 0x03, 0x2c, 0x89, 0x41, 0xce, 0x65, 0xf4, 0x44, 0xde, 0x36, 0xf2, 0x51,
+	0x35, 0xa3, 0x4e, 0x49, 0x4c, 0xaf, 0xdb, 0x2d, 0x6b, 0xd2, 0x5e, 0xe8, 0x0b, 0x58, 0x9f, 0x89,
+	0x6f, 0x59, 0x77, 0xd3, 0x23, 0xa1, 0x34, 0xf0, 0x35, 0x57, 0xad, 0x43, 0x58, 0x9b, 0x0e, 0x6f,
+	0x59, 0x77, 0xd2, 0xdd, 0x50, 0x16, 0xf6, 0x9a, 0xcb, 0xea, 0x63, 0xa8, 0x99, 0x68, 0x82, 0x95,
+	0xde, 0x6e, 0x0a, 0xd1, 0x85, 0xeb, 0x16, 0x2a, 0xff, 0x78, 0xb7, 0xd2, 0x7b, 0xec, 0xcc, 0x83,
+	0x7e, 0x2e, 0x8b, 0x63, 0x58, 0x9f, 0x89, 0xe6, 0x98, 0x59, 0x99, 0x17, 0xe6, 0x31, 0xae, 0xbe,
+	0x24, 0x54, 0xf3, 0x10, 0x9a, 0xf9, 0x30, 0x8a, 0xd1, 0xa8, 0x24, 0xb4, 0x72, 0x8d, 0x11, 0xb7,
+	0x0a, 0x8f, 0xf1, 0xdc, 0xf5, 0x74, 0xe6, 0x85, 0x6e, 0x34, 0x29, 0x79, 0xa4, 0x1f, 0xc1, 0x86,
+	0x31, 0x9c, 0xfc, 0x53, 0xf3, 0x4e, 0xe9, 0xab, 0x32, 0x7f, 0x49, 0x2b, 0x6b, 0xb6, 0x3e, 0x83,
+	0xd5, 0xc7, 0x44, 0xe4, 0x9f, 0x63, 0x9d, 0xec, 0xf9, 0x55, 0x7c, 0x14, 0x76, 0xd7, 0x67, 0x5a,
+	0xf6, 0x9a, 0xdf, 0x7c, 0x7b, 0xb7, 0xf2, 0x6f, 0xdf, 0xde, 0xad, 0xfc, 0xe7, 0xb7, 0x77, 0x2b,
+	0xe7, 0xcb, 0x72, 0xc4, 0x0f, 0xfe, 0x77, 0x00, 0x6e, 0xb5, 0x42, 0x78, 0xb7, 0x3c, 0x00, 0x00,
 }
@@ -140,6 +140,9 @@ type Process struct {
 	OOMScoreAdj int64 `protobuf:"varint,11,opt,name=OOMScoreAdj,proto3" json:"OOMScoreAdj,omitempty"`
 	// SelinuxLabel specifies the selinux context that the container process is run as.
 	SelinuxLabel string `protobuf:"bytes,12,opt,name=SelinuxLabel,proto3" json:"SelinuxLabel,omitempty"`
+	// Landlock specifies the Landlock ruleset restricting the filesystem
+	// accesses of the process.
+	Landlock *Landlock `protobuf:"bytes,13,opt,name=Landlock" json:"Landlock,omitempty"`
 }
 
 func (m *Process) Reset()                    { *m = Process{} }
@@ -231,6 +234,79 @@ func (m *Process) GetSelinuxLabel() string {
 	return ""
 }
 
+func (m *Process) GetLandlock() *Landlock {
+	if m != nil {
+		return m.Landlock
+	}
+	return nil
+}
+
+// Landlock represents a Landlock ruleset, see landlock(7).
+type Landlock struct {
+	// HandledAccessFS lists the filesystem access rights restricted by the
+	// ruleset, such as "read_file", all of them when empty.
+	HandledAccessFS []string `protobuf:"bytes,1,rep,name=HandledAccessFS" json:"HandledAccessFS,omitempty"`
+	// PathBeneath lists the rules granting access rights to file hierarchies.
+	PathBeneath []LandlockPathBeneath `protobuf:"bytes,2,rep,name=PathBeneath" json:"PathBeneath"`
+	// DisableBestEffort fails the process creation if the kernel does not
+	// support Landlock, or some of the access rights, instead of ignoring
+	// them.
+	DisableBestEffort bool `protobuf:"varint,3,opt,name=DisableBestEffort,proto3" json:"DisableBestEffort,omitempty"`
+}
+
+func (m *Landlock) Reset()                    { *m = Landlock{} }
+func (m *Landlock) String() string            { return proto.CompactTextString(m) }
+func (*Landlock) ProtoMessage()               {}
+func (*Landlock) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{2} }
+
+func (m *Landlock) GetHandledAccessFS() []string {
+	if m != nil {
+		return m.HandledAccessFS
+	}
+	return nil
+}
+
+func (m *Landlock) GetPathBeneath() []LandlockPathBeneath {
+	if m != nil {
+		return m.PathBeneath
+	}
+	return nil
+}
+
+func (m *Landlock) GetDisableBestEffort() bool {
+	if m != nil {
+		return m.DisableBestEffort
+	}
+	return false
+}
+
+// LandlockPathBeneath grants access rights beneath paths of the container.
+type LandlockPathBeneath struct {
+	// AllowedAccess lists the access rights granted.
+	AllowedAccess []string `protobuf:"bytes,1,rep,name=AllowedAccess" json:"AllowedAccess,omitempty"`
+	// Paths lists the paths, relative to the root of the container.
+	Paths []string `protobuf:"bytes,2,rep,name=Paths" json:"Paths,omitempty"`
+}
+
+func (m *LandlockPathBeneath) Reset()                    { *m = LandlockPathBeneath{} }
+func (m *LandlockPathBeneath) String() string            { return proto.CompactTextString(m) }
+func (*LandlockPathBeneath) ProtoMessage()               {}
+func (*LandlockPathBeneath) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{3} }
+
+func (m *LandlockPathBeneath) GetAllowedAccess() []string {
+	if m != nil {
+		return m.AllowedAccess
+	}
+	return nil
+}
+
+func (m *LandlockPathBeneath) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
 type Box struct {
 	// Height is the vertical dimension of a box.
 	Height uint32 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
@@ -241,7 +317,7 @@ type Box struct {
 func (m *Box) Reset()                    { *m = Box{} }
 func (m *Box) String() string            { return proto.CompactTextString(m) }
 func (*Box) ProtoMessage()               {}
-func (*Box) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{2} }
+func (*Box) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{4} }
 
 func (m *Box) GetHeight() uint32 {
 	if m != nil {
@@ -271,7 +347,7 @@ type User struct {
 func (m *User) Reset()                    { *m = User{} }
 func (m *User) String() string            { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()               {}
-func (*User) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{3} }
+func (*User) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{5} }
 
 func (m *User) GetUID() uint32 {
 	if m != nil {
@@ -317,7 +393,7 @@ type LinuxCapabilities struct {
 func (m *LinuxCapabilities) Reset()                    { *m = LinuxCapabilities{} }
 func (m *LinuxCapabilities) String() string            { return proto.CompactTextString(m) }
 func (*LinuxCapabilities) ProtoMessage()               {}
-func (*LinuxCapabilities) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{4} }
+func (*LinuxCapabilities) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{6} }
 
 func (m *LinuxCapabilities) GetBounding() []string {
 	if m != nil {
@@ -366,7 +442,7 @@ type POSIXRlimit struct {
 func (m *POSIXRlimit) Reset()                    { *m = POSIXRlimit{} }
 func (m *POSIXRlimit) String() string            { return proto.CompactTextString(m) }
 func (*POSIXRlimit) ProtoMessage()               {}
-func (*POSIXRlimit) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{5} }
+func (*POSIXRlimit) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{7} }
 
 func (m *POSIXRlimit) GetType() string {
 	if m != nil {
@@ -404,7 +480,7 @@ type Mount struct {
 func (m *Mount) Reset()                    { *m = Mount{} }
 func (m *Mount) String() string            { return proto.CompactTextString(m) }
 func (*Mount) ProtoMessage()               {}
-func (*Mount) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{6} }
+func (*Mount) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{8} }
 
 func (m *Mount) GetDestination() string {
 	if m != nil {
@@ -444,7 +520,7 @@ type Root struct {
 func (m *Root) Reset()                    { *m = Root{} }
 func (m *Root) String() string            { return proto.CompactTextString(m) }
 func (*Root) ProtoMessage()               {}
-func (*Root) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{7} }
+func (*Root) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{9} }
 
 func (m *Root) GetPath() string {
 	if m != nil {
@@ -472,7 +548,7 @@ type Hooks struct {
 func (m *Hooks) Reset()                    { *m = Hooks{} }
 func (m *Hooks) String() string            { return proto.CompactTextString(m) }
 func (*Hooks) ProtoMessage()               {}
-func (*Hooks) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{8} }
+func (*Hooks) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{10} }
 
 func (m *Hooks) GetPrestart() []Hook {
 	if m != nil {
@@ -505,7 +581,7 @@ type Hook struct {
 func (m *Hook) Reset()                    { *m = Hook{} }
 func (m *Hook) String() string            { return proto.CompactTextString(m) }
 func (*Hook) ProtoMessage()               {}
-func (*Hook) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{9} }
+func (*Hook) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{11} }
 
 func (m *Hook) GetPath() string {
 	if m != nil {
@@ -566,12 +642,14 @@ type Linux struct {
 	// IntelRdt contains Intel Resource Director Technology (RDT) information
 	// for handling resource constraints (e.g., L3 cache) for the container
 	IntelRdt *LinuxIntelRdt `protobuf:"bytes,13,opt,name=IntelRdt" json:"IntelRdt,omitempty"`
+	// Personality contains configuration for the Linux personality syscall
+	Personality *LinuxPersonality `protobuf:"bytes,14,opt,name=Personality" json:"Personality,omitempty"`
 }
 
 func (m *Linux) Reset()                    { *m = Linux{} }
 func (m *Linux) String() string            { return proto.CompactTextString(m) }
 func (*Linux) ProtoMessage()               {}
-func (*Linux) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{10} }
+func (*Linux) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{12} }
 
 func (m *Linux) GetUIDMappings() []LinuxIDMapping {
 	if m != nil {
@@ -664,6 +742,40 @@ func (m *Linux) GetIntelRdt() *LinuxIntelRdt {
 	return nil
 }
 
+func (m *Linux) GetPersonality() *LinuxPersonality {
+	if m != nil {
+		return m.Personality
+	}
+	return nil
+}
+
+// LinuxPersonality represents the Linux personality syscall input
+type LinuxPersonality struct {
+	// Domain for the personality, LINUX or LINUX32
+	Domain string `protobuf:"bytes,1,opt,name=Domain,proto3" json:"Domain,omitempty"`
+	// Additional flags, such as ADDR_NO_RANDOMIZE
+	Flags []string `protobuf:"bytes,2,rep,name=Flags" json:"Flags,omitempty"`
+}
+
+func (m *LinuxPersonality) Reset()                    { *m = LinuxPersonality{} }
+func (m *LinuxPersonality) String() string            { return proto.CompactTextString(m) }
+func (*LinuxPersonality) ProtoMessage()               {}
+func (*LinuxPersonality) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{13} }
+
+func (m *LinuxPersonality) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *LinuxPersonality) GetFlags() []string {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
+}
+
 type Windows struct {
 	// Dummy string, never used.
 	Dummy string `protobuf:"bytes,1,opt,name=dummy,proto3" json:"dummy,omitempty"`
@@ -672,7 +784,7 @@ type Windows struct {
 func (m *Windows) Reset()                    { *m = Windows{} }
 func (m *Windows) String() string            { return proto.CompactTextString(m) }
 func (*Windows) ProtoMessage()               {}
-func (*Windows) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{11} }
+func (*Windows) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{14} }
 
 func (m *Windows) GetDummy() string {
 	if m != nil {
@@ -689,7 +801,7 @@ type Solaris struct {
 func (m *Solaris) Reset()                    { *m = Solaris{} }
 func (m *Solaris) String() string            { return proto.CompactTextString(m) }
 func (*Solaris) ProtoMessage()               {}
-func (*Solaris) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{12} }
+func (*Solaris) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{15} }
 
 func (m *Solaris) GetDummy() string {
 	if m != nil {
@@ -710,7 +822,7 @@ type LinuxIDMapping struct {
 func (m *LinuxIDMapping) Reset()                    { *m = LinuxIDMapping{} }
 func (m *LinuxIDMapping) String() string            { return proto.CompactTextString(m) }
 func (*LinuxIDMapping) ProtoMessage()               {}
-func (*LinuxIDMapping) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{13} }
+func (*LinuxIDMapping) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{16} }
 
 func (m *LinuxIDMapping) GetHostID() uint32 {
 	if m != nil {
@@ -744,7 +856,7 @@ type LinuxNamespace struct {
 func (m *LinuxNamespace) Reset()                    { *m = LinuxNamespace{} }
 func (m *LinuxNamespace) String() string            { return proto.CompactTextString(m) }
 func (*LinuxNamespace) ProtoMessage()               {}
-func (*LinuxNamespace) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{14} }
+func (*LinuxNamespace) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{17} }
 
 func (m *LinuxNamespace) GetType() string {
 	if m != nil {
@@ -780,7 +892,7 @@ type LinuxDevice struct {
 func (m *LinuxDevice) Reset()                    { *m = LinuxDevice{} }
 func (m *LinuxDevice) String() string            { return proto.CompactTextString(m) }
 func (*LinuxDevice) ProtoMessage()               {}
-func (*LinuxDevice) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{15} }
+func (*LinuxDevice) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{18} }
 
 func (m *LinuxDevice) GetPath() string {
 	if m != nil {
@@ -851,7 +963,7 @@ type LinuxResources struct {
 func (m *LinuxResources) Reset()                    { *m = LinuxResources{} }
 func (m *LinuxResources) String() string            { return proto.CompactTextString(m) }
 func (*LinuxResources) ProtoMessage()               {}
-func (*LinuxResources) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{16} }
+func (*LinuxResources) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{19} }
 
 func (m *LinuxResources) GetDevices() []LinuxDeviceCgroup {
 	if m != nil {
@@ -922,7 +1034,7 @@ type LinuxMemory struct {
 func (m *LinuxMemory) Reset()                    { *m = LinuxMemory{} }
 func (m *LinuxMemory) String() string            { return proto.CompactTextString(m) }
 func (*LinuxMemory) ProtoMessage()               {}
-func (*LinuxMemory) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{17} }
+func (*LinuxMemory) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{20} }
 
 func (m *LinuxMemory) GetLimit() int64 {
 	if m != nil {
@@ -988,12 +1100,15 @@ type LinuxCPU struct {
 	Cpus string `protobuf:"bytes,6,opt,name=Cpus,proto3" json:"Cpus,omitempty"`
 	// List of memory nodes in the cpuset. Default is to use any available memory node.
 	Mems string `protobuf:"bytes,7,opt,name=Mems,proto3" json:"Mems,omitempty"`
+	// Amount of CPU time (in usecs) the cgroup can accumulate beyond its quota to absorb spikes.
+	// Left unchanged by updates when 0.
+	Burst uint64 `protobuf:"varint,8,opt,name=Burst,proto3" json:"Burst,omitempty"`
 }
 
 func (m *LinuxCPU) Reset()                    { *m = LinuxCPU{} }
 func (m *LinuxCPU) String() string            { return proto.CompactTextString(m) }
 func (*LinuxCPU) ProtoMessage()               {}
-func (*LinuxCPU) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{18} }
+func (*LinuxCPU) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{21} }
 
 func (m *LinuxCPU) GetShares() uint64 {
 	if m != nil {
@@ -1044,6 +1159,13 @@ func (m *LinuxCPU) GetMems() string {
 	return ""
 }
 
+func (m *LinuxCPU) GetBurst() uint64 {
+	if m != nil {
+		return m.Burst
+	}
+	return 0
+}
+
 type LinuxWeightDevice struct {
 	// Major is the device's major number.
 	Major int64 `protobuf:"varint,1,opt,name=Major,proto3" json:"Major,omitempty"`
@@ -1058,7 +1180,7 @@ type LinuxWeightDevice struct {
 func (m *LinuxWeightDevice) Reset()                    { *m = LinuxWeightDevice{} }
 func (m *LinuxWeightDevice) String() string            { return proto.CompactTextString(m) }
 func (*LinuxWeightDevice) ProtoMessage()               {}
-func (*LinuxWeightDevice) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{19} }
+func (*LinuxWeightDevice) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{22} }
 
 func (m *LinuxWeightDevice) GetMajor() int64 {
 	if m != nil {
@@ -1100,7 +1222,7 @@ type LinuxThrottleDevice struct {
 func (m *LinuxThrottleDevice) Reset()                    { *m = LinuxThrottleDevice{} }
 func (m *LinuxThrottleDevice) String() string            { return proto.CompactTextString(m) }
 func (*LinuxThrottleDevice) ProtoMessage()               {}
-func (*LinuxThrottleDevice) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{20} }
+func (*LinuxThrottleDevice) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{23} }
 
 func (m *LinuxThrottleDevice) GetMajor() int64 {
 	if m != nil {
@@ -1143,7 +1265,7 @@ type LinuxBlockIO struct {
 func (m *LinuxBlockIO) Reset()                    { *m = LinuxBlockIO{} }
 func (m *LinuxBlockIO) String() string            { return proto.CompactTextString(m) }
 func (*LinuxBlockIO) ProtoMessage()               {}
-func (*LinuxBlockIO) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{21} }
+func (*LinuxBlockIO) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{24} }
 
 func (m *LinuxBlockIO) GetWeight() uint32 {
 	if m != nil {
@@ -1202,7 +1324,7 @@ type LinuxPids struct {
 func (m *LinuxPids) Reset()                    { *m = LinuxPids{} }
 func (m *LinuxPids) String() string            { return proto.CompactTextString(m) }
 func (*LinuxPids) ProtoMessage()               {}
-func (*LinuxPids) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{22} }
+func (*LinuxPids) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{25} }
 
 func (m *LinuxPids) GetLimit() int64 {
 	if m != nil {
@@ -1227,7 +1349,7 @@ type LinuxDeviceCgroup struct {
 func (m *LinuxDeviceCgroup) Reset()                    { *m = LinuxDeviceCgroup{} }
 func (m *LinuxDeviceCgroup) String() string            { return proto.CompactTextString(m) }
 func (*LinuxDeviceCgroup) ProtoMessage()               {}
-func (*LinuxDeviceCgroup) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{23} }
+func (*LinuxDeviceCgroup) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{26} }
 
 func (m *LinuxDeviceCgroup) GetAllow() bool {
 	if m != nil {
@@ -1274,7 +1396,7 @@ type LinuxNetwork struct {
 func (m *LinuxNetwork) Reset()                    { *m = LinuxNetwork{} }
 func (m *LinuxNetwork) String() string            { return proto.CompactTextString(m) }
 func (*LinuxNetwork) ProtoMessage()               {}
-func (*LinuxNetwork) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{24} }
+func (*LinuxNetwork) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{27} }
 
 func (m *LinuxNetwork) GetClassID() uint32 {
 	if m != nil {
@@ -1300,7 +1422,7 @@ type LinuxHugepageLimit struct {
 func (m *LinuxHugepageLimit) Reset()                    { *m = LinuxHugepageLimit{} }
 func (m *LinuxHugepageLimit) String() string            { return proto.CompactTextString(m) }
 func (*LinuxHugepageLimit) ProtoMessage()               {}
-func (*LinuxHugepageLimit) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{25} }
+func (*LinuxHugepageLimit) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{28} }
 
 func (m *LinuxHugepageLimit) GetPagesize() string {
 	if m != nil {
@@ -1326,7 +1448,7 @@ type LinuxInterfacePriority struct {
 func (m *LinuxInterfacePriority) Reset()                    { *m = LinuxInterfacePriority{} }
 func (m *LinuxInterfacePriority) String() string            { return proto.CompactTextString(m) }
 func (*LinuxInterfacePriority) ProtoMessage()               {}
-func (*LinuxInterfacePriority) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{26} }
+func (*LinuxInterfacePriority) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{29} }
 
 func (m *LinuxInterfacePriority) GetName() string {
 	if m != nil {
@@ -1351,7 +1473,7 @@ type LinuxSeccomp struct {
 func (m *LinuxSeccomp) Reset()                    { *m = LinuxSeccomp{} }
 func (m *LinuxSeccomp) String() string            { return proto.CompactTextString(m) }
 func (*LinuxSeccomp) ProtoMessage()               {}
-func (*LinuxSeccomp) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{27} }
+func (*LinuxSeccomp) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{30} }
 
 func (m *LinuxSeccomp) GetDefaultAction() string {
 	if m != nil {
@@ -1384,7 +1506,7 @@ type LinuxSeccompArg struct {
 func (m *LinuxSeccompArg) Reset()                    { *m = LinuxSeccompArg{} }
 func (m *LinuxSeccompArg) String() string            { return proto.CompactTextString(m) }
 func (*LinuxSeccompArg) ProtoMessage()               {}
-func (*LinuxSeccompArg) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{28} }
+func (*LinuxSeccompArg) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{31} }
 
 func (m *LinuxSeccompArg) GetIndex() uint64 {
 	if m != nil {
@@ -1423,7 +1545,7 @@ type LinuxSyscall struct {
 func (m *LinuxSyscall) Reset()                    { *m = LinuxSyscall{} }
 func (m *LinuxSyscall) String() string            { return proto.CompactTextString(m) }
 func (*LinuxSyscall) ProtoMessage()               {}
-func (*LinuxSyscall) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{29} }
+func (*LinuxSyscall) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{32} }
 
 func (m *LinuxSyscall) GetNames() []string {
 	if m != nil {
@@ -1455,7 +1577,7 @@ type LinuxIntelRdt struct {
 func (m *LinuxIntelRdt) Reset()                    { *m = LinuxIntelRdt{} }
 func (m *LinuxIntelRdt) String() string            { return proto.CompactTextString(m) }
 func (*LinuxIntelRdt) ProtoMessage()               {}
-func (*LinuxIntelRdt) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{30} }
+func (*LinuxIntelRdt) Descriptor() ([]byte, []int) { return fileDescriptorOci, []int{33} }
 
 func (m *LinuxIntelRdt) GetL3CacheSchema() string {
 	if m != nil {
@@ -1467,6 +1589,8 @@ func (m *LinuxIntelRdt) GetL3CacheSchema() string {
 func init() {
 	proto.RegisterType((*Spec)(nil), "grpc.Spec")
 	proto.RegisterType((*Process)(nil), "grpc.Process")
+	proto.RegisterType((*Landlock)(nil), "grpc.Landlock")
+	proto.RegisterType((*LandlockPathBeneath)(nil), "grpc.LandlockPathBeneath")
 	proto.RegisterType((*Box)(nil), "grpc.Box")
 	proto.RegisterType((*User)(nil), "grpc.User")
 	proto.RegisterType((*LinuxCapabilities)(nil), "grpc.LinuxCapabilities")
@@ -1476,6 +1600,7 @@ func init() {
 	proto.RegisterType((*Hooks)(nil), "grpc.Hooks")
 	proto.RegisterType((*Hook)(nil), "grpc.Hook")
 	proto.RegisterType((*Linux)(nil), "grpc.Linux")
+	proto.RegisterType((*LinuxPersonality)(nil), "grpc.LinuxPersonality")
 	proto.RegisterType((*Windows)(nil), "grpc.Windows")
 	proto.RegisterType((*Solaris)(nil), "grpc.Solaris")
 	proto.RegisterType((*LinuxIDMapping)(nil), "grpc.LinuxIDMapping")
@@ -1628,6 +1753,86 @@ func (this *Process) Equal(that interface{}) bool {
 	if this.SelinuxLabel != that1.SelinuxLabel {
 		return false
 	}
+	if !this.Landlock.Equal(that1.Landlock) {
+		return false
+	}
+	return true
+}
+func (this *Landlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Landlock)
+	if !ok {
+		that2, ok := that.(Landlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.HandledAccessFS) != len(that1.HandledAccessFS) {
+		return false
+	}
+	for i := range this.HandledAccessFS {
+		if this.HandledAccessFS[i] != that1.HandledAccessFS[i] {
+			return false
+		}
+	}
+	if len(this.PathBeneath) != len(that1.PathBeneath) {
+		return false
+	}
+	for i := range this.PathBeneath {
+		if !this.PathBeneath[i].Equal(&that1.PathBeneath[i]) {
+			return false
+		}
+	}
+	if this.DisableBestEffort != that1.DisableBestEffort {
+		return false
+	}
+	return true
+}
+func (this *LandlockPathBeneath) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LandlockPathBeneath)
+	if !ok {
+		that2, ok := that.(LandlockPathBeneath)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.AllowedAccess) != len(that1.AllowedAccess) {
+		return false
+	}
+	for i := range this.AllowedAccess {
+		if this.AllowedAccess[i] != that1.AllowedAccess[i] {
+			return false
+		}
+	}
+	if len(this.Paths) != len(that1.Paths) {
+		return false
+	}
+	for i := range this.Paths {
+		if this.Paths[i] != that1.Paths[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *Box) Equal(that interface{}) bool {
@@ -2032,6 +2237,41 @@ func (this *Linux) Equal(that interface{}) bool {
 	if !this.IntelRdt.Equal(that1.IntelRdt) {
 		return false
 	}
+	if !this.Personality.Equal(that1.Personality) {
+		return false
+	}
+	return true
+}
+func (this *LinuxPersonality) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LinuxPersonality)
+	if !ok {
+		that2, ok := that.(LinuxPersonality)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if len(this.Flags) != len(that1.Flags) {
+		return false
+	}
+	for i := range this.Flags {
+		if this.Flags[i] != that1.Flags[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *Windows) Equal(that interface{}) bool {
@@ -2315,6 +2555,9 @@ func (this *LinuxCPU) Equal(that interface{}) bool {
 	if this.Mems != that1.Mems {
 		return false
 	}
+	if this.Burst != that1.Burst {
+		return false
+	}
 	return true
 }
 func (this *LinuxWeightDevice) Equal(that interface{}) bool {
@@ -2977,6 +3220,119 @@ func (m *Process) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintOci(dAtA, i, uint64(len(m.SelinuxLabel)))
 		i += copy(dAtA[i:], m.SelinuxLabel)
 	}
+	if m.Landlock != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintOci(dAtA, i, uint64(m.Landlock.Size()))
+		n10, err := m.Landlock.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+
+func (m *Landlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Landlock) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.HandledAccessFS) > 0 {
+		for _, s := range m.HandledAccessFS {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.PathBeneath) > 0 {
+		for _, msg := range m.PathBeneath {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintOci(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.DisableBestEffort {
+		dAtA[i] = 0x18
+		i++
+		if m.DisableBestEffort {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *LandlockPathBeneath) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LandlockPathBeneath) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedAccess) > 0 {
+		for _, s := range m.AllowedAccess {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -3034,21 +3390,21 @@ func (m *User) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintOci(dAtA, i, uint64(m.GID))
 	}
 	if len(m.AdditionalGids) > 0 {
-		dAtA11 := make([]byte, len(m.AdditionalGids)*10)
-		var j10 int
+		dAtA12 := make([]byte, len(m.AdditionalGids)*10)
+		var j11 int
 		for _, num := range m.AdditionalGids {
 			for num >= 1<<7 {
-				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j10++
+				j11++
 			}
-			dAtA11[j10] = uint8(num)
-			j10++
+			dAtA12[j11] = uint8(num)
+			j11++
 		}
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintOci(dAtA, i, uint64(j10))
-		i += copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintOci(dAtA, i, uint64(j11))
+		i += copy(dAtA[i:], dAtA12[:j11])
 	}
 	if len(m.Username) > 0 {
 		dAtA[i] = 0x22
@@ -3444,11 +3800,11 @@ func (m *Linux) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintOci(dAtA, i, uint64(m.Resources.Size()))
-		n12, err := m.Resources.MarshalTo(dAtA[i:])
+		n13, err := m.Resources.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n13
 	}
 	if len(m.CgroupsPath) > 0 {
 		dAtA[i] = 0x2a
@@ -3484,11 +3840,11 @@ func (m *Linux) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintOci(dAtA, i, uint64(m.Seccomp.Size()))
-		n13, err := m.Seccomp.MarshalTo(dAtA[i:])
+		n14, err := m.Seccomp.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n14
 	}
 	if len(m.RootfsPropagation) > 0 {
 		dAtA[i] = 0x4a
@@ -3536,16 +3892,26 @@ func (m *Linux) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6a
 		i++
 		i = encodeVarintOci(dAtA, i, uint64(m.IntelRdt.Size()))
-		n14, err := m.IntelRdt.MarshalTo(dAtA[i:])
+		n15, err := m.IntelRdt.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n15
+	}
+	if m.Personality != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintOci(dAtA, i, uint64(m.Personality.Size()))
+		n16, err := m.Personality.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
 	}
 	return i, nil
 }
 
-func (m *Windows) Marshal() (dAtA []byte, err error) {
+func (m *LinuxPersonality) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3555,7 +3921,46 @@ func (m *Windows) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Windows) MarshalTo(dAtA []byte) (int, error) {
+func (m *LinuxPersonality) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Domain) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOci(dAtA, i, uint64(len(m.Domain)))
+		i += copy(dAtA[i:], m.Domain)
+	}
+	if len(m.Flags) > 0 {
+		for _, s := range m.Flags {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *Windows) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Windows) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -3742,41 +4147,41 @@ func (m *LinuxResources) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintOci(dAtA, i, uint64(m.Memory.Size()))
-		n15, err := m.Memory.MarshalTo(dAtA[i:])
+		n17, err := m.Memory.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n17
 	}
 	if m.CPU != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintOci(dAtA, i, uint64(m.CPU.Size()))
-		n16, err := m.CPU.MarshalTo(dAtA[i:])
+		n18, err := m.CPU.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n18
 	}
 	if m.Pids != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintOci(dAtA, i, uint64(m.Pids.Size()))
-		n17, err := m.Pids.MarshalTo(dAtA[i:])
+		n19, err := m.Pids.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n19
 	}
 	if m.BlockIO != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintOci(dAtA, i, uint64(m.BlockIO.Size()))
-		n18, err := m.BlockIO.MarshalTo(dAtA[i:])
+		n20, err := m.BlockIO.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n20
 	}
 	if len(m.HugepageLimits) > 0 {
 		for _, msg := range m.HugepageLimits {
@@ -3794,11 +4199,11 @@ func (m *LinuxResources) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintOci(dAtA, i, uint64(m.Network.Size()))
-		n19, err := m.Network.MarshalTo(dAtA[i:])
+		n21, err := m.Network.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n21
 	}
 	return i, nil
 }
@@ -3913,6 +4318,11 @@ func (m *LinuxCPU) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintOci(dAtA, i, uint64(len(m.Mems)))
 		i += copy(dAtA[i:], m.Mems)
 	}
+	if m.Burst != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintOci(dAtA, i, uint64(m.Burst))
+	}
 	return i, nil
 }
 
@@ -4494,6 +4904,47 @@ func NewPopulatedProcess(r randyOci, easy bool) *Process {
 		this.OOMScoreAdj *= -1
 	}
 	this.SelinuxLabel = string(randStringOci(r))
+	if r.Intn(10) != 0 {
+		this.Landlock = NewPopulatedLandlock(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedLandlock(r randyOci, easy bool) *Landlock {
+	this := &Landlock{}
+	v9 := r.Intn(10)
+	this.HandledAccessFS = make([]string, v9)
+	for i := 0; i < v9; i++ {
+		this.HandledAccessFS[i] = string(randStringOci(r))
+	}
+	if r.Intn(10) != 0 {
+		v10 := r.Intn(5)
+		this.PathBeneath = make([]LandlockPathBeneath, v10)
+		for i := 0; i < v10; i++ {
+			v11 := NewPopulatedLandlockPathBeneath(r, easy)
+			this.PathBeneath[i] = *v11
+		}
+	}
+	this.DisableBestEffort = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedLandlockPathBeneath(r randyOci, easy bool) *LandlockPathBeneath {
+	this := &LandlockPathBeneath{}
+	v12 := r.Intn(10)
+	this.AllowedAccess = make([]string, v12)
+	for i := 0; i < v12; i++ {
+		this.AllowedAccess[i] = string(randStringOci(r))
+	}
+	v13 := r.Intn(10)
+	this.Paths = make([]string, v13)
+	for i := 0; i < v13; i++ {
+		this.Paths[i] = string(randStringOci(r))
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4512,9 +4963,9 @@ func NewPopulatedUser(r randyOci, easy bool) *User {
 	this := &User{}
 	this.UID = uint32(r.Uint32())
 	this.GID = uint32(r.Uint32())
-	v9 := r.Intn(10)
-	this.AdditionalGids = make([]uint32, v9)
-	for i := 0; i < v9; i++ {
+	v14 := r.Intn(10)
+	this.AdditionalGids = make([]uint32, v14)
+	for i := 0; i < v14; i++ {
 		this.AdditionalGids[i] = uint32(r.Uint32())
 	}
 	this.Username = string(randStringOci(r))
@@ -4525,29 +4976,29 @@ func NewPopulatedUser(r randyOci, easy bool) *User {
 
 func NewPopulatedLinuxCapabilities(r randyOci, easy bool) *LinuxCapabilities {
 	this := &LinuxCapabilities{}
-	v10 := r.Intn(10)
-	this.Bounding = make([]string, v10)
-	for i := 0; i < v10; i++ {
+	v15 := r.Intn(10)
+	this.Bounding = make([]string, v15)
+	for i := 0; i < v15; i++ {
 		this.Bounding[i] = string(randStringOci(r))
 	}
-	v11 := r.Intn(10)
-	this.Effective = make([]string, v11)
-	for i := 0; i < v11; i++ {
+	v16 := r.Intn(10)
+	this.Effective = make([]string, v16)
+	for i := 0; i < v16; i++ {
 		this.Effective[i] = string(randStringOci(r))
 	}
-	v12 := r.Intn(10)
-	this.Inheritable = make([]string, v12)
-	for i := 0; i < v12; i++ {
+	v17 := r.Intn(10)
+	this.Inheritable = make([]string, v17)
+	for i := 0; i < v17; i++ {
 		this.Inheritable[i] = string(randStringOci(r))
 	}
-	v13 := r.Intn(10)
-	this.Permitted = make([]string, v13)
-	for i := 0; i < v13; i++ {
+	v18 := r.Intn(10)
+	this.Permitted = make([]string, v18)
+	for i := 0; i < v18; i++ {
 		this.Permitted[i] = string(randStringOci(r))
 	}
-	v14 := r.Intn(10)
-	this.Ambient = make([]string, v14)
-	for i := 0; i < v14; i++ {
+	v19 := r.Intn(10)
+	this.Ambient = make([]string, v19)
+	for i := 0; i < v19; i++ {
 		this.Ambient[i] = string(randStringOci(r))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -4570,9 +5021,9 @@ func NewPopulatedMount(r randyOci, easy bool) *Mount {
 	this.Destination = string(randStringOci(r))
 	this.Source = string(randStringOci(r))
 	this.Type = string(randStringOci(r))
-	v15 := r.Intn(10)
-	this.Options = make([]string, v15)
-	for i := 0; i < v15; i++ {
+	v20 := r.Intn(10)
+	this.Options = make([]string, v20)
+	for i := 0; i < v20; i++ {
 		this.Options[i] = string(randStringOci(r))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -4592,27 +5043,27 @@ func NewPopulatedRoot(r randyOci, easy bool) *Root {
 func NewPopulatedHooks(r randyOci, easy bool) *Hooks {
 	this := &Hooks{}
 	if r.Intn(10) != 0 {
-		v16 := r.Intn(5)
-		this.Prestart = make([]Hook, v16)
-		for i := 0; i < v16; i++ {
-			v17 := NewPopulatedHook(r, easy)
-			this.Prestart[i] = *v17
+		v21 := r.Intn(5)
+		this.Prestart = make([]Hook, v21)
+		for i := 0; i < v21; i++ {
+			v22 := NewPopulatedHook(r, easy)
+			this.Prestart[i] = *v22
 		}
 	}
 	if r.Intn(10) != 0 {
-		v18 := r.Intn(5)
-		this.Poststart = make([]Hook, v18)
-		for i := 0; i < v18; i++ {
-			v19 := NewPopulatedHook(r, easy)
-			this.Poststart[i] = *v19
+		v23 := r.Intn(5)
+		this.Poststart = make([]Hook, v23)
+		for i := 0; i < v23; i++ {
+			v24 := NewPopulatedHook(r, easy)
+			this.Poststart[i] = *v24
 		}
 	}
 	if r.Intn(10) != 0 {
-		v20 := r.Intn(5)
-		this.Poststop = make([]Hook, v20)
-		for i := 0; i < v20; i++ {
-			v21 := NewPopulatedHook(r, easy)
-			this.Poststop[i] = *v21
+		v25 := r.Intn(5)
+		this.Poststop = make([]Hook, v25)
+		for i := 0; i < v25; i++ {
+			v26 := NewPopulatedHook(r, easy)
+			this.Poststop[i] = *v26
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -4623,14 +5074,14 @@ func NewPopulatedHooks(r randyOci, easy bool) *Hooks {
 func NewPopulatedHook(r randyOci, easy bool) *Hook {
 	this := &Hook{}
 	this.Path = string(randStringOci(r))
-	v22 := r.Intn(10)
-	this.Args = make([]string, v22)
-	for i := 0; i < v22; i++ {
+	v27 := r.Intn(10)
+	this.Args = make([]string, v27)
+	for i := 0; i < v27; i++ {
 		this.Args[i] = string(randStringOci(r))
 	}
-	v23 := r.Intn(10)
-	this.Env = make([]string, v23)
-	for i := 0; i < v23; i++ {
+	v28 := r.Intn(10)
+	this.Env = make([]string, v28)
+	for i := 0; i < v28; i++ {
 		this.Env[i] = string(randStringOci(r))
 	}
 	this.Timeout = int64(r.Int63())
@@ -4645,25 +5096,25 @@ func NewPopulatedHook(r randyOci, easy bool) *Hook {
 func NewPopulatedLinux(r randyOci, easy bool) *Linux {
 	this := &Linux{}
 	if r.Intn(10) != 0 {
-		v24 := r.Intn(5)
-		this.UIDMappings = make([]LinuxIDMapping, v24)
-		for i := 0; i < v24; i++ {
-			v25 := NewPopulatedLinuxIDMapping(r, easy)
-			this.UIDMappings[i] = *v25
+		v29 := r.Intn(5)
+		this.UIDMappings = make([]LinuxIDMapping, v29)
+		for i := 0; i < v29; i++ {
+			v30 := NewPopulatedLinuxIDMapping(r, easy)
+			this.UIDMappings[i] = *v30
 		}
 	}
 	if r.Intn(10) != 0 {
-		v26 := r.Intn(5)
-		this.GIDMappings = make([]LinuxIDMapping, v26)
-		for i := 0; i < v26; i++ {
-			v27 := NewPopulatedLinuxIDMapping(r, easy)
-			this.GIDMappings[i] = *v27
+		v31 := r.Intn(5)
+		this.GIDMappings = make([]LinuxIDMapping, v31)
+		for i := 0; i < v31; i++ {
+			v32 := NewPopulatedLinuxIDMapping(r, easy)
+			this.GIDMappings[i] = *v32
 		}
 	}
 	if r.Intn(10) != 0 {
-		v28 := r.Intn(10)
+		v33 := r.Intn(10)
 		this.Sysctl = make(map[string]string)
-		for i := 0; i < v28; i++ {
+		for i := 0; i < v33; i++ {
 			this.Sysctl[randStringOci(r)] = randStringOci(r)
 		}
 	}
@@ -4672,39 +5123,55 @@ func NewPopulatedLinux(r randyOci, easy bool) *Linux {
 	}
 	this.CgroupsPath = string(randStringOci(r))
 	if r.Intn(10) != 0 {
-		v29 := r.Intn(5)
-		this.Namespaces = make([]LinuxNamespace, v29)
-		for i := 0; i < v29; i++ {
-			v30 := NewPopulatedLinuxNamespace(r, easy)
-			this.Namespaces[i] = *v30
+		v34 := r.Intn(5)
+		this.Namespaces = make([]LinuxNamespace, v34)
+		for i := 0; i < v34; i++ {
+			v35 := NewPopulatedLinuxNamespace(r, easy)
+			this.Namespaces[i] = *v35
 		}
 	}
 	if r.Intn(10) != 0 {
-		v31 := r.Intn(5)
-		this.Devices = make([]LinuxDevice, v31)
-		for i := 0; i < v31; i++ {
-			v32 := NewPopulatedLinuxDevice(r, easy)
-			this.Devices[i] = *v32
+		v36 := r.Intn(5)
+		this.Devices = make([]LinuxDevice, v36)
+		for i := 0; i < v36; i++ {
+			v37 := NewPopulatedLinuxDevice(r, easy)
+			this.Devices[i] = *v37
 		}
 	}
 	if r.Intn(10) != 0 {
 		this.Seccomp = NewPopulatedLinuxSeccomp(r, easy)
 	}
 	this.RootfsPropagation = string(randStringOci(r))
-	v33 := r.Intn(10)
-	this.MaskedPaths = make([]string, v33)
-	for i := 0; i < v33; i++ {
+	v38 := r.Intn(10)
+	this.MaskedPaths = make([]string, v38)
+	for i := 0; i < v38; i++ {
 		this.MaskedPaths[i] = string(randStringOci(r))
 	}
-	v34 := r.Intn(10)
-	this.ReadonlyPaths = make([]string, v34)
-	for i := 0; i < v34; i++ {
+	v39 := r.Intn(10)
+	this.ReadonlyPaths = make([]string, v39)
+	for i := 0; i < v39; i++ {
 		this.ReadonlyPaths[i] = string(randStringOci(r))
 	}
 	this.MountLabel = string(randStringOci(r))
 	if r.Intn(10) != 0 {
 		this.IntelRdt = NewPopulatedLinuxIntelRdt(r, easy)
 	}
+	if r.Intn(10) != 0 {
+		this.Personality = NewPopulatedLinuxPersonality(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedLinuxPersonality(r randyOci, easy bool) *LinuxPersonality {
+	this := &LinuxPersonality{}
+	this.Domain = string(randStringOci(r))
+	v40 := r.Intn(10)
+	this.Flags = make([]string, v40)
+	for i := 0; i < v40; i++ {
+		this.Flags[i] = string(randStringOci(r))
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4768,11 +5235,11 @@ func NewPopulatedLinuxDevice(r randyOci, easy bool) *LinuxDevice {
 func NewPopulatedLinuxResources(r randyOci, easy bool) *LinuxResources {
 	this := &LinuxResources{}
 	if r.Intn(10) != 0 {
-		v35 := r.Intn(5)
-		this.Devices = make([]LinuxDeviceCgroup, v35)
-		for i := 0; i < v35; i++ {
-			v36 := NewPopulatedLinuxDeviceCgroup(r, easy)
-			this.Devices[i] = *v36
+		v41 := r.Intn(5)
+		this.Devices = make([]LinuxDeviceCgroup, v41)
+		for i := 0; i < v41; i++ {
+			v42 := NewPopulatedLinuxDeviceCgroup(r, easy)
+			this.Devices[i] = *v42
 		}
 	}
 	if r.Intn(10) != 0 {
@@ -4788,11 +5255,11 @@ func NewPopulatedLinuxResources(r randyOci, easy bool) *LinuxResources {
 		this.BlockIO = NewPopulatedLinuxBlockIO(r, easy)
 	}
 	if r.Intn(10) != 0 {
-		v37 := r.Intn(5)
-		this.HugepageLimits = make([]LinuxHugepageLimit, v37)
-		for i := 0; i < v37; i++ {
-			v38 := NewPopulatedLinuxHugepageLimit(r, easy)
-			this.HugepageLimits[i] = *v38
+		v43 := r.Intn(5)
+		this.HugepageLimits = make([]LinuxHugepageLimit, v43)
+		for i := 0; i < v43; i++ {
+			v44 := NewPopulatedLinuxHugepageLimit(r, easy)
+			this.HugepageLimits[i] = *v44
 		}
 	}
 	if r.Intn(10) != 0 {
@@ -4847,6 +5314,7 @@ func NewPopulatedLinuxCPU(r randyOci, easy bool) *LinuxCPU {
 	this.RealtimePeriod = uint64(uint64(r.Uint32()))
 	this.Cpus = string(randStringOci(r))
 	this.Mems = string(randStringOci(r))
+	this.Burst = uint64(uint64(r.Uint32()))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4890,43 +5358,43 @@ func NewPopulatedLinuxBlockIO(r randyOci, easy bool) *LinuxBlockIO {
 	this.Weight = uint32(r.Uint32())
 	this.LeafWeight = uint32(r.Uint32())
 	if r.Intn(10) != 0 {
-		v39 := r.Intn(5)
-		this.WeightDevice = make([]LinuxWeightDevice, v39)
-		for i := 0; i < v39; i++ {
-			v40 := NewPopulatedLinuxWeightDevice(r, easy)
-			this.WeightDevice[i] = *v40
+		v45 := r.Intn(5)
+		this.WeightDevice = make([]LinuxWeightDevice, v45)
+		for i := 0; i < v45; i++ {
+			v46 := NewPopulatedLinuxWeightDevice(r, easy)
+			this.WeightDevice[i] = *v46
 		}
 	}
 	if r.Intn(10) != 0 {
-		v41 := r.Intn(5)
-		this.ThrottleReadBpsDevice = make([]LinuxThrottleDevice, v41)
-		for i := 0; i < v41; i++ {
-			v42 := NewPopulatedLinuxThrottleDevice(r, easy)
-			this.ThrottleReadBpsDevice[i] = *v42
+		v47 := r.Intn(5)
+		this.ThrottleReadBpsDevice = make([]LinuxThrottleDevice, v47)
+		for i := 0; i < v47; i++ {
+			v48 := NewPopulatedLinuxThrottleDevice(r, easy)
+			this.ThrottleReadBpsDevice[i] = *v48
 		}
 	}
 	if r.Intn(10) != 0 {
-		v43 := r.Intn(5)
-		this.ThrottleWriteBpsDevice = make([]LinuxThrottleDevice, v43)
-		for i := 0; i < v43; i++ {
-			v44 := NewPopulatedLinuxThrottleDevice(r, easy)
-			this.ThrottleWriteBpsDevice[i] = *v44
+		v49 := r.Intn(5)
+		this.ThrottleWriteBpsDevice = make([]LinuxThrottleDevice, v49)
+		for i := 0; i < v49; i++ {
+			v50 := NewPopulatedLinuxThrottleDevice(r, easy)
+			this.ThrottleWriteBpsDevice[i] = *v50
 		}
 	}
 	if r.Intn(10) != 0 {
-		v45 := r.Intn(5)
-		this.ThrottleReadIOPSDevice = make([]LinuxThrottleDevice, v45)
-		for i := 0; i < v45; i++ {
-			v46 := NewPopulatedLinuxThrottleDevice(r, easy)
-			this.ThrottleReadIOPSDevice[i] = *v46
+		v51 := r.Intn(5)
+		this.ThrottleReadIOPSDevice = make([]LinuxThrottleDevice, v51)
+		for i := 0; i < v51; i++ {
+			v52 := NewPopulatedLinuxThrottleDevice(r, easy)
+			this.ThrottleReadIOPSDevice[i] = *v52
 		}
 	}
 	if r.Intn(10) != 0 {
-		v47 := r.Intn(5)
-		this.ThrottleWriteIOPSDevice = make([]LinuxThrottleDevice, v47)
-		for i := 0; i < v47; i++ {
-			v48 := NewPopulatedLinuxThrottleDevice(r, easy)
-			this.ThrottleWriteIOPSDevice[i] = *v48
+		v53 := r.Intn(5)
+		this.ThrottleWriteIOPSDevice = make([]LinuxThrottleDevice, v53)
+		for i := 0; i < v53; i++ {
+			v54 := NewPopulatedLinuxThrottleDevice(r, easy)
+			this.ThrottleWriteIOPSDevice[i] = *v54
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -4967,11 +5435,11 @@ func NewPopulatedLinuxNetwork(r randyOci, easy bool) *LinuxNetwork {
 	this := &LinuxNetwork{}
 	this.ClassID = uint32(r.Uint32())
 	if r.Intn(10) != 0 {
-		v49 := r.Intn(5)
-		this.Priorities = make([]LinuxInterfacePriority, v49)
-		for i := 0; i < v49; i++ {
-			v50 := NewPopulatedLinuxInterfacePriority(r, easy)
-			this.Priorities[i] = *v50
+		v55 := r.Intn(5)
+		this.Priorities = make([]LinuxInterfacePriority, v55)
+		for i := 0; i < v55; i++ {
+			v56 := NewPopulatedLinuxInterfacePriority(r, easy)
+			this.Priorities[i] = *v56
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -5000,17 +5468,17 @@ func NewPopulatedLinuxInterfacePriority(r randyOci, easy bool) *LinuxInterfacePr
 func NewPopulatedLinuxSeccomp(r randyOci, easy bool) *LinuxSeccomp {
 	this := &LinuxSeccomp{}
 	this.DefaultAction = string(randStringOci(r))
-	v51 := r.Intn(10)
-	this.Architectures = make([]string, v51)
-	for i := 0; i < v51; i++ {
+	v57 := r.Intn(10)
+	this.Architectures = make([]string, v57)
+	for i := 0; i < v57; i++ {
 		this.Architectures[i] = string(randStringOci(r))
 	}
 	if r.Intn(10) != 0 {
-		v52 := r.Intn(5)
-		this.Syscalls = make([]LinuxSyscall, v52)
-		for i := 0; i < v52; i++ {
-			v53 := NewPopulatedLinuxSyscall(r, easy)
-			this.Syscalls[i] = *v53
+		v58 := r.Intn(5)
+		this.Syscalls = make([]LinuxSyscall, v58)
+		for i := 0; i < v58; i++ {
+			v59 := NewPopulatedLinuxSyscall(r, easy)
+			this.Syscalls[i] = *v59
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -5031,18 +5499,18 @@ func NewPopulatedLinuxSeccompArg(r randyOci, easy bool) *LinuxSeccompArg {
 
 func NewPopulatedLinuxSyscall(r randyOci, easy bool) *LinuxSyscall {
 	this := &LinuxSyscall{}
-	v54 := r.Intn(10)
-	this.Names = make([]string, v54)
-	for i := 0; i < v54; i++ {
+	v60 := r.Intn(10)
+	this.Names = make([]string, v60)
+	for i := 0; i < v60; i++ {
 		this.Names[i] = string(randStringOci(r))
 	}
 	this.Action = string(randStringOci(r))
 	if r.Intn(10) != 0 {
-		v55 := r.Intn(5)
-		this.Args = make([]LinuxSeccompArg, v55)
-		for i := 0; i < v55; i++ {
-			v56 := NewPopulatedLinuxSeccompArg(r, easy)
-			this.Args[i] = *v56
+		v61 := r.Intn(5)
+		this.Args = make([]LinuxSeccompArg, v61)
+		for i := 0; i < v61; i++ {
+			v62 := NewPopulatedLinuxSeccompArg(r, easy)
+			this.Args[i] = *v62
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -5077,9 +5545,9 @@ func randUTF8RuneOci(r randyOci) rune {
 	return rune(ru + 61)
 }
 func randStringOci(r randyOci) string {
-	v57 := r.Intn(100)
-	tmps := make([]rune, v57)
-	for i := 0; i < v57; i++ {
+	v63 := r.Intn(100)
+	tmps := make([]rune, v63)
+	for i := 0; i < v63; i++ {
 		tmps[i] = randUTF8RuneOci(r)
 	}
 	return string(tmps)
@@ -5101,11 +5569,11 @@ func randFieldOci(dAtA []byte, r randyOci, fieldNumber int, wire int) []byte {
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateOci(dAtA, uint64(key))
-		v58 := r.Int63()
+		v64 := r.Int63()
 		if r.Intn(2) == 0 {
-			v58 *= -1
+			v64 *= -1
 		}
-		dAtA = encodeVarintPopulateOci(dAtA, uint64(v58))
+		dAtA = encodeVarintPopulateOci(dAtA, uint64(v64))
 	case 1:
 		dAtA = encodeVarintPopulateOci(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -5234,6 +5702,49 @@ func (m *Process) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOci(uint64(l))
 	}
+	if m.Landlock != nil {
+		l = m.Landlock.Size()
+		n += 1 + l + sovOci(uint64(l))
+	}
+	return n
+}
+
+func (m *Landlock) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.HandledAccessFS) > 0 {
+		for _, s := range m.HandledAccessFS {
+			l = len(s)
+			n += 1 + l + sovOci(uint64(l))
+		}
+	}
+	if len(m.PathBeneath) > 0 {
+		for _, e := range m.PathBeneath {
+			l = e.Size()
+			n += 1 + l + sovOci(uint64(l))
+		}
+	}
+	if m.DisableBestEffort {
+		n += 2
+	}
+	return n
+}
+
+func (m *LandlockPathBeneath) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.AllowedAccess) > 0 {
+		for _, s := range m.AllowedAccess {
+			l = len(s)
+			n += 1 + l + sovOci(uint64(l))
+		}
+	}
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 1 + l + sovOci(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5481,6 +5992,26 @@ func (m *Linux) Size() (n int) {
 		l = m.IntelRdt.Size()
 		n += 1 + l + sovOci(uint64(l))
 	}
+	if m.Personality != nil {
+		l = m.Personality.Size()
+		n += 1 + l + sovOci(uint64(l))
+	}
+	return n
+}
+
+func (m *LinuxPersonality) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovOci(uint64(l))
+	}
+	if len(m.Flags) > 0 {
+		for _, s := range m.Flags {
+			l = len(s)
+			n += 1 + l + sovOci(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5653,6 +6184,9 @@ func (m *LinuxCPU) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOci(uint64(l))
 	}
+	if m.Burst != 0 {
+		n += 1 + sovOci(uint64(m.Burst))
+	}
 	return n
 }
 
@@ -6705,6 +7239,39 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 			}
 			m.SelinuxLabel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Landlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Landlock == nil {
+				m.Landlock = &Landlock{}
+			}
+			if err := m.Landlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOci(dAtA[iNdEx:])
@@ -6726,7 +7293,7 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Box) Unmarshal(dAtA []byte) error {
+func (m *Landlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6749,17 +7316,17 @@ func (m *Box) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Box: wiretype end group for non-group")
+			return fmt.Errorf("proto: Landlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Box: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Landlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandledAccessFS", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOci
@@ -6769,16 +7336,57 @@ func (m *Box) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HandledAccessFS = append(m.HandledAccessFS, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathBeneath", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathBeneath = append(m.PathBeneath, LandlockPathBeneath{})
+			if err := m.PathBeneath[len(m.PathBeneath)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Width", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableBestEffort", wireType)
 			}
-			m.Width = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOci
@@ -6788,11 +7396,12 @@ func (m *Box) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Width |= (uint32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DisableBestEffort = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOci(dAtA[iNdEx:])
@@ -6814,7 +7423,7 @@ func (m *Box) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *User) Unmarshal(dAtA []byte) error {
+func (m *LandlockPathBeneath) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6837,14 +7446,210 @@ func (m *User) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: User: wiretype end group for non-group")
+			return fmt.Errorf("proto: LandlockPathBeneath: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LandlockPathBeneath: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedAccess = append(m.AllowedAccess, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Box) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Box: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Box: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Width", wireType)
+			}
+			m.Width = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Width |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *User) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: User: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
 			}
 			m.UID = 0
@@ -8384,6 +9189,147 @@ func (m *Linux) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Personality", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Personality == nil {
+				m.Personality = &LinuxPersonality{}
+			}
+			if err := m.Personality.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LinuxPersonality) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinuxPersonality: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinuxPersonality: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flags = append(m.Flags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOci(dAtA[iNdEx:])
@@ -9624,6 +10570,25 @@ func (m *LinuxCPU) Unmarshal(dAtA []byte) error {
 			}
 			m.Mems = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burst", wireType)
+			}
+			m.Burst = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Burst |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOci(dAtA[iNdEx:])
@@ -11253,133 +12218,142 @@ var (
 func init() { proto.RegisterFile("oci.proto", fileDescriptorOci) }
 
 var fileDescriptorOci = []byte{
-	// 2035 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x18, 0x4b, 0x6f, 0x23, 0x49,
-	0x99, 0xb6, 0x1d, 0xc7, 0x2e, 0x27, 0x99, 0x99, 0xda, 0xd9, 0x6c, 0x13, 0x46, 0xde, 0x6c, 0x33,
-	0x82, 0x00, 0x43, 0x22, 0x66, 0x78, 0x2c, 0xcb, 0x43, 0x72, 0x92, 0x99, 0x89, 0xb5, 0xc9, 0xc4,
-	0x94, 0x93, 0x0d, 0x70, 0x40, 0xea, 0xb4, 0x2b, 0x76, 0x6d, 0xda, 0x5d, 0xad, 0xea, 0x72, 0x32,
-	0xe1, 0xc6, 0x3f, 0x40, 0xe2, 0x17, 0x70, 0x02, 0xfe, 0x01, 0xe2, 0xc4, 0x8d, 0x15, 0x27, 0xee,
-	0x48, 0x3c, 0x72, 0xe7, 0xce, 0x11, 0x7d, 0x55, 0x5f, 0xb5, 0xcb, 0x4e, 0x02, 0x3b, 0xec, 0xc9,
-	0xf5, 0x3d, 0xab, 0xbe, 0xf7, 0xd7, 0x26, 0x4d, 0x99, 0x88, 0xcd, 0x5c, 0x49, 0x2d, 0x69, 0x6d,
-	0xa8, 0xf2, 0x64, 0xed, 0xeb, 0x43, 0xa1, 0x47, 0x93, 0xd3, 0xcd, 0x44, 0x8e, 0xb7, 0x86, 0x72,
-	0x28, 0xb7, 0x0c, 0xf1, 0x74, 0x72, 0x66, 0x20, 0x03, 0x98, 0x93, 0x15, 0x5a, 0x6b, 0x0f, 0xa5,
-	0x1c, 0xa6, 0x7c, 0xca, 0x75, 0xa9, 0xe2, 0x3c, 0xe7, 0xaa, 0xb0, 0xf4, 0xe8, 0x4f, 0x55, 0x52,
-	0xeb, 0xe7, 0x3c, 0xa1, 0x21, 0x59, 0xfc, 0x88, 0xab, 0x42, 0xc8, 0x2c, 0x0c, 0xd6, 0x83, 0x8d,
-	0x26, 0x73, 0x20, 0xfd, 0x32, 0x59, 0xec, 0x29, 0x99, 0xf0, 0xa2, 0x08, 0x2b, 0xeb, 0xc1, 0x46,
-	0xeb, 0xe9, 0xf2, 0x26, 0xbc, 0x64, 0x13, 0x91, 0xcc, 0x51, 0x69, 0x9b, 0xd4, 0x98, 0x94, 0x3a,
-	0xac, 0x1a, 0x2e, 0x62, 0xb9, 0x00, 0xc3, 0x0c, 0x9e, 0xae, 0x91, 0xc6, 0x9e, 0x2c, 0x74, 0x16,
-	0x8f, 0x79, 0x58, 0x33, 0x77, 0x94, 0x30, 0xfd, 0x0a, 0xa9, 0x1f, 0xc8, 0x49, 0xa6, 0x8b, 0x70,
-	0x61, 0xbd, 0xba, 0xd1, 0x7a, 0xda, 0xb2, 0xd2, 0x06, 0xb7, 0x5d, 0xfb, 0xe4, 0x6f, 0xef, 0x7e,
-	0x8e, 0x21, 0x03, 0x7d, 0x8f, 0x2c, 0xec, 0x49, 0x79, 0x5e, 0x84, 0x75, 0x73, 0x0f, 0x72, 0x1a,
-	0x14, 0xb3, 0x14, 0xfa, 0x03, 0xd2, 0xea, 0x64, 0x99, 0xd4, 0xb1, 0x16, 0x32, 0x2b, 0xc2, 0x45,
-	0xa3, 0xf2, 0x0b, 0x96, 0x11, 0xac, 0xdd, 0xf4, 0xa8, 0xcf, 0x33, 0xad, 0xae, 0x98, 0xcf, 0x0f,
-	0x37, 0xec, 0x8b, 0x6c, 0xf2, 0x3a, 0x6c, 0xf8, 0x37, 0x18, 0x14, 0xb3, 0x14, 0x70, 0x4a, 0x5f,
-	0xa6, 0xb1, 0x12, 0x45, 0xd8, 0xf4, 0x9d, 0x82, 0x48, 0xe6, 0xa8, 0xc0, 0x78, 0x22, 0xb2, 0x81,
-	0xbc, 0x2c, 0x42, 0xe2, 0x33, 0x22, 0x92, 0x39, 0xea, 0xda, 0x0f, 0xc9, 0xfd, 0xf9, 0x57, 0xd1,
-	0xfb, 0xa4, 0x7a, 0xce, 0xaf, 0x30, 0x20, 0x70, 0xa4, 0x0f, 0xc9, 0xc2, 0x45, 0x9c, 0x4e, 0xb8,
-	0x09, 0x45, 0x93, 0x59, 0xe0, 0x83, 0xca, 0xfb, 0x41, 0xf4, 0x87, 0x6a, 0x19, 0x27, 0xf0, 0xf4,
-	0x11, 0x57, 0x63, 0x91, 0xc5, 0xa9, 0x11, 0x6e, 0xb0, 0x12, 0xa6, 0x5f, 0x23, 0xad, 0x1d, 0x99,
-	0x15, 0x32, 0xe5, 0x7d, 0xf1, 0x73, 0x8e, 0x21, 0x6d, 0xda, 0x47, 0x6d, 0xcb, 0xd7, 0xcc, 0xa7,
-	0xd2, 0xc7, 0xa4, 0x76, 0x5c, 0x70, 0x35, 0x1b, 0x52, 0xc0, 0x60, 0x4c, 0x0c, 0x95, 0x52, 0x52,
-	0xeb, 0xa8, 0x61, 0x11, 0xd6, 0xd6, 0xab, 0x1b, 0x4d, 0x66, 0xce, 0xf0, 0xf4, 0xe7, 0xd9, 0x85,
-	0x89, 0x66, 0x93, 0xc1, 0x11, 0x30, 0x3b, 0x97, 0x03, 0x13, 0xb5, 0x26, 0x83, 0x23, 0xfd, 0x1e,
-	0x59, 0xda, 0x89, 0xf3, 0xf8, 0x54, 0xa4, 0x42, 0x0b, 0x0e, 0x71, 0x82, 0x5b, 0xde, 0xf1, 0xdc,
-	0xed, 0x93, 0xd9, 0x0c, 0x33, 0xfd, 0x06, 0x59, 0x64, 0xa9, 0x18, 0x0b, 0x5d, 0x84, 0x0d, 0x13,
-	0xdf, 0x07, 0x98, 0x96, 0x87, 0xfd, 0xee, 0x8f, 0x2d, 0x05, 0x1f, 0xe9, 0xf8, 0xe8, 0x06, 0xb9,
-	0xf7, 0x4a, 0xbe, 0xe2, 0x97, 0x3d, 0x25, 0x2e, 0x44, 0xca, 0x87, 0xdc, 0x06, 0xaf, 0xc1, 0xe6,
-	0xd1, 0xc0, 0xd9, 0xc9, 0xf3, 0x58, 0x8d, 0xa5, 0xea, 0x29, 0x79, 0x26, 0x52, 0x6e, 0xa2, 0xd7,
-	0x64, 0xf3, 0x68, 0xba, 0x4e, 0x5a, 0x87, 0x87, 0x07, 0xfd, 0x44, 0x2a, 0xde, 0x19, 0x7c, 0x1c,
-	0xb6, 0xd6, 0x83, 0x8d, 0x2a, 0xf3, 0x51, 0x34, 0x22, 0x4b, 0x7d, 0x9e, 0x82, 0x35, 0xfb, 0xf1,
-	0x29, 0x4f, 0xc3, 0x25, 0xa3, 0x68, 0x06, 0x17, 0x3d, 0x23, 0xd5, 0x6d, 0xf9, 0x9a, 0xae, 0x92,
-	0xfa, 0x1e, 0x17, 0xc3, 0x91, 0x36, 0x51, 0x5b, 0x66, 0x08, 0x41, 0xd4, 0x4f, 0xc4, 0x40, 0x8f,
-	0x4c, 0xb4, 0x96, 0x99, 0x05, 0xa2, 0xcc, 0x06, 0x07, 0x1c, 0x7b, 0xdc, 0xdd, 0x45, 0x11, 0x38,
-	0x02, 0xe6, 0x65, 0x77, 0x17, 0xb9, 0xe1, 0x48, 0xbf, 0x44, 0x56, 0x3a, 0x83, 0x81, 0x80, 0xdc,
-	0x8a, 0xd3, 0x97, 0x62, 0x50, 0x84, 0xd5, 0xf5, 0xea, 0xc6, 0x32, 0x9b, 0xc3, 0x42, 0xe6, 0x80,
-	0x4e, 0xbf, 0x46, 0x1d, 0x1c, 0xfd, 0x26, 0x20, 0x0f, 0x6e, 0x44, 0x05, 0x24, 0xb6, 0xe5, 0x24,
-	0x1b, 0x88, 0x6c, 0x18, 0x06, 0x26, 0xda, 0x25, 0x4c, 0x1f, 0x91, 0xe6, 0xf3, 0xb3, 0x33, 0x9e,
-	0x68, 0x71, 0x01, 0x99, 0x06, 0xc4, 0x29, 0x02, 0x5c, 0xd7, 0xcd, 0x46, 0x5c, 0x09, 0x1d, 0x9f,
-	0xa6, 0xdc, 0x3c, 0xa8, 0xc9, 0x7c, 0x14, 0xc8, 0xf7, 0x20, 0x6f, 0xb5, 0xe6, 0x03, 0xcc, 0xae,
-	0x29, 0x02, 0x5a, 0x56, 0x67, 0x7c, 0x2a, 0x78, 0xa6, 0x31, 0xcd, 0x1c, 0x18, 0x75, 0x49, 0xcb,
-	0x4b, 0x03, 0xc8, 0xcf, 0xa3, 0xab, 0x9c, 0x63, 0x1d, 0x99, 0x33, 0xe0, 0xf6, 0x62, 0x35, 0x30,
-	0x3e, 0xaa, 0x31, 0x73, 0x06, 0x5c, 0x5f, 0x9e, 0xd9, 0x06, 0x56, 0x63, 0xe6, 0x1c, 0x49, 0xb2,
-	0x60, 0xfa, 0x0e, 0xbc, 0x76, 0xc0, 0x0b, 0x2d, 0x32, 0x53, 0xa0, 0xa8, 0xcb, 0x47, 0x41, 0xf4,
-	0x0a, 0x39, 0x51, 0x89, 0x2b, 0x4e, 0x84, 0x40, 0xad, 0x86, 0xeb, 0xab, 0xf6, 0x7a, 0x38, 0xc3,
-	0xdb, 0x65, 0x6e, 0xbb, 0x93, 0xb5, 0xcb, 0x81, 0xd1, 0xb7, 0x6d, 0x17, 0x05, 0xa9, 0x5e, 0xac,
-	0x47, 0xee, 0xd1, 0x70, 0x06, 0x5f, 0x33, 0x1e, 0x0f, 0x64, 0x96, 0x5e, 0x99, 0x3b, 0x1a, 0xac,
-	0x84, 0xa3, 0x5f, 0x05, 0xd8, 0x17, 0xe9, 0x13, 0xd2, 0xe8, 0x29, 0x5e, 0xe8, 0x58, 0x69, 0x13,
-	0x91, 0xb2, 0x70, 0x81, 0x8c, 0x35, 0x51, 0x72, 0xd0, 0x4d, 0xd2, 0xec, 0xc9, 0x42, 0x5b, 0xf6,
-	0xca, 0x1d, 0xec, 0x53, 0x16, 0xa3, 0xdd, 0x00, 0x32, 0x37, 0x21, 0xbb, 0x5d, 0x3b, 0x72, 0x44,
-	0x3f, 0x25, 0x35, 0xc0, 0xdf, 0x6a, 0x8d, 0x6b, 0x1b, 0x95, 0x9b, 0x6d, 0xa3, 0x3a, 0x6d, 0x1b,
-	0x21, 0x59, 0x3c, 0x12, 0x63, 0x2e, 0x27, 0xda, 0x24, 0x64, 0x95, 0x39, 0x30, 0xfa, 0xdd, 0x02,
-	0xf6, 0x69, 0xfa, 0x7d, 0xd2, 0x3a, 0xee, 0xee, 0x1e, 0xc4, 0x79, 0x2e, 0xb2, 0x61, 0x81, 0x46,
-	0x3f, 0xf4, 0xfa, 0x48, 0x49, 0xc4, 0x07, 0xfa, 0xec, 0x20, 0xfd, 0xd2, 0x93, 0xae, 0xfc, 0x6f,
-	0x69, 0x8f, 0x9d, 0x6e, 0x91, 0x7a, 0xff, 0xaa, 0x48, 0x74, 0x8a, 0xde, 0xf0, 0xdb, 0xd7, 0xa6,
-	0xa5, 0xd8, 0x11, 0x83, 0x6c, 0xf4, 0x29, 0x69, 0x32, 0x6e, 0x53, 0xa3, 0x30, 0x26, 0xcd, 0x5e,
-	0x56, 0xd2, 0xd8, 0x94, 0x0d, 0x92, 0x6f, 0x67, 0xa8, 0xe4, 0x24, 0x2f, 0x8c, 0x17, 0x17, 0x6c,
-	0xf2, 0x79, 0x28, 0xfa, 0x01, 0x21, 0xaf, 0xe2, 0x31, 0x2f, 0xf2, 0x18, 0xd4, 0xd6, 0x6f, 0xd8,
-	0x50, 0x12, 0xd1, 0x06, 0x8f, 0x1b, 0x5a, 0xe9, 0x2e, 0xbf, 0x10, 0x09, 0x77, 0xa3, 0xf2, 0x81,
-	0x27, 0x68, 0x29, 0xae, 0x95, 0x22, 0x1f, 0x7d, 0x42, 0x16, 0xfb, 0x3c, 0x49, 0xe4, 0x38, 0xc7,
-	0x21, 0x49, 0x3d, 0x11, 0xa4, 0x30, 0xc7, 0x42, 0x9f, 0x90, 0x07, 0x90, 0xd3, 0x67, 0x45, 0x4f,
-	0xc9, 0x3c, 0x1e, 0xda, 0x0a, 0x6a, 0x1a, 0x23, 0x6e, 0x12, 0xc0, 0xd8, 0x83, 0xb8, 0x38, 0xe7,
-	0x03, 0x30, 0x0c, 0xc6, 0xa6, 0xe9, 0x0b, 0x1e, 0x8a, 0x3e, 0x26, 0xcb, 0x2e, 0xef, 0x2d, 0x4f,
-	0xcb, 0xf0, 0xcc, 0x22, 0x69, 0x9b, 0x10, 0x53, 0xba, 0x7e, 0xdb, 0xf5, 0x30, 0x74, 0x8b, 0x34,
-	0xba, 0x99, 0xe6, 0x29, 0x1b, 0xe8, 0x70, 0xd9, 0x18, 0xf1, 0x96, 0x1f, 0x74, 0x24, 0xb1, 0x92,
-	0x69, 0xed, 0xbb, 0xa4, 0xe5, 0x05, 0xf4, 0x8d, 0xa6, 0xf3, 0xbb, 0xe5, 0x1a, 0x00, 0x4c, 0x83,
-	0xc9, 0x78, 0xec, 0x04, 0x2d, 0x00, 0x0c, 0x6e, 0x65, 0xb8, 0x9d, 0xe1, 0x67, 0x64, 0x65, 0x36,
-	0x19, 0xcd, 0xb4, 0x90, 0x85, 0x2e, 0x5b, 0x3f, 0x42, 0x26, 0x59, 0x64, 0xa6, 0x63, 0x91, 0x71,
-	0x55, 0x4e, 0x01, 0x1f, 0x65, 0x1a, 0x1d, 0x0c, 0xff, 0xaa, 0x21, 0x99, 0x73, 0xf4, 0x3e, 0xea,
-	0x2f, 0xf3, 0xe2, 0xae, 0xb6, 0x69, 0x32, 0xb0, 0x32, 0xad, 0xe3, 0xe8, 0xd7, 0x01, 0x69, 0x79,
-	0xa9, 0x72, 0x57, 0xad, 0x1b, 0x5d, 0x15, 0x4f, 0xd7, 0x43, 0xb2, 0x70, 0x10, 0x7f, 0x2c, 0xed,
-	0x76, 0x51, 0x65, 0x16, 0x30, 0x58, 0x91, 0x49, 0x85, 0xd5, 0x6e, 0x01, 0xe8, 0x7c, 0x2f, 0x44,
-	0xca, 0x0f, 0xe4, 0x80, 0x9b, 0xec, 0x5f, 0x66, 0x25, 0xec, 0xe6, 0x5f, 0xfd, 0xc6, 0xfc, 0x5b,
-	0x2c, 0xe7, 0x5f, 0xf4, 0xf7, 0x0a, 0x9a, 0x37, 0xad, 0xa9, 0xef, 0x4c, 0xb3, 0x3e, 0xb8, 0x51,
-	0xb9, 0x96, 0x62, 0x0b, 0x6c, 0x3e, 0xf7, 0x61, 0x57, 0xe5, 0x63, 0xa9, 0xae, 0x70, 0x79, 0xf2,
-	0xab, 0xc5, 0x12, 0x18, 0x32, 0xd0, 0x75, 0x52, 0xdd, 0xe9, 0x1d, 0xe3, 0xfa, 0xb4, 0xe2, 0x2f,
-	0x36, 0xbd, 0x63, 0x06, 0x24, 0xfa, 0x45, 0x52, 0xeb, 0xc1, 0x38, 0xb6, 0x8d, 0xe0, 0x9e, 0xc7,
-	0x02, 0x68, 0x66, 0x88, 0x50, 0x6d, 0xdb, 0xa9, 0x4c, 0xce, 0xbb, 0x87, 0xc6, 0xf8, 0xd9, 0x6a,
-	0x43, 0x0a, 0x73, 0x2c, 0xf4, 0x05, 0x59, 0xd9, 0x9b, 0x0c, 0x79, 0x1e, 0x0f, 0xf9, 0xbe, 0x5d,
-	0x90, 0x6c, 0x3b, 0x08, 0x3d, 0xa1, 0x19, 0x06, 0x34, 0x70, 0x4e, 0x0a, 0x6e, 0x7d, 0xc5, 0xf5,
-	0xa5, 0x54, 0xe7, 0xb8, 0x99, 0xf9, 0xb7, 0x22, 0x85, 0x39, 0x96, 0xe8, 0xaf, 0x2e, 0x0b, 0xd0,
-	0xf4, 0x87, 0xd0, 0x9c, 0xc7, 0xc2, 0xae, 0x32, 0x55, 0x66, 0x01, 0xc8, 0x4d, 0xc6, 0x0b, 0xae,
-	0x2e, 0x6c, 0x0f, 0xa8, 0xd8, 0x75, 0xc9, 0x43, 0x99, 0xdc, 0xbc, 0x8c, 0x73, 0x4c, 0x0a, 0x73,
-	0x86, 0x4c, 0xff, 0x90, 0xab, 0x8c, 0xa7, 0x98, 0x14, 0x08, 0xc1, 0x7e, 0x60, 0x4f, 0x47, 0x3b,
-	0x3d, 0xe3, 0x99, 0x2a, 0x9b, 0x22, 0xa0, 0xfe, 0x41, 0x3a, 0x17, 0x19, 0x7c, 0xbb, 0xd4, 0xcd,
-	0x50, 0xf7, 0x30, 0xf4, 0xab, 0xe4, 0xfe, 0xae, 0x28, 0x60, 0xd1, 0x38, 0x3c, 0x3c, 0xf8, 0x50,
-	0xa4, 0x29, 0x57, 0xc6, 0xd0, 0x06, 0xbb, 0x81, 0x8f, 0xfe, 0x1c, 0x90, 0x86, 0x0b, 0x1c, 0x3c,
-	0xa7, 0x3f, 0x8a, 0x95, 0x49, 0x1c, 0x50, 0x8a, 0x10, 0x98, 0xfc, 0xa3, 0x89, 0xd4, 0x31, 0x9a,
-	0x65, 0x01, 0xe0, 0xee, 0x71, 0x25, 0xe4, 0x00, 0xf7, 0x0a, 0x84, 0x60, 0xc7, 0x64, 0x3c, 0x4e,
-	0xb5, 0x18, 0x73, 0x36, 0xc9, 0xe0, 0x07, 0xad, 0x9b, 0x47, 0xc3, 0xf2, 0xe6, 0x50, 0xa8, 0x69,
-	0xc1, 0x68, 0x9a, 0xc3, 0x82, 0xeb, 0x76, 0xf2, 0x49, 0x81, 0x2b, 0xb6, 0x39, 0x03, 0xee, 0x80,
-	0x8f, 0xed, 0x6e, 0xdd, 0x64, 0xe6, 0x1c, 0x5d, 0xe2, 0x1e, 0x77, 0x62, 0xb6, 0x4b, 0xac, 0xda,
-	0xb2, 0x1a, 0x83, 0x5b, 0xab, 0xb1, 0xe2, 0x57, 0xe3, 0x2a, 0xa9, 0x5b, 0x59, 0xec, 0x20, 0x08,
-	0x81, 0xc7, 0xf7, 0x79, 0x7c, 0x86, 0xb4, 0x9a, 0xa1, 0x79, 0x98, 0xe8, 0x98, 0xbc, 0x65, 0x2e,
-	0x3e, 0x1a, 0x29, 0xa9, 0x75, 0xca, 0xff, 0x8f, 0xab, 0x29, 0xa9, 0xb1, 0x58, 0x73, 0xb7, 0xa3,
-	0xc1, 0x39, 0xfa, 0x57, 0x95, 0x2c, 0xf9, 0xa5, 0xe0, 0xbd, 0x2f, 0xf8, 0x2f, 0xef, 0xab, 0xcc,
-	0xbf, 0x8f, 0x76, 0xc8, 0x92, 0xef, 0x93, 0x5b, 0x26, 0xba, 0x4f, 0xc6, 0xb2, 0x99, 0x11, 0xa1,
-	0xc7, 0xe4, 0x6d, 0x67, 0x1d, 0x4c, 0xa3, 0xed, 0xbc, 0x40, 0x5d, 0x35, 0xa3, 0xeb, 0xf3, 0x9e,
-	0xae, 0x59, 0x2f, 0xa0, 0xb6, 0xdb, 0xa5, 0xe9, 0x09, 0x59, 0x75, 0x84, 0x13, 0x25, 0x34, 0x9f,
-	0xea, 0x5d, 0xf8, 0x74, 0x7a, 0xef, 0x10, 0xf7, 0x15, 0xc3, 0x8d, 0xdd, 0xc3, 0x5e, 0x1f, 0x15,
-	0xd7, 0xdf, 0x50, 0xf1, 0xac, 0x38, 0xfd, 0x09, 0x79, 0x67, 0xe6, 0x4a, 0x4f, 0xf3, 0xe2, 0xa7,
-	0xd3, 0x7c, 0x97, 0x7c, 0xf4, 0x1e, 0x69, 0x96, 0x1d, 0xf2, 0xf6, 0x3e, 0x13, 0xfd, 0xc2, 0x7d,
-	0xab, 0xf8, 0x8d, 0x1c, 0x78, 0x3b, 0x69, 0x2a, 0x2f, 0xf1, 0xa3, 0xd8, 0x02, 0x9f, 0x79, 0x36,
-	0xad, 0x92, 0x7a, 0x27, 0x31, 0xff, 0x8f, 0xd8, 0xbd, 0x0c, 0xa1, 0x28, 0xc5, 0xac, 0xc4, 0x0e,
-	0x09, 0x9b, 0xec, 0x4e, 0x1a, 0x17, 0x45, 0x39, 0xb0, 0x1d, 0x48, 0xb7, 0x09, 0xe9, 0x29, 0x21,
-	0x95, 0xfd, 0x0c, 0xb6, 0x0b, 0xe8, 0xa3, 0xb9, 0x5d, 0x44, 0x9d, 0xc5, 0x09, 0x47, 0xae, 0x2b,
-	0xb7, 0xc4, 0x4d, 0xa5, 0xa2, 0x17, 0x84, 0xde, 0xec, 0xec, 0x30, 0x37, 0x7b, 0xf1, 0x90, 0x17,
-	0x30, 0xed, 0xed, 0x3c, 0x2e, 0xe1, 0xa9, 0xe7, 0xec, 0x37, 0x10, 0x7a, 0x6e, 0x8f, 0xac, 0xde,
-	0x7e, 0x27, 0xf8, 0x09, 0x96, 0x03, 0x37, 0xd7, 0xe1, 0x6c, 0xf4, 0x23, 0x1d, 0xeb, 0xa9, 0x84,
-	0xa3, 0x5f, 0x06, 0xe8, 0x00, 0xb7, 0x06, 0x3e, 0x26, 0xcb, 0xbb, 0xfc, 0x2c, 0x9e, 0xa4, 0xba,
-	0x93, 0x78, 0x1f, 0x51, 0xb3, 0x48, 0xe0, 0xea, 0xa8, 0x64, 0x24, 0x34, 0x4f, 0xf4, 0x44, 0x71,
-	0xf7, 0x7d, 0x30, 0x8b, 0xa4, 0xdf, 0x24, 0x0d, 0xd8, 0xc5, 0xe2, 0x34, 0x2d, 0xb0, 0x4c, 0x67,
-	0x36, 0x50, 0x4b, 0x72, 0x9f, 0x23, 0x8e, 0x33, 0x12, 0xe4, 0x9e, 0xff, 0xa2, 0x8e, 0x1a, 0x82,
-	0x17, 0xba, 0xd9, 0x80, 0xbf, 0xc6, 0x5e, 0x6e, 0x01, 0xc0, 0x7e, 0x54, 0x6e, 0x72, 0x35, 0x66,
-	0x01, 0xb0, 0xd6, 0x1c, 0x8e, 0x2e, 0x25, 0x36, 0xa0, 0x12, 0xa6, 0x2b, 0xa4, 0x72, 0x98, 0xe3,
-	0x37, 0x73, 0xe5, 0x30, 0x8f, 0xc6, 0xce, 0x78, 0x7b, 0x37, 0x68, 0x34, 0xab, 0x15, 0x7e, 0x24,
-	0x5b, 0xc0, 0xe6, 0x4e, 0x39, 0x0a, 0x4d, 0xee, 0x18, 0x27, 0x6c, 0xe1, 0xb7, 0x91, 0x35, 0xed,
-	0xed, 0x9b, 0xcb, 0x75, 0x47, 0xb9, 0xaf, 0x11, 0xc3, 0x18, 0x7d, 0x8b, 0x2c, 0xcf, 0xac, 0xad,
-	0xe0, 0xc6, 0xfd, 0x67, 0x3b, 0x71, 0x32, 0xe2, 0xfd, 0x64, 0xc4, 0xc7, 0xb1, 0x73, 0xf6, 0x0c,
-	0x72, 0xfb, 0xd1, 0xbf, 0xff, 0xd9, 0x0e, 0x7e, 0x7b, 0xdd, 0x0e, 0x7e, 0x7f, 0xdd, 0x0e, 0xfe,
-	0x78, 0xdd, 0x0e, 0x3e, 0xb9, 0x6e, 0x07, 0x7f, 0xb9, 0x6e, 0x07, 0xff, 0xb8, 0x6e, 0x07, 0xa7,
-	0x75, 0xf3, 0x27, 0xe1, 0xb3, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x40, 0x5d, 0x0f, 0x86,
-	0x14, 0x00, 0x00,
+	// 2192 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcd, 0x73, 0x1b, 0x49,
+	0x15, 0x67, 0x24, 0x59, 0x96, 0x5a, 0xb1, 0x37, 0xe9, 0x64, 0xbd, 0x43, 0x48, 0x79, 0xbd, 0x43,
+	0x0a, 0xcc, 0x12, 0x9c, 0x22, 0xe1, 0x23, 0x2c, 0x1f, 0x85, 0x64, 0x27, 0xb1, 0x6b, 0xed, 0x58,
+	0xdb, 0xb2, 0x37, 0xc0, 0x81, 0xaa, 0xf6, 0x4c, 0x4b, 0xee, 0xcd, 0x68, 0x7a, 0xaa, 0xa7, 0x65,
+	0xc7, 0x7b, 0xe3, 0x3f, 0xa0, 0x8a, 0xbf, 0x80, 0x03, 0x05, 0x7f, 0x02, 0x47, 0x6e, 0xec, 0x91,
+	0x3b, 0x55, 0xb0, 0x98, 0x2a, 0x6e, 0xdc, 0x39, 0x52, 0xaf, 0xfb, 0xcd, 0xa8, 0x25, 0xd9, 0xb0,
+	0x0b, 0x27, 0xf5, 0xfb, 0xbd, 0x8f, 0xee, 0x7e, 0xfd, 0xbe, 0x46, 0xa4, 0xad, 0x62, 0xb9, 0x95,
+	0x6b, 0x65, 0x14, 0x6d, 0x8c, 0x74, 0x1e, 0xdf, 0xfd, 0xc6, 0x48, 0x9a, 0xd3, 0xc9, 0xc9, 0x56,
+	0xac, 0xc6, 0x0f, 0x47, 0x6a, 0xa4, 0x1e, 0x5a, 0xe6, 0xc9, 0x64, 0x68, 0x29, 0x4b, 0xd8, 0x95,
+	0x53, 0xba, 0xbb, 0x3e, 0x52, 0x6a, 0x94, 0x8a, 0xa9, 0xd4, 0xb9, 0xe6, 0x79, 0x2e, 0x74, 0xe1,
+	0xf8, 0xd1, 0x1f, 0xeb, 0xa4, 0x31, 0xc8, 0x45, 0x4c, 0x43, 0xb2, 0xfc, 0xa1, 0xd0, 0x85, 0x54,
+	0x59, 0x18, 0x6c, 0x04, 0x9b, 0x6d, 0x56, 0x92, 0xf4, 0xab, 0x64, 0xb9, 0xaf, 0x55, 0x2c, 0x8a,
+	0x22, 0xac, 0x6d, 0x04, 0x9b, 0x9d, 0x47, 0x2b, 0x5b, 0x70, 0x92, 0x2d, 0x04, 0x59, 0xc9, 0xa5,
+	0xeb, 0xa4, 0xc1, 0x94, 0x32, 0x61, 0xdd, 0x4a, 0x11, 0x27, 0x05, 0x08, 0xb3, 0x38, 0xbd, 0x4b,
+	0x5a, 0xbb, 0xaa, 0x30, 0x19, 0x1f, 0x8b, 0xb0, 0x61, 0xf7, 0xa8, 0x68, 0xfa, 0x35, 0xd2, 0x3c,
+	0x50, 0x93, 0xcc, 0x14, 0xe1, 0xd2, 0x46, 0x7d, 0xb3, 0xf3, 0xa8, 0xe3, 0xb4, 0x2d, 0xd6, 0x6b,
+	0x7c, 0xf2, 0x97, 0xb7, 0xbf, 0xc0, 0x50, 0x80, 0xbe, 0x43, 0x96, 0x76, 0x95, 0x7a, 0x55, 0x84,
+	0xcd, 0x8d, 0x60, 0x2a, 0x69, 0x21, 0xe6, 0x38, 0xf4, 0x87, 0xa4, 0xd3, 0xcd, 0x32, 0x65, 0xb8,
+	0x91, 0x2a, 0x2b, 0xc2, 0x65, 0x6b, 0xf2, 0x4b, 0x4e, 0x10, 0x6e, 0xbb, 0xe5, 0x71, 0x9f, 0x66,
+	0x46, 0x5f, 0x30, 0x5f, 0x1e, 0x76, 0xd8, 0x97, 0xd9, 0xe4, 0x75, 0xd8, 0xf2, 0x77, 0xb0, 0x10,
+	0x73, 0x1c, 0x70, 0xca, 0x40, 0xa5, 0x5c, 0xcb, 0x22, 0x6c, 0xfb, 0x4e, 0x41, 0x90, 0x95, 0x5c,
+	0x10, 0x7c, 0x29, 0xb3, 0x44, 0x9d, 0x17, 0x21, 0xf1, 0x05, 0x11, 0x64, 0x25, 0xf7, 0xee, 0x8f,
+	0xc8, 0xcd, 0xf9, 0x53, 0xd1, 0x9b, 0xa4, 0xfe, 0x4a, 0x5c, 0xe0, 0x83, 0xc0, 0x92, 0xde, 0x21,
+	0x4b, 0x67, 0x3c, 0x9d, 0x08, 0xfb, 0x14, 0x6d, 0xe6, 0x88, 0xf7, 0x6a, 0x4f, 0x82, 0xe8, 0x1f,
+	0xf5, 0xea, 0x9d, 0xc0, 0xd3, 0x47, 0x42, 0x8f, 0x65, 0xc6, 0x53, 0xab, 0xdc, 0x62, 0x15, 0x4d,
+	0xbf, 0x4e, 0x3a, 0xdb, 0x2a, 0x2b, 0x54, 0x2a, 0x06, 0xf2, 0x63, 0x81, 0x4f, 0xda, 0x76, 0x87,
+	0xea, 0xa9, 0xd7, 0xcc, 0xe7, 0xd2, 0xfb, 0xa4, 0x71, 0x5c, 0x08, 0x3d, 0xfb, 0xa4, 0x80, 0xe0,
+	0x9b, 0x58, 0x2e, 0xa5, 0xa4, 0xd1, 0xd5, 0xa3, 0x22, 0x6c, 0x6c, 0xd4, 0x37, 0xdb, 0xcc, 0xae,
+	0xe1, 0xe8, 0x4f, 0xb3, 0x33, 0xfb, 0x9a, 0x6d, 0x06, 0x4b, 0x40, 0xb6, 0xcf, 0x13, 0xfb, 0x6a,
+	0x6d, 0x06, 0x4b, 0xfa, 0x7d, 0x72, 0x63, 0x9b, 0xe7, 0xfc, 0x44, 0xa6, 0xd2, 0x48, 0x01, 0xef,
+	0x04, 0xbb, 0xbc, 0xe5, 0xb9, 0xdb, 0x67, 0xb3, 0x19, 0x61, 0xfa, 0x4d, 0xb2, 0xcc, 0x52, 0x39,
+	0x96, 0xa6, 0x08, 0x5b, 0xf6, 0x7d, 0x6f, 0x61, 0x58, 0x1e, 0x0e, 0xf6, 0x7e, 0xe2, 0x38, 0x78,
+	0xc8, 0x52, 0x8e, 0x6e, 0x92, 0x37, 0x5e, 0xa8, 0x17, 0xe2, 0xbc, 0xaf, 0xe5, 0x99, 0x4c, 0xc5,
+	0x48, 0xb8, 0xc7, 0x6b, 0xb1, 0x79, 0x18, 0x24, 0xbb, 0x79, 0xce, 0xf5, 0x58, 0xe9, 0xbe, 0x56,
+	0x43, 0x99, 0x0a, 0xfb, 0x7a, 0x6d, 0x36, 0x0f, 0xd3, 0x0d, 0xd2, 0x39, 0x3c, 0x3c, 0x18, 0xc4,
+	0x4a, 0x8b, 0x6e, 0xf2, 0x51, 0xd8, 0xd9, 0x08, 0x36, 0xeb, 0xcc, 0x87, 0x68, 0x44, 0x6e, 0x0c,
+	0x44, 0x0a, 0xb7, 0xd9, 0xe7, 0x27, 0x22, 0x0d, 0x6f, 0x58, 0x43, 0x33, 0x18, 0x7d, 0x97, 0xb4,
+	0xf6, 0x79, 0x96, 0xa4, 0x2a, 0x7e, 0x15, 0xae, 0x58, 0x2f, 0xac, 0xa2, 0x17, 0x10, 0x65, 0x15,
+	0x3f, 0xfa, 0x4d, 0x30, 0x15, 0x86, 0x83, 0xee, 0xc2, 0x5a, 0x24, 0xdd, 0x18, 0x9e, 0xfe, 0xd9,
+	0x20, 0x0c, 0xac, 0xcb, 0xe7, 0x61, 0xda, 0x25, 0x9d, 0x3e, 0x37, 0xa7, 0x3d, 0x91, 0x09, 0x6e,
+	0x4e, 0xc3, 0x9a, 0xf5, 0xd9, 0x17, 0x67, 0x77, 0xf1, 0x04, 0xd0, 0x77, 0xbe, 0x0e, 0x7d, 0x40,
+	0x6e, 0xed, 0xc8, 0x82, 0x9f, 0xa4, 0xa2, 0x27, 0x0a, 0xf3, 0x74, 0x38, 0x54, 0xda, 0x65, 0x7b,
+	0x8b, 0x2d, 0x32, 0xa2, 0x0f, 0xc8, 0xed, 0x2b, 0xec, 0xd2, 0xfb, 0x64, 0xa5, 0x9b, 0xa6, 0xea,
+	0xbc, 0x3c, 0x1a, 0x9e, 0x77, 0x16, 0x84, 0x38, 0x07, 0xa5, 0xc2, 0x9e, 0xb3, 0xcd, 0x1c, 0x11,
+	0x3d, 0x26, 0xf5, 0x9e, 0x7a, 0x4d, 0xd7, 0x48, 0x73, 0x57, 0xc8, 0xd1, 0xa9, 0xb1, 0xc1, 0xbd,
+	0xc2, 0x90, 0x02, 0xa5, 0x97, 0x32, 0xb1, 0x97, 0x03, 0xd8, 0x11, 0x51, 0xe6, 0x62, 0x18, 0xe2,
+	0xef, 0x78, 0x6f, 0x07, 0x55, 0x60, 0x09, 0xc8, 0xf3, 0xbd, 0x1d, 0x94, 0x86, 0x25, 0xfd, 0x0a,
+	0x59, 0xed, 0x26, 0x89, 0x84, 0x14, 0xe4, 0xe9, 0x73, 0x99, 0x14, 0x61, 0x7d, 0xa3, 0xbe, 0xb9,
+	0xc2, 0xe6, 0x50, 0x48, 0x30, 0xb0, 0xe9, 0x97, 0xb2, 0x92, 0x8e, 0x7e, 0x1b, 0x90, 0x5b, 0x0b,
+	0xc1, 0x0b, 0x1a, 0x3d, 0x35, 0xc9, 0x12, 0x99, 0x8d, 0xf0, 0xc6, 0x15, 0x4d, 0xef, 0x91, 0xf6,
+	0xd3, 0xe1, 0x50, 0xc4, 0x46, 0x9e, 0x09, 0xbc, 0xf0, 0x14, 0x80, 0x08, 0xdb, 0xcb, 0x4e, 0x85,
+	0x96, 0x06, 0x1c, 0x6c, 0x0f, 0xd4, 0x66, 0x3e, 0x04, 0xfa, 0x7d, 0x48, 0x6f, 0x63, 0x44, 0x82,
+	0x49, 0x38, 0x05, 0xa0, 0xb2, 0x77, 0xc7, 0x27, 0x52, 0x64, 0x06, 0xb3, 0xb1, 0x24, 0xa3, 0x3d,
+	0xd2, 0xf1, 0xb2, 0x05, 0xd2, 0xf8, 0xe8, 0x22, 0x17, 0x58, 0x6e, 0xec, 0x1a, 0xb0, 0x5d, 0xae,
+	0x13, 0xeb, 0xa3, 0x06, 0xb3, 0x6b, 0xc0, 0x06, 0x6a, 0xe8, 0x5e, 0xbe, 0xc1, 0xec, 0x3a, 0x52,
+	0x64, 0xc9, 0x96, 0x67, 0x38, 0x6d, 0x22, 0x0a, 0x23, 0x33, 0x5b, 0xc7, 0xd0, 0x96, 0x0f, 0xc1,
+	0xeb, 0x15, 0x6a, 0xa2, 0xe3, 0xb2, 0x86, 0x21, 0x05, 0x66, 0x0d, 0x6c, 0x5f, 0x77, 0xdb, 0xc3,
+	0x1a, 0xce, 0xae, 0x72, 0x57, 0xc4, 0xdd, 0xbd, 0x4a, 0x32, 0xfa, 0x8e, 0x6b, 0x36, 0xa0, 0x05,
+	0xb1, 0x51, 0x1e, 0x1a, 0xd6, 0xe0, 0x6b, 0x26, 0x78, 0xa2, 0xb2, 0xf4, 0xc2, 0xee, 0xd1, 0x62,
+	0x15, 0x1d, 0xfd, 0x2a, 0xc0, 0xf6, 0x41, 0x1f, 0x90, 0x56, 0x5f, 0x8b, 0xc2, 0x70, 0x6d, 0xec,
+	0x8b, 0x54, 0xf5, 0x0d, 0xd8, 0x18, 0xfe, 0x95, 0x04, 0xdd, 0x22, 0xed, 0xbe, 0x2a, 0x8c, 0x13,
+	0xaf, 0x5d, 0x23, 0x3e, 0x15, 0xb1, 0xd6, 0x2d, 0xa1, 0xf2, 0xb0, 0x7e, 0x8d, 0x78, 0x25, 0x11,
+	0xfd, 0x8c, 0x34, 0x00, 0xbf, 0xf2, 0x36, 0x65, 0x75, 0xad, 0x2d, 0x56, 0xd7, 0xfa, 0xb4, 0xba,
+	0x86, 0x64, 0xf9, 0x48, 0x8e, 0x85, 0x9a, 0x18, 0x1b, 0x90, 0x75, 0x56, 0x92, 0xd1, 0xdf, 0x97,
+	0xb0, 0x9d, 0xd1, 0x1f, 0x90, 0xce, 0xf1, 0xde, 0xce, 0x01, 0xcf, 0x73, 0x99, 0x8d, 0x0a, 0xbc,
+	0xf4, 0x1d, 0xaf, 0xdc, 0x56, 0xcc, 0x32, 0xfb, 0x3d, 0x71, 0xd0, 0x7e, 0xee, 0x69, 0xd7, 0xfe,
+	0xbb, 0xb6, 0x27, 0x4e, 0x1f, 0x92, 0xe6, 0xe0, 0xa2, 0x88, 0x4d, 0x8a, 0xde, 0xf0, 0xab, 0xfc,
+	0x96, 0xe3, 0xb8, 0x4e, 0x8c, 0x62, 0xf4, 0x11, 0x69, 0x33, 0xe1, 0x42, 0xa3, 0xb0, 0x57, 0x9a,
+	0xdd, 0xac, 0xe2, 0xb1, 0xa9, 0x18, 0x04, 0xdf, 0xf6, 0x48, 0xab, 0x49, 0x5e, 0x58, 0x2f, 0x2e,
+	0xb9, 0xe0, 0xf3, 0x20, 0xfa, 0x1e, 0x21, 0x2f, 0xf8, 0x58, 0x14, 0x39, 0x07, 0xb3, 0xcd, 0x85,
+	0x3b, 0x54, 0x4c, 0xbc, 0x83, 0x27, 0x0d, 0x1d, 0x67, 0x47, 0x9c, 0xc9, 0x58, 0x94, 0x13, 0xc5,
+	0x2d, 0x4f, 0xd1, 0x71, 0xca, 0x8e, 0x83, 0x72, 0xf4, 0x01, 0x59, 0x1e, 0x88, 0x38, 0x56, 0xe3,
+	0x1c, 0x67, 0x09, 0xea, 0xa9, 0x20, 0x87, 0x95, 0x22, 0x50, 0x5f, 0x21, 0xa6, 0x87, 0x45, 0x5f,
+	0xab, 0x9c, 0x8f, 0x5c, 0x06, 0xb5, 0xed, 0x25, 0x16, 0x19, 0x70, 0xd9, 0x03, 0x5e, 0xbc, 0x12,
+	0x89, 0x2b, 0x94, 0xc4, 0xd5, 0x05, 0x0f, 0x82, 0x52, 0x5b, 0xc6, 0xbd, 0x93, 0xe9, 0xb8, 0x52,
+	0x3b, 0x03, 0xd2, 0x75, 0x42, 0x6c, 0xea, 0xfa, 0xdd, 0xc9, 0x43, 0xe8, 0x43, 0xd2, 0xda, 0xcb,
+	0x8c, 0x48, 0x59, 0x62, 0xb0, 0x37, 0xdd, 0xf6, 0x1f, 0x1d, 0x59, 0xac, 0x12, 0xa2, 0x4f, 0x48,
+	0xa7, 0x2f, 0x74, 0x01, 0xc5, 0x52, 0x9a, 0x8b, 0x70, 0xd5, 0xea, 0xac, 0x79, 0x3a, 0x1e, 0x97,
+	0xf9, 0xa2, 0x77, 0xbf, 0x47, 0x3a, 0x5e, 0x28, 0x7c, 0xae, 0xf1, 0xe7, 0xc7, 0xe4, 0xe6, 0xbc,
+	0x6d, 0xa8, 0x34, 0x3b, 0x6a, 0xcc, 0x65, 0x59, 0x86, 0x90, 0x02, 0x2b, 0xcf, 0x52, 0x5e, 0xa5,
+	0x94, 0x23, 0xa2, 0xb7, 0xab, 0x49, 0x0d, 0x04, 0x92, 0xc9, 0x78, 0x5c, 0x6e, 0xed, 0x08, 0x10,
+	0x28, 0xa7, 0xba, 0xab, 0x05, 0x7e, 0x4e, 0x56, 0x67, 0x13, 0xc1, 0x76, 0x2a, 0x55, 0x98, 0xaa,
+	0xed, 0x20, 0x65, 0x03, 0x55, 0x65, 0x86, 0xcb, 0x4c, 0xe8, 0xaa, 0x03, 0xf9, 0x90, 0x2d, 0xb2,
+	0xf2, 0x63, 0x57, 0x0d, 0x57, 0x98, 0x5d, 0x47, 0x4f, 0xd0, 0x7e, 0x15, 0x93, 0xd7, 0x95, 0xec,
+	0x3e, 0xc7, 0x26, 0x88, 0x35, 0x24, 0xfa, 0x75, 0x40, 0x3a, 0x5e, 0x98, 0x5e, 0x57, 0x67, 0xac,
+	0xad, 0x9a, 0x67, 0xeb, 0x0e, 0x59, 0x3a, 0xe0, 0x1f, 0x29, 0x37, 0x00, 0xd6, 0x99, 0x23, 0x2c,
+	0x2a, 0x33, 0xa5, 0xb1, 0xd2, 0x38, 0x02, 0xaa, 0xee, 0x33, 0x99, 0x8a, 0x03, 0x95, 0x08, 0x9b,
+	0x79, 0x2b, 0xac, 0xa2, 0xcb, 0xde, 0xdb, 0x5c, 0xe8, 0xbd, 0xcb, 0x55, 0xef, 0x8d, 0xfe, 0x5a,
+	0xc3, 0xeb, 0x4d, 0xf3, 0xf9, 0xbb, 0xd3, 0x8c, 0x0b, 0x16, 0xaa, 0x86, 0xe3, 0xb8, 0xe4, 0x9e,
+	0xcf, 0x3b, 0xf8, 0x9c, 0x10, 0x63, 0xa5, 0x2f, 0x70, 0xbe, 0xf5, 0x33, 0xd5, 0x31, 0x18, 0x0a,
+	0xd0, 0x0d, 0x52, 0xdf, 0xee, 0x1f, 0x87, 0xf5, 0x99, 0xa9, 0xcb, 0xb6, 0xef, 0xfe, 0x31, 0x03,
+	0x16, 0xfd, 0x32, 0x69, 0xf4, 0x61, 0x14, 0x70, 0x45, 0xe8, 0x0d, 0x3f, 0x90, 0x65, 0x52, 0x30,
+	0xcb, 0x84, 0x4c, 0xef, 0xc1, 0xa8, 0xb3, 0x77, 0x18, 0x2e, 0x2d, 0x64, 0x3a, 0x72, 0x58, 0x29,
+	0x42, 0x9f, 0x91, 0xd5, 0xdd, 0xc9, 0x48, 0xe4, 0x7c, 0x24, 0xf6, 0xdd, 0x0c, 0xeb, 0x4a, 0x51,
+	0xe8, 0x29, 0xcd, 0x08, 0xe0, 0x05, 0xe7, 0xb4, 0x60, 0xd7, 0x17, 0xc2, 0x9c, 0x2b, 0xfd, 0x2a,
+	0x5c, 0x5e, 0xd8, 0x15, 0x39, 0xac, 0x14, 0x89, 0xfe, 0x5c, 0x46, 0x01, 0x5e, 0xfd, 0x0e, 0x34,
+	0x86, 0xb1, 0x74, 0x63, 0x54, 0x9d, 0x39, 0x02, 0x62, 0x93, 0x89, 0x42, 0xe8, 0x33, 0x57, 0x7f,
+	0x6a, 0x96, 0xe7, 0x43, 0x36, 0x36, 0xcf, 0x79, 0x8e, 0x41, 0x61, 0xd7, 0x10, 0xe9, 0xef, 0x0b,
+	0x9d, 0x89, 0x14, 0x83, 0x02, 0x29, 0x98, 0x4d, 0xdc, 0xea, 0x68, 0xbb, 0x6f, 0x3d, 0x53, 0x67,
+	0x53, 0x00, 0x6a, 0x0f, 0x68, 0xe7, 0x32, 0x83, 0x49, 0xb0, 0x69, 0x07, 0x0a, 0x0f, 0xa1, 0xef,
+	0x92, 0x9b, 0x38, 0x58, 0x1e, 0x1e, 0x1e, 0xbc, 0x2f, 0xd3, 0x54, 0x68, 0x7b, 0xd1, 0x16, 0x5b,
+	0xc0, 0xa3, 0x4f, 0x61, 0x2e, 0xc6, 0x87, 0x83, 0xe3, 0x0c, 0x4e, 0xb9, 0xb6, 0x81, 0x03, 0x46,
+	0x91, 0x82, 0x2b, 0x7f, 0x30, 0x51, 0x86, 0xe3, 0xb5, 0x1c, 0x01, 0xd2, 0x7d, 0xa1, 0xa5, 0x4a,
+	0x70, 0xa6, 0x41, 0x0a, 0xa6, 0x6b, 0x26, 0x78, 0x6a, 0xe4, 0x58, 0xb0, 0x49, 0x06, 0x3f, 0x78,
+	0xbb, 0x79, 0x18, 0x06, 0xc7, 0x12, 0x42, 0x4b, 0x4b, 0xd6, 0xd2, 0x1c, 0x0a, 0xae, 0xdb, 0xce,
+	0x27, 0x05, 0x7e, 0x05, 0xd9, 0x35, 0x60, 0x07, 0x62, 0xec, 0x3e, 0x7f, 0xda, 0xcc, 0xae, 0xe1,
+	0x9c, 0xbd, 0x89, 0x2e, 0x8c, 0x6d, 0x1b, 0x0d, 0xe6, 0x88, 0xe8, 0x1c, 0x27, 0xcb, 0x97, 0x76,
+	0xde, 0xc5, 0x5c, 0xae, 0x72, 0x34, 0xb8, 0x32, 0x47, 0x6b, 0x7e, 0x8e, 0xae, 0x91, 0xa6, 0xd3,
+	0xc5, 0xba, 0x82, 0x14, 0xbc, 0xc3, 0xbe, 0xe0, 0x43, 0xe4, 0x35, 0x2c, 0xcf, 0x43, 0xa2, 0x63,
+	0x72, 0xdb, 0x6e, 0x7c, 0x74, 0xaa, 0x95, 0x31, 0xa9, 0xf8, 0x1f, 0xb6, 0xa6, 0xa4, 0xc1, 0xb8,
+	0x11, 0xe5, 0xd4, 0x08, 0xeb, 0xe8, 0x9f, 0x75, 0x72, 0xc3, 0x4f, 0x10, 0xef, 0x7c, 0xc1, 0x7f,
+	0x38, 0x5f, 0x6d, 0xfe, 0x7c, 0xb4, 0x4b, 0x6e, 0xf8, 0x3e, 0xb9, 0x62, 0xc6, 0xf0, 0xd9, 0x98,
+	0x4c, 0x33, 0x2a, 0xf4, 0x98, 0xbc, 0x59, 0xde, 0x0e, 0xfa, 0x63, 0x2f, 0x2f, 0xd0, 0x56, 0x63,
+	0xe6, 0x4b, 0x69, 0xd1, 0x0b, 0x68, 0xed, 0x6a, 0x6d, 0xfa, 0x92, 0xac, 0x95, 0x8c, 0x97, 0x5a,
+	0x1a, 0x31, 0xb5, 0xbb, 0xf4, 0xd9, 0xec, 0x5e, 0xa3, 0xee, 0x1b, 0x86, 0x1d, 0xf7, 0x0e, 0xfb,
+	0x03, 0x34, 0xdc, 0xfc, 0x9c, 0x86, 0x67, 0xd5, 0xe9, 0x4f, 0xc9, 0x5b, 0x33, 0x5b, 0x7a, 0x96,
+	0x97, 0x3f, 0x9b, 0xe5, 0xeb, 0xf4, 0xa3, 0x77, 0x48, 0xbb, 0xaa, 0x9b, 0x57, 0x57, 0x9f, 0xe8,
+	0x17, 0xe5, 0xd7, 0x93, 0x5f, 0xde, 0x41, 0xd6, 0x7e, 0x1f, 0xe2, 0xbf, 0x19, 0x8e, 0xf8, 0xbf,
+	0x3b, 0xd6, 0x1a, 0x69, 0xe2, 0x37, 0xa8, 0x9b, 0x14, 0x91, 0x8a, 0x52, 0x8c, 0x4a, 0xac, 0x9b,
+	0x30, 0x5b, 0x6f, 0xa7, 0xbc, 0x28, 0xaa, 0x36, 0x5e, 0x92, 0xb4, 0x47, 0x48, 0x5f, 0x4b, 0xa5,
+	0xdd, 0xff, 0x17, 0x6e, 0x24, 0xbe, 0x37, 0x37, 0x1d, 0xe9, 0x21, 0x8f, 0x05, 0x4a, 0x5d, 0x94,
+	0x63, 0xe5, 0x54, 0x2b, 0x7a, 0x46, 0xe8, 0x62, 0xbd, 0x87, 0x6e, 0xda, 0xe7, 0x23, 0x51, 0xc0,
+	0x0c, 0xe0, 0xba, 0x74, 0x45, 0x4f, 0x3d, 0xe7, 0xbe, 0xca, 0xd0, 0x73, 0xbb, 0x64, 0xed, 0xea,
+	0x3d, 0xc1, 0x4f, 0x30, 0x32, 0x94, 0xdd, 0x1e, 0xd6, 0xd6, 0x3e, 0xf2, 0x31, 0x9f, 0x2a, 0x3a,
+	0xfa, 0x65, 0x80, 0x0e, 0x28, 0x07, 0xd3, 0xfb, 0x64, 0x65, 0x47, 0x0c, 0xf9, 0x24, 0x35, 0xdd,
+	0xd8, 0xfb, 0xac, 0x9b, 0x05, 0xed, 0x97, 0xbd, 0x8e, 0x4f, 0xa5, 0x11, 0xb1, 0x99, 0x68, 0x51,
+	0x8e, 0x57, 0xb3, 0x20, 0xfd, 0x16, 0x69, 0xc1, 0x8c, 0xc7, 0xd3, 0xb4, 0xc0, 0x34, 0x9d, 0x99,
+	0x89, 0x1d, 0xab, 0xfc, 0x40, 0x2a, 0x25, 0x23, 0x49, 0xde, 0xf0, 0x4f, 0xd4, 0xd5, 0x23, 0xf0,
+	0xc2, 0x5e, 0x96, 0x88, 0xd7, 0x58, 0xe1, 0x1d, 0x01, 0xe8, 0x87, 0xd5, 0x84, 0xd8, 0x60, 0x8e,
+	0x80, 0xdb, 0xda, 0xc5, 0xd1, 0xb9, 0xc2, 0x02, 0x54, 0xd1, 0x74, 0x95, 0xd4, 0x0e, 0x73, 0xfc,
+	0x8a, 0xaf, 0x1d, 0xe6, 0xd1, 0xb8, 0xbc, 0xbc, 0xdb, 0x1b, 0x2c, 0xda, 0x81, 0x0b, 0x3f, 0xdb,
+	0x1d, 0xe1, 0x62, 0xa7, 0x6a, 0x90, 0x6d, 0x86, 0x14, 0x7d, 0x88, 0x5f, 0x6b, 0xee, 0x6a, 0x6f,
+	0x2e, 0x8e, 0xfb, 0x5d, 0x5d, 0x7e, 0x1f, 0x59, 0xc1, 0xe8, 0xdb, 0x64, 0x65, 0x66, 0x90, 0x06,
+	0x37, 0xee, 0x3f, 0xde, 0xe6, 0xf1, 0xa9, 0x18, 0xc4, 0xa7, 0x62, 0xcc, 0x4b, 0x67, 0xcf, 0x80,
+	0xbd, 0x7b, 0xff, 0xfa, 0xdb, 0x7a, 0xf0, 0xbb, 0xcb, 0xf5, 0xe0, 0xf7, 0x97, 0xeb, 0xc1, 0x1f,
+	0x2e, 0xd7, 0x83, 0x4f, 0x2e, 0xd7, 0x83, 0x3f, 0x5d, 0xae, 0x07, 0x9f, 0x5e, 0xae, 0x07, 0x27,
+	0x4d, 0xfb, 0xef, 0xee, 0xe3, 0x7f, 0x0f, 0x00, 0x8b, 0x44, 0x38, 0x24, 0x3f, 0x16, 0x00, 0x00,
 }
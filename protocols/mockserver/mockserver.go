@@ -171,7 +171,7 @@ func (m *mockServer) RemoveContainer(ctx context.Context, req *pb.RemoveContaine
 	return &types.Empty{}, nil
 }
 
-func (m *mockServer) ExecProcess(ctx context.Context, req *pb.ExecProcessRequest) (*types.Empty, error) {
+func (m *mockServer) ExecProcess(ctx context.Context, req *pb.ExecProcessRequest) (*pb.ExecProcessResponse, error) {
 	mockLock.Lock()
 	defer mockLock.Unlock()
 	if err := m.containerExist(req.ContainerId); err != nil {
@@ -183,7 +183,7 @@ func (m *mockServer) ExecProcess(ctx context.Context, req *pb.ExecProcessRequest
 		pid:  req.ExecId,
 		proc: req.Process,
 	}
-	return &types.Empty{}, nil
+	return &pb.ExecProcessResponse{}, nil
 }
 
 func (m *mockServer) SignalProcess(ctx context.Context, req *pb.SignalProcessRequest) (*types.Empty, error) {
@@ -307,6 +307,36 @@ func (m *mockServer) UpdateRoutes(ctx context.Context, req *pb.UpdateRoutesReque
 	return nil, nil
 }
 
+func (m *mockServer) UpdateSourceRouting(ctx context.Context, req *pb.UpdateSourceRoutingRequest) (*pb.SourceRouting, error) {
+	mockLock.RLock()
+	defer mockLock.RUnlock()
+	if err := m.podExist(); err != nil {
+		return nil, err
+	}
+
+	return &pb.SourceRouting{Interfaces: req.Interfaces}, nil
+}
+
+func (m *mockServer) SetTransparentProxy(ctx context.Context, req *pb.SetTransparentProxyRequest) (*types.Empty, error) {
+	mockLock.RLock()
+	defer mockLock.RUnlock()
+	if err := m.podExist(); err != nil {
+		return nil, err
+	}
+
+	return &types.Empty{}, nil
+}
+
+func (m *mockServer) RemoveTransparentProxy(ctx context.Context, req *pb.RemoveTransparentProxyRequest) (*types.Empty, error) {
+	mockLock.RLock()
+	defer mockLock.RUnlock()
+	if err := m.podExist(); err != nil {
+		return nil, err
+	}
+
+	return &types.Empty{}, nil
+}
+
 func (m *mockServer) OnlineCPUMem(ctx context.Context, req *pb.OnlineCPUMemRequest) (*types.Empty, error) {
 	mockLock.RLock()
 	defer mockLock.RUnlock()
@@ -327,6 +357,36 @@ func (m *mockServer) ListProcesses(ctx context.Context, req *pb.ListProcessesReq
 	return &pb.ListProcessesResponse{}, nil
 }
 
+func (m *mockServer) GetProcessEnv(ctx context.Context, req *pb.GetProcessEnvRequest) (*pb.ProcessEnv, error) {
+	mockLock.RLock()
+	defer mockLock.RUnlock()
+	if err := m.podExist(); err != nil {
+		return nil, err
+	}
+
+	return &pb.ProcessEnv{}, nil
+}
+
+func (m *mockServer) GetProcessFiles(ctx context.Context, req *pb.GetProcessFilesRequest) (*pb.ProcessFiles, error) {
+	mockLock.RLock()
+	defer mockLock.RUnlock()
+	if err := m.podExist(); err != nil {
+		return nil, err
+	}
+
+	return &pb.ProcessFiles{}, nil
+}
+
+func (m *mockServer) ListContainerMounts(ctx context.Context, req *pb.ListContainerMountsRequest) (*pb.ContainerMounts, error) {
+	mockLock.RLock()
+	defer mockLock.RUnlock()
+	if err := m.podExist(); err != nil {
+		return nil, err
+	}
+
+	return &pb.ContainerMounts{}, nil
+}
+
 func (m *mockServer) UpdateContainer(ctx context.Context, req *pb.UpdateContainerRequest) (*types.Empty, error) {
 	mockLock.RLock()
 	defer mockLock.RUnlock()
@@ -347,6 +407,56 @@ func (m *mockServer) StatsContainer(ctx context.Context, req *pb.StatsContainerR
 
 }
 
+func (m *mockServer) StatsSandbox(ctx context.Context, req *pb.StatsSandboxRequest) (*pb.StatsSandboxResponse, error) {
+	mockLock.RLock()
+	defer mockLock.RUnlock()
+	if err := m.podExist(); err != nil {
+		return nil, err
+	}
+
+	return &pb.StatsSandboxResponse{}, nil
+}
+
+func (m *mockServer) MemoryStatContainer(ctx context.Context, req *pb.MemoryStatContainerRequest) (*pb.MemoryStatContainerResponse, error) {
+	mockLock.RLock()
+	defer mockLock.RUnlock()
+	if err := m.podExist(); err != nil {
+		return nil, err
+	}
+
+	return &pb.MemoryStatContainerResponse{}, nil
+}
+
+func (m *mockServer) ExposeContainerRootfs(ctx context.Context, req *pb.ExposeContainerRootfsRequest) (*pb.ExposeContainerRootfsResponse, error) {
+	mockLock.RLock()
+	defer mockLock.RUnlock()
+	if err := m.podExist(); err != nil {
+		return nil, err
+	}
+
+	return &pb.ExposeContainerRootfsResponse{}, nil
+}
+
+func (m *mockServer) UnexposeContainerRootfs(ctx context.Context, req *pb.UnexposeContainerRootfsRequest) (*types.Empty, error) {
+	mockLock.RLock()
+	defer mockLock.RUnlock()
+	if err := m.podExist(); err != nil {
+		return nil, err
+	}
+
+	return &types.Empty{}, nil
+}
+
+func (m *mockServer) ReadContainerRootfs(ctx context.Context, req *pb.ReadContainerRootfsRequest) (*pb.ContainerRootfsFile, error) {
+	mockLock.RLock()
+	defer mockLock.RUnlock()
+	if err := m.podExist(); err != nil {
+		return nil, err
+	}
+
+	return &pb.ContainerRootfsFile{}, nil
+}
+
 func (m *mockServer) PauseContainer(ctx context.Context, req *pb.PauseContainerRequest) (*types.Empty, error) {
 	mockLock.RLock()
 	defer mockLock.RUnlock()
@@ -421,6 +531,40 @@ func (m *mockServer) CopyFile(ctx context.Context, req *pb.CopyFileRequest) (*ty
 	return nil, m.podExist()
 }
 
+func (m *mockServer) ExtractLayer(ctx context.Context, req *pb.ExtractLayerRequest) (*types.Empty, error) {
+	mockLock.RLock()
+	defer mockLock.RUnlock()
+	return nil, m.podExist()
+}
+
+func (m *mockServer) CheckKernelModule(ctx context.Context, req *pb.CheckKernelModuleRequest) (*pb.KernelModuleStatus, error) {
+	mockLock.RLock()
+	defer mockLock.RUnlock()
+	if err := m.podExist(); err != nil {
+		return nil, err
+	}
+
+	return &pb.KernelModuleStatus{Name: req.Module.Name, Loaded: true}, nil
+}
+
+func (m *mockServer) RestartAgent(ctx context.Context, req *pb.RestartAgentRequest) (*types.Empty, error) {
+	mockLock.RLock()
+	defer mockLock.RUnlock()
+	return nil, m.podExist()
+}
+
+func (m *mockServer) GetAPIVersion(ctx context.Context, req *pb.GetAPIVersionRequest) (*pb.APIVersionResponse, error) {
+	return &pb.APIVersionResponse{Version: pb.APIVersion}, nil
+}
+
+func (m *mockServer) GetGuestFilesystems(ctx context.Context, req *pb.GuestFilesystemsRequest) (*pb.GuestFilesystems, error) {
+	return &pb.GuestFilesystems{}, nil
+}
+
+func (m *mockServer) GetInitTimings(ctx context.Context, req *pb.InitTimingsRequest) (*pb.InitTimings, error) {
+	return &pb.InitTimings{}, nil
+}
+
 func (m *mockServer) StartTracing(ctx context.Context, req *pb.StartTracingRequest) (*types.Empty, error) {
 	return nil, nil
 }
@@ -428,3 +572,7 @@ func (m *mockServer) StartTracing(ctx context.Context, req *pb.StartTracingReque
 func (m *mockServer) StopTracing(ctx context.Context, req *pb.StopTracingRequest) (*types.Empty, error) {
 	return nil, nil
 }
+
+func (m *mockServer) GetProfile(ctx context.Context, req *pb.GetProfileRequest) (*pb.Profile, error) {
+	return &pb.Profile{}, nil
+}
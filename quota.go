@@ -0,0 +1,265 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"unsafe"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// See include/uapi/linux/quota.h and include/uapi/linux/fs.h
+const (
+	qGetInfo   = 0x800005
+	qSetQuota  = 0x800008
+	prjQuota   = 2
+	qifBLimits = 1
+
+	// Unit of the quota block limits
+	quotaBlockSize = 1024
+
+	fsXflagProjInherit = 0x200
+
+	ext4SuperMagic = 0xef53
+	xfsSuperMagic  = 0x58465342
+
+	// Project IDs below this one are left to the guest administrator
+	quotaProjectIDBase = 1000
+)
+
+// The ioctl direction bits differ between architectures, they are taken
+// from well known requests of each direction rather than hardcoded.
+var (
+	iocRead  = uintptr(unix.FS_IOC_SET_ENCRYPTION_POLICY) & 0xe0000000
+	iocWrite = uintptr(unix.FS_IOC_GET_ENCRYPTION_POLICY) & 0xe0000000
+
+	fsIOCFSGetXattr = iocRead | unsafe.Sizeof(fsxattr{})<<16 | 'X'<<8 | 31
+	fsIOCFSSetXattr = iocWrite | unsafe.Sizeof(fsxattr{})<<16 | 'X'<<8 | 32
+)
+
+type fsxattr struct {
+	xflags     uint32
+	extsize    uint32
+	nextents   uint32
+	projid     uint32
+	cowextsize uint32
+	pad        [8]byte
+}
+
+type ifDqblk struct {
+	bhardlimit uint64
+	bsoftlimit uint64
+	curspace   uint64
+	ihardlimit uint64
+	isoftlimit uint64
+	curinodes  uint64
+	btime      uint64
+	itime      uint64
+	valid      uint32
+}
+
+type ifDqinfo struct {
+	bgrace uint64
+	igrace uint64
+	flags  uint32
+	valid  uint32
+}
+
+func quotactl(cmd int, special string, id uint32, addr unsafe.Pointer) error {
+	s, err := unix.BytePtrFromString(special)
+	if err != nil {
+		return err
+	}
+
+	qcmd := uintptr(cmd<<8 | prjQuota)
+	if _, _, errno := unix.Syscall6(unix.SYS_QUOTACTL, qcmd, uintptr(unsafe.Pointer(s)), uintptr(id), uintptr(addr), 0, 0); errno != 0 {
+		return errno
+	}
+
+	return nil
+}
+
+// quotaDevice returns the block device backing the filesystem of path.
+func quotaDevice(path string) (string, error) {
+	var st unix.Stat_t
+	if err := unix.Stat(path, &st); err != nil {
+		return "", err
+	}
+
+	mounts, err := getGuestMounts()
+	if err != nil {
+		return "", err
+	}
+
+	for _, m := range mounts {
+		if uint32(m.Major) == unix.Major(st.Dev) && uint32(m.Minor) == unix.Minor(st.Dev) {
+			return unescapeMountPath(m.Source), nil
+		}
+	}
+
+	return "", grpcStatus.Errorf(codes.NotFound, "Could not find the device backing %s", path)
+}
+
+// checkProjectQuotaSupport returns the block device of the filesystem of
+// path, provided the filesystem enforces project quotas.
+func checkProjectQuotaSupport(path string) (string, error) {
+	var st unix.Statfs_t
+	if err := unix.Statfs(path, &st); err != nil {
+		return "", err
+	}
+
+	if st.Type != ext4SuperMagic && st.Type != xfsSuperMagic {
+		return "", grpcStatus.Errorf(codes.FailedPrecondition, "Filesystem of %s does not support project quotas", path)
+	}
+
+	device, err := quotaDevice(path)
+	if err != nil {
+		return "", err
+	}
+
+	var info ifDqinfo
+	if err := quotactl(qGetInfo, device, 0, unsafe.Pointer(&info)); err != nil {
+		return "", grpcStatus.Errorf(codes.FailedPrecondition, "Project quotas not enabled on %s: %v", device, err)
+	}
+
+	return device, nil
+}
+
+// setProjectID assigns the project to the directory tree, new files and
+// directories created under it inheriting the project. The tree is not
+// walked beyond its filesystem, nor through symbolic links.
+func setProjectID(path string, projectID uint32) error {
+	var root unix.Stat_t
+	if err := unix.Stat(path, &root); err != nil {
+		return err
+	}
+
+	return filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if st, ok := info.Sys().(*syscall.Stat_t); ok && st.Dev != root.Dev {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		// Opening the other files could block or follow links.
+		if !info.IsDir() && !info.Mode().IsRegular() {
+			return nil
+		}
+
+		return setFileProjectID(path, projectID, info.IsDir())
+	})
+}
+
+// setFileProjectID assigns the project to the file, the project being
+// inherited by the new entries of a directory.
+func setFileProjectID(path string, projectID uint32, dir bool) error {
+	f, err := os.OpenFile(path, os.O_RDONLY|unix.O_NOFOLLOW|unix.O_NONBLOCK, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var attr fsxattr
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), fsIOCFSGetXattr, uintptr(unsafe.Pointer(&attr))); errno != 0 {
+		return errno
+	}
+
+	attr.projid = projectID
+	if dir {
+		attr.xflags |= fsXflagProjInherit
+	}
+
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), fsIOCFSSetXattr, uintptr(unsafe.Pointer(&attr))); errno != 0 {
+		return errno
+	}
+
+	return nil
+}
+
+// setProjectQuotaLimit sets the block limit of the project, 0 removing it.
+func setProjectQuotaLimit(device string, projectID uint32, size uint64) error {
+	blocks := (size + quotaBlockSize - 1) / quotaBlockSize
+
+	dq := ifDqblk{
+		bhardlimit: blocks,
+		bsoftlimit: blocks,
+		valid:      qifBLimits,
+	}
+
+	return quotactl(qSetQuota, device, projectID, unsafe.Pointer(&dq))
+}
+
+// setupContainerQuota limits the disk usage of the container rootfs to
+// size bytes, through a project quota dedicated to the container.
+func (s *sandbox) setupContainerQuota(ctr *container, spec *specs.Spec, size uint64) error {
+	if size == 0 {
+		return nil
+	}
+
+	if spec.Root == nil || !filepath.IsAbs(spec.Root.Path) {
+		return grpcStatus.Error(codes.InvalidArgument, "Disk quota requires an absolute rootfs path")
+	}
+	rootfs := spec.Root.Path
+
+	device, err := checkProjectQuotaSupport(rootfs)
+	if err != nil {
+		return err
+	}
+
+	s.Lock()
+	s.quotaProjectID++
+	projectID := quotaProjectIDBase + s.quotaProjectID
+	s.Unlock()
+
+	if err := setProjectQuotaLimit(device, projectID, size); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not set quota of project %d on %s: %v", projectID, device, err)
+	}
+
+	ctr.quotaDevice = device
+	ctr.quotaProjectID = projectID
+
+	if err := setProjectID(rootfs, projectID); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not set project %d on %s: %v", projectID, rootfs, err)
+	}
+
+	agentLog.WithFields(logrus.Fields{
+		"container": ctr.id,
+		"device":    device,
+		"project":   projectID,
+		"size":      size,
+	}).Debug("Setup container disk quota")
+
+	return nil
+}
+
+// removeContainerQuota lifts the limit of the container project, so that
+// the project ID can be safely reused.
+func (c *container) removeContainerQuota() error {
+	if c.quotaProjectID == 0 {
+		return nil
+	}
+
+	if err := setProjectQuotaLimit(c.quotaDevice, c.quotaProjectID, 0); err != nil {
+		return err
+	}
+
+	c.quotaProjectID = 0
+
+	return nil
+}
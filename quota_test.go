@@ -0,0 +1,180 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestFsxattrIoctls(t *testing.T) {
+	assert := assert.New(t)
+
+	// Values from include/uapi/linux/fs.h
+	switch runtime.GOARCH {
+	case "amd64", "arm64", "s390x":
+		assert.Equal(uintptr(0x801c581f), fsIOCFSGetXattr)
+		assert.Equal(uintptr(0x401c5820), fsIOCFSSetXattr)
+	case "ppc64le":
+		assert.Equal(uintptr(0x401c581f), fsIOCFSGetXattr)
+		assert.Equal(uintptr(0x801c5820), fsIOCFSSetXattr)
+	}
+}
+
+// getProjectID returns the project of the file, and whether its new
+// entries inherit it.
+func getProjectID(path string) (uint32, bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, false, err
+	}
+	defer f.Close()
+
+	var attr fsxattr
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), fsIOCFSGetXattr, uintptr(unsafe.Pointer(&attr))); errno != 0 {
+		return 0, false, errno
+	}
+
+	return attr.projid, attr.xflags&fsXflagProjInherit != 0, nil
+}
+
+func TestSetProjectID(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	rootfs := filepath.Join(dir, "rootfs")
+	subdir := filepath.Join(rootfs, "var", "lib")
+	assert.NoError(os.MkdirAll(subdir, testDirMode))
+	assert.NoError(ioutil.WriteFile(filepath.Join(subdir, "data"), []byte("data"), testFileMode))
+	assert.NoError(unix.Mkfifo(filepath.Join(subdir, "fifo"), 0600))
+
+	// Files outside of the tree are left untouched
+	outside := filepath.Join(dir, "outside")
+	assert.NoError(ioutil.WriteFile(outside, []byte("outside"), testFileMode))
+	assert.NoError(os.Symlink(outside, filepath.Join(subdir, "link")))
+
+	projectID := uint32(quotaProjectIDBase + 1)
+
+	probe := filepath.Join(dir, "probe")
+	assert.NoError(ioutil.WriteFile(probe, nil, testFileMode))
+	if err := setFileProjectID(probe, projectID, false); err != nil {
+		t.Skipf("project IDs not supported: %v", err)
+	}
+
+	assert.NoError(setProjectID(rootfs, projectID))
+
+	type testData struct {
+		path            string
+		expectedProject uint32
+		expectedInherit bool
+	}
+
+	data := []testData{
+		{rootfs, projectID, true},
+		{filepath.Join(rootfs, "var"), projectID, true},
+		{subdir, projectID, true},
+		{filepath.Join(subdir, "data"), projectID, false},
+		{outside, 0, false},
+	}
+
+	for i, d := range data {
+		project, inherit, err := getProjectID(d.path)
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedProject, project, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedInherit, inherit, "test %d (%+v)", i, d)
+	}
+}
+
+func TestSetupContainerQuotaInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	s := &sandbox{}
+	ctr := &container{id: "foo"}
+
+	// No quota requested
+	err := s.setupContainerQuota(ctr, &specs.Spec{}, 0)
+	assert.NoError(err)
+
+	err = s.setupContainerQuota(ctr, &specs.Spec{}, 1<<20)
+	assert.Error(err)
+
+	err = s.setupContainerQuota(ctr, &specs.Spec{Root: &specs.Root{Path: "rootfs"}}, 1<<20)
+	assert.Error(err)
+
+	// procfs does not support quotas
+	err = s.setupContainerQuota(ctr, &specs.Spec{Root: &specs.Root{Path: "/proc"}}, 1<<20)
+	assert.Equal(codes.FailedPrecondition, grpcStatus.Code(err))
+
+	assert.Zero(ctr.quotaProjectID)
+	assert.NoError(ctr.removeContainerQuota())
+}
+
+func TestSetupContainerQuota(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	if _, err := checkProjectQuotaSupport(dir); err != nil {
+		t.Skipf("project quotas not supported: %v", err)
+	}
+
+	rootfs := filepath.Join(dir, "rootfs")
+	subdir := filepath.Join(rootfs, "var", "lib")
+	assert.NoError(os.MkdirAll(subdir, testDirMode))
+	assert.NoError(ioutil.WriteFile(filepath.Join(subdir, "data"), make([]byte, 256<<10), testFileMode))
+
+	s := &sandbox{}
+	ctr := &container{id: "foo"}
+
+	err = s.setupContainerQuota(ctr, &specs.Spec{Root: &specs.Root{Path: rootfs}}, 1<<20)
+	assert.NoError(err)
+	assert.Equal(uint32(quotaProjectIDBase+1), ctr.quotaProjectID)
+	defer ctr.removeContainerQuota()
+
+	// Writes within the quota succeed
+	err = ioutil.WriteFile(filepath.Join(rootfs, "small"), make([]byte, 512<<10), testFileMode)
+	assert.NoError(err)
+
+	// Writes beyond the quota fail, including in the directories and
+	// files of the rootfs existing beforehand, which are accounted.
+	for _, path := range []string{
+		filepath.Join(rootfs, "big"),
+		filepath.Join(subdir, "big"),
+		filepath.Join(subdir, "data"),
+	} {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, testFileMode)
+		assert.NoError(err)
+		_, err = f.Write(make([]byte, 1<<20))
+		if err == nil {
+			err = f.Sync()
+		}
+		f.Close()
+		assert.Error(err, path)
+	}
+
+	assert.NoError(ctr.removeContainerQuota())
+	assert.Zero(ctr.quotaProjectID)
+}
@@ -14,6 +14,7 @@ import (
 	"os/exec"
 	"sync"
 
+	pb "github.com/kata-containers/agent/protocols/grpc"
 	"github.com/opencontainers/runc/libcontainer"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sys/unix"
@@ -26,6 +27,8 @@ type reaper interface {
 	getExitCodeCh(pid int) (chan<- int, error)
 	setExitCodeCh(pid int, exitCodeCh chan<- int)
 	deleteExitCodeCh(pid int)
+	getExitStatus(pid int) (unix.WaitStatus, error)
+	deleteExitStatus(pid int)
 	getEpoller(pid int) (*epoller, error)
 	setEpoller(pid int, epoller *epoller)
 	deleteEpoller(pid int)
@@ -43,6 +46,7 @@ type agentReaper struct {
 
 	chansLock     sync.RWMutex
 	exitCodeChans map[int]chan<- int
+	exitStatuses  map[int]unix.WaitStatus
 	epoller       map[int]*epoller
 }
 
@@ -54,8 +58,24 @@ func exitStatus(status unix.WaitStatus) int {
 	return status.ExitStatus()
 }
 
+// exitReason returns how a process terminated and, if it was killed by a
+// signal, the signal number. A process killed by SIGKILL is reported as OOM
+// killed if an OOM kill has been notified for its cgroups.
+func exitReason(status unix.WaitStatus, oomKilled bool) (pb.WaitProcessResponse_Reason, int32) {
+	if !status.Signaled() {
+		return pb.WaitProcessResponse_EXITED, 0
+	}
+
+	if status.Signal() == unix.SIGKILL && oomKilled {
+		return pb.WaitProcessResponse_OOM_KILLED, int32(status.Signal())
+	}
+
+	return pb.WaitProcessResponse_SIGNALED, int32(status.Signal())
+}
+
 func (r *agentReaper) init() {
 	r.exitCodeChans = make(map[int]chan<- int)
+	r.exitStatuses = make(map[int]unix.WaitStatus)
 	r.epoller = make(map[int]*epoller)
 }
 
@@ -119,6 +139,37 @@ func (r *agentReaper) deleteExitCodeCh(pid int) {
 	delete(r.exitCodeChans, pid)
 }
 
+// getExitStatus returns the raw wait status of a reaped process which had an
+// exit code channel registered. The status can only be retrieved once.
+func (r *agentReaper) getExitStatus(pid int) (unix.WaitStatus, error) {
+	r.chansLock.Lock()
+	defer r.chansLock.Unlock()
+
+	status, exist := r.exitStatuses[pid]
+	if !exist {
+		return 0, grpcStatus.Errorf(codes.NotFound, "Exit status for PID %d not found", pid)
+	}
+	delete(r.exitStatuses, pid)
+
+	return status, nil
+}
+
+// deleteExitStatus drops the wait status of a reaped process which will not
+// be waited for.
+func (r *agentReaper) deleteExitStatus(pid int) {
+	r.chansLock.Lock()
+	defer r.chansLock.Unlock()
+
+	delete(r.exitStatuses, pid)
+}
+
+func (r *agentReaper) setExitStatus(pid int, status unix.WaitStatus) {
+	r.chansLock.Lock()
+	defer r.chansLock.Unlock()
+
+	r.exitStatuses[pid] = status
+}
+
 func (r *agentReaper) reap() error {
 	var (
 		ws  unix.WaitStatus
@@ -166,6 +217,10 @@ func (r *agentReaper) reap() error {
 		// stored by the caller, in order to wait for the exit code.
 		r.deleteExitCodeCh(pid)
 
+		// Keep the raw status around so that the caller can tell
+		// apart a normal exit from a termination by a signal.
+		r.setExitStatus(pid, ws)
+
 		// Here, we have to signal the routine listening on
 		// this channel so that it can complete the cleanup
 		// of the process and return the exit code to the
@@ -0,0 +1,129 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"os/exec"
+	"testing"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+)
+
+func reapCommand(t *testing.T, r *agentReaper, script string) (int, unix.WaitStatus) {
+	assert := assert.New(t)
+
+	cmd := exec.Command(shPath, "-c", script)
+	exitCodeCh, err := r.start(cmd)
+	assert.NoError(err)
+
+	for {
+		assert.NoError(r.reap())
+
+		select {
+		case exitCode := <-exitCodeCh:
+			status, err := r.getExitStatus(cmd.Process.Pid)
+			assert.NoError(err)
+
+			// The status can only be retrieved once
+			_, err = r.getExitStatus(cmd.Process.Pid)
+			assert.Error(err)
+
+			return exitCode, status
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestExitReason(t *testing.T) {
+	assert := assert.New(t)
+
+	r := &agentReaper{}
+	r.init()
+
+	type testData struct {
+		script         string
+		oomKilled      bool
+		expectedCode   int
+		expectedReason pb.WaitProcessResponse_Reason
+		expectedSignal int32
+	}
+
+	data := []testData{
+		{"exit 0", false, 0, pb.WaitProcessResponse_EXITED, 0},
+		{"exit 3", true, 3, pb.WaitProcessResponse_EXITED, 0},
+		{"exit 137", true, 137, pb.WaitProcessResponse_EXITED, 0},
+		{"kill -TERM $$", false, 128 + int(unix.SIGTERM), pb.WaitProcessResponse_SIGNALED, int32(unix.SIGTERM)},
+		{"kill -TERM $$", true, 128 + int(unix.SIGTERM), pb.WaitProcessResponse_SIGNALED, int32(unix.SIGTERM)},
+		{"kill -KILL $$", false, 128 + int(unix.SIGKILL), pb.WaitProcessResponse_SIGNALED, int32(unix.SIGKILL)},
+		{"kill -KILL $$", true, 128 + int(unix.SIGKILL), pb.WaitProcessResponse_OOM_KILLED, int32(unix.SIGKILL)},
+	}
+
+	for i, d := range data {
+		exitCode, status := reapCommand(t, r, d.script)
+		assert.Equal(d.expectedCode, exitCode, "test %d (%+v)", i, d)
+
+		reason, signal := exitReason(status, d.oomKilled)
+		assert.Equal(d.expectedReason, reason, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedSignal, signal, "test %d (%+v)", i, d)
+	}
+}
+
+func TestDeleteExitStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	r := &agentReaper{}
+	r.init()
+
+	// The status of a process never waited for is dropped
+	r.setExitStatus(42, unix.WaitStatus(0))
+	r.deleteExitStatus(42)
+	_, err := r.getExitStatus(42)
+	assert.Error(err)
+	assert.Empty(r.exitStatuses)
+
+	r.setExitStatus(42, unix.WaitStatus(0))
+	s := &sandbox{subreaper: r}
+	s.deleteExitStatuses(&container{
+		processes: map[string]*process{
+			"foo": {id: "foo", restoredPid: 42},
+		},
+	})
+	assert.Empty(r.exitStatuses)
+}
+
+func TestProcessExitReason(t *testing.T) {
+	assert := assert.New(t)
+
+	r := &agentReaper{}
+	r.init()
+
+	// Killed by SIGKILL
+	killed := unix.WaitStatus(unix.SIGKILL)
+
+	c := &container{oomKilled: 1}
+	r.setExitStatus(1, killed)
+	r.setExitStatus(2, killed)
+
+	// The OOM kill is attributed to a single process
+	proc := &process{restoredPid: 1}
+	reason, signal := proc.getExitReason(r, c)
+	assert.Equal(pb.WaitProcessResponse_OOM_KILLED, reason)
+	assert.Equal(int32(unix.SIGKILL), signal)
+	assert.Zero(c.oomKilled)
+
+	// The reason is kept for the later callers
+	reason, _ = proc.getExitReason(r, c)
+	assert.Equal(pb.WaitProcessResponse_OOM_KILLED, reason)
+
+	proc = &process{restoredPid: 2}
+	reason, signal = proc.getExitReason(r, c)
+	assert.Equal(pb.WaitProcessResponse_SIGNALED, reason)
+	assert.Equal(int32(unix.SIGKILL), signal)
+}
@@ -0,0 +1,396 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/opencontainers/runc/libcontainer/utils"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Annotations used to request the syscalls of the container processes to
+// be supervised from user space, as a comma separated list of syscall
+// numbers for the guest architecture, and the path of the unix socket of
+// the supervisor the notification descriptors are sent to. The agent
+// supervises the syscalls itself when no socket is given.
+const (
+	seccompNotifyAnnotation         = "io.katacontainers.container.seccomp_notify"
+	seccompNotifyListenerAnnotation = "io.katacontainers.container.seccomp_notify_listener"
+)
+
+// Not defined by the unix package, see seccomp(2) and seccomp_unotify(2).
+const (
+	seccompSetModeFilter         = 1
+	seccompGetActionAvail        = 2
+	seccompFilterFlagNewListener = 1 << 3
+	seccompRetUserNotif          = 0x7fc00000
+	seccompRetAllow              = 0x7fff0000
+	seccompUserNotifFlagContinue = 1
+
+	// Offsets of the fields of struct seccomp_data.
+	seccompDataNrOffset   = 0
+	seccompDataArchOffset = 4
+)
+
+// The jumps of the filter being 8 bits offsets, the number of syscalls
+// it can match is limited.
+const maxSeccompNotifySyscalls = 254
+
+// The filter is installed by the libcontainer init process, which finds
+// the socket it sends the notification descriptor on through this variable.
+const seccompNotifyFdEnv = "_KATA_SECCOMPNOTIFYFD"
+
+// Architectures of the syscalls supervised, see linux/audit.h.
+var seccompAuditArches = map[string]uint32{
+	"amd64":   0xc000003e,
+	"arm64":   0xc00000b7,
+	"ppc64le": 0xc0000015,
+	"s390x":   0x80000016,
+}
+
+type seccompData struct {
+	Nr                 int32
+	Arch               uint32
+	InstructionPointer uint64
+	Args               [6]uint64
+}
+
+type seccompNotif struct {
+	ID    uint64
+	Pid   uint32
+	Flags uint32
+	Data  seccompData
+}
+
+type seccompNotifResp struct {
+	ID    uint64
+	Val   int64
+	Error int32
+	Flags uint32
+}
+
+// seccompNotify describes the syscalls of the container processes
+// supervised from user space.
+type seccompNotify struct {
+	Syscalls []uint32
+	Listener string
+}
+
+// seccompIoctl returns the number of the _IOWR seccomp notification ioctl
+// nr, powerpc having its own encoding.
+func seccompIoctl(nr, size uintptr) uint {
+	dir, dirShift := uintptr(3), uint(30)
+	if strings.HasPrefix(runtime.GOARCH, "ppc64") {
+		dir, dirShift = 6, 29
+	}
+	return uint(dir<<dirShift | size<<16 | '!'<<8 | nr)
+}
+
+var (
+	seccompIoctlNotifRecv = seccompIoctl(0, unsafe.Sizeof(seccompNotif{}))
+	seccompIoctlNotifSend = seccompIoctl(1, unsafe.Sizeof(seccompNotifResp{}))
+)
+
+// isSeccompNotifySupported returns true if the kernel supports returning
+// SECCOMP_RET_USER_NOTIF from a filter, on a known architecture.
+func isSeccompNotifySupported() bool {
+	if _, ok := seccompAuditArches[runtime.GOARCH]; !ok {
+		return false
+	}
+
+	action := uint32(seccompRetUserNotif)
+	_, _, errno := unix.Syscall(unix.SYS_SECCOMP, seccompGetActionAvail, 0, uintptr(unsafe.Pointer(&action)))
+	return errno == 0
+}
+
+// parseSeccompNotifySyscalls converts a comma separated list of syscall
+// numbers.
+func parseSeccompNotifySyscalls(value string) ([]uint32, error) {
+	var syscalls []uint32
+
+	for _, field := range strings.Split(value, ",") {
+		nr, err := strconv.ParseUint(strings.TrimSpace(field), 10, 32)
+		if err != nil {
+			return nil, grpcStatus.Errorf(codes.InvalidArgument, "Invalid syscall number %q", field)
+		}
+		syscalls = append(syscalls, uint32(nr))
+	}
+
+	if len(syscalls) > maxSeccompNotifySyscalls {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "Too many supervised syscalls, at most %d allowed", maxSeccompNotifySyscalls)
+	}
+
+	return syscalls, nil
+}
+
+// seccompNotifyFilter returns a filter notifying the listed syscalls of
+// the native architecture, and allowing the others.
+func seccompNotifyFilter(arch uint32, syscalls []uint32) []unix.SockFilter {
+	count := uint8(len(syscalls))
+
+	filter := []unix.SockFilter{
+		{Code: unix.BPF_LD | unix.BPF_W | unix.BPF_ABS, K: seccompDataArchOffset},
+		{Code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, Jf: count + 1, K: arch},
+		{Code: unix.BPF_LD | unix.BPF_W | unix.BPF_ABS, K: seccompDataNrOffset},
+	}
+
+	for i, nr := range syscalls {
+		filter = append(filter, unix.SockFilter{Code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, Jt: count - uint8(i), K: nr})
+	}
+
+	return append(filter,
+		unix.SockFilter{Code: unix.BPF_RET | unix.BPF_K, K: seccompRetAllow},
+		unix.SockFilter{Code: unix.BPF_RET | unix.BPF_K, K: seccompRetUserNotif})
+}
+
+// setContainerSeccompNotify records the syscalls supervised from user
+// space requested by the spec annotations.
+func (c *container) setContainerSeccompNotify(spec *specs.Spec) error {
+	value, ok := spec.Annotations[seccompNotifyAnnotation]
+	if !ok {
+		return nil
+	}
+
+	if !isSeccompNotifySupported() {
+		return grpcStatus.Error(codes.FailedPrecondition, "Seccomp user notifications not supported")
+	}
+
+	syscalls, err := parseSeccompNotifySyscalls(value)
+	if err != nil {
+		return err
+	}
+
+	c.seccompNotify = &seccompNotify{
+		Syscalls: syscalls,
+		Listener: spec.Annotations[seccompNotifyListenerAnnotation],
+	}
+
+	return nil
+}
+
+// setSeccompNotify passes the syscalls to supervise to the libcontainer
+// init process, through a socket it sends the notification descriptor back
+// on.
+func (p *process) setSeccompNotify(notify *seccompNotify) error {
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_SEQPACKET|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+
+	sock := os.NewFile(uintptr(fds[0]), "seccomp-notify")
+	peer := os.NewFile(uintptr(fds[1]), "seccomp-notify-peer")
+
+	syscalls := make([]string, len(notify.Syscalls))
+	for i, nr := range notify.Syscalls {
+		syscalls[i] = strconv.FormatUint(uint64(nr), 10)
+	}
+
+	if _, err := sock.Write([]byte(strings.Join(syscalls, ","))); err != nil {
+		sock.Close()
+		peer.Close()
+		return err
+	}
+
+	p.seccompSock = sock
+	p.seccompSockPeer = peer
+	p.addInitFd(seccompNotifyFdEnv, peer)
+
+	return nil
+}
+
+// receiveSeccompListener receives the notification descriptor sent by the
+// libcontainer init process. The peer socket must be closed once the
+// process is spawned, not to wait forever if the init process fails before
+// sending it.
+func (p *process) receiveSeccompListener() (*os.File, error) {
+	defer p.seccompSock.Close()
+
+	listener, err := utils.RecvFd(p.seccompSock)
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.Internal, "Could not receive seccomp notification descriptor: %v", err)
+	}
+
+	return listener, nil
+}
+
+// startSeccompSupervision supervises the notifications of the process as
+// soon as the libcontainer init process sends its descriptor. The filter
+// being installed before the container initialization, the syscalls of the
+// init process itself are notified, and must be answered while the process
+// is being spawned. The outcome is reported on seccompErrCh.
+func (p *process) startSeccompSupervision(ctr *container) {
+	errCh := make(chan error, 1)
+	p.seccompErrCh = errCh
+
+	go func() {
+		errCh <- p.superviseSeccompNotify(ctr)
+	}()
+}
+
+// superviseSeccompNotify hands the notifications of the process to the
+// supervisor listening on the socket requested, or to the agent.
+func (p *process) superviseSeccompNotify(ctr *container) error {
+	listener, err := p.receiveSeccompListener()
+	if err != nil {
+		return err
+	}
+
+	if ctr.seccompNotify.Listener == "" {
+		go p.serveSeccompListener(listener)
+		return nil
+	}
+
+	defer listener.Close()
+
+	conn, err := net.Dial("unix", ctr.seccompNotify.Listener)
+	if err != nil {
+		return grpcStatus.Errorf(codes.FailedPrecondition, "Could not connect to seccomp supervisor: %v", err)
+	}
+	defer conn.Close()
+
+	sock, err := conn.(*net.UnixConn).File()
+	if err != nil {
+		return err
+	}
+	defer sock.Close()
+
+	// The supervisor is told which process the notifications are from.
+	return utils.SendFd(sock, ctr.id+"/"+p.id, listener.Fd())
+}
+
+// handleSeccompNotif is the agent supervisor. sync(2) flushing the page
+// cache of the whole sandbox, it succeeds without effect, the other
+// syscalls being carried out as is.
+func handleSeccompNotif(notif *seccompNotif) seccompNotifResp {
+	resp := seccompNotifResp{ID: notif.ID}
+
+	if notif.Data.Nr != unix.SYS_SYNC {
+		resp.Flags = seccompUserNotifFlagContinue
+	}
+
+	return resp
+}
+
+// serveSeccompListener answers the notifications of the process with the
+// agent supervisor. The listener is kept by the process so that a restarted
+// agent inherits it and keeps supervising the process.
+func (p *process) serveSeccompListener(listener *os.File) {
+	p.Lock()
+	p.seccompListener = listener
+	p.Unlock()
+
+	serveSeccompNotify(listener, handleSeccompNotif)
+
+	// A listener of a failed attempt to spawn the process is replaced.
+	p.Lock()
+	if p.seccompListener == listener {
+		p.seccompListener = nil
+	}
+	p.Unlock()
+
+	listener.Close()
+}
+
+// serveSeccompNotify answers the notifications received on the listener,
+// until no process uses the filter anymore.
+func serveSeccompNotify(listener *os.File, handle func(*seccompNotif) seccompNotifResp) {
+	fd := int(listener.Fd())
+
+	for {
+		fds := []unix.PollFd{{Fd: int32(fd), Events: unix.POLLIN}}
+		if _, err := unix.Poll(fds, -1); err != nil {
+			if err == unix.EINTR {
+				continue
+			}
+			agentLog.WithError(err).Error("Could not poll seccomp notifications")
+			return
+		}
+
+		if fds[0].Revents&unix.POLLIN == 0 {
+			return
+		}
+
+		var notif seccompNotif
+		if _, _, errno := unix.Syscall(unix.SYS_IOCTL, uintptr(fd), uintptr(seccompIoctlNotifRecv), uintptr(unsafe.Pointer(&notif))); errno != 0 {
+			// The process may have been killed meanwhile.
+			if errno == unix.ENOENT || errno == unix.EINTR {
+				continue
+			}
+			agentLog.WithError(errno).Error("Could not receive seccomp notification")
+			return
+		}
+
+		resp := handle(&notif)
+
+		agentLog.WithFields(logrus.Fields{
+			"pid":     notif.Pid,
+			"syscall": notif.Data.Nr,
+		}).Debug("Seccomp notification handled")
+
+		if _, _, errno := unix.Syscall(unix.SYS_IOCTL, uintptr(fd), uintptr(seccompIoctlNotifSend), uintptr(unsafe.Pointer(&resp))); errno != 0 && errno != unix.ENOENT {
+			agentLog.WithError(errno).Error("Could not answer seccomp notification")
+		}
+	}
+}
+
+// setupSeccompNotifyInit installs the filter notifying the syscalls passed
+// by the agent to the libcontainer init process, if any, and sends the
+// notification descriptor back. The filter applies to the calling thread,
+// which executes the container process.
+func setupSeccompNotifyInit() error {
+	fd, err := initFd(seccompNotifyFdEnv)
+	if err != nil || fd < 0 {
+		return err
+	}
+
+	return installSeccompNotify(os.NewFile(uintptr(fd), "seccomp-notify"))
+}
+
+func installSeccompNotify(sock *os.File) error {
+	defer sock.Close()
+
+	buf := make([]byte, 4096)
+	n, err := sock.Read(buf)
+	if err != nil {
+		return err
+	}
+
+	syscalls, err := parseSeccompNotifySyscalls(string(buf[:n]))
+	if err != nil {
+		return err
+	}
+
+	arch, ok := seccompAuditArches[runtime.GOARCH]
+	if !ok {
+		return fmt.Errorf("seccomp notifications not supported on %s", runtime.GOARCH)
+	}
+
+	filter := seccompNotifyFilter(arch, syscalls)
+	prog := unix.SockFprog{
+		Len:    uint16(len(filter)),
+		Filter: &filter[0],
+	}
+
+	listener, _, errno := unix.Syscall(unix.SYS_SECCOMP, seccompSetModeFilter, seccompFilterFlagNewListener, uintptr(unsafe.Pointer(&prog)))
+	if errno != 0 {
+		return errno
+	}
+	defer unix.Close(int(listener))
+
+	return utils.SendFd(sock, "seccomp-notify", listener)
+}
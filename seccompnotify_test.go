@@ -0,0 +1,332 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/opencontainers/runc/libcontainer/utils"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Argument used by the seccomp notification tests to re-execute the test
+// binary as the libcontainer init process, which syncs once the filter is
+// installed.
+const seccompNotifyTestInitArg = "seccomp-notify-test-init"
+
+func init() {
+	if len(os.Args) < 2 || os.Args[1] != seccompNotifyTestInitArg {
+		return
+	}
+
+	runtime.LockOSThread()
+	if err := setupSeccompNotifyInit(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	unix.Sync()
+	os.Exit(0)
+}
+
+func skipUnlessSeccompNotify(t *testing.T) {
+	if !isSeccompNotifySupported() {
+		t.Skip("Seccomp user notification support needed")
+	}
+}
+
+func TestParseSeccompNotifySyscalls(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		value            string
+		expectedSyscalls []uint32
+		expectError      bool
+	}
+
+	data := []testData{
+		{"", nil, true},
+		{"foo", nil, true},
+		{"162,", nil, true},
+		{"-1", nil, true},
+		{"4294967296", nil, true},
+		{strings.Repeat("0,", maxSeccompNotifySyscalls) + "0", nil, true},
+		{"162", []uint32{162}, false},
+		{"162, 0,4294967295", []uint32{162, 0, 4294967295}, false},
+	}
+
+	for i, d := range data {
+		syscalls, err := parseSeccompNotifySyscalls(d.value)
+		if d.expectError {
+			assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.Equal(d.expectedSyscalls, syscalls, "test %d (%+v)", i, d)
+		}
+	}
+}
+
+func TestSeccompNotifyFilter(t *testing.T) {
+	assert := assert.New(t)
+
+	filter := seccompNotifyFilter(0xc000003e, []uint32{1, 2, 3})
+	if !assert.Len(filter, 8) {
+		return
+	}
+
+	allow, notify := len(filter)-2, len(filter)-1
+	assert.Equal(uint32(seccompRetAllow), filter[allow].K)
+	assert.Equal(uint32(seccompRetUserNotif), filter[notify].K)
+
+	// Another architecture is allowed
+	assert.Equal(uint32(0xc000003e), filter[1].K)
+	assert.Equal(allow, 1+1+int(filter[1].Jf))
+
+	// The listed syscalls are notified
+	for i := 3; i < allow; i++ {
+		assert.Equal(uint32(i-2), filter[i].K)
+		assert.Equal(notify, i+1+int(filter[i].Jt), "instruction %d", i)
+		assert.Equal(uint8(0), filter[i].Jf)
+	}
+}
+
+func TestHandleSeccompNotif(t *testing.T) {
+	assert := assert.New(t)
+
+	notif := &seccompNotif{ID: 42}
+	notif.Data.Nr = unix.SYS_SYNC
+	assert.Equal(seccompNotifResp{ID: 42}, handleSeccompNotif(notif))
+
+	notif.Data.Nr = unix.SYS_GETPID
+	assert.Equal(seccompNotifResp{ID: 42, Flags: seccompUserNotifFlagContinue}, handleSeccompNotif(notif))
+}
+
+func TestSetContainerSeccompNotify(t *testing.T) {
+	assert := assert.New(t)
+
+	ctr := &container{}
+	assert.NoError(ctr.setContainerSeccompNotify(&specs.Spec{}))
+	assert.Nil(ctr.seccompNotify)
+
+	skipUnlessSeccompNotify(t)
+
+	spec := &specs.Spec{
+		Annotations: map[string]string{seccompNotifyAnnotation: "foo"},
+	}
+	err := ctr.setContainerSeccompNotify(spec)
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+	assert.Nil(ctr.seccompNotify)
+
+	spec.Annotations = map[string]string{
+		seccompNotifyAnnotation:         "1,2",
+		seccompNotifyListenerAnnotation: "/run/supervisor.sock",
+	}
+	assert.NoError(ctr.setContainerSeccompNotify(spec))
+	assert.Equal(&seccompNotify{Syscalls: []uint32{1, 2}, Listener: "/run/supervisor.sock"}, ctr.seccompNotify)
+}
+
+// startSeccompNotifyTestInit starts the test binary as the init process of
+// a container supervising sync(2).
+func startSeccompNotifyTestInit(t *testing.T, proc *process) *exec.Cmd {
+	if !assert.NoError(t, proc.setSeccompNotify(&seccompNotify{Syscalls: []uint32{unix.SYS_SYNC}})) {
+		return nil
+	}
+	if !assert.NoError(t, proc.setInitFds()) {
+		return nil
+	}
+
+	cmd := exec.Command(os.Args[0], seccompNotifyTestInitArg)
+	cmd.ExtraFiles = proc.process.ExtraFiles
+	err := cmd.Start()
+	proc.seccompSockPeer.Close()
+	if !assert.NoError(t, err) {
+		proc.seccompSock.Close()
+		return nil
+	}
+
+	return cmd
+}
+
+func TestSeccompNotify(t *testing.T) {
+	skipUnlessRoot(t)
+	skipUnlessSeccompNotify(t)
+
+	assert := assert.New(t)
+
+	proc := &process{}
+	cmd := startSeccompNotifyTestInit(t, proc)
+	if cmd == nil {
+		return
+	}
+
+	listener, err := proc.receiveSeccompListener()
+	if !assert.NoError(err) {
+		cmd.Process.Kill()
+		cmd.Wait()
+		return
+	}
+
+	notified := make(chan seccompNotif, 1)
+	go serveSeccompNotify(listener, func(notif *seccompNotif) seccompNotifResp {
+		notified <- *notif
+		return handleSeccompNotif(notif)
+	})
+
+	assert.NoError(cmd.Wait())
+
+	select {
+	case notif := <-notified:
+		assert.Equal(int32(unix.SYS_SYNC), notif.Data.Nr)
+		assert.Equal(uint32(cmd.Process.Pid), notif.Pid)
+	default:
+		assert.Fail("sync(2) not notified")
+	}
+}
+
+func TestSeccompNotifyListener(t *testing.T) {
+	skipUnlessRoot(t)
+	skipUnlessSeccompNotify(t)
+
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "supervisor.sock")
+	l, err := net.Listen("unix", path)
+	if !assert.NoError(err) {
+		return
+	}
+	defer l.Close()
+
+	// The supervisor receives the descriptor, named after the process.
+	names := make(chan string, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			names <- err.Error()
+			return
+		}
+		defer conn.Close()
+
+		sock, err := conn.(*net.UnixConn).File()
+		if err != nil {
+			names <- err.Error()
+			return
+		}
+		defer sock.Close()
+
+		listener, err := utils.RecvFd(sock)
+		if err != nil {
+			names <- err.Error()
+			return
+		}
+
+		names <- listener.Name()
+		serveSeccompNotify(listener, handleSeccompNotif)
+	}()
+
+	ctr := &container{
+		id:            "foo",
+		seccompNotify: &seccompNotify{Listener: path},
+	}
+	proc := &process{id: "bar"}
+	cmd := startSeccompNotifyTestInit(t, proc)
+	if cmd == nil {
+		return
+	}
+
+	assert.NoError(proc.superviseSeccompNotify(ctr))
+	assert.Equal("foo/bar", <-names)
+	assert.NoError(cmd.Wait())
+}
+
+func TestSeccompNotifySupervisedSpawn(t *testing.T) {
+	skipUnlessRoot(t)
+	skipUnlessSeccompNotify(t)
+
+	assert := assert.New(t)
+
+	ctr := &container{
+		id:            "foo",
+		seccompNotify: &seccompNotify{Syscalls: []uint32{unix.SYS_SYNC}},
+	}
+	proc := &process{id: "bar"}
+	if !assert.NoError(proc.setSeccompNotify(ctr.seccompNotify)) {
+		return
+	}
+	if !assert.NoError(proc.setInitFds()) {
+		return
+	}
+
+	// The notifications are answered while the process is being spawned,
+	// the init process syncing before it completes.
+	proc.startSeccompSupervision(ctr)
+
+	cmd := exec.Command(os.Args[0], seccompNotifyTestInitArg)
+	cmd.ExtraFiles = proc.process.ExtraFiles
+	err := cmd.Run()
+	proc.seccompSockPeer.Close()
+	assert.NoError(err)
+	assert.NoError(<-proc.seccompErrCh)
+}
+
+func TestSeccompNotifySpawnFailure(t *testing.T) {
+	assert := assert.New(t)
+
+	ctr := &container{
+		id:            "foo",
+		seccompNotify: &seccompNotify{Syscalls: []uint32{unix.SYS_SYNC}},
+	}
+	proc := &process{id: "bar"}
+	if !assert.NoError(proc.setSeccompNotify(ctr.seccompNotify)) {
+		return
+	}
+
+	proc.startSeccompSupervision(ctr)
+
+	// No descriptor is sent by a process failing to spawn
+	proc.seccompSockPeer.Close()
+	assert.Equal(codes.Internal, grpcStatus.Code(<-proc.seccompErrCh))
+}
+
+func TestSaveSeccompListener(t *testing.T) {
+	assert := assert.New(t)
+
+	r, w, err := os.Pipe()
+	assert.NoError(err)
+	defer r.Close()
+	defer w.Close()
+
+	proc := &process{id: "bar", restoredPid: os.Getpid()}
+
+	state, err := proc.saveState()
+	assert.NoError(err)
+	assert.Equal(noFd, state.SeccompListener)
+
+	// The descriptor served by the agent is inherited by the restarted
+	// agent.
+	proc.seccompListener = r
+	state, err = proc.saveState()
+	assert.NoError(err)
+	assert.Equal(int(r.Fd()), state.SeccompListener)
+
+	sandboxState := &sandboxState{Containers: []containerState{{Processes: []processState{state}}}}
+	assert.Contains(sandboxState.stateFds(), int(r.Fd()))
+}
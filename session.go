@@ -0,0 +1,56 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// set function in variable to overwrite for testing.
+var procStatPath = func(pid int) string {
+	return fmt.Sprintf("/proc/%d/stat", pid)
+}
+
+// getProcessSession returns the process group and session IDs of the
+// process, see proc(5). nsexec, run by the libcontainer init process,
+// calls setsid(2) for every process it spawns, so that the processes lead
+// their own session and process group, unless they changed them since.
+func getProcessSession(pid int) (pgid, sid int, err error) {
+	content, err := ioutil.ReadFile(procStatPath(pid))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// The command name may contain spaces and parentheses.
+	stat := string(content)
+	end := strings.LastIndex(stat, ")")
+	if end < 0 {
+		return 0, 0, grpcStatus.Errorf(codes.Internal, "Invalid stat of process %d: %q", pid, stat)
+	}
+
+	// Fields following the command name: state, ppid, pgrp, session...
+	fields := strings.Fields(stat[end+1:])
+	if len(fields) < 4 {
+		return 0, 0, grpcStatus.Errorf(codes.Internal, "Invalid stat of process %d: %q", pid, stat)
+	}
+
+	if pgid, err = strconv.Atoi(fields[2]); err != nil {
+		return 0, 0, grpcStatus.Errorf(codes.Internal, "Invalid process group of process %d: %v", pid, err)
+	}
+
+	if sid, err = strconv.Atoi(fields[3]); err != nil {
+		return 0, 0, grpcStatus.Errorf(codes.Internal, "Invalid session of process %d: %v", pid, err)
+	}
+
+	return pgid, sid, nil
+}
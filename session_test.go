@@ -0,0 +1,183 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/opencontainers/runc/libcontainer/specconv"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+)
+
+func TestGetProcessSessionParse(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedProcStatPath := procStatPath
+	defer func() {
+		procStatPath = savedProcStatPath
+	}()
+
+	file := filepath.Join(dir, "stat")
+	procStatPath = func(pid int) string {
+		return file
+	}
+
+	_, _, err = getProcessSession(1)
+	assert.True(os.IsNotExist(err))
+
+	type testData struct {
+		stat         string
+		expectedPgid int
+		expectedSid  int
+		expectError  bool
+	}
+
+	data := []testData{
+		{"", 0, 0, true},
+		{"42 (sleep) S 1 42", 0, 0, true},
+		{"42 (sleep) S 1 foo 42 0", 0, 0, true},
+		{"42 (sleep) S 1 42 bar 0", 0, 0, true},
+		{"42 (sleep) S 1 42 41 0 -1 4194560", 42, 41, false},
+		{"42 (a) b) (c) S 1 40 41 0 -1 4194560", 40, 41, false},
+	}
+
+	for i, d := range data {
+		err := ioutil.WriteFile(file, []byte(d.stat), testFileMode)
+		assert.NoError(err)
+
+		pgid, sid, err := getProcessSession(1)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.Equal(d.expectedPgid, pgid, "test %d (%+v)", i, d)
+			assert.Equal(d.expectedSid, sid, "test %d (%+v)", i, d)
+		}
+	}
+}
+
+func TestExecProcessSession(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	rootfs := filepath.Join(dir, "rootfs")
+	assert.NoError(os.MkdirAll(rootfs, testDirMode))
+
+	// The container shares the PID namespace of the test, so that the
+	// session of its processes can be checked from here.
+	spec := specconv.Example()
+	spec.Root = &specs.Root{Path: rootfs}
+	spec.Hostname = ""
+	spec.Linux.Resources = nil
+	spec.Linux.MaskedPaths = nil
+	spec.Linux.ReadonlyPaths = nil
+	spec.Linux.Namespaces = []specs.LinuxNamespace{
+		{Type: specs.MountNamespace},
+	}
+	assert.NoError(addGuestBinaries(spec))
+
+	config, err := specconv.CreateLibcontainerConfig(&specconv.CreateOpts{
+		CgroupName:   "exec-session",
+		NoNewKeyring: true,
+		Spec:         spec,
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	factory, err := libcontainer.New(filepath.Join(dir, "state"), libcontainer.Cgroupfs)
+	if !assert.NoError(err) {
+		return
+	}
+
+	libContainer, err := factory.Create("foo", config)
+	if !assert.NoError(err) {
+		return
+	}
+	defer libContainer.Destroy()
+
+	initProc := &libcontainer.Process{
+		Args: []string{"/bin/sleep", "100"},
+		Env:  []string{"PATH=/usr/bin:/bin"},
+		Cwd:  "/",
+		Init: true,
+	}
+	if !assert.NoError(libContainer.Run(initProc)) {
+		return
+	}
+	defer initProc.Signal(syscall.SIGKILL)
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: map[string]*container{
+				"foo": {
+					id:        "foo",
+					container: libContainer,
+					processes: make(map[string]*process),
+				},
+			},
+			running:   true,
+			subreaper: &mockreaper{},
+		},
+	}
+
+	agentSid, err := unix.Getsid(0)
+	assert.NoError(err)
+
+	// The processes lead a new session, requested or not.
+	for i, setsid := range []bool{true, false} {
+		resp, err := a.ExecProcess(context.Background(), &pb.ExecProcessRequest{
+			ContainerId: "foo",
+			ExecId:      fmt.Sprintf("exec-%d", i),
+			Process: &pb.Process{
+				Args: []string{"/bin/sleep", "100"},
+				Env:  []string{"PATH=/usr/bin:/bin"},
+				Cwd:  "/",
+			},
+			Setsid:  setsid,
+			Setpgid: setsid,
+		})
+		if !assert.NoError(err, "setsid: %v", setsid) {
+			continue
+		}
+
+		pid := int(resp.Pid)
+		assert.NotZero(pid, "setsid: %v", setsid)
+
+		sid, err := unix.Getsid(pid)
+		assert.NoError(err, "setsid: %v", setsid)
+		pgid, err := unix.Getpgid(pid)
+		assert.NoError(err, "setsid: %v", setsid)
+
+		assert.Equal(pid, sid, "setsid: %v", setsid)
+		assert.Equal(pid, pgid, "setsid: %v", setsid)
+		assert.NotEqual(agentSid, sid, "setsid: %v", setsid)
+		assert.Equal(int32(sid), resp.Sid, "setsid: %v", setsid)
+		assert.Equal(int32(pgid), resp.Pgid, "setsid: %v", setsid)
+
+		syscall.Kill(pid, syscall.SIGKILL)
+	}
+}
@@ -0,0 +1,210 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	shmDestination = "/dev/shm"
+	shmSizeOption  = "size="
+
+	// Annotation used to request the size of the container /dev/shm,
+	// taking precedence over the size option of the mount itself.
+	shmSizeAnnotation = "io.katacontainers.container.shm_size"
+)
+
+// Mountpoint of the /dev/shm shared by the containers of the sandbox
+// IPC namespace. Overridden in unit tests.
+var sandboxShmPath = "/run/kata-containers/sandbox/shm"
+
+// parseShmSize converts a tmpfs size expressed in bytes, optionally
+// followed by a k, m or g suffix, into a number of bytes.
+func parseShmSize(size string) (uint64, error) {
+	multiplier := uint64(1)
+
+	value := strings.ToLower(strings.TrimSpace(size))
+	if value != "" {
+		switch value[len(value)-1] {
+		case 'k':
+			multiplier = 1 << 10
+		case 'm':
+			multiplier = 1 << 20
+		case 'g':
+			multiplier = 1 << 30
+		}
+
+		if multiplier != 1 {
+			value = value[:len(value)-1]
+		}
+	}
+
+	bytes, err := strconv.ParseUint(value, 10, 64)
+	if err != nil || bytes == 0 {
+		return 0, grpcStatus.Errorf(codes.InvalidArgument, "Invalid shm size %q", size)
+	}
+
+	return bytes * multiplier, nil
+}
+
+// getMemTotal returns the total amount of guest memory in bytes.
+func getMemTotal() (uint64, error) {
+	memTotal, err := getMemory()
+	if err != nil {
+		return 0, err
+	}
+
+	fields := strings.Fields(memTotal)
+	if len(fields) != 2 || fields[1] != "kB" {
+		return 0, fmt.Errorf("unexpected total memory format %q", memTotal)
+	}
+
+	kb, err := strconv.ParseUint(fields[0], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return kb << 10, nil
+}
+
+// getShmSize returns the size requested for the container /dev/shm, either
+// through the annotation or through the mount options, 0 meaning that the
+// tmpfs default should be used. The size is validated against the guest
+// memory.
+func getShmSize(spec *specs.Spec, m specs.Mount) (uint64, error) {
+	size := spec.Annotations[shmSizeAnnotation]
+	if size == "" {
+		for _, opt := range m.Options {
+			if strings.HasPrefix(opt, shmSizeOption) {
+				size = strings.TrimPrefix(opt, shmSizeOption)
+			}
+		}
+	}
+
+	if size == "" {
+		return 0, nil
+	}
+
+	bytes, err := parseShmSize(size)
+	if err != nil {
+		return 0, err
+	}
+
+	memTotal, err := getMemTotal()
+	if err != nil {
+		return 0, err
+	}
+
+	if bytes > memTotal {
+		return 0, grpcStatus.Errorf(codes.InvalidArgument, "shm size %d exceeds guest memory %d", bytes, memTotal)
+	}
+
+	return bytes, nil
+}
+
+// setShmSizeOption replaces any size option from the mount options.
+func setShmSizeOption(options []string, size uint64) []string {
+	var newOptions []string
+
+	for _, opt := range options {
+		if !strings.HasPrefix(opt, shmSizeOption) {
+			newOptions = append(newOptions, opt)
+		}
+	}
+
+	return append(newOptions, fmt.Sprintf("%s%d", shmSizeOption, size))
+}
+
+// handleShmMount applies the requested size to the container /dev/shm.
+// Containers joining the sandbox IPC namespace also share the same shm
+// mount, so that POSIX shared memory is actually shared between them.
+func (a *agentGRPC) handleShmMount(spec *specs.Spec) error {
+	for idx, m := range spec.Mounts {
+		if filepath.Clean(m.Destination) != shmDestination || m.Type != typeTmpFs {
+			continue
+		}
+
+		size, err := getShmSize(spec, m)
+		if err != nil {
+			return err
+		}
+
+		if a.sandbox.sharedIPCNs.path == "" {
+			if size > 0 {
+				spec.Mounts[idx].Options = setShmSizeOption(m.Options, size)
+			}
+			return nil
+		}
+
+		if err := a.sandbox.setupSharedShm(size); err != nil {
+			return err
+		}
+
+		spec.Mounts[idx] = specs.Mount{
+			Destination: m.Destination,
+			Source:      sandboxShmPath,
+			Type:        "bind",
+			Options:     []string{"rbind", "nosuid", "nodev"},
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+// setupSharedShm mounts the sandbox shared /dev/shm the first time it is
+// needed. It is only resized when a container requests a size larger than
+// the current one, as shrinking it could hurt the containers using it.
+func (s *sandbox) setupSharedShm(size uint64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.shmMounted && size <= s.shmSize {
+		return nil
+	}
+
+	flags := unix.MS_NOSUID | unix.MS_NODEV | unix.MS_NOEXEC
+	if s.shmMounted {
+		flags |= unix.MS_REMOUNT
+	} else if err := os.MkdirAll(sandboxShmPath, mountPerm); err != nil {
+		return err
+	}
+
+	var options string
+	if size > 0 {
+		options = fmt.Sprintf("%s%d", shmSizeOption, size)
+	}
+
+	if err := mount("shm", sandboxShmPath, typeTmpFs, flags, options); err != nil {
+		return err
+	}
+
+	agentLog.WithFields(logrus.Fields{
+		"path": sandboxShmPath,
+		"size": size,
+	}).Debug("Setup sandbox shared shm")
+
+	if !s.shmMounted {
+		s.mounts = append(s.mounts, sandboxShmPath)
+		s.shmMounted = true
+	}
+	s.shmSize = size
+
+	return nil
+}
@@ -0,0 +1,205 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+)
+
+func setupTestMeminfo(t *testing.T, dir string, content string) func() {
+	file := filepath.Join(dir, "meminfo")
+	err := ioutil.WriteFile(file, []byte(content), testFileMode)
+	assert.NoError(t, err)
+
+	savedMeminfo := meminfo
+	meminfo = file
+
+	return func() {
+		meminfo = savedMeminfo
+	}
+}
+
+func newShmSpec(size string, annotation string) *specs.Spec {
+	spec := &specs.Spec{
+		Mounts: []specs.Mount{
+			{
+				Destination: "/proc",
+				Source:      "proc",
+				Type:        "proc",
+			},
+			{
+				Destination: "/dev/shm",
+				Source:      "shm",
+				Type:        "tmpfs",
+				Options:     []string{"nosuid", "noexec", "nodev", "mode=1777"},
+			},
+		},
+	}
+
+	if size != "" {
+		spec.Mounts[1].Options = append(spec.Mounts[1].Options, "size="+size)
+	}
+
+	if annotation != "" {
+		spec.Annotations = map[string]string{
+			shmSizeAnnotation: annotation,
+		}
+	}
+
+	return spec
+}
+
+func TestParseShmSize(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		size          string
+		expectedBytes uint64
+		expectError   bool
+	}
+
+	data := []testData{
+		{"", 0, true},
+		{"0", 0, true},
+		{"foo", 0, true},
+		{"-1", 0, true},
+		{"10%", 0, true},
+		{"k", 0, true},
+		{"65536", 65536, false},
+		{"64k", 64 << 10, false},
+		{"64M", 64 << 20, false},
+		{"2g", 2 << 30, false},
+	}
+
+	for i, d := range data {
+		bytes, err := parseShmSize(d.size)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.Equal(d.expectedBytes, bytes, "test %d (%+v)", i, d)
+		}
+	}
+}
+
+func TestHandleShmMountPrivateIPC(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	// 1GiB of guest memory
+	defer setupTestMeminfo(t, dir, "MemTotal:       1048576 kB\n")()
+
+	a := &agentGRPC{
+		sandbox: &sandbox{},
+	}
+
+	type testData struct {
+		size            string
+		annotation      string
+		expectedOptions []string
+		expectError     bool
+	}
+
+	defaultOptions := []string{"nosuid", "noexec", "nodev", "mode=1777"}
+
+	data := []testData{
+		{"", "", defaultOptions, false},
+		{"64m", "", append(defaultOptions, "size=67108864"), false},
+		{"", "128m", append(defaultOptions, "size=134217728"), false},
+		{"64m", "128m", append(defaultOptions, "size=134217728"), false},
+		{"2g", "", nil, true},
+		{"", "2g", nil, true},
+		{"", "foo", nil, true},
+	}
+
+	for i, d := range data {
+		spec := newShmSpec(d.size, d.annotation)
+
+		err := a.handleShmMount(spec)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal("tmpfs", spec.Mounts[1].Type, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedOptions, spec.Mounts[1].Options, "test %d (%+v)", i, d)
+	}
+}
+
+func TestHandleShmMountSharedIPC(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	defer setupTestMeminfo(t, dir, "MemTotal:       1048576 kB\n")()
+
+	savedSandboxShmPath := sandboxShmPath
+	defer func() {
+		sandboxShmPath = savedSandboxShmPath
+	}()
+	sandboxShmPath = filepath.Join(dir, "shm")
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			sharedIPCNs: namespace{
+				path: "/proc/self/ns/ipc",
+			},
+		},
+	}
+	defer func() {
+		assert.NoError(removeMounts(a.sandbox.mounts))
+	}()
+
+	shmSize := func() uint64 {
+		var st unix.Statfs_t
+		assert.NoError(unix.Statfs(sandboxShmPath, &st))
+		return st.Blocks * uint64(st.Bsize)
+	}
+
+	spec1 := newShmSpec("64m", "")
+	err = a.handleShmMount(spec1)
+	assert.NoError(err)
+	assert.Equal(uint64(64<<20), shmSize())
+
+	// A container sharing the IPC namespace gets the same mount
+	spec2 := newShmSpec("", "")
+	err = a.handleShmMount(spec2)
+	assert.NoError(err)
+	assert.Equal(uint64(64<<20), shmSize())
+
+	for _, spec := range []*specs.Spec{spec1, spec2} {
+		assert.Equal("bind", spec.Mounts[1].Type)
+		assert.Equal(sandboxShmPath, spec.Mounts[1].Source)
+		assert.Equal("/dev/shm", spec.Mounts[1].Destination)
+	}
+
+	// The shared mount is grown but never shrunk
+	err = a.handleShmMount(newShmSpec("", "128m"))
+	assert.NoError(err)
+	assert.Equal(uint64(128<<20), shmSize())
+
+	err = a.handleShmMount(newShmSpec("32m", ""))
+	assert.NoError(err)
+	assert.Equal(uint64(128<<20), shmSize())
+
+	assert.Equal([]string{sandboxShmPath}, a.sandbox.mounts)
+}
@@ -0,0 +1,618 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/kata-containers/agent/pkg/types"
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+// Environment variable pointing the re-executed agent to the state saved
+// by its predecessor.
+const agentStateEnv = "KATA_AGENT_STATE"
+
+// Value of the file descriptors of a process state when not set.
+const noFd = -1
+
+var (
+	agentStatePath = "/run/kata-containers/agent-state.json"
+
+	// Delay between the RestartAgent reply and the re-execution, so
+	// that the reply reaches the runtime.
+	agentRestartDelay = 500 * time.Millisecond
+)
+
+// loadContainer reopens a container from the libcontainer state, which
+// outlives the agent. Overridden in unit tests.
+var loadContainer = func(sandboxID, containerID string) (libcontainer.Container, error) {
+	factory, err := libcontainer.New(filepath.Join(libcontainerPath, sandboxID), libcontainer.Cgroupfs)
+	if err != nil {
+		return nil, err
+	}
+
+	return factory.Load(containerID)
+}
+
+// processState describes a process, its stdio being referenced by file
+// descriptors inherited over exec.
+type processState struct {
+	ExecID      string
+	Pid         int
+	Stdin       int
+	Stdout      int
+	Stderr      int
+	TermMaster  int
+	StdinClosed bool
+
+	// Nice value requested for the process, if any.
+	Nice *int
+
+	// Output buffering of the process, with the output read but not
+	// delivered yet.
+	OutputBuffering int32
+	FlushInterval   time.Duration
+	StdoutPending   []byte
+	StderrPending   []byte
+
+	// Timestamping of the output streams of the process, with the
+	// stamped output not delivered yet.
+	StdoutTimestamper *outputTimestamper
+	StderrTimestamper *outputTimestamper
+
+	// Copies of the output read by the runtime, if any.
+	StdoutLog *outputLogState
+	StderrLog *outputLogState
+
+	// Notification descriptor of the seccomp filter of the process, when
+	// supervised by the agent.
+	SeccompListener int
+
+	// Exit code of a process reaped but not waited for yet.
+	ExitCode *int
+}
+
+// outputLogState describes an output log file, reopened by the restarted
+// agent.
+type outputLogState struct {
+	Path       string
+	MaxSize    int64
+	MaxBackups int
+}
+
+type containerState struct {
+	ID              string
+	InitExecID      string
+	Mounts          []string
+	UseSandboxPidNs bool
+	UseCgroupNs     bool
+	QuotaDevice     string
+	QuotaProjectID  uint32
+	Personality     *uint32
+	TimeOffsets     *timeOffsets
+	SeccompNotify   *seccompNotify
+	StopSignal      int
+	StopPolicy      string
+	CPUBurst        uint64
+	OOMKillDisable  bool
+	Nice            *int
+	StorageDeps     []string
+	ExposedRootfs   string
+	Processes       []processState
+}
+
+type namespaceState struct {
+	Path    string
+	InitPid int
+}
+
+type sandboxState struct {
+	ID             string
+	Hostname       string
+	Running        bool
+	Created        bool
+	SandboxPidNs   bool
+	SharedPidNs    namespaceState
+	SharedIPCNs    namespaceState
+	SharedUTSNs    namespaceState
+	Mounts         []string
+	Storages       map[string]int
+	PCIDeviceMap   map[string]string
+	Interfaces     map[string]*types.Interface
+	DNS            []string
+	DNSStub        string
+	ProxyInstalled bool
+	ShmMounted     bool
+	ShmSize        uint64
+	QuotaProjectID uint32
+	Containers     []containerState
+}
+
+func fileFd(f *os.File) int {
+	if f == nil {
+		return noFd
+	}
+
+	return int(f.Fd())
+}
+
+func fdFile(fd int, name string) *os.File {
+	if fd == noFd {
+		return nil
+	}
+
+	return os.NewFile(uintptr(fd), name)
+}
+
+func saveOutputLog(r *rotatingFile) *outputLogState {
+	if r == nil {
+		return nil
+	}
+
+	return &outputLogState{
+		Path:       r.path,
+		MaxSize:    r.maxSize,
+		MaxBackups: r.maxBackups,
+	}
+}
+
+// saveOutputTimestamper returns a copy of the timestamper, taken under its
+// lock as the process output may be read meanwhile.
+func saveOutputTimestamper(t *outputTimestamper) *outputTimestamper {
+	if t == nil {
+		return nil
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	return &outputTimestamper{
+		MidLine: t.MidLine,
+		Pending: append([]byte(nil), t.Pending...),
+	}
+}
+
+func restoreOutputLog(state *outputLogState) (*rotatingFile, error) {
+	if state == nil {
+		return nil, nil
+	}
+
+	return newRotatingFile(state.Path, state.MaxSize, state.MaxBackups)
+}
+
+func saveNamespace(ns namespace) namespaceState {
+	state := namespaceState{Path: ns.path}
+	if ns.init != nil {
+		state.InitPid = ns.init.Pid
+	}
+
+	return state
+}
+
+func (p *process) saveState() (processState, error) {
+	pid, err := p.pid()
+	if err != nil {
+		return processState{}, err
+	}
+
+	state := processState{
+		ExecID:      p.id,
+		Pid:         pid,
+		Stdin:       fileFd(p.stdin),
+		Stdout:      fileFd(p.stdout),
+		Stderr:      fileFd(p.stderr),
+		TermMaster:  fileFd(p.termMaster),
+		StdinClosed: p.stdinClosed,
+		Nice:        p.nice,
+
+		StdoutTimestamper: saveOutputTimestamper(p.stdoutTimestamper),
+		StderrTimestamper: saveOutputTimestamper(p.stderrTimestamper),
+
+		StdoutLog: saveOutputLog(p.stdoutLog),
+		StderrLog: saveOutputLog(p.stderrLog),
+	}
+
+	p.RLock()
+	state.SeccompListener = fileFd(p.seccompListener)
+	p.RUnlock()
+
+	if p.outputBuffering != pb.ExecProcessRequest_NONE {
+		state.OutputBuffering = int32(p.outputBuffering)
+		state.FlushInterval = p.flushInterval
+		state.StdoutPending = p.stdoutBuffer.pending()
+		state.StderrPending = p.stderrBuffer.pending()
+	}
+
+	// The exit code of a reaped process waits in the channel, put it
+	// back as the process can still be waited for until the restart.
+	select {
+	case exitCode := <-p.exitCodeCh:
+		state.ExitCode = &exitCode
+		p.exitCodeCh <- exitCode
+	default:
+	}
+
+	return state, nil
+}
+
+// saveState serializes the sandbox, its containers and their processes.
+// The caller must hold the sandbox lock.
+func (s *sandbox) saveState() (*sandboxState, error) {
+	state := &sandboxState{
+		ID:             s.id,
+		Hostname:       s.hostname,
+		Running:        s.running,
+		Created:        s.created,
+		SandboxPidNs:   s.sandboxPidNs,
+		SharedPidNs:    saveNamespace(s.sharedPidNs),
+		SharedIPCNs:    saveNamespace(s.sharedIPCNs),
+		SharedUTSNs:    saveNamespace(s.sharedUTSNs),
+		Mounts:         s.mounts,
+		Storages:       make(map[string]int),
+		PCIDeviceMap:   s.pciDeviceMap,
+		Interfaces:     s.network.ifaces,
+		DNS:            s.network.dns,
+		DNSStub:        s.network.dnsStub,
+		ProxyInstalled: s.network.proxyInstalled,
+		ShmMounted:     s.shmMounted,
+		ShmSize:        s.shmSize,
+		QuotaProjectID: s.quotaProjectID,
+	}
+
+	for mountPoint, storage := range s.storages {
+		state.Storages[mountPoint] = storage.refCount
+	}
+
+	for _, ctr := range s.containers {
+		ctr.RLock()
+
+		ctrState := containerState{
+			ID:              ctr.id,
+			Mounts:          ctr.mounts,
+			UseSandboxPidNs: ctr.useSandboxPidNs,
+			UseCgroupNs:     ctr.useCgroupNs,
+			QuotaDevice:     ctr.quotaDevice,
+			QuotaProjectID:  ctr.quotaProjectID,
+			Personality:     ctr.personality,
+			TimeOffsets:     ctr.timeOffsets,
+			SeccompNotify:   ctr.seccompNotify,
+			StopSignal:      int(ctr.stopSignal),
+			StopPolicy:      ctr.stopPolicy,
+			CPUBurst:        ctr.cpuBurst,
+			OOMKillDisable:  ctr.oomKillDisable,
+			Nice:            ctr.nice,
+			StorageDeps:     ctr.storageDeps,
+			ExposedRootfs:   ctr.exposedRootfs,
+		}
+
+		if ctr.initProcess != nil {
+			ctrState.InitExecID = ctr.initProcess.id
+		}
+
+		for _, proc := range ctr.processes {
+			procState, err := proc.saveState()
+			if err != nil {
+				ctr.RUnlock()
+				return nil, err
+			}
+			ctrState.Processes = append(ctrState.Processes, procState)
+		}
+
+		ctr.RUnlock()
+
+		state.Containers = append(state.Containers, ctrState)
+	}
+
+	return state, nil
+}
+
+// restoreProcess re-adopts a process, registering it to the subreaper so
+// that it can be waited for.
+func (s *sandbox) restoreProcess(state processState) (*process, error) {
+	proc := &process{
+		id:          state.ExecID,
+		restoredPid: state.Pid,
+		stdin:       fdFile(state.Stdin, "stdin"),
+		stdout:      fdFile(state.Stdout, "stdout"),
+		stderr:      fdFile(state.Stderr, "stderr"),
+		termMaster:  fdFile(state.TermMaster, "console"),
+		stdinClosed: state.StdinClosed,
+		nice:        state.Nice,
+		exitCodeCh:  make(chan int, 1),
+
+		stdoutTimestamper: state.StdoutTimestamper,
+		stderrTimestamper: state.StderrTimestamper,
+	}
+
+	var err error
+	if proc.stdoutLog, err = restoreOutputLog(state.StdoutLog); err != nil {
+		return nil, err
+	}
+	if proc.stderrLog, err = restoreOutputLog(state.StderrLog); err != nil {
+		proc.closeOutputLog()
+		return nil, err
+	}
+
+	if listener := fdFile(state.SeccompListener, "seccomp-notify"); listener != nil {
+		go proc.serveSeccompListener(listener)
+	}
+
+	if state.OutputBuffering != 0 {
+		proc.outputBuffering = pb.ExecProcessRequest_OutputBuffering(state.OutputBuffering)
+		proc.flushInterval = state.FlushInterval
+		proc.stdoutBuffer = &outputBuffer{data: state.StdoutPending}
+		proc.stderrBuffer = &outputBuffer{data: state.StderrPending}
+	}
+
+	if state.ExitCode != nil {
+		proc.exitCodeCh <- *state.ExitCode
+		return proc, nil
+	}
+
+	if proc.termMaster != nil {
+		epoller, err := newEpoller()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := epoller.add(proc.termMaster); err != nil {
+			return nil, err
+		}
+
+		proc.epoller = epoller
+		s.subreaper.setEpoller(state.Pid, epoller)
+	}
+
+	s.subreaper.setExitCodeCh(state.Pid, proc.exitCodeCh)
+
+	return proc, nil
+}
+
+func (s *sandbox) restoreNamespace(state namespaceState) namespace {
+	ns := namespace{path: state.Path}
+
+	if state.InitPid > 0 {
+		if init, err := os.FindProcess(state.InitPid); err == nil {
+			exitCodeCh := make(chan int, 1)
+			s.subreaper.setExitCodeCh(state.InitPid, exitCodeCh)
+
+			ns.init = init
+			ns.exitCodeCh = exitCodeCh
+		}
+	}
+
+	return ns
+}
+
+func (s *sandbox) restoreContainer(state containerState) (*container, error) {
+	libContainer, err := loadContainer(s.id, state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctr := &container{
+		id:              state.ID,
+		container:       libContainer,
+		config:          libContainer.Config(),
+		processes:       make(map[string]*process),
+		mounts:          state.Mounts,
+		useSandboxPidNs: state.UseSandboxPidNs,
+		useCgroupNs:     state.UseCgroupNs,
+		ctx:             s.ctx,
+		quotaDevice:     state.QuotaDevice,
+		quotaProjectID:  state.QuotaProjectID,
+		personality:     state.Personality,
+		timeOffsets:     state.TimeOffsets,
+		seccompNotify:   state.SeccompNotify,
+		stopSignal:      syscall.Signal(state.StopSignal),
+		stopPolicy:      state.StopPolicy,
+		cpuBurst:        state.CPUBurst,
+		oomKillDisable:  state.OOMKillDisable,
+		nice:            state.Nice,
+		storageDeps:     state.StorageDeps,
+		exposedRootfs:   state.ExposedRootfs,
+	}
+
+	for _, procState := range state.Processes {
+		proc, err := s.restoreProcess(procState)
+		if err != nil {
+			return nil, err
+		}
+
+		ctr.processes[proc.id] = proc
+		if proc.id == state.InitExecID {
+			ctr.initProcess = proc
+		}
+	}
+
+	ctr.watchOOM()
+	ctr.startWorkingSetSampling()
+	ctr.restoreMemoryHighWatch()
+
+	return ctr, nil
+}
+
+// restoreState rebuilds the sandbox out of the state saved before the
+// agent restart.
+func (s *sandbox) restoreState(state *sandboxState) error {
+	s.Lock()
+	defer s.Unlock()
+
+	s.id = state.ID
+	s.hostname = state.Hostname
+	s.running = state.Running
+	s.created = state.Created
+	s.sandboxPidNs = state.SandboxPidNs
+	s.sharedPidNs = s.restoreNamespace(state.SharedPidNs)
+	s.sharedIPCNs = s.restoreNamespace(state.SharedIPCNs)
+	s.sharedUTSNs = s.restoreNamespace(state.SharedUTSNs)
+	s.mounts = state.Mounts
+	s.network.ifaces = state.Interfaces
+	s.network.dns = state.DNS
+	s.network.dnsStub = state.DNSStub
+	s.network.proxyInstalled = state.ProxyInstalled
+	s.shmMounted = state.ShmMounted
+	s.shmSize = state.ShmSize
+	s.quotaProjectID = state.QuotaProjectID
+
+	if state.PCIDeviceMap != nil {
+		s.pciDeviceMap = state.PCIDeviceMap
+	}
+
+	if s.network.ifaces == nil {
+		s.network.ifaces = make(map[string]*types.Interface)
+	}
+
+	s.storages = make(map[string]*sandboxStorage)
+	for mountPoint, refCount := range state.Storages {
+		s.storages[mountPoint] = &sandboxStorage{refCount: refCount}
+	}
+
+	s.containers = make(map[string]*container)
+	for _, ctrState := range state.Containers {
+		ctr, err := s.restoreContainer(ctrState)
+		if err != nil {
+			return err
+		}
+
+		s.containers[ctr.id] = ctr
+	}
+
+	return nil
+}
+
+// restoreStateFile restores the sandbox from the state file written
+// before the agent restart, and removes it.
+func (s *sandbox) restoreStateFile(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	os.Remove(path)
+
+	var state sandboxState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+
+	if err := s.restoreState(&state); err != nil {
+		return err
+	}
+
+	agentLog.WithFields(logrus.Fields{
+		"sandbox":    state.ID,
+		"containers": len(state.Containers),
+	}).Info("Restored agent state")
+
+	return nil
+}
+
+// stateFds returns the file descriptors referenced by the state.
+func (state *sandboxState) stateFds() []int {
+	var fds []int
+
+	for _, ctr := range state.Containers {
+		for _, proc := range ctr.Processes {
+			for _, fd := range []int{proc.Stdin, proc.Stdout, proc.Stderr, proc.TermMaster, proc.SeccompListener} {
+				if fd != noFd {
+					fds = append(fds, fd)
+				}
+			}
+		}
+	}
+
+	return fds
+}
+
+func setCloseOnExec(fds []int, closeOnExec bool) error {
+	flags := 0
+	if closeOnExec {
+		flags = unix.FD_CLOEXEC
+	}
+
+	for _, fd := range fds {
+		if _, err := unix.FcntlInt(uintptr(fd), unix.F_SETFD, flags); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// prepareRestart saves the agent state and lets the process stdio file
+// descriptors be inherited over exec. On success, the subreaper stays
+// locked until the agent is re-executed, so that the processes exiting in
+// the meantime are left for the restarted agent to reap.
+func (s *sandbox) prepareRestart() ([]int, error) {
+	s.subreaper.lock()
+	s.Lock()
+	defer s.Unlock()
+
+	fds, err := func() ([]int, error) {
+		state, err := s.saveState()
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := json.Marshal(state)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := os.MkdirAll(filepath.Dir(agentStatePath), mountPerm); err != nil {
+			return nil, err
+		}
+
+		if err := ioutil.WriteFile(agentStatePath, data, 0600); err != nil {
+			return nil, err
+		}
+
+		fds := state.stateFds()
+
+		return fds, setCloseOnExec(fds, false)
+	}()
+
+	if err != nil {
+		setCloseOnExec(fds, true)
+		s.subreaper.unlock()
+		return nil, err
+	}
+
+	return fds, nil
+}
+
+// restart re-executes the running agent binary, which restores the saved
+// state. The agent keeps running as before if it cannot be re-executed.
+func (s *sandbox) restart() {
+	time.Sleep(agentRestartDelay)
+
+	fds, err := s.prepareRestart()
+	if err != nil {
+		agentLog.WithError(err).Error("Could not save agent state")
+		return
+	}
+
+	agentLog.Info("Restarting agent")
+
+	env := append(os.Environ(), agentStateEnv+"="+agentStatePath)
+	err = syscall.Exec(selfBinPath, os.Args, env)
+
+	agentLog.WithError(err).Error("Could not restart agent")
+
+	os.Remove(agentStatePath)
+	setCloseOnExec(fds, true)
+	s.subreaper.unlock()
+}
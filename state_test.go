@@ -0,0 +1,308 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func newStateTestSandbox() *sandbox {
+	r := &agentReaper{}
+	r.init()
+
+	return &sandbox{
+		ctx:          context.Background(),
+		containers:   make(map[string]*container),
+		subreaper:    r,
+		pciDeviceMap: make(map[string]string),
+		storages:     make(map[string]*sandboxStorage),
+	}
+}
+
+// dupStateFds duplicates the file descriptors referenced by the state. The
+// restarted agent inherits them over exec, while both the saved and the
+// restored tables live in the test process here.
+func dupStateFds(t *testing.T, state *sandboxState) {
+	for i := range state.Containers {
+		for j := range state.Containers[i].Processes {
+			proc := &state.Containers[i].Processes[j]
+			for _, fd := range []*int{&proc.Stdin, &proc.Stdout, &proc.Stderr, &proc.TermMaster, &proc.SeccompListener} {
+				if *fd == noFd {
+					continue
+				}
+				newFd, err := unix.Dup(*fd)
+				assert.NoError(t, err)
+				*fd = newFd
+			}
+		}
+	}
+}
+
+func TestSaveRestoreState(t *testing.T) {
+	assert := assert.New(t)
+
+	savedLoadContainer := loadContainer
+	defer func() {
+		loadContainer = savedLoadContainer
+	}()
+
+	loadContainer = func(sandboxID, containerID string) (libcontainer.Container, error) {
+		assert.Equal("sandbox", sandboxID)
+		return &mockContainer{id: containerID}, nil
+	}
+
+	// Container init process, still running
+	initCmd := exec.Command("sleep", "60")
+	assert.NoError(initCmd.Start())
+	defer initCmd.Process.Kill()
+
+	// Exec process whose output has not been read yet
+	rStdout, wStdout, err := os.Pipe()
+	assert.NoError(err)
+	defer rStdout.Close()
+
+	execCmd := exec.Command("echo", "hello")
+	execCmd.Stdout = wStdout
+	assert.NoError(execCmd.Run())
+	wStdout.Close()
+
+	// Exec process already reaped, not waited for yet
+	exitedCh := make(chan int, 1)
+	exitedCh <- 3
+
+	logDir, err := ioutil.TempDir("", "output")
+	assert.NoError(err)
+	defer os.RemoveAll(logDir)
+
+	stdoutLog, err := newRotatingFile(filepath.Join(logDir, stdoutLogName), 1024, 2)
+	assert.NoError(err)
+
+	nice := 5
+	execNice := 10
+
+	s := newStateTestSandbox()
+	s.id = "sandbox"
+	s.hostname = "foo"
+	s.running = true
+	s.created = true
+	s.storages["/run/storage"] = &sandboxStorage{refCount: 2}
+	s.network.dns = []string{"nameserver 10.0.0.1"}
+	s.network.proxyInstalled = true
+
+	s.containers["ctr"] = &container{
+		id:     "ctr",
+		mounts: []string{"/run/storage"},
+		nice:   &nice,
+		processes: map[string]*process{
+			"ctr": {
+				id:          "ctr",
+				restoredPid: initCmd.Process.Pid,
+				exitCodeCh:  make(chan int, 1),
+			},
+			"exec": {
+				id:          "exec",
+				restoredPid: execCmd.Process.Pid,
+				stdout:      rStdout,
+				exitCodeCh:  make(chan int, 1),
+				stdoutLog:   stdoutLog,
+				nice:        &execNice,
+			},
+			"exited": {
+				id:          "exited",
+				restoredPid: 123456,
+				exitCodeCh:  exitedCh,
+				stdinClosed: true,
+			},
+		},
+	}
+	s.containers["ctr"].initProcess = s.containers["ctr"].processes["ctr"]
+
+	state, err := s.saveState()
+	assert.NoError(err)
+
+	// The exit code is still available to the saved agent
+	assert.Len(exitedCh, 1)
+
+	data, err := json.Marshal(state)
+	assert.NoError(err)
+
+	var restoredState sandboxState
+	assert.NoError(json.Unmarshal(data, &restoredState))
+	assert.Equal(*state, restoredState)
+
+	dupStateFds(t, &restoredState)
+
+	// The log files are reopened by the restarted agent
+	assert.NoError(stdoutLog.Close())
+
+	// Restore the table as the restarted agent would
+	s2 := newStateTestSandbox()
+	assert.NoError(s2.restoreState(&restoredState))
+
+	assert.Equal("sandbox", s2.id)
+	assert.Equal("foo", s2.hostname)
+	assert.True(s2.running)
+	// A CreateSandbox request replayed after the restart is still
+	// recognized.
+	assert.True(s2.created)
+	assert.Equal(2, s2.storages["/run/storage"].refCount)
+	assert.Equal([]string{"nameserver 10.0.0.1"}, s2.network.dns)
+	assert.True(s2.network.proxyInstalled)
+
+	ctr, err := s2.getContainer("ctr")
+	assert.NoError(err)
+	assert.Equal([]string{"/run/storage"}, ctr.mounts)
+	assert.Equal("ctr", ctr.initProcess.id)
+	assert.Len(ctr.processes, 3)
+	assert.Equal(&nice, ctr.nice)
+	assert.Equal(&execNice, ctr.processes["exec"].nice)
+
+	// The pending output can still be read, and is still logged
+	out, err := s2.readStdio("ctr", "exec", 32, true)
+	assert.NoError(err)
+	assert.Equal("hello\n", string(out))
+
+	restoredLog := ctr.processes["exec"].stdoutLog
+	if assert.NotNil(restoredLog) {
+		defer restoredLog.Close()
+		assert.Equal(1024, int(restoredLog.maxSize))
+		assert.Equal(2, restoredLog.maxBackups)
+	}
+	assert.Nil(ctr.processes["exec"].stderrLog)
+
+	content, err := ioutil.ReadFile(filepath.Join(logDir, stdoutLogName))
+	assert.NoError(err)
+	assert.Equal("hello\n", string(content))
+
+	a := &agentGRPC{sandbox: s2}
+
+	// Exit codes reaped before the restart are preserved
+	resp, err := a.WaitProcess(context.Background(), &pb.WaitProcessRequest{ContainerId: "ctr", ExecId: "exited"})
+	assert.NoError(err)
+	assert.Equal(int32(3), resp.Status)
+
+	proc, err := ctr.getProcess("exited")
+	assert.Error(err)
+	assert.Nil(proc)
+
+	// Exits are tracked by the new subreaper
+	assert.NoError(ctr.initProcess.signal(unix.SIGKILL))
+
+	// Reap until the signal has been delivered
+	for i := 0; i < 100 && len(ctr.initProcess.exitCodeCh) == 0; i++ {
+		assert.NoError(s2.subreaper.reap())
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	resp, err = a.WaitProcess(context.Background(), &pb.WaitProcessRequest{ContainerId: "ctr", ExecId: "ctr"})
+	assert.NoError(err)
+	assert.Equal(int32(exitSignalOffset+int(unix.SIGKILL)), resp.Status)
+}
+
+func TestRestoreStateFile(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "state.json")
+
+	s := newStateTestSandbox()
+	assert.Error(s.restoreStateFile(path))
+
+	assert.NoError(ioutil.WriteFile(path, []byte("{"), testFileMode))
+	assert.Error(s.restoreStateFile(path))
+
+	assert.NoError(ioutil.WriteFile(path, []byte(`{"ID":"sandbox","Running":true}`), testFileMode))
+	assert.NoError(s.restoreStateFile(path))
+	assert.Equal("sandbox", s.id)
+	assert.True(s.running)
+
+	// The state file is consumed
+	_, err = os.Stat(path)
+	assert.True(os.IsNotExist(err))
+}
+
+func TestPrepareRestart(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedAgentStatePath := agentStatePath
+	defer func() {
+		agentStatePath = savedAgentStatePath
+	}()
+	agentStatePath = filepath.Join(dir, "agent", "state.json")
+
+	r, w, err := os.Pipe()
+	assert.NoError(err)
+	defer r.Close()
+	defer w.Close()
+
+	s := newStateTestSandbox()
+	s.id = "sandbox"
+	s.containers["ctr"] = &container{
+		id: "ctr",
+		processes: map[string]*process{
+			"exec": {
+				id:          "exec",
+				restoredPid: os.Getpid(),
+				stdout:      r,
+			},
+		},
+	}
+
+	fds, err := s.prepareRestart()
+	assert.NoError(err)
+	assert.Equal([]int{int(r.Fd())}, fds)
+
+	// The descriptors are inherited over exec
+	flags, err := unix.FcntlInt(r.Fd(), unix.F_GETFD, 0)
+	assert.NoError(err)
+	assert.Zero(flags & unix.FD_CLOEXEC)
+
+	data, err := ioutil.ReadFile(agentStatePath)
+	assert.NoError(err)
+
+	var state sandboxState
+	assert.NoError(json.Unmarshal(data, &state))
+	assert.Equal("sandbox", state.ID)
+	assert.Len(state.Containers, 1)
+
+	// Only the subreaper stays locked until the re-execution
+	assert.NoError(setCloseOnExec(fds, true))
+	s.Lock()
+	s.Unlock()
+	s.subreaper.unlock()
+}
+
+func TestRestartAgentPath(t *testing.T) {
+	assert := assert.New(t)
+
+	a := &agentGRPC{sandbox: newStateTestSandbox()}
+
+	// Only the running agent binary is re-executed
+	_, err := a.RestartAgent(context.Background(), &pb.RestartAgentRequest{Path: "/bin/sh"})
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+}
@@ -0,0 +1,127 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	// Annotation used to request the signal stopping the container
+	// gracefully, such as "SIGQUIT", "QUIT" or "3".
+	stopSignalAnnotation = "io.katacontainers.container.stop_signal"
+
+	// Annotation carrying the StopSignal of the image configuration,
+	// overridden by stopSignalAnnotation.
+	imageStopSignalAnnotation = "org.opencontainers.image.stopSignal"
+
+	defaultStopSignal = syscall.SIGTERM
+
+	// Annotation used to request which processes of the container are
+	// signaled when stopping it, either stopPolicyInit or stopPolicyGroup.
+	stopPolicyAnnotation = "io.katacontainers.container.stop_policy"
+
+	// Only the init process is signaled, managing its children.
+	stopPolicyInit = "init"
+	// All the processes of the container are signaled.
+	stopPolicyGroup = "group"
+
+	// Highest signal number, SIGRTMAX.
+	maxSignal = 64
+)
+
+// parseSignal converts a signal name or number into the signal.
+func parseSignal(s string) (syscall.Signal, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		if n <= 0 || n > maxSignal {
+			return 0, grpcStatus.Errorf(codes.InvalidArgument, "Invalid signal number %d", n)
+		}
+		return syscall.Signal(n), nil
+	}
+
+	name := strings.ToUpper(s)
+	if !strings.HasPrefix(name, "SIG") {
+		name = "SIG" + name
+	}
+
+	for n := 1; n <= maxSignal; n++ {
+		if unix.SignalName(syscall.Signal(n)) == name {
+			return syscall.Signal(n), nil
+		}
+	}
+
+	return 0, grpcStatus.Errorf(codes.InvalidArgument, "Unknown signal %q", s)
+}
+
+// setContainerStopSignal records the stop signal requested by the spec
+// annotations, validating it.
+func (c *container) setContainerStopSignal(spec *specs.Spec) error {
+	for _, annotation := range []string{stopSignalAnnotation, imageStopSignalAnnotation} {
+		value, ok := spec.Annotations[annotation]
+		if !ok {
+			continue
+		}
+
+		signal, err := parseSignal(value)
+		if err != nil {
+			return err
+		}
+
+		c.stopSignal = signal
+		return nil
+	}
+
+	return nil
+}
+
+// getStopSignal returns the signal stopping the container gracefully.
+func (c *container) getStopSignal() syscall.Signal {
+	if c.stopSignal == 0 {
+		return defaultStopSignal
+	}
+
+	return c.stopSignal
+}
+
+// setContainerStopPolicy records the stop policy requested by the spec
+// annotations, validating it.
+func (c *container) setContainerStopPolicy(spec *specs.Spec) error {
+	policy, ok := spec.Annotations[stopPolicyAnnotation]
+	if !ok {
+		return nil
+	}
+
+	if policy != stopPolicyInit && policy != stopPolicyGroup {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Invalid stop policy %q", policy)
+	}
+
+	c.stopPolicy = policy
+	return nil
+}
+
+// signalInit sends the signal to the init process of the container. For a
+// stop or SIGTERM signal, SIGKILL is sent instead when the init process does
+// not handle it, as it would be ignored.
+func (c *container) signalInit(signal syscall.Signal, stop bool) error {
+	pid, err := c.initProcess.pid()
+	if err != nil {
+		return err
+	}
+
+	if (signal == syscall.SIGTERM || stop) && !isSignalHandled(pid, signal) {
+		signal = syscall.SIGKILL
+	}
+
+	return c.container.Signal(signal, false)
+}
@@ -0,0 +1,343 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/opencontainers/runc/libcontainer/specconv"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestParseSignal(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		signal         string
+		expectedSignal syscall.Signal
+		expectError    bool
+	}
+
+	data := []testData{
+		{"", 0, true},
+		{"foo", 0, true},
+		{"SIGFOO", 0, true},
+		{"0", 0, true},
+		{"-1", 0, true},
+		{"65", 0, true},
+		{"3", syscall.SIGQUIT, false},
+		{"QUIT", syscall.SIGQUIT, false},
+		{"SIGQUIT", syscall.SIGQUIT, false},
+		{"sigusr1", syscall.SIGUSR1, false},
+		{"SIGWINCH", syscall.SIGWINCH, false},
+	}
+
+	for i, d := range data {
+		signal, err := parseSignal(d.signal)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.Equal(d.expectedSignal, signal, "test %d (%+v)", i, d)
+		}
+	}
+}
+
+func TestSetContainerStopSignal(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		annotations    map[string]string
+		expectedSignal syscall.Signal
+		expectError    bool
+	}
+
+	data := []testData{
+		{nil, syscall.SIGTERM, false},
+		{map[string]string{stopSignalAnnotation: "foo"}, syscall.SIGTERM, true},
+		{map[string]string{imageStopSignalAnnotation: "SIGQUIT"}, syscall.SIGQUIT, false},
+		{map[string]string{stopSignalAnnotation: "SIGUSR1"}, syscall.SIGUSR1, false},
+		// The annotation overrides the image configuration
+		{map[string]string{stopSignalAnnotation: "SIGUSR1", imageStopSignalAnnotation: "SIGQUIT"}, syscall.SIGUSR1, false},
+	}
+
+	for i, d := range data {
+		ctr := &container{}
+
+		err := ctr.setContainerStopSignal(&specs.Spec{Annotations: d.annotations})
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+		assert.Equal(d.expectedSignal, ctr.getStopSignal(), "test %d (%+v)", i, d)
+	}
+}
+
+func TestSetContainerStopPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		annotations    map[string]string
+		expectedPolicy string
+		expectError    bool
+	}
+
+	data := []testData{
+		{nil, "", false},
+		{map[string]string{stopPolicyAnnotation: "foo"}, "", true},
+		{map[string]string{stopPolicyAnnotation: ""}, "", true},
+		{map[string]string{stopPolicyAnnotation: stopPolicyInit}, stopPolicyInit, false},
+		{map[string]string{stopPolicyAnnotation: stopPolicyGroup}, stopPolicyGroup, false},
+	}
+
+	for i, d := range data {
+		ctr := &container{}
+		err := ctr.setContainerStopPolicy(&specs.Spec{Annotations: d.annotations})
+		if d.expectError {
+			assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+		assert.Equal(d.expectedPolicy, ctr.stopPolicy, "test %d (%+v)", i, d)
+	}
+}
+
+// signalRecorderContainer records the signals sent to the container.
+type signalRecorderContainer struct {
+	mockContainer
+	signals []os.Signal
+}
+
+func (c *signalRecorderContainer) Signal(s os.Signal, all bool) error {
+	c.signals = append(c.signals, s)
+	return nil
+}
+
+func TestSignalProcessStop(t *testing.T) {
+	assert := assert.New(t)
+
+	libContainer := &signalRecorderContainer{
+		mockContainer: mockContainer{
+			status: libcontainer.Running,
+		},
+	}
+
+	cmd := exec.Command("sleep", "10")
+	assert.NoError(cmd.Start())
+	defer cmd.Process.Kill()
+
+	ctr := &container{
+		id:          "foo",
+		initProcess: &process{id: "foo"},
+		container:   libContainer,
+		processes:   make(map[string]*process),
+		stopSignal:  syscall.SIGUSR1,
+		stopPolicy:  stopPolicyGroup,
+	}
+	ctr.processes["bar"] = &process{
+		id:          "bar",
+		restoredPid: cmd.Process.Pid,
+	}
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: map[string]*container{"foo": ctr},
+			running:    true,
+		},
+	}
+
+	// The stop signal replaces the requested signal
+	_, err := a.SignalProcess(context.Background(), &pb.SignalProcessRequest{
+		ContainerId: "foo",
+		Signal:      uint32(syscall.SIGTERM),
+		Stop:        true,
+	})
+	assert.NoError(err)
+	assert.Equal([]os.Signal{syscall.SIGUSR1}, libContainer.signals)
+
+	_, err = a.SignalProcess(context.Background(), &pb.SignalProcessRequest{
+		ContainerId: "foo",
+		Signal:      uint32(syscall.SIGTERM),
+	})
+	assert.NoError(err)
+	assert.Equal([]os.Signal{syscall.SIGUSR1, syscall.SIGTERM}, libContainer.signals)
+
+	_, err = a.SignalProcess(context.Background(), &pb.SignalProcessRequest{
+		ContainerId: "foo",
+		ExecId:      "bar",
+		Stop:        true,
+	})
+	assert.NoError(err)
+
+	err = cmd.Wait()
+	assert.Error(err)
+
+	status, ok := cmd.ProcessState.Sys().(syscall.WaitStatus)
+	if assert.True(ok) {
+		assert.True(status.Signaled())
+		assert.Equal(syscall.SIGUSR1, status.Signal())
+	}
+}
+
+// processRunning returns whether the process is running, zombies excluded.
+func processRunning(pid int) bool {
+	stat, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return false
+	}
+
+	// The state follows the command name, in parentheses.
+	fields := strings.Fields(string(stat[bytes.LastIndexByte(stat, ')')+1:]))
+	return len(fields) > 0 && fields[0] != "Z"
+}
+
+func TestSignalProcessStopPolicy(t *testing.T) {
+	skipUnlessRoot(t)
+
+	assert := assert.New(t)
+
+	type testData struct {
+		policy             string
+		expectChildStopped bool
+	}
+
+	data := []testData{
+		{"", false},
+		{stopPolicyInit, false},
+		{stopPolicyGroup, true},
+	}
+
+	for i, d := range data {
+		dir, err := ioutil.TempDir("", "")
+		assert.NoError(err)
+		defer os.RemoveAll(dir)
+
+		rootfs := filepath.Join(dir, "rootfs")
+		volume := filepath.Join(dir, "volume")
+		for _, d := range []string{rootfs, volume} {
+			assert.NoError(os.MkdirAll(d, testDirMode))
+		}
+
+		// The container shares the PID namespace of the test, its
+		// processes surviving their init process.
+		spec := specconv.Example()
+		spec.Root = &specs.Root{Path: rootfs}
+		spec.Hostname = ""
+		spec.Linux.Resources = nil
+		spec.Linux.MaskedPaths = nil
+		spec.Linux.ReadonlyPaths = nil
+		spec.Linux.Namespaces = []specs.LinuxNamespace{
+			{Type: specs.MountNamespace},
+		}
+		spec.Mounts = append(spec.Mounts, specs.Mount{
+			Source:      volume,
+			Destination: "/volume",
+			Type:        "bind",
+			Options:     []string{"rbind", "rw"},
+		})
+		assert.NoError(addGuestBinaries(spec))
+
+		config, err := specconv.CreateLibcontainerConfig(&specconv.CreateOpts{
+			CgroupName:   fmt.Sprintf("stop-policy-%d", i),
+			NoNewKeyring: true,
+			Spec:         spec,
+		})
+		if !assert.NoError(err, "test %d (%+v)", i, d) {
+			continue
+		}
+
+		factory, err := libcontainer.New(filepath.Join(dir, "state"), libcontainer.Cgroupfs)
+		if !assert.NoError(err, "test %d (%+v)", i, d) {
+			continue
+		}
+
+		libContainer, err := factory.Create("foo", config)
+		if !assert.NoError(err, "test %d (%+v)", i, d) {
+			continue
+		}
+		defer libContainer.Destroy()
+
+		// The init process forks a child, then exits once stopped. The
+		// trap is set after forking, not to be inherited by the child
+		// until it executes.
+		proc := &libcontainer.Process{
+			Args: []string{"/bin/sh", "-c", "sleep 100 & trap 'exit 0' TERM; echo $! > /volume/child; wait"},
+			Env:  []string{"PATH=/usr/bin:/bin"},
+			Cwd:  "/",
+			Init: true,
+		}
+		if !assert.NoError(libContainer.Run(proc), "test %d (%+v)", i, d) {
+			continue
+		}
+
+		var childPid int
+		for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+			content, err := ioutil.ReadFile(filepath.Join(volume, "child"))
+			if err == nil && strings.HasSuffix(string(content), "\n") {
+				childPid, err = strconv.Atoi(strings.TrimSpace(string(content)))
+				assert.NoError(err, "test %d (%+v)", i, d)
+				break
+			}
+		}
+		if !assert.NotZero(childPid, "test %d (%+v)", i, d) {
+			continue
+		}
+
+		initPid, err := proc.Pid()
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		ctr := &container{
+			id:          "foo",
+			initProcess: &process{id: "foo", restoredPid: initPid},
+			container:   libContainer,
+			processes:   make(map[string]*process),
+			stopPolicy:  d.policy,
+		}
+
+		a := &agentGRPC{
+			sandbox: &sandbox{
+				containers: map[string]*container{"foo": ctr},
+				running:    true,
+			},
+		}
+
+		_, err = a.SignalProcess(context.Background(), &pb.SignalProcessRequest{
+			ContainerId: "foo",
+			Stop:        true,
+		})
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		_, err = proc.Wait()
+		assert.NoError(err, "test %d (%+v)", i, d)
+
+		// Give the child the time to handle the signal.
+		running := processRunning(childPid)
+		for start := time.Now(); running && time.Since(start) < time.Second; running = processRunning(childPid) {
+			time.Sleep(10 * time.Millisecond)
+		}
+		assert.Equal(!d.expectChildStopped, running, "test %d (%+v)", i, d)
+
+		syscall.Kill(childPid, syscall.SIGKILL)
+	}
+}
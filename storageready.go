@@ -0,0 +1,94 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Annotation used to start the container without waiting for its storages,
+// set to "false".
+const waitStoragesAnnotation = "io.katacontainers.container.wait_storages"
+
+// Interval between two checks of the container storages readiness.
+var storageReadyPollInterval = 100 * time.Millisecond
+
+// setContainerStorageDeps records the mount points of the storages the
+// container start waits for, unless disabled by the spec annotations. The
+// local storages are plain directories, never mounted.
+func (c *container) setContainerStorageDeps(spec *specs.Spec, storages []*pb.Storage) error {
+	if value, ok := spec.Annotations[waitStoragesAnnotation]; ok {
+		wait, err := strconv.ParseBool(value)
+		if err != nil {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid %s annotation %q", waitStoragesAnnotation, value)
+		}
+		if !wait {
+			return nil
+		}
+	}
+
+	for _, storage := range storages {
+		if storage == nil || storage.Driver == driverLocalType || storage.MountPoint == "" {
+			continue
+		}
+		c.storageDeps = append(c.storageDeps, storage.MountPoint)
+	}
+
+	return nil
+}
+
+// pendingStorages returns the storages of the container not mounted yet,
+// or whose mount point is not reachable.
+func (c *container) pendingStorages() ([]string, error) {
+	mounts, err := guestMountPoints()
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []string
+	for _, mountPoint := range c.storageDeps {
+		if _, mounted := mounts[mountPoint]; !mounted || !pathReachable(mountPoint) {
+			pending = append(pending, mountPoint)
+		}
+	}
+
+	return pending, nil
+}
+
+// waitStoragesReady waits for the storages of the container to be mounted
+// and healthy before it starts.
+func (c *container) waitStoragesReady(timeout time.Duration) error {
+	if len(c.storageDeps) == 0 || timeout == 0 {
+		return nil
+	}
+
+	deadline := time.After(timeout)
+
+	for {
+		pending, err := c.pendingStorages()
+		if err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+
+		select {
+		case <-deadline:
+			return grpcStatus.Errorf(codes.DeadlineExceeded, "Timeout reached after %s waiting for the storages of container %s: %s",
+				timeout, c.id, strings.Join(pending, ", "))
+		case <-time.After(storageReadyPollInterval):
+		}
+	}
+}
@@ -0,0 +1,169 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/opencontainers/runc/libcontainer"
+	mountinfo "github.com/opencontainers/runc/libcontainer/mount"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestSetContainerStorageDeps(t *testing.T) {
+	assert := assert.New(t)
+
+	storages := []*pb.Storage{
+		{Driver: driverBlkType, MountPoint: "/run/kata-containers/foo"},
+		nil,
+		{Driver: driverLocalType, MountPoint: "/run/kata-containers/local"},
+		{Driver: driver9pType, MountPoint: "/run/kata-containers/shared"},
+	}
+
+	type testData struct {
+		annotations  map[string]string
+		storages     []*pb.Storage
+		expectedDeps []string
+		expectError  bool
+	}
+
+	data := []testData{
+		{nil, nil, nil, false},
+		{nil, storages, []string{"/run/kata-containers/foo", "/run/kata-containers/shared"}, false},
+		{map[string]string{waitStoragesAnnotation: "true"}, storages, []string{"/run/kata-containers/foo", "/run/kata-containers/shared"}, false},
+		{map[string]string{waitStoragesAnnotation: "false"}, storages, nil, false},
+		{map[string]string{waitStoragesAnnotation: "foo"}, storages, nil, true},
+	}
+
+	for i, d := range data {
+		ctr := &container{}
+		err := ctr.setContainerStorageDeps(&specs.Spec{Annotations: d.annotations}, d.storages)
+		if d.expectError {
+			assert.Equal(codes.InvalidArgument, grpcStatus.Code(err), "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+		assert.Equal(d.expectedDeps, ctr.storageDeps, "test %d (%+v)", i, d)
+	}
+}
+
+func TestStartContainerStorageReady(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedGetGuestMounts := getGuestMounts
+	savedPollInterval := storageReadyPollInterval
+	savedStorageReadyTimeout := storageReadyTimeout
+	defer func() {
+		getGuestMounts = savedGetGuestMounts
+		storageReadyPollInterval = savedPollInterval
+		storageReadyTimeout = savedStorageReadyTimeout
+	}()
+
+	storageReadyPollInterval = 10 * time.Millisecond
+	storageReadyTimeout = time.Second
+
+	var mountsLock sync.Mutex
+	var mounts []*mountinfo.Info
+	getGuestMounts = func() ([]*mountinfo.Info, error) {
+		mountsLock.Lock()
+		defer mountsLock.Unlock()
+		return mounts, nil
+	}
+
+	storage := filepath.Join(dir, "storage")
+	assert.NoError(os.MkdirAll(storage, testDirMode))
+
+	type testData struct {
+		mountDelay  time.Duration
+		storageDeps []string
+		expectError bool
+	}
+
+	data := []testData{
+		// No storage to wait for
+		{-1, nil, false},
+		{0, []string{storage}, false},
+		// The volume mounts late
+		{200 * time.Millisecond, []string{storage}, false},
+		{-1, []string{storage}, true},
+		// The mount point is not reachable
+		{0, []string{filepath.Join(dir, "missing")}, true},
+	}
+
+	for i, d := range data {
+		mountsLock.Lock()
+		mounts = []*mountinfo.Info{{Mountpoint: "/proc", Fstype: "proc", Source: "proc"}}
+		mountsLock.Unlock()
+
+		ctr := &execRecorderContainer{
+			mockContainer: mockContainer{
+				status: libcontainer.Created,
+			},
+		}
+
+		a := &agentGRPC{
+			sandbox: &sandbox{
+				containers: map[string]*container{
+					"foo": {
+						id:          "foo",
+						container:   ctr,
+						storageDeps: d.storageDeps,
+					},
+				},
+				running: true,
+			},
+		}
+
+		var mountTime time.Time
+		mounted := make(chan struct{})
+		if d.mountDelay >= 0 {
+			go func(deps []string) {
+				defer close(mounted)
+				time.Sleep(d.mountDelay)
+
+				mountsLock.Lock()
+				defer mountsLock.Unlock()
+				for _, dep := range deps {
+					mounts = append(mounts, &mountinfo.Info{Mountpoint: dep, Fstype: "virtiofs", Source: "kataShared"})
+				}
+
+				mountTime = time.Now()
+			}(d.storageDeps)
+		} else {
+			close(mounted)
+		}
+
+		_, err := a.StartContainer(context.Background(), &pb.StartContainerRequest{ContainerId: "foo"})
+		<-mounted
+
+		if d.expectError {
+			assert.Equal(codes.DeadlineExceeded, grpcStatus.Code(err), "test %d (%+v)", i, d)
+			assert.True(ctr.execTime.IsZero(), "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.False(ctr.execTime.IsZero(), "test %d (%+v)", i, d)
+		if len(d.storageDeps) > 0 {
+			assert.True(ctr.execTime.After(mountTime), "test %d (%+v)", i, d)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/mdlayher/vsock"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	syslogTag = "kata-agent"
+
+	// Facility used for all the forwarded entries (LOG_DAEMON)
+	syslogFacility = 3
+
+	syslogDialTimeout = time.Second
+
+	// Entries waiting to be forwarded, further entries being dropped.
+	syslogBufferSize = 1024
+)
+
+// Delays between the connection attempts, doubled after each failure.
+// Set in variables to overwrite for testing.
+var (
+	syslogMinRetryDelay = time.Second
+	syslogMaxRetryDelay = time.Minute
+)
+
+// syslog severities, see RFC 5424.
+const (
+	syslogEmerg   = 0
+	syslogCrit    = 2
+	syslogErr     = 3
+	syslogWarning = 4
+	syslogInfo    = 6
+	syslogDebug   = 7
+)
+
+// parseSyslogDestination validates a syslog destination, expressed as an
+// URL such as vsock://2:514, udp://10.0.0.1:514 or unix:///dev/log, and
+// returns its scheme and address.
+func parseSyslogDestination(dest string) (string, string, error) {
+	u, err := url.Parse(dest)
+	if err != nil {
+		return "", "", grpcStatus.Errorf(codes.InvalidArgument, "Invalid syslog destination %q: %v", dest, err)
+	}
+
+	switch u.Scheme {
+	case "unix", "unixgram":
+		if u.Path == "" {
+			return "", "", grpcStatus.Errorf(codes.InvalidArgument, "Missing syslog socket path in %q", dest)
+		}
+		return u.Scheme, u.Path, nil
+	case "tcp", "udp", "vsock":
+		if _, _, err := net.SplitHostPort(u.Host); err != nil {
+			return "", "", grpcStatus.Errorf(codes.InvalidArgument, "Invalid syslog address in %q: %v", dest, err)
+		}
+		if u.Scheme == "vsock" {
+			if _, _, err := parseVSockAddress(u.Host); err != nil {
+				return "", "", err
+			}
+		}
+		return u.Scheme, u.Host, nil
+	}
+
+	return "", "", grpcStatus.Errorf(codes.InvalidArgument, "Unsupported syslog destination %q", dest)
+}
+
+// parseVSockAddress splits a CID:PORT vsock address.
+func parseVSockAddress(address string) (uint32, uint32, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	cid, err := strconv.ParseUint(host, 10, 32)
+	if err != nil {
+		return 0, 0, grpcStatus.Errorf(codes.InvalidArgument, "Invalid vsock context ID %q", host)
+	}
+
+	p, err := strconv.ParseUint(port, 10, 32)
+	if err != nil {
+		return 0, 0, grpcStatus.Errorf(codes.InvalidArgument, "Invalid vsock port %q", port)
+	}
+
+	return uint32(cid), uint32(p), nil
+}
+
+// dialSyslog connects to the syslog destination. Overridden in unit tests.
+var dialSyslog = func(dest string) (io.WriteCloser, error) {
+	scheme, address, err := parseSyslogDestination(dest)
+	if err != nil {
+		return nil, err
+	}
+
+	if scheme == "vsock" {
+		cid, port, err := parseVSockAddress(address)
+		if err != nil {
+			return nil, err
+		}
+		return dialVSock(cid, port, syslogDialTimeout)
+	}
+
+	return net.DialTimeout(scheme, address, syslogDialTimeout)
+}
+
+// dialVSock connects to the vsock address, giving up after the timeout. A
+// connection established afterwards is closed.
+func dialVSock(cid, port uint32, timeout time.Duration) (io.WriteCloser, error) {
+	type result struct {
+		conn *vsock.Conn
+		err  error
+	}
+
+	resultCh := make(chan result, 1)
+	go func() {
+		conn, err := vsock.Dial(cid, port)
+		resultCh <- result{conn, err}
+	}()
+
+	select {
+	case r := <-resultCh:
+		if r.err != nil {
+			return nil, r.err
+		}
+		return r.conn, nil
+	case <-time.After(timeout):
+		go func() {
+			if r := <-resultCh; r.err == nil {
+				r.conn.Close()
+			}
+		}()
+		return nil, fmt.Errorf("Timeout connecting to vsock %d:%d", cid, port)
+	}
+}
+
+// syslogSeverity maps a logrus level to a syslog severity.
+func syslogSeverity(level logrus.Level) int {
+	switch level {
+	case logrus.PanicLevel:
+		return syslogEmerg
+	case logrus.FatalLevel:
+		return syslogCrit
+	case logrus.ErrorLevel:
+		return syslogErr
+	case logrus.WarnLevel:
+		return syslogWarning
+	case logrus.InfoLevel:
+		return syslogInfo
+	}
+
+	return syslogDebug
+}
+
+// syslogHook forwards the agent log entries to a syslog endpoint, in
+// addition to the regular logger output. The entries are buffered and
+// sent in the background, not to slow the agent down when the endpoint is
+// slow or unreachable.
+type syslogHook struct {
+	dest     string
+	hostname string
+	entries  chan string
+
+	// Entries dropped since the last one forwarded.
+	dropped uint64
+
+	// Connection to the endpoint, and time of the next connection
+	// attempt after failures. Only used by the forwarding goroutine.
+	conn       io.WriteCloser
+	retryDelay time.Duration
+	retryTime  time.Time
+}
+
+func newSyslogHook(dest string) (*syslogHook, error) {
+	if _, _, err := parseSyslogDestination(dest); err != nil {
+		return nil, err
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+
+	h := &syslogHook{
+		dest:     dest,
+		hostname: hostname,
+		entries:  make(chan string, syslogBufferSize),
+	}
+
+	go h.run()
+
+	return h, nil
+}
+
+func (h *syslogHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// format builds a RFC 5424 message out of the entry, the entry fields
+// being carried by the message itself.
+func (h *syslogHook) format(entry *logrus.Entry) (string, error) {
+	msg, err := entry.String()
+	if err != nil {
+		return "", err
+	}
+
+	return h.formatMessage(entry.Level, entry.Time, msg), nil
+}
+
+func (h *syslogHook) formatMessage(level logrus.Level, t time.Time, msg string) string {
+	priority := syslogFacility*8 + syslogSeverity(level)
+
+	return fmt.Sprintf("<%d>1 %s %s %s %d - - %s\n", priority,
+		t.Format(time.RFC3339Nano), h.hostname, syslogTag,
+		os.Getpid(), strings.TrimSpace(msg))
+}
+
+// Fire queues the entry to be sent to the syslog endpoint. Errors cannot
+// be logged from here, the entry is dropped instead when the queue is
+// full.
+func (h *syslogHook) Fire(entry *logrus.Entry) error {
+	msg, err := h.format(entry)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case h.entries <- msg:
+	default:
+		atomic.AddUint64(&h.dropped, 1)
+	}
+
+	return nil
+}
+
+// run sends the queued entries to the syslog endpoint.
+func (h *syslogHook) run() {
+	for msg := range h.entries {
+		h.send(msg)
+	}
+}
+
+// send writes the message to the syslog endpoint, connecting to it on
+// first use and after a write failure. The connection attempts are spaced
+// out while failing, the messages being dropped meanwhile. The number of
+// dropped messages is reported once they can be sent again.
+func (h *syslogHook) send(msg string) {
+	if h.conn == nil && !h.connect() {
+		atomic.AddUint64(&h.dropped, 1)
+		return
+	}
+
+	if dropped := atomic.SwapUint64(&h.dropped, 0); dropped > 0 {
+		notice := h.formatMessage(logrus.WarnLevel, time.Now(), fmt.Sprintf("level=warning msg=\"%d log entries dropped\" source=agent", dropped))
+		if !h.write(notice) {
+			atomic.AddUint64(&h.dropped, dropped+1)
+			return
+		}
+	}
+
+	if !h.write(msg) {
+		atomic.AddUint64(&h.dropped, 1)
+	}
+}
+
+// connect connects to the syslog endpoint, unless the next attempt is
+// not due yet.
+func (h *syslogHook) connect() bool {
+	if time.Now().Before(h.retryTime) {
+		return false
+	}
+
+	conn, err := dialSyslog(h.dest)
+	if err != nil {
+		if h.retryDelay == 0 {
+			h.retryDelay = syslogMinRetryDelay
+		} else if h.retryDelay *= 2; h.retryDelay > syslogMaxRetryDelay {
+			h.retryDelay = syslogMaxRetryDelay
+		}
+		h.retryTime = time.Now().Add(h.retryDelay)
+		return false
+	}
+
+	h.conn = conn
+	h.retryDelay = 0
+	h.retryTime = time.Time{}
+
+	return true
+}
+
+// write writes the message on the connection, which is closed on failure.
+func (h *syslogHook) write(msg string) bool {
+	if _, err := h.conn.Write([]byte(msg)); err != nil {
+		h.conn.Close()
+		h.conn = nil
+		return false
+	}
+
+	return true
+}
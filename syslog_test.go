@@ -0,0 +1,260 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockSyslogSink passes the messages written by the syslog hook on a
+// channel, the hook writing them from its own goroutine.
+type mockSyslogSink struct {
+	sync.Mutex
+	msgs      chan string
+	failWrite bool
+	closed    bool
+}
+
+func newMockSyslogSink() *mockSyslogSink {
+	return &mockSyslogSink{msgs: make(chan string, 16)}
+}
+
+func (s *mockSyslogSink) Write(p []byte) (int, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.failWrite {
+		s.msgs <- ""
+		return 0, errors.New("broken connection")
+	}
+	s.msgs <- string(p)
+	return len(p), nil
+}
+
+func (s *mockSyslogSink) Close() error {
+	s.Lock()
+	defer s.Unlock()
+
+	s.closed = true
+	return nil
+}
+
+func (s *mockSyslogSink) setFailWrite(fail bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.failWrite = fail
+}
+
+// next returns the next message written, "" for a failed write.
+func (s *mockSyslogSink) next() (string, error) {
+	select {
+	case msg := <-s.msgs:
+		return msg, nil
+	case <-time.After(5 * time.Second):
+		return "", errors.New("no message written")
+	}
+}
+
+func TestParseSyslogDestination(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		dest            string
+		expectedScheme  string
+		expectedAddress string
+		expectError     bool
+	}
+
+	data := []testData{
+		{"", "", "", true},
+		{"foo", "", "", true},
+		{"http://10.0.0.1:514", "", "", true},
+		{"udp://10.0.0.1", "", "", true},
+		{"vsock://host:514", "", "", true},
+		{"vsock://2:port", "", "", true},
+		{"unix://", "", "", true},
+		{"udp://10.0.0.1:514", "udp", "10.0.0.1:514", false},
+		{"tcp://[::1]:514", "tcp", "[::1]:514", false},
+		{"vsock://2:514", "vsock", "2:514", false},
+		{"unix:///dev/log", "unix", "/dev/log", false},
+		{"unixgram:///dev/log", "unixgram", "/dev/log", false},
+	}
+
+	for i, d := range data {
+		scheme, address, err := parseSyslogDestination(d.dest)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+			continue
+		}
+
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedScheme, scheme, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedAddress, address, "test %d (%+v)", i, d)
+	}
+}
+
+func newTestSyslogLogger(hook *syslogHook) *logrus.Entry {
+	logger := logrus.New()
+	logger.Out = ioutil.Discard
+	logger.Level = logrus.DebugLevel
+	logger.Formatter = &logrus.TextFormatter{DisableColors: true}
+	logger.AddHook(hook)
+
+	return logger.WithField("source", "agent")
+}
+
+func TestSyslogHook(t *testing.T) {
+	assert := assert.New(t)
+
+	savedDialSyslog := dialSyslog
+	defer func() {
+		dialSyslog = savedDialSyslog
+	}()
+
+	sink := newMockSyslogSink()
+	var dials int32
+	dialSyslog = func(dest string) (io.WriteCloser, error) {
+		atomic.AddInt32(&dials, 1)
+		return sink, nil
+	}
+
+	_, err := newSyslogHook("foo://bar")
+	assert.Error(err)
+
+	hook, err := newSyslogHook("vsock://2:514")
+	assert.NoError(err)
+
+	log := newTestSyslogLogger(hook)
+
+	type testData struct {
+		level            logrus.Level
+		expectedPriority int
+	}
+
+	data := []testData{
+		{logrus.ErrorLevel, 27},
+		{logrus.WarnLevel, 28},
+		{logrus.InfoLevel, 30},
+		{logrus.DebugLevel, 31},
+	}
+
+	for i, d := range data {
+		log.WithField("container", "foo").Log(d.level, "hello")
+
+		msg, err := sink.next()
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.True(strings.HasPrefix(msg, fmt.Sprintf("<%d>1 ", d.expectedPriority)), "test %d (%+v): %q", i, d, msg)
+		assert.Contains(msg, " "+syslogTag+" ", "test %d (%+v)", i, d)
+		assert.Contains(msg, "msg=hello", "test %d (%+v)", i, d)
+		assert.Contains(msg, "container=foo", "test %d (%+v)", i, d)
+		assert.Contains(msg, "source=agent", "test %d (%+v)", i, d)
+		assert.True(strings.HasSuffix(msg, "\n"), "test %d (%+v)", i, d)
+	}
+
+	// The connection is reused
+	assert.Equal(int32(1), atomic.LoadInt32(&dials))
+
+	// A broken connection is closed, and reopened for the next entry, the
+	// lost entry being reported.
+	sink.setFailWrite(true)
+	log.Info("lost")
+	_, err = sink.next()
+	assert.NoError(err)
+
+	sink.setFailWrite(false)
+	log.Info("restored")
+
+	msg, err := sink.next()
+	assert.NoError(err)
+	assert.Contains(msg, "1 log entries dropped")
+	msg, err = sink.next()
+	assert.NoError(err)
+	assert.Contains(msg, "msg=restored")
+
+	assert.Equal(int32(2), atomic.LoadInt32(&dials))
+	sink.Lock()
+	assert.True(sink.closed)
+	sink.Unlock()
+}
+
+func TestSyslogHookRetry(t *testing.T) {
+	assert := assert.New(t)
+
+	savedDialSyslog := dialSyslog
+	savedMinRetryDelay := syslogMinRetryDelay
+	defer func() {
+		dialSyslog = savedDialSyslog
+		syslogMinRetryDelay = savedMinRetryDelay
+	}()
+
+	syslogMinRetryDelay = time.Hour
+
+	sink := newMockSyslogSink()
+	var dials int32
+	dialSyslog = func(dest string) (io.WriteCloser, error) {
+		if atomic.AddInt32(&dials, 1) == 1 {
+			return nil, errors.New("unreachable")
+		}
+		return sink, nil
+	}
+
+	hook, err := newSyslogHook("vsock://2:514")
+	assert.NoError(err)
+
+	log := newTestSyslogLogger(hook)
+
+	// The endpoint is not dialed again before the retry delay, the
+	// entries being dropped meanwhile.
+	for i := 0; i < 3; i++ {
+		log.Info("lost")
+	}
+
+	for i := 0; i < 100 && atomic.LoadUint64(&hook.dropped) < 3; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(uint64(3), atomic.LoadUint64(&hook.dropped))
+	assert.Equal(int32(1), atomic.LoadInt32(&dials))
+}
+
+func TestSyslogHookFull(t *testing.T) {
+	assert := assert.New(t)
+
+	hook := &syslogHook{
+		dest:     "vsock://2:514",
+		hostname: "-",
+		entries:  make(chan string, 1),
+	}
+
+	// Entries are dropped rather than blocking the logger when the queue
+	// is full.
+	log := newTestSyslogLogger(hook)
+	log.Info("queued")
+	log.Info("dropped")
+
+	assert.Len(hook.entries, 1)
+	assert.Equal(uint64(1), atomic.LoadUint64(&hook.dropped))
+}
+
+func TestSyslogSeverity(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(syslogEmerg, syslogSeverity(logrus.PanicLevel))
+	assert.Equal(syslogCrit, syslogSeverity(logrus.FatalLevel))
+	assert.Equal(syslogDebug, syslogSeverity(logrus.TraceLevel))
+}
@@ -0,0 +1,185 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"time"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Annotations used to request a time namespace for the container, with
+// the offsets of its monotonic and boot time clocks, as durations.
+const (
+	timeOffsetMonotonicAnnotation = "io.katacontainers.container.time_offset.monotonic"
+	timeOffsetBoottimeAnnotation  = "io.katacontainers.container.time_offset.boottime"
+)
+
+const (
+	// Not defined by the unix package.
+	cloneNewTime = 0x80
+	memfdCloexec = 0x1
+
+	// The offsets are passed to the libcontainer init process as an extra
+	// file, identified by its name.
+	timeOffsetsName = "kata-time-offsets"
+	timeOffsetsLink = "/memfd:" + timeOffsetsName + " (deleted)"
+
+	// The kernel rejects offsets moving a clock backward past zero, or
+	// forward past half its maximum value, see time_namespaces(7).
+	maxShiftedClock = time.Duration(math.MaxInt64/int64(time.Second)/2) * time.Second
+)
+
+var timeNsPath = "/proc/self/ns/time"
+
+// timeOffsets are the offsets of the clocks of a time namespace.
+type timeOffsets struct {
+	Monotonic time.Duration
+	Boottime  time.Duration
+}
+
+// String returns the offsets in the timens_offsets format, seconds and
+// nanoseconds, the latter being positive.
+func (t *timeOffsets) String() string {
+	split := func(d time.Duration) (int64, int64) {
+		sec, nsec := int64(d/time.Second), int64(d%time.Second)
+		if nsec < 0 {
+			sec--
+			nsec += int64(time.Second)
+		}
+		return sec, nsec
+	}
+
+	monoSec, monoNsec := split(t.Monotonic)
+	bootSec, bootNsec := split(t.Boottime)
+
+	return fmt.Sprintf("monotonic %d %d\nboottime %d %d\n", monoSec, monoNsec, bootSec, bootNsec)
+}
+
+// parseTimeOffset converts the offset of a clock, validating that the
+// shifted clock stays in the range accepted by the kernel.
+func parseTimeOffset(clock int32, value string) (time.Duration, error) {
+	offset, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, grpcStatus.Errorf(codes.InvalidArgument, "Invalid time offset %q: %v", value, err)
+	}
+
+	var now unix.Timespec
+	if err := unix.ClockGettime(clock, &now); err != nil {
+		return 0, err
+	}
+
+	current := time.Duration(now.Nano())
+	if offset < -current || offset > maxShiftedClock-current {
+		return 0, grpcStatus.Errorf(codes.InvalidArgument, "Time offset %q out of range", value)
+	}
+
+	return offset, nil
+}
+
+// setContainerTimeOffsets records the clock offsets requested by the spec
+// annotations, the container sharing the time namespace of the agent if
+// none is.
+func (c *container) setContainerTimeOffsets(spec *specs.Spec) error {
+	monotonic, hasMonotonic := spec.Annotations[timeOffsetMonotonicAnnotation]
+	boottime, hasBoottime := spec.Annotations[timeOffsetBoottimeAnnotation]
+	if !hasMonotonic && !hasBoottime {
+		return nil
+	}
+
+	if _, err := os.Stat(timeNsPath); err != nil {
+		return grpcStatus.Errorf(codes.FailedPrecondition, "Time namespaces not supported: %v", err)
+	}
+
+	var offsets timeOffsets
+	var err error
+
+	if hasMonotonic {
+		if offsets.Monotonic, err = parseTimeOffset(unix.CLOCK_MONOTONIC, monotonic); err != nil {
+			return err
+		}
+	}
+
+	if hasBoottime {
+		if offsets.Boottime, err = parseTimeOffset(unix.CLOCK_BOOTTIME, boottime); err != nil {
+			return err
+		}
+	}
+
+	c.timeOffsets = &offsets
+
+	return nil
+}
+
+// setTimeOffsets passes the clock offsets to the libcontainer init process,
+// for it to create the time namespace of the process.
+func (p *process) setTimeOffsets(offsets *timeOffsets) error {
+	file, err := createMemfd(timeOffsetsName)
+	if err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not pass time offsets: %v", err)
+	}
+
+	if _, err := file.WriteString(offsets.String()); err != nil {
+		file.Close()
+		return err
+	}
+
+	p.process.ExtraFiles = append(p.process.ExtraFiles, file)
+
+	return nil
+}
+
+// setupTimeNamespaceInit creates the time namespace with the offsets passed
+// by the agent to the libcontainer init process, if any. A multi-threaded
+// process cannot join a time namespace, and the offsets can only be written
+// for the main thread: the init process creates the namespace from its main
+// thread, and enters it when executing the container process (Linux 5.11).
+// The processes executed in the container each get their own namespace,
+// with the same offsets, hence the same clocks.
+func setupTimeNamespaceInit() error {
+	fds, err := initExtraFds()
+	if err != nil {
+		return err
+	}
+
+	for _, fd := range fds {
+		link, err := os.Readlink(fmt.Sprintf("/proc/self/fd/%d", fd))
+		if err == nil && link == timeOffsetsLink {
+			return enterTimeNamespace(os.NewFile(uintptr(fd), timeOffsetsName))
+		}
+	}
+
+	return nil
+}
+
+func enterTimeNamespace(file *os.File) error {
+	defer file.Close()
+
+	// The same file is shared by the attempts to spawn the process.
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	offsets, err := ioutil.ReadAll(file)
+	if err != nil {
+		return err
+	}
+
+	if err := unix.Unshare(cloneNewTime); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile("/proc/self/timens_offsets", offsets, 0)
+}
@@ -0,0 +1,190 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Arguments used by TestTimeNamespaceInit to re-execute the test binary,
+// first as the libcontainer init process, then as the container process.
+const (
+	timeNsTestInitArg  = "timens-test-init"
+	timeNsTestClockArg = "timens-test-clock"
+)
+
+// The time namespace has to be created from the main thread, as in the
+// libcontainer init process, hence before the tests run.
+func init() {
+	if len(os.Args) < 2 {
+		return
+	}
+
+	switch os.Args[1] {
+	case timeNsTestInitArg:
+		runtime.LockOSThread()
+		if err := setupTimeNamespaceInit(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		err := unix.Exec(os.Args[0], []string{os.Args[0], timeNsTestClockArg}, nil)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	case timeNsTestClockArg:
+		var now unix.Timespec
+		if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &now); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Println(now.Nano())
+		os.Exit(0)
+	}
+}
+
+func TestTimeOffsetsString(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		offsets  timeOffsets
+		expected string
+	}
+
+	data := []testData{
+		{timeOffsets{}, "monotonic 0 0\nboottime 0 0\n"},
+		{timeOffsets{Monotonic: time.Hour}, "monotonic 3600 0\nboottime 0 0\n"},
+		{timeOffsets{Boottime: 1500 * time.Millisecond}, "monotonic 0 0\nboottime 1 500000000\n"},
+		{timeOffsets{Monotonic: -1500 * time.Millisecond}, "monotonic -2 500000000\nboottime 0 0\n"},
+		{timeOffsets{Monotonic: -time.Second, Boottime: -time.Nanosecond}, "monotonic -1 0\nboottime -1 999999999\n"},
+	}
+
+	for i, d := range data {
+		assert.Equal(d.expected, d.offsets.String(), "test %d (%+v)", i, d)
+	}
+}
+
+func TestParseTimeOffset(t *testing.T) {
+	assert := assert.New(t)
+
+	var now unix.Timespec
+	assert.NoError(unix.ClockGettime(unix.CLOCK_MONOTONIC, &now))
+	current := time.Duration(now.Nano())
+
+	type testData struct {
+		value          string
+		expectedOffset time.Duration
+		expectedCode   codes.Code
+	}
+
+	data := []testData{
+		{"", 0, codes.InvalidArgument},
+		{"foo", 0, codes.InvalidArgument},
+		{"3600", 0, codes.InvalidArgument},
+		{"0s", 0, codes.OK},
+		{"1h", time.Hour, codes.OK},
+		{"-1ms", -time.Millisecond, codes.OK},
+		{"1.5s", 1500 * time.Millisecond, codes.OK},
+		{(-current - time.Hour).String(), 0, codes.InvalidArgument},
+		{(maxShiftedClock).String(), 0, codes.InvalidArgument},
+		{(maxShiftedClock - current - time.Hour).String(), maxShiftedClock - current - time.Hour, codes.OK},
+	}
+
+	for i, d := range data {
+		offset, err := parseTimeOffset(unix.CLOCK_MONOTONIC, d.value)
+		assert.Equal(d.expectedCode, grpcStatus.Code(err), "test %d (%+v): %v", i, d, err)
+		assert.Equal(d.expectedOffset, offset, "test %d (%+v)", i, d)
+	}
+}
+
+func TestSetContainerTimeOffsets(t *testing.T) {
+	assert := assert.New(t)
+
+	ctr := &container{}
+	assert.NoError(ctr.setContainerTimeOffsets(&specs.Spec{}))
+	assert.Nil(ctr.timeOffsets)
+
+	spec := &specs.Spec{
+		Annotations: map[string]string{timeOffsetBoottimeAnnotation: "foo"},
+	}
+	err := ctr.setContainerTimeOffsets(spec)
+	assert.Equal(codes.InvalidArgument, grpcStatus.Code(err))
+	assert.Nil(ctr.timeOffsets)
+
+	spec.Annotations = map[string]string{timeOffsetMonotonicAnnotation: "24h"}
+	assert.NoError(ctr.setContainerTimeOffsets(spec))
+	assert.Equal(&timeOffsets{Monotonic: 24 * time.Hour}, ctr.timeOffsets)
+
+	// The offsets cannot be applied without time namespaces.
+	savedTimeNsPath := timeNsPath
+	defer func() {
+		timeNsPath = savedTimeNsPath
+	}()
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	timeNsPath = filepath.Join(dir, "time")
+	ctr = &container{}
+	err = ctr.setContainerTimeOffsets(spec)
+	assert.Equal(codes.FailedPrecondition, grpcStatus.Code(err))
+	assert.Nil(ctr.timeOffsets)
+}
+
+func TestSetupTimeNamespaceInitNoOffsets(t *testing.T) {
+	assert.NoError(t, setupTimeNamespaceInit())
+}
+
+func TestTimeNamespaceInit(t *testing.T) {
+	skipUnlessRoot(t)
+
+	if _, err := os.Stat(timeNsPath); err != nil {
+		t.Skip("Time namespace support needed")
+	}
+
+	assert := assert.New(t)
+
+	offset := 24 * time.Hour
+
+	proc := &process{}
+	if !assert.NoError(proc.setTimeOffsets(&timeOffsets{Monotonic: offset})) {
+		return
+	}
+	defer proc.closePostStartFDs()
+
+	var before, after unix.Timespec
+	assert.NoError(unix.ClockGettime(unix.CLOCK_MONOTONIC, &before))
+
+	cmd := exec.Command(os.Args[0], timeNsTestInitArg)
+	cmd.ExtraFiles = proc.process.ExtraFiles
+	output, err := cmd.Output()
+	if !assert.NoError(err) {
+		return
+	}
+
+	assert.NoError(unix.ClockGettime(unix.CLOCK_MONOTONIC, &after))
+
+	// The process executed sees the clock shifted by the offset.
+	shifted, err := strconv.ParseInt(strings.TrimSpace(string(output)), 10, 64)
+	assert.NoError(err)
+	assert.True(shifted >= before.Nano()+int64(offset), "%d < %d", shifted, before.Nano()+int64(offset))
+	assert.True(shifted <= after.Nano()+int64(offset), "%d > %d", shifted, after.Nano()+int64(offset))
+}
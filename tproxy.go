@@ -0,0 +1,155 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"net"
+	"os/exec"
+	"strings"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+const (
+	// NAT chain holding the transparent proxy rules, jumped to from the
+	// OUTPUT chain.
+	proxyChain = "KATA_PROXY_OUTPUT"
+
+	// UID that cannot be given as the proxy one, (uid_t)-1.
+	invalidUID = 0xffffffff
+)
+
+// Path overridden in unit tests
+var iptablesRestorePath = "iptables-restore"
+
+// validateTransparentProxy checks the port and UID of the proxy, and the
+// excluded destinations.
+func validateTransparentProxy(req *pb.SetTransparentProxyRequest) error {
+	if req.ProxyPort == 0 || req.ProxyPort > 65535 {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Invalid proxy port %d", req.ProxyPort)
+	}
+
+	// Excluding root would leave the traffic of most guest processes,
+	// the agent included, unredirected.
+	if req.ProxyUid == 0 || req.ProxyUid == invalidUID {
+		return grpcStatus.Errorf(codes.InvalidArgument, "Invalid proxy UID %d", req.ProxyUid)
+	}
+
+	// The rules are only installed for IPv4, IPv6 traffic not being
+	// redirected.
+	for _, cidr := range req.ExcludeCidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Invalid excluded destination %q", cidr)
+		}
+
+		if len(ipNet.Mask) != net.IPv4len {
+			return grpcStatus.Errorf(codes.InvalidArgument, "Excluded destination %q is not IPv4", cidr)
+		}
+	}
+
+	return nil
+}
+
+// transparentProxyRules returns the iptables-restore input installing the
+// proxy chain. Declaring the existing chain flushes it, so that the rules
+// are replaced, the jump to the chain being only added once.
+func transparentProxyRules(req *pb.SetTransparentProxyRequest, installed bool) string {
+	rules := []string{
+		"*nat",
+		fmt.Sprintf(":%s - [0:0]", proxyChain),
+	}
+
+	if !installed {
+		rules = append(rules, fmt.Sprintf("-A OUTPUT -p tcp -j %s", proxyChain))
+	}
+
+	rules = append(rules,
+		fmt.Sprintf("-A %s -m owner --uid-owner %d -j RETURN", proxyChain, req.ProxyUid),
+		fmt.Sprintf("-A %s -d 127.0.0.1/32 -j RETURN", proxyChain))
+
+	for _, cidr := range req.ExcludeCidrs {
+		rules = append(rules, fmt.Sprintf("-A %s -d %s -j RETURN", proxyChain, cidr))
+	}
+
+	rules = append(rules,
+		fmt.Sprintf("-A %s -p tcp -j REDIRECT --to-ports %d", proxyChain, req.ProxyPort),
+		"COMMIT")
+
+	return strings.Join(rules, "\n") + "\n"
+}
+
+// removeTransparentProxyRules returns the iptables-restore input removing
+// the proxy chain and the jump to it.
+func removeTransparentProxyRules() string {
+	rules := []string{
+		"*nat",
+		fmt.Sprintf("-D OUTPUT -p tcp -j %s", proxyChain),
+		fmt.Sprintf("-F %s", proxyChain),
+		fmt.Sprintf("-X %s", proxyChain),
+		"COMMIT",
+	}
+
+	return strings.Join(rules, "\n") + "\n"
+}
+
+// restoreIptables applies the rules in a single transaction, leaving the
+// other rules of the tables untouched.
+func (s *sandbox) restoreIptables(rules string) error {
+	cmd := exec.Command(iptablesRestorePath, "--noflush")
+	cmd.Stdin = strings.NewReader(rules)
+
+	if output, err := s.subreaper.combinedOutput(cmd); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not apply iptables rules: %v: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+func (s *sandbox) setTransparentProxy(req *pb.SetTransparentProxyRequest) error {
+	if err := validateTransparentProxy(req); err != nil {
+		return err
+	}
+
+	s.network.proxyLock.Lock()
+	defer s.network.proxyLock.Unlock()
+
+	if err := s.restoreIptables(transparentProxyRules(req, s.network.proxyInstalled)); err != nil {
+		return err
+	}
+
+	s.network.proxyInstalled = true
+
+	agentLog.WithFields(logrus.Fields{
+		"proxy-port": req.ProxyPort,
+		"proxy-uid":  req.ProxyUid,
+		"excluded":   strings.Join(req.ExcludeCidrs, ","),
+	}).Info("Installed transparent proxy rules")
+
+	return nil
+}
+
+func (s *sandbox) removeTransparentProxy() error {
+	s.network.proxyLock.Lock()
+	defer s.network.proxyLock.Unlock()
+
+	if !s.network.proxyInstalled {
+		return nil
+	}
+
+	if err := s.restoreIptables(removeTransparentProxyRules()); err != nil {
+		return err
+	}
+
+	s.network.proxyInstalled = false
+
+	return nil
+}
@@ -0,0 +1,139 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTransparentProxy(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		req         pb.SetTransparentProxyRequest
+		expectError bool
+	}
+
+	data := []testData{
+		{pb.SetTransparentProxyRequest{}, true},
+		{pb.SetTransparentProxyRequest{ProxyPort: 15001}, true},
+		{pb.SetTransparentProxyRequest{ProxyPort: 65536, ProxyUid: 1337}, true},
+		{pb.SetTransparentProxyRequest{ProxyPort: 15001, ProxyUid: 0xffffffff}, true},
+		{pb.SetTransparentProxyRequest{ProxyPort: 15001, ProxyUid: 1337, ExcludeCidrs: []string{"10.0.0.1"}}, true},
+		{pb.SetTransparentProxyRequest{ProxyPort: 15001, ProxyUid: 1337, ExcludeCidrs: []string{"fd00::/8"}}, true},
+		{pb.SetTransparentProxyRequest{ProxyPort: 15001, ProxyUid: 1337, ExcludeCidrs: []string{"10.0.0.0/8", "::ffff:10.0.0.0/104"}}, true},
+		{pb.SetTransparentProxyRequest{ProxyPort: 15001, ProxyUid: 1337}, false},
+		{pb.SetTransparentProxyRequest{ProxyPort: 65535, ProxyUid: 1337, ExcludeCidrs: []string{"169.254.169.254/32", "10.0.0.0/8"}}, false},
+	}
+
+	for i, d := range data {
+		err := validateTransparentProxy(&d.req)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+		}
+	}
+}
+
+func TestTransparentProxy(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedIptablesRestorePath := iptablesRestorePath
+	defer func() {
+		iptablesRestorePath = savedIptablesRestorePath
+	}()
+
+	restored := filepath.Join(dir, "restored")
+	iptablesRestorePath = filepath.Join(dir, "iptables-restore")
+	script := fmt.Sprintf("#!/bin/sh\necho \"$@\" > %s.args\ncat > %s\n", restored, restored)
+	assert.NoError(ioutil.WriteFile(iptablesRestorePath, []byte(script), 0700))
+
+	s := &sandbox{subreaper: &mockreaper{}}
+
+	// Nothing installed yet
+	assert.NoError(s.removeTransparentProxy())
+	assert.False(s.network.proxyInstalled)
+	_, err = os.Stat(restored)
+	assert.True(os.IsNotExist(err))
+
+	req := &pb.SetTransparentProxyRequest{
+		ProxyPort:    15001,
+		ProxyUid:     1337,
+		ExcludeCidrs: []string{"169.254.169.254/32"},
+	}
+
+	assert.Error(s.setTransparentProxy(&pb.SetTransparentProxyRequest{ProxyPort: 15001}))
+	assert.False(s.network.proxyInstalled)
+
+	assert.NoError(s.setTransparentProxy(req))
+	assert.True(s.network.proxyInstalled)
+
+	args, err := ioutil.ReadFile(restored + ".args")
+	assert.NoError(err)
+	assert.Equal("--noflush\n", string(args))
+
+	content, err := ioutil.ReadFile(restored)
+	assert.NoError(err)
+	assert.Equal(`*nat
+:KATA_PROXY_OUTPUT - [0:0]
+-A OUTPUT -p tcp -j KATA_PROXY_OUTPUT
+-A KATA_PROXY_OUTPUT -m owner --uid-owner 1337 -j RETURN
+-A KATA_PROXY_OUTPUT -d 127.0.0.1/32 -j RETURN
+-A KATA_PROXY_OUTPUT -d 169.254.169.254/32 -j RETURN
+-A KATA_PROXY_OUTPUT -p tcp -j REDIRECT --to-ports 15001
+COMMIT
+`, string(content))
+
+	// Replacing the rules does not jump to the chain twice.
+	req.ProxyPort = 15006
+	req.ExcludeCidrs = nil
+	assert.NoError(s.setTransparentProxy(req))
+
+	content, err = ioutil.ReadFile(restored)
+	assert.NoError(err)
+	assert.Equal(`*nat
+:KATA_PROXY_OUTPUT - [0:0]
+-A KATA_PROXY_OUTPUT -m owner --uid-owner 1337 -j RETURN
+-A KATA_PROXY_OUTPUT -d 127.0.0.1/32 -j RETURN
+-A KATA_PROXY_OUTPUT -p tcp -j REDIRECT --to-ports 15006
+COMMIT
+`, string(content))
+
+	assert.NoError(s.removeTransparentProxy())
+	assert.False(s.network.proxyInstalled)
+
+	content, err = ioutil.ReadFile(restored)
+	assert.NoError(err)
+	assert.Equal(`*nat
+-D OUTPUT -p tcp -j KATA_PROXY_OUTPUT
+-F KATA_PROXY_OUTPUT
+-X KATA_PROXY_OUTPUT
+COMMIT
+`, string(content))
+
+	// Failures are reported, the state being unchanged.
+	script = "#!/bin/sh\necho 'iptables-restore: line 2 failed' >&2\nexit 1\n"
+	assert.NoError(ioutil.WriteFile(iptablesRestorePath, []byte(script), 0700))
+
+	err = s.setTransparentProxy(req)
+	assert.Error(err)
+	assert.True(strings.Contains(err.Error(), "line 2 failed"), err.Error())
+	assert.False(s.network.proxyInstalled)
+}
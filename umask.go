@@ -0,0 +1,153 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Annotation used to request the umask of the container process, as an
+// octal value such as "0027".
+const umaskAnnotation = "io.katacontainers.container.umask"
+
+// Variable set to the descriptor of the socket the umask is passed to the
+// libcontainer init process on.
+const umaskFdEnv = "_KATA_UMASKFD"
+
+// Environment variable libcontainer sets to the kind of init process, the
+// "standard" one creating the container.
+const initTypeEnv = "_LIBCONTAINER_INITTYPE"
+
+// parseUmask converts an octal umask into its value.
+func parseUmask(umask string) (uint32, error) {
+	value, err := strconv.ParseUint(umask, 8, 32)
+	if err != nil || value > 0777 {
+		return 0, grpcStatus.Errorf(codes.InvalidArgument, "Invalid umask %q", umask)
+	}
+
+	return uint32(value), nil
+}
+
+// containerUmask returns the umask requested through the annotation for
+// the container process, nil if none.
+func containerUmask(spec *specs.Spec) (*uint32, error) {
+	umask, ok := spec.Annotations[umaskAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	value, err := parseUmask(umask)
+	if err != nil {
+		return nil, err
+	}
+
+	return &value, nil
+}
+
+// setUmask passes the umask of the process to the libcontainer init
+// process, which sets it before executing the process. The agent umask is
+// left untouched. libcontainer resetting the umask of a container process
+// while finalizing its rootfs, the umask is only sent once it is started.
+func (p *process) setUmask() error {
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_SEQPACKET|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+
+	sock := os.NewFile(uintptr(fds[0]), "umask")
+	peer := os.NewFile(uintptr(fds[1]), "umask-peer")
+
+	if !p.process.Init {
+		if _, err := sock.Write([]byte(fmt.Sprintf("%o", *p.umask))); err != nil {
+			sock.Close()
+			peer.Close()
+			return err
+		}
+	}
+
+	p.umaskSock = sock
+	p.umaskSockPeer = peer
+	p.addInitFd(umaskFdEnv, peer)
+
+	return nil
+}
+
+// applyUmask sends the umask to the started init process of a container,
+// and waits for it to be set. The peer socket must be closed beforehand,
+// not to wait forever if the init process fails.
+func (p *process) applyUmask() error {
+	if _, err := p.umaskSock.Write([]byte(fmt.Sprintf("%o", *p.umask))); err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not set the process umask: %v", err)
+	}
+
+	buf := make([]byte, 1)
+	if n, err := p.umaskSock.Read(buf); err != nil || n != 1 {
+		return grpcStatus.Errorf(codes.Internal, "Could not set the process umask: %v", err)
+	}
+
+	return nil
+}
+
+// setupUmaskInit sets the umask passed by the agent to the libcontainer
+// init process, if any.
+func setupUmaskInit() error {
+	fd, err := initFd(umaskFdEnv)
+	if err != nil || fd < 0 {
+		return err
+	}
+
+	return initUmask(os.NewFile(uintptr(fd), "umask"), os.Getenv(initTypeEnv) == "standard")
+}
+
+// initUmask sets the umask received on the socket. The init process of a
+// container serves the socket in the background instead, the umask being
+// received once the process waits for the container to be started. The
+// socket is closed when the process is executed.
+func initUmask(sock *os.File, standard bool) error {
+	if !standard {
+		defer sock.Close()
+		return receiveUmask(sock)
+	}
+
+	unix.CloseOnExec(int(sock.Fd()))
+
+	go func() {
+		defer sock.Close()
+
+		if err := receiveUmask(sock); err != nil {
+			return
+		}
+
+		sock.Write([]byte{0})
+	}()
+
+	return nil
+}
+
+func receiveUmask(sock *os.File) error {
+	buf := make([]byte, 16)
+	n, err := sock.Read(buf)
+	if err != nil {
+		return err
+	}
+
+	umask, err := parseUmask(string(buf[:n]))
+	if err != nil {
+		return err
+	}
+
+	unix.Umask(int(umask))
+
+	return nil
+}
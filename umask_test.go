@@ -0,0 +1,187 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/opencontainers/runc/libcontainer"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+)
+
+// currentUmask returns the agent umask, which can only be read by
+// setting it.
+func currentUmask() uint32 {
+	umask := unix.Umask(0)
+	unix.Umask(umask)
+	return uint32(umask)
+}
+
+func TestParseUmask(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		umask         string
+		expectedValue uint32
+		expectError   bool
+	}
+
+	data := []testData{
+		{"", 0, true},
+		{"foo", 0, true},
+		{"-1", 0, true},
+		{"0800", 0, true},
+		{"01000", 0, true},
+		{"0", 0, false},
+		{"022", 0022, false},
+		{"0027", 0027, false},
+		{"777", 0777, false},
+	}
+
+	for i, d := range data {
+		value, err := parseUmask(d.umask)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.Equal(d.expectedValue, value, "test %d (%+v)", i, d)
+		}
+	}
+}
+
+func TestContainerUmask(t *testing.T) {
+	assert := assert.New(t)
+
+	spec := &specs.Spec{}
+
+	umask, err := containerUmask(spec)
+	assert.NoError(err)
+	assert.Nil(umask)
+
+	spec.Annotations = map[string]string{umaskAnnotation: "9"}
+	_, err = containerUmask(spec)
+	assert.Error(err)
+
+	spec.Annotations[umaskAnnotation] = "0077"
+	umask, err = containerUmask(spec)
+	assert.NoError(err)
+	if assert.NotNil(umask) {
+		assert.Equal(uint32(0077), *umask)
+	}
+}
+
+func TestExecProcessUmask(t *testing.T) {
+	assert := assert.New(t)
+
+	savedSpawnProcess := spawnProcess
+	savedUmask := currentUmask()
+	defer func() {
+		spawnProcess = savedSpawnProcess
+		unix.Umask(int(savedUmask))
+	}()
+
+	// The init process umask is set by the agent hook, run in place
+	// on a duplicate of the socket listed as passed.
+	var spawnUmask uint32
+	var spawnFiles int
+	spawnProcess = func(c libcontainer.Container, p *libcontainer.Process, createContainer bool) (int, error) {
+		spawnUmask = currentUmask()
+		spawnFiles = len(p.ExtraFiles)
+		if spawnFiles == 0 {
+			return 1234, nil
+		}
+
+		list := p.ExtraFiles[spawnFiles-1]
+		umaskFd, err := lookupInitFd(int(list.Fd()), umaskFdEnv)
+		if err != nil {
+			return -1, err
+		}
+
+		fd, err := unix.Dup(int(p.ExtraFiles[umaskFd-stdioFdCount].Fd()))
+		if err != nil {
+			return -1, err
+		}
+		if err := initUmask(os.NewFile(uintptr(fd), "umask"), createContainer); err != nil {
+			return -1, err
+		}
+		return 1234, nil
+	}
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			subreaper: &mockreaper{},
+		},
+	}
+
+	ctr := &container{
+		container: &mockContainer{},
+	}
+
+	umask := uint32(0027)
+
+	type testData struct {
+		umask           *uint32
+		createContainer bool
+		expectedUmask   uint32
+	}
+
+	data := []testData{
+		{nil, false, savedUmask},
+		{nil, true, savedUmask},
+		{&umask, false, umask},
+		{&umask, true, umask},
+	}
+
+	for i, d := range data {
+		unix.Umask(int(savedUmask))
+
+		proc := &process{umask: d.umask}
+		proc.process.Init = d.createContainer
+		assert.NoError(a.execProcess(ctr, proc, d.createContainer), "test %d (%+v)", i, d)
+
+		// The agent umask is left untouched while spawning
+		assert.Equal(savedUmask, spawnUmask, "test %d (%+v)", i, d)
+		if d.umask != nil {
+			assert.Equal(2, spawnFiles, "test %d (%+v)", i, d)
+		} else {
+			assert.Equal(0, spawnFiles, "test %d (%+v)", i, d)
+		}
+		assert.Equal(d.expectedUmask, currentUmask(), "test %d (%+v)", i, d)
+	}
+}
+
+func TestExecProcessUmaskInitFailure(t *testing.T) {
+	assert := assert.New(t)
+
+	savedSpawnProcess := spawnProcess
+	defer func() {
+		spawnProcess = savedSpawnProcess
+	}()
+
+	// The init process exits without setting the umask
+	spawnProcess = func(c libcontainer.Container, p *libcontainer.Process, createContainer bool) (int, error) {
+		return 1234, nil
+	}
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			subreaper: &mockreaper{},
+		},
+	}
+
+	ctr := &container{
+		container: &mockContainer{},
+	}
+
+	umask := uint32(0027)
+	proc := &process{umask: &umask}
+	proc.process.Init = true
+	assert.Error(a.execProcess(ctr, proc, true))
+}
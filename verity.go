@@ -0,0 +1,461 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Device-mapper ioctl interface, see include/uapi/linux/dm-ioctl.h.
+const (
+	dmControlPath = "/dev/mapper/control"
+	dmDevicesDir  = "/dev/mapper"
+
+	dmVersionMajor = 4
+
+	// _IOWR(0xfd, nr, struct dm_ioctl), identical on all the supported
+	// architectures.
+	dmIoctlBase = 0xc138fd00
+
+	dmDevCreate   = dmIoctlBase | 3
+	dmDevRemove   = dmIoctlBase | 4
+	dmDevSuspend  = dmIoctlBase | 6
+	dmTableLoad   = dmIoctlBase | 9
+	dmTableStatus = dmIoctlBase | 12
+
+	dmReadOnlyFlag    = 1 << 0
+	dmDeferredRemove  = 1 << 17
+	dmIoctlBufferSize = 16 * 1024
+	dmTargetTypeLen   = 16
+	dmTargetSpecSize  = int(unsafe.Sizeof(dmTargetSpec{}))
+	dmIoctlHeaderSize = int(unsafe.Sizeof(dmIoctl{}))
+	dmSectorSize      = 512
+)
+
+const (
+	verityTargetType       = "verity"
+	verityNamePrefix       = "kata-verity-"
+	verityDefaultHash      = "sha256"
+	verityDefaultBlockSize = 4096
+
+	// Status of a verity target which met a hash mismatch.
+	verityCorruptedStatus = "C"
+)
+
+// dmIoctl matches struct dm_ioctl.
+type dmIoctl struct {
+	version     [3]uint32
+	dataSize    uint32
+	dataStart   uint32
+	targetCount uint32
+	openCount   int32
+	flags       uint32
+	eventNr     uint32
+	_           uint32
+	dev         uint64
+	name        [128]byte
+	uuid        [129]byte
+	_           [7]byte
+}
+
+// dmTargetSpec matches struct dm_target_spec.
+type dmTargetSpec struct {
+	sectorStart uint64
+	length      uint64
+	status      int32
+	next        uint32
+	targetType  [dmTargetTypeLen]byte
+}
+
+// dmTarget describes a device-mapper table entry.
+type dmTarget struct {
+	length     uint64
+	targetType string
+	params     string
+}
+
+// dmIoctlCall issues a device-mapper command on the named device. The
+// payload follows the header, and the returned buffer holds the reply.
+func dmIoctlCall(cmd uintptr, name string, flags uint32, targets []dmTarget) (*dmIoctl, []byte, error) {
+	control, err := os.OpenFile(dmControlPath, os.O_RDWR, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer control.Close()
+
+	// Keep the buffer 8-byte aligned, as expected for the headers.
+	backing := make([]uint64, dmIoctlBufferSize/8)
+	buf := (*[dmIoctlBufferSize]byte)(unsafe.Pointer(&backing[0]))[:]
+
+	hdr := (*dmIoctl)(unsafe.Pointer(&buf[0]))
+	hdr.version = [3]uint32{dmVersionMajor, 0, 0}
+	hdr.dataSize = dmIoctlBufferSize
+	hdr.dataStart = uint32(dmIoctlHeaderSize)
+	hdr.flags = flags
+	hdr.targetCount = uint32(len(targets))
+	copy(hdr.name[:len(hdr.name)-1], name)
+
+	offset := dmIoctlHeaderSize
+	var sector uint64
+	for _, t := range targets {
+		// The parameters are NUL terminated, the next spec being 8-byte
+		// aligned.
+		size := (dmTargetSpecSize + len(t.params) + 1 + 7) &^ 7
+		if offset+size > dmIoctlBufferSize {
+			return nil, nil, grpcStatus.Errorf(codes.InvalidArgument, "Device-mapper table of %s too large", name)
+		}
+
+		spec := (*dmTargetSpec)(unsafe.Pointer(&buf[offset]))
+		spec.sectorStart = sector
+		spec.length = t.length
+		spec.next = uint32(size)
+		copy(spec.targetType[:dmTargetTypeLen-1], t.targetType)
+		copy(buf[offset+dmTargetSpecSize:], t.params)
+
+		sector += t.length
+		offset += size
+	}
+
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, control.Fd(), cmd, uintptr(unsafe.Pointer(&buf[0])))
+	if errno != 0 {
+		return nil, nil, errno
+	}
+
+	return hdr, buf, nil
+}
+
+// dmTableStatuses returns the status string of each target of the device.
+func dmTableStatuses(name string) ([]string, error) {
+	hdr, buf, err := dmIoctlCall(dmTableStatus, name, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []string
+	offset := int(hdr.dataStart)
+	for i := uint32(0); i < hdr.targetCount; i++ {
+		if offset+dmTargetSpecSize > len(buf) {
+			break
+		}
+		spec := (*dmTargetSpec)(unsafe.Pointer(&buf[offset]))
+
+		status := buf[offset+dmTargetSpecSize:]
+		if end := strings.IndexByte(string(status), 0); end >= 0 {
+			status = status[:end]
+		}
+		statuses = append(statuses, string(status))
+
+		offset = int(hdr.dataStart) + int(spec.next)
+		if spec.next == 0 {
+			break
+		}
+	}
+
+	return statuses, nil
+}
+
+// dmRemoveDevice removes the device. With deferred set, the removal of a
+// device still in use is postponed until its last user closes it.
+func dmRemoveDevice(name string, deferred bool) error {
+	var flags uint32
+	if deferred {
+		flags = dmDeferredRemove
+	}
+
+	_, _, err := dmIoctlCall(dmDevRemove, name, flags, nil)
+	return err
+}
+
+// verityParams holds the dm-verity settings of a storage.
+type verityParams struct {
+	dataDevice    string
+	hashDevice    string
+	rootHash      string
+	salt          string
+	hashAlgorithm string
+	dataBlockSize uint64
+	hashBlockSize uint64
+	dataBlocks    uint64
+	hashOffset    uint64
+}
+
+// parseVerityOptions reads the dm-verity settings from the driver options:
+// "hash_device" and "root_hash" are mandatory, while "salt",
+// "hash_algorithm", "data_block_size", "hash_block_size", "data_blocks"
+// and "hash_offset" (in bytes) are optional.
+func parseVerityOptions(dataDevice string, driverOptions []string) (*verityParams, error) {
+	opts := parseOptions(driverOptions)
+
+	params := &verityParams{
+		dataDevice:    dataDevice,
+		hashDevice:    opts["hash_device"],
+		rootHash:      strings.ToLower(opts["root_hash"]),
+		salt:          opts["salt"],
+		hashAlgorithm: opts["hash_algorithm"],
+		dataBlockSize: verityDefaultBlockSize,
+		hashBlockSize: verityDefaultBlockSize,
+	}
+
+	if params.hashDevice == "" {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "Missing verity hash device")
+	}
+
+	if _, err := hex.DecodeString(params.rootHash); err != nil || params.rootHash == "" {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "Invalid verity root hash %q", params.rootHash)
+	}
+
+	if params.salt == "" {
+		params.salt = "-"
+	} else if _, err := hex.DecodeString(params.salt); err != nil {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "Invalid verity salt %q", params.salt)
+	}
+
+	if params.hashAlgorithm == "" {
+		params.hashAlgorithm = verityDefaultHash
+	}
+
+	for _, o := range []struct {
+		name  string
+		value *uint64
+	}{
+		{"data_block_size", &params.dataBlockSize},
+		{"hash_block_size", &params.hashBlockSize},
+		{"data_blocks", &params.dataBlocks},
+		{"hash_offset", &params.hashOffset},
+	} {
+		val, ok := opts[o.name]
+		if !ok {
+			continue
+		}
+
+		v, err := strconv.ParseUint(val, 10, 64)
+		if err != nil {
+			return nil, grpcStatus.Errorf(codes.InvalidArgument, "Invalid verity option %s=%q", o.name, val)
+		}
+		*o.value = v
+	}
+
+	for _, size := range []uint64{params.dataBlockSize, params.hashBlockSize} {
+		if size < dmSectorSize || size&(size-1) != 0 {
+			return nil, grpcStatus.Errorf(codes.InvalidArgument, "Invalid verity block size %d", size)
+		}
+	}
+
+	if params.hashOffset%params.hashBlockSize != 0 {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument,
+			"Verity hash offset %d is not a multiple of the hash block size", params.hashOffset)
+	}
+
+	return params, nil
+}
+
+// table returns the dm-verity table line, see
+// Documentation/admin-guide/device-mapper/verity.rst.
+func (p *verityParams) table() string {
+	return fmt.Sprintf("1 %s %s %d %d %d %d %s %s %s",
+		p.dataDevice, p.hashDevice, p.dataBlockSize, p.hashBlockSize,
+		p.dataBlocks, p.hashOffset/p.hashBlockSize, p.hashAlgorithm,
+		p.rootHash, p.salt)
+}
+
+// getBlockDeviceSize returns the size of the block device in bytes.
+func getBlockDeviceSize(path string) (uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	var size uint64
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), unix.BLKGETSIZE64, uintptr(unsafe.Pointer(&size)))
+	if errno != 0 {
+		return 0, errno
+	}
+
+	return size, nil
+}
+
+// verityDeviceName returns the device-mapper name of the verity device
+// mounted at mountPoint.
+func verityDeviceName(mountPoint string) string {
+	sum := sha256.Sum256([]byte(mountPoint))
+	return verityNamePrefix + hex.EncodeToString(sum[:8])
+}
+
+// createVerityDevice sets up a read-only dm-verity device and returns the
+// path of its node.
+func createVerityDevice(name string, params *verityParams) (devPath string, err error) {
+	if params.dataBlocks == 0 {
+		size, err := getBlockDeviceSize(params.dataDevice)
+		if err != nil {
+			return "", grpcStatus.Errorf(codes.FailedPrecondition, "Could not get the size of %s: %v", params.dataDevice, err)
+		}
+		params.dataBlocks = size / params.dataBlockSize
+	}
+
+	if params.dataBlocks == 0 {
+		return "", grpcStatus.Errorf(codes.InvalidArgument, "Empty verity data device %s", params.dataDevice)
+	}
+
+	hdr, _, err := dmIoctlCall(dmDevCreate, name, dmReadOnlyFlag, nil)
+	if err != nil {
+		return "", grpcStatus.Errorf(codes.Internal, "Could not create device %s: %v", name, err)
+	}
+	dev := hdr.dev
+
+	defer func() {
+		if err != nil {
+			if rmErr := dmRemoveDevice(name, false); rmErr != nil {
+				agentLog.WithError(rmErr).WithField("device", name).Error("Could not remove verity device")
+			}
+		}
+	}()
+
+	target := dmTarget{
+		length:     params.dataBlocks * params.dataBlockSize / dmSectorSize,
+		targetType: verityTargetType,
+		params:     params.table(),
+	}
+
+	if _, _, err := dmIoctlCall(dmTableLoad, name, dmReadOnlyFlag, []dmTarget{target}); err != nil {
+		return "", grpcStatus.Errorf(codes.InvalidArgument, "Could not load verity table of %s: %v", name, err)
+	}
+
+	// Resuming the device activates the loaded table.
+	if _, _, err := dmIoctlCall(dmDevSuspend, name, 0, nil); err != nil {
+		return "", grpcStatus.Errorf(codes.Internal, "Could not activate device %s: %v", name, err)
+	}
+
+	// The device node is normally created by udev, which is not running
+	// in the guest.
+	if err := os.MkdirAll(dmDevicesDir, 0755); err != nil {
+		return "", err
+	}
+
+	devPath = filepath.Join(dmDevicesDir, name)
+	os.Remove(devPath)
+	devNum := unix.Mkdev(unix.Major(dev), unix.Minor(dev))
+	if err := unix.Mknod(devPath, unix.S_IFBLK|0600, int(devNum)); err != nil {
+		return "", grpcStatus.Errorf(codes.Internal, "Could not create node of device %s: %v", name, err)
+	}
+
+	return devPath, nil
+}
+
+// checkVerityDevice fails if the kernel detected a hash mismatch on the
+// device.
+func checkVerityDevice(name string) error {
+	statuses, err := dmTableStatuses(name)
+	if err != nil {
+		return grpcStatus.Errorf(codes.Internal, "Could not get status of device %s: %v", name, err)
+	}
+
+	for _, status := range statuses {
+		if strings.TrimSpace(status) == verityCorruptedStatus {
+			return grpcStatus.Errorf(codes.DataLoss, "Verity device %s is corrupted", name)
+		}
+	}
+
+	return nil
+}
+
+// resolveBlockDevice returns the path of the block device, identified by
+// its path or by its PCI address.
+func resolveBlockDevice(s *sandbox, source string) (string, error) {
+	if strings.HasPrefix(source, "/dev") {
+		return source, nil
+	}
+
+	return getPCIDeviceName(s, source)
+}
+
+// verityStorageHandler handles the storage for the verity driver: the data
+// device is mounted read-only through a dm-verity device checking its
+// blocks against the hash tree of the hash device, a hash mismatch making
+// the reads, and thus the mount, fail.
+func verityStorageHandler(_ context.Context, storage pb.Storage, s *sandbox) (mountPoint string, err error) {
+	dataDevice, err := resolveBlockDevice(s, storage.Source)
+	if err != nil {
+		return "", err
+	}
+
+	params, err := parseVerityOptions(dataDevice, storage.DriverOptions)
+	if err != nil {
+		return "", err
+	}
+
+	if params.hashDevice, err = resolveBlockDevice(s, params.hashDevice); err != nil {
+		return "", err
+	}
+
+	name := verityDeviceName(storage.MountPoint)
+	devPath, err := createVerityDevice(name, params)
+	if err != nil {
+		return "", err
+	}
+
+	mounted := false
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		if mounted {
+			if umountErr := unmountWithTimeout(storage.MountPoint); umountErr != nil {
+				agentLog.WithError(umountErr).WithField("mount-point", storage.MountPoint).Error("Could not unmount verity storage")
+			}
+		}
+
+		os.Remove(devPath)
+		if rmErr := dmRemoveDevice(name, false); rmErr != nil {
+			agentLog.WithError(rmErr).WithField("device", name).Error("Could not remove verity device")
+		}
+	}()
+
+	storage.Source = devPath
+	storage.Options = append(storage.Options, "ro")
+	if err := mountStorage(storage); err != nil {
+		// Report a hash mismatch met while reading the superblock
+		if checkErr := checkVerityDevice(name); checkErr != nil {
+			return "", checkErr
+		}
+		return "", err
+	}
+	mounted = true
+
+	if err := checkVerityDevice(name); err != nil {
+		return "", err
+	}
+
+	// Let the kernel remove the device once unmounted.
+	if err := dmRemoveDevice(name, true); err != nil {
+		return "", grpcStatus.Errorf(codes.Internal, "Could not schedule removal of device %s: %v", name, err)
+	}
+
+	agentLog.WithFields(logrus.Fields{
+		"device":      name,
+		"data-device": params.dataDevice,
+		"hash-device": params.hashDevice,
+		"mount-point": storage.MountPoint,
+	}).Info("Mounted verity storage")
+
+	return storage.MountPoint, nil
+}
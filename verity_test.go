@@ -0,0 +1,197 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"unsafe"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+)
+
+const testRootHash = "4392712ba01368efdf14b05c76f9e4df0d53664630b5d48632ed17a137f39076"
+
+func TestDmIoctlSizes(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(uintptr(312), unsafe.Sizeof(dmIoctl{}))
+	assert.Equal(uintptr(40), unsafe.Sizeof(dmTargetSpec{}))
+}
+
+func TestParseVerityOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	type testData struct {
+		options       []string
+		expectedTable string
+		expectError   bool
+	}
+
+	data := []testData{
+		{[]string{}, "", true},
+		{[]string{"root_hash=" + testRootHash}, "", true},
+		{[]string{"hash_device=/dev/vdc"}, "", true},
+		{[]string{"hash_device=/dev/vdc", "root_hash=foo"}, "", true},
+		{[]string{"hash_device=/dev/vdc", "root_hash=" + testRootHash, "salt=bar"}, "", true},
+		{[]string{"hash_device=/dev/vdc", "root_hash=" + testRootHash, "data_block_size=foo"}, "", true},
+		{[]string{"hash_device=/dev/vdc", "root_hash=" + testRootHash, "data_block_size=256"}, "", true},
+		{[]string{"hash_device=/dev/vdc", "root_hash=" + testRootHash, "hash_block_size=1000"}, "", true},
+		{[]string{"hash_device=/dev/vdc", "root_hash=" + testRootHash, "hash_offset=512"}, "", true},
+		{
+			[]string{"hash_device=/dev/vdc", "root_hash=" + strings.ToUpper(testRootHash)},
+			"1 /dev/vdb /dev/vdc 4096 4096 0 0 sha256 " + testRootHash + " -",
+			false,
+		},
+		{
+			[]string{"hash_device=/dev/vdc", "root_hash=" + testRootHash, "salt=abcd",
+				"hash_algorithm=sha512", "data_block_size=1024", "hash_block_size=512",
+				"data_blocks=100", "hash_offset=1048576"},
+			"1 /dev/vdb /dev/vdc 1024 512 100 2048 sha512 " + testRootHash + " abcd",
+			false,
+		},
+	}
+
+	for i, d := range data {
+		params, err := parseVerityOptions("/dev/vdb", d.options)
+		if d.expectError {
+			assert.Error(err, "test %d (%+v)", i, d)
+		} else {
+			assert.NoError(err, "test %d (%+v)", i, d)
+			assert.Equal(d.expectedTable, params.table(), "test %d (%+v)", i, d)
+		}
+	}
+}
+
+func TestVerityDeviceName(t *testing.T) {
+	assert := assert.New(t)
+
+	name := verityDeviceName("/run/kata-containers/shared/containers/foo/rootfs")
+	assert.True(strings.HasPrefix(name, verityNamePrefix))
+	assert.Len(name, len(verityNamePrefix)+16)
+	assert.NotEqual(name, verityDeviceName("/run/kata-containers/shared/containers/bar/rootfs"))
+}
+
+func setupVerityLoopDevice(t *testing.T, file string) (string, func()) {
+	out, err := exec.Command("losetup", "--find", "--show", file).Output()
+	assert.NoError(t, err)
+
+	dev := strings.TrimSpace(string(out))
+
+	return dev, func() {
+		exec.Command("losetup", "--detach", dev).Run()
+	}
+}
+
+func TestVerityStorageHandler(t *testing.T) {
+	skipUnlessRoot(t)
+
+	if _, err := os.Stat(dmControlPath); err != nil {
+		t.Skip("dm-verity support needed")
+	}
+
+	for _, cmd := range []string{"losetup", "mkfs.ext4", "veritysetup"} {
+		if _, err := exec.LookPath(cmd); err != nil {
+			t.Skipf("%s needed", cmd)
+		}
+	}
+
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	content := filepath.Join(dir, "content")
+	assert.NoError(os.Mkdir(content, testDirMode))
+	assert.NoError(ioutil.WriteFile(filepath.Join(content, "foo"), []byte("bar"), testFileMode))
+
+	dataFile := filepath.Join(dir, "data.img")
+	hashFile := filepath.Join(dir, "hash.img")
+
+	assert.NoError(exec.Command("truncate", "-s", "8M", dataFile).Run())
+	assert.NoError(exec.Command("mkfs.ext4", "-q", "-F", "-d", content, dataFile).Run())
+
+	out, err := exec.Command("veritysetup", "format", dataFile, hashFile).Output()
+	assert.NoError(err)
+	match := regexp.MustCompile(`Root hash:\s+([0-9a-f]+)`).FindSubmatch(out)
+	if !assert.NotNil(match) {
+		return
+	}
+	rootHash := string(match[1])
+
+	mountPoint := filepath.Join(dir, "rootfs")
+	assert.NoError(os.Mkdir(mountPoint, testDirMode))
+
+	name := verityDeviceName(mountPoint)
+
+	dataDev, detachData := setupVerityLoopDevice(t, dataFile)
+	hashDev, detachHash := setupVerityLoopDevice(t, hashFile)
+	defer detachHash()
+
+	storage := func() pb.Storage {
+		return pb.Storage{
+			Driver:        driverVerityType,
+			Source:        dataDev,
+			Fstype:        "ext4",
+			MountPoint:    mountPoint,
+			DriverOptions: []string{"hash_device=" + hashDev, "root_hash=" + rootHash},
+		}
+	}
+
+	s := &sandbox{}
+
+	// Wrong root hash
+	wrongStorage := storage()
+	wrongStorage.DriverOptions[1] = "root_hash=" + testRootHash
+	_, err = verityStorageHandler(context.Background(), wrongStorage, s)
+	assert.Error(err)
+	_, err = dmTableStatuses(name)
+	assert.Error(err, "device should have been removed")
+
+	mounted, err := verityStorageHandler(context.Background(), storage(), s)
+	assert.NoError(err)
+	assert.Equal(mountPoint, mounted)
+
+	data, err := ioutil.ReadFile(filepath.Join(mountPoint, "foo"))
+	assert.NoError(err)
+	assert.Equal("bar", string(data))
+
+	assert.Error(ioutil.WriteFile(filepath.Join(mountPoint, "foo"), []byte("baz"), testFileMode))
+
+	// The device goes away with the mount
+	assert.NoError(removeMounts([]string{mountPoint}))
+	_, err = dmTableStatuses(name)
+	assert.Error(err)
+
+	detachData()
+
+	// Tamper with the filesystem superblock
+	f, err := os.OpenFile(dataFile, os.O_WRONLY, 0)
+	assert.NoError(err)
+	_, err = f.WriteAt([]byte("tampered"), 1024)
+	assert.NoError(err)
+	f.Close()
+
+	dataDev, detachData = setupVerityLoopDevice(t, dataFile)
+	defer detachData()
+
+	_, err = verityStorageHandler(context.Background(), storage(), s)
+	assert.Error(err)
+
+	_, err = dmTableStatuses(name)
+	assert.Error(err, "device should have been removed")
+	_, err = os.Stat(filepath.Join(dmDevicesDir, name))
+	assert.True(os.IsNotExist(err))
+}
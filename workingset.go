@@ -0,0 +1,172 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Number of samples averaged by the working set estimate.
+	workingSetWindow = 6
+
+	memoryUsageFileV1 = "memory.usage_in_bytes"
+	memoryUsageFileV2 = "memory.current"
+
+	// memory.stat entries of the inactive file cache, the v1 total one
+	// accounting for the descendant cgroups as well.
+	inactiveFileStatV1 = "total_inactive_file"
+	inactiveFileStatV2 = "inactive_file"
+)
+
+// workingSetSampler keeps the recent samples of a container working set.
+type workingSetSampler struct {
+	sync.Mutex
+	samples [workingSetWindow]uint64
+	count   int
+	next    int
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+func newWorkingSetSampler() *workingSetSampler {
+	return &workingSetSampler{
+		stopCh: make(chan struct{}),
+	}
+}
+
+// add records a sample, replacing the oldest one once the window is full.
+func (w *workingSetSampler) add(sample uint64) {
+	w.Lock()
+	defer w.Unlock()
+
+	w.samples[w.next] = sample
+	w.next = (w.next + 1) % workingSetWindow
+	if w.count < workingSetWindow {
+		w.count++
+	}
+}
+
+// stats returns the last sample and the moving average of the recent ones,
+// or nil before the first sample.
+func (w *workingSetSampler) stats() *pb.WorkingSetStats {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.count == 0 {
+		return nil
+	}
+
+	var sum uint64
+	for i := 0; i < w.count; i++ {
+		sum += w.samples[i]
+	}
+
+	return &pb.WorkingSetStats{
+		Current: w.samples[(w.next+workingSetWindow-1)%workingSetWindow],
+		Average: sum / uint64(w.count),
+		Samples: uint32(w.count),
+	}
+}
+
+func (w *workingSetSampler) stop() {
+	w.stopOnce.Do(func() {
+		close(w.stopCh)
+	})
+}
+
+// run samples the working set of the cgroup until stopped, or until the
+// cgroup goes away.
+func (w *workingSetSampler) run(dir string, cgroupV2 bool, interval time.Duration, log *logrus.Entry) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		sample, err := computeWorkingSet(dir, cgroupV2)
+		if err == nil {
+			w.add(sample)
+		} else if os.IsNotExist(err) {
+			log.WithError(err).Debug("Container cgroup gone, stopping working set sampling")
+			return
+		} else {
+			log.WithError(err).Warn("Could not sample the container working set")
+		}
+
+		select {
+		case <-w.stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// computeWorkingSet estimates the working set of the memory cgroup as its
+// usage minus its inactive file cache, which can be reclaimed first.
+func computeWorkingSet(dir string, cgroupV2 bool) (uint64, error) {
+	usageFile, inactiveFileStat := memoryUsageFileV1, inactiveFileStatV1
+	if cgroupV2 {
+		usageFile, inactiveFileStat = memoryUsageFileV2, inactiveFileStatV2
+	}
+
+	content, err := ioutil.ReadFile(filepath.Join(dir, usageFile))
+	if err != nil {
+		return 0, err
+	}
+
+	usage, err := strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	stat, err := parseMemoryStat(filepath.Join(dir, memoryStatFile))
+	if err != nil {
+		return 0, err
+	}
+
+	inactiveFile := stat[inactiveFileStat]
+	if usage < inactiveFile {
+		return 0, nil
+	}
+
+	return usage - inactiveFile, nil
+}
+
+// startWorkingSetSampling periodically samples the working set of the
+// container, unless disabled.
+func (c *container) startWorkingSetSampling() {
+	if workingSetInterval == 0 {
+		return
+	}
+
+	log := agentLog.WithField("container", c.id)
+
+	cgroupV2 := isCgroupV2()
+	dir, err := c.getMemoryCgroupPath(cgroupV2)
+	if err != nil {
+		log.WithError(err).Warn("Could not sample the container working set")
+		return
+	}
+
+	c.workingSet = newWorkingSetSampler()
+	go c.workingSet.run(dir, cgroupV2, workingSetInterval, log)
+}
+
+func (c *container) stopWorkingSetSampling() {
+	if c.workingSet != nil {
+		c.workingSet.stop()
+	}
+}
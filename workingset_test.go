@@ -0,0 +1,194 @@
+//
+// Copyright (c) 2019 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/kata-containers/agent/protocols/grpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTestWorkingSet(t *testing.T, dir string, cgroupV2 bool, usage, inactiveFile uint64) {
+	usageFile, stat := memoryUsageFileV1, fmt.Sprintf("rss 1024\ninactive_file 1\ntotal_inactive_file %d\n", inactiveFile)
+	if cgroupV2 {
+		usageFile, stat = memoryUsageFileV2, fmt.Sprintf("anon 1024\ninactive_file %d\n", inactiveFile)
+	}
+
+	err := ioutil.WriteFile(filepath.Join(dir, usageFile), []byte(fmt.Sprintf("%d\n", usage)), testFileMode)
+	assert.NoError(t, err)
+
+	err = ioutil.WriteFile(filepath.Join(dir, memoryStatFile), []byte(stat), testFileMode)
+	assert.NoError(t, err)
+}
+
+func TestComputeWorkingSet(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	_, err = computeWorkingSet(dir, false)
+	assert.True(os.IsNotExist(err))
+
+	type testData struct {
+		cgroupV2           bool
+		usage              uint64
+		inactiveFile       uint64
+		expectedWorkingSet uint64
+	}
+
+	data := []testData{
+		{false, 4096, 1024, 3072},
+		{true, 4096, 1024, 3072},
+		{false, 4096, 0, 4096},
+		{true, 1024, 4096, 0},
+	}
+
+	for i, d := range data {
+		writeTestWorkingSet(t, dir, d.cgroupV2, d.usage, d.inactiveFile)
+
+		workingSet, err := computeWorkingSet(dir, d.cgroupV2)
+		assert.NoError(err, "test %d (%+v)", i, d)
+		assert.Equal(d.expectedWorkingSet, workingSet, "test %d (%+v)", i, d)
+	}
+
+	err = ioutil.WriteFile(filepath.Join(dir, memoryUsageFileV2), []byte("foo"), testFileMode)
+	assert.NoError(err)
+	_, err = computeWorkingSet(dir, true)
+	assert.Error(err)
+}
+
+func TestWorkingSetSampler(t *testing.T) {
+	assert := assert.New(t)
+
+	w := newWorkingSetSampler()
+	assert.Nil(w.stats())
+
+	type testData struct {
+		sample   uint64
+		expected pb.WorkingSetStats
+	}
+
+	data := []testData{
+		{600, pb.WorkingSetStats{Current: 600, Average: 600, Samples: 1}},
+		{1200, pb.WorkingSetStats{Current: 1200, Average: 900, Samples: 2}},
+		{0, pb.WorkingSetStats{Current: 0, Average: 600, Samples: 3}},
+		{600, pb.WorkingSetStats{Current: 600, Average: 600, Samples: 4}},
+		{600, pb.WorkingSetStats{Current: 600, Average: 600, Samples: 5}},
+		{600, pb.WorkingSetStats{Current: 600, Average: 600, Samples: 6}},
+		// The oldest samples leave the window
+		{1200, pb.WorkingSetStats{Current: 1200, Average: 700, Samples: 6}},
+		{1800, pb.WorkingSetStats{Current: 1800, Average: 800, Samples: 6}},
+		{1800, pb.WorkingSetStats{Current: 1800, Average: 1100, Samples: 6}},
+	}
+
+	for i, d := range data {
+		w.add(d.sample)
+		assert.Equal(&d.expected, w.stats(), "test %d (%+v)", i, d)
+	}
+}
+
+func TestWorkingSetSampling(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	savedCgroupMemoryPath := cgroupMemoryPath
+	savedIsCgroupV2 := isCgroupV2
+	savedWorkingSetInterval := workingSetInterval
+	defer func() {
+		cgroupMemoryPath = savedCgroupMemoryPath
+		isCgroupV2 = savedIsCgroupV2
+		workingSetInterval = savedWorkingSetInterval
+	}()
+
+	cgroupMemoryPath = dir
+	isCgroupV2 = func() bool {
+		return false
+	}
+
+	containerID := "foo"
+	cgroupDir := filepath.Join(dir, "cgroup", containerID)
+	assert.NoError(os.MkdirAll(cgroupDir, testDirMode))
+
+	ctr := &container{
+		id: containerID,
+		container: &mockContainer{
+			id: containerID,
+		},
+	}
+
+	a := &agentGRPC{
+		sandbox: &sandbox{
+			containers: map[string]*container{
+				containerID: ctr,
+			},
+		},
+	}
+
+	// Disabled
+	workingSetInterval = 0
+	ctr.startWorkingSetSampling()
+	assert.Nil(ctr.workingSet)
+
+	resp, err := a.StatsContainer(context.Background(), &pb.StatsContainerRequest{ContainerId: containerID})
+	assert.NoError(err)
+	assert.Nil(resp.WorkingSet)
+
+	writeTestWorkingSet(t, cgroupDir, false, 8192, 2048)
+
+	workingSetInterval = 10 * time.Millisecond
+	ctr.startWorkingSetSampling()
+	defer ctr.stopWorkingSetSampling()
+
+	waitStats := func(check func(*pb.WorkingSetStats) bool) *pb.WorkingSetStats {
+		for i := 0; i < 500; i++ {
+			resp, err := a.StatsContainer(context.Background(), &pb.StatsContainerRequest{ContainerId: containerID})
+			assert.NoError(err)
+			if resp.WorkingSet != nil && check(resp.WorkingSet) {
+				return resp.WorkingSet
+			}
+			time.Sleep(workingSetInterval)
+		}
+		return nil
+	}
+
+	stats := waitStats(func(s *pb.WorkingSetStats) bool {
+		return s.Samples >= 1
+	})
+	if assert.NotNil(stats) {
+		assert.Equal(uint64(6144), stats.Current)
+		assert.Equal(uint64(6144), stats.Average)
+	}
+
+	// The average smoothes the change
+	writeTestWorkingSet(t, cgroupDir, false, 14336, 2048)
+
+	stats = waitStats(func(s *pb.WorkingSetStats) bool {
+		return s.Current == 12288
+	})
+	if assert.NotNil(stats) {
+		assert.True(stats.Average > 6144 && stats.Average <= 12288)
+	}
+
+	stats = waitStats(func(s *pb.WorkingSetStats) bool {
+		return s.Average == 12288
+	})
+	if assert.NotNil(stats) {
+		assert.Equal(uint32(workingSetWindow), stats.Samples)
+	}
+}